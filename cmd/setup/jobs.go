@@ -3,7 +3,12 @@ package setup
 import (
 	"fmt"
 	"github.com/MarlonG1/api-facturacion-sv/config/drivers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation"
+	domainJobs "github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
 	"github.com/go-co-op/gocron"
 	"time"
 
@@ -12,66 +17,153 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 )
 
-type JobConfig struct {
-	StartTime   string
-	EndTime     string
-	Interval    int
-	Environment string
-}
+// CertificateExpiryJobTime es la hora (UTC) en la que se revisa diariamente el vencimiento de los certificados
+const CertificateExpiryJobTime = "12:00"
+
+// MailDeliveryJobInterval es el intervalo en minutos en el que se reintentan los envíos de DTE y de eventos por correo
+const MailDeliveryJobInterval = 1
+
+// WebhookDeliveryJobInterval es el intervalo en minutos en el que se reintentan las entregas de webhooks
+const WebhookDeliveryJobInterval = 1
+
+// ReconciliationJobInterval es el intervalo en minutos en el que se concilia el estado de los documentos con Hacienda
+const ReconciliationJobInterval = 15
 
-func SetupJobs(contingencyService contingency.ContingencyManager, ambientCode string, connection *drivers.DbConnection) error {
+// OutboxJobInterval es el intervalo en minutos en el que se completan los documentos pendientes del outbox
+const OutboxJobInterval = 1
+
+// Nombres con los que los jobs se muestran en el estado de los jobs
+const (
+	CertificateExpiryJobName = "certificate_expiry"
+	MailDeliveryJobName      = "mail_delivery"
+	WebhookDeliveryJobName   = "webhook_delivery"
+	ReconciliationJobName    = "reconciliation"
+	OutboxJobName            = "dte_outbox"
+)
+
+func SetupJobs(contingencyService contingency.ContingencyManager, scheduleManager contingency.RetransmissionScheduleManager, certificateService certificate.CertificateManager, notifier ports.DTENotifier, eventNotifier ports.NotificationRetrier, webhookManager webhook.WebhookManager, reconciliationManager reconciliation.ReconciliationManager, outboxProcessor ports.DTEOutboxProcessor, monitor domainJobs.JobMonitor, connection *drivers.DbConnection) error {
 	scheduler := gocron.NewScheduler(time.UTC)
-	job := jobs.NewRetransmissionJob(contingencyService, connection)
-
-	// Configuración segun ambiente
-	var jobConfig JobConfig
-	if ambientCode == "01" {
-		jobConfig = JobConfig{
-			StartTime:   "22:00",
-			EndTime:     "05:00",
-			Interval:    30,
-			Environment: "production",
-		}
-	} else {
-		jobConfig = JobConfig{
-			StartTime:   "08:00",
-			EndTime:     "17:00",
-			Interval:    30,
-			Environment: "testing",
-		}
-	}
 
-	if err := ScheduleContingencyJob(scheduler, job, jobConfig); err != nil {
+	if err := ScheduleContingencyJobs(contingencyService, scheduleManager, monitor, connection); err != nil {
 		logs.Error("Failed to setup contingency job", map[string]interface{}{
-			"error":  err.Error(),
-			"config": jobConfig,
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if err := ScheduleCertificateExpiryJob(scheduler, monitor, jobs.NewCertificateExpiryJob(certificateService)); err != nil {
+		logs.Error("Failed to setup certificate expiry job", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if err := ScheduleMailDeliveryJob(scheduler, monitor, jobs.NewMailDeliveryJob(notifier, eventNotifier)); err != nil {
+		logs.Error("Failed to setup mail delivery job", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if err := ScheduleWebhookDeliveryJob(scheduler, monitor, jobs.NewWebhookDeliveryJob(webhookManager)); err != nil {
+		logs.Error("Failed to setup webhook delivery job", map[string]interface{}{
+			"error": err.Error(),
 		})
 		return err
 	}
 
+	if err := ScheduleReconciliationJob(scheduler, monitor, jobs.NewReconciliationJob(reconciliationManager)); err != nil {
+		logs.Error("Failed to setup reconciliation job", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if err := ScheduleOutboxJob(scheduler, monitor, jobs.NewOutboxJob(outboxProcessor)); err != nil {
+		logs.Error("Failed to setup outbox job", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	defaults := scheduleManager.Default()
 	logs.Info("Jobs scheduled successfully", map[string]interface{}{
-		"environment": jobConfig.Environment,
-		"startTime":   jobConfig.StartTime,
-		"endTime":     jobConfig.EndTime,
-		"interval":    jobConfig.Interval,
+		"retransmissionCron":    defaults.Cron,
+		"retransmissionWindows": defaults.Windows,
+		"timezone":              utils.TimeLocation().String(),
 	})
 
 	scheduler.StartAsync()
 	return nil
 }
 
-func ScheduleContingencyJob(scheduler *gocron.Scheduler, job *jobs.RetransmissionJob, config JobConfig) error {
-	_, err := scheduler.Every(config.Interval).Minutes().Do(func() {
-		logs.Info("Starting contingency job execution", map[string]interface{}{
-			"environment": config.Environment,
-			"timestamp":   utils.TimeNow().Format(time.RFC3339),
+// ScheduleContingencyJobs programa el job de retransmisión predeterminado y los jobs de los usuarios con programación
+// propia. Los cambios de programación se aplican en el mismo programador y cada réplica sincroniza los recibidos por
+// las demás.
+func ScheduleContingencyJobs(contingencyService contingency.ContingencyManager, scheduleManager contingency.RetransmissionScheduleManager, monitor domainJobs.JobMonitor, connection *drivers.DbConnection) error {
+	retransmissionScheduler := jobs.NewRetransmissionScheduler(contingencyService, connection, monitor)
+	scheduleManager.AttachScheduler(retransmissionScheduler)
+
+	if err := retransmissionScheduler.Start(scheduleManager.Default(), scheduleManager.ListCustom); err != nil {
+		return fmt.Errorf("failed to schedule contingency job: %w", err)
+	}
+
+	return nil
+}
+
+func ScheduleCertificateExpiryJob(scheduler *gocron.Scheduler, monitor domainJobs.JobMonitor, job *jobs.CertificateExpiryJob) error {
+	run := monitor.Track(CertificateExpiryJobName, job.Execute)
+	scheduled, err := scheduler.Every(1).Day().At(CertificateExpiryJobTime).Do(func() {
+		logs.Info("Starting certificate expiry job execution", map[string]interface{}{
+			"timestamp": utils.TimeNow().Format(time.RFC3339),
 		})
-		job.Execute()
+		run()
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to schedule contingency job: %w", err)
+		return fmt.Errorf("failed to schedule certificate expiry job: %w", err)
+	}
+
+	monitor.Register(CertificateExpiryJobName, fmt.Sprintf("daily at %s UTC", CertificateExpiryJobTime), scheduled.NextRun)
+	return nil
+}
+
+func ScheduleMailDeliveryJob(scheduler *gocron.Scheduler, monitor domainJobs.JobMonitor, job *jobs.MailDeliveryJob) error {
+	scheduled, err := scheduler.Every(MailDeliveryJobInterval).Minutes().Do(monitor.Track(MailDeliveryJobName, job.Execute))
+	if err != nil {
+		return fmt.Errorf("failed to schedule mail delivery job: %w", err)
+	}
+
+	monitor.Register(MailDeliveryJobName, fmt.Sprintf("every %d minute", MailDeliveryJobInterval), scheduled.NextRun)
+	return nil
+}
+
+func ScheduleWebhookDeliveryJob(scheduler *gocron.Scheduler, monitor domainJobs.JobMonitor, job *jobs.WebhookDeliveryJob) error {
+	scheduled, err := scheduler.Every(WebhookDeliveryJobInterval).Minutes().Do(monitor.Track(WebhookDeliveryJobName, job.Execute))
+	if err != nil {
+		return fmt.Errorf("failed to schedule webhook delivery job: %w", err)
+	}
+
+	monitor.Register(WebhookDeliveryJobName, fmt.Sprintf("every %d minute", WebhookDeliveryJobInterval), scheduled.NextRun)
+	return nil
+}
+
+func ScheduleReconciliationJob(scheduler *gocron.Scheduler, monitor domainJobs.JobMonitor, job *jobs.ReconciliationJob) error {
+	scheduled, err := scheduler.Every(ReconciliationJobInterval).Minutes().Do(monitor.Track(ReconciliationJobName, job.Execute))
+	if err != nil {
+		return fmt.Errorf("failed to schedule reconciliation job: %w", err)
+	}
+
+	monitor.Register(ReconciliationJobName, fmt.Sprintf("every %d minutes", ReconciliationJobInterval), scheduled.NextRun)
+	return nil
+}
+
+func ScheduleOutboxJob(scheduler *gocron.Scheduler, monitor domainJobs.JobMonitor, job *jobs.OutboxJob) error {
+	scheduled, err := scheduler.Every(OutboxJobInterval).Minutes().Do(monitor.Track(OutboxJobName, job.Execute))
+	if err != nil {
+		return fmt.Errorf("failed to schedule outbox job: %w", err)
 	}
 
+	monitor.Register(OutboxJobName, fmt.Sprintf("every %d minute", OutboxJobInterval), scheduled.NextRun)
 	return nil
 }
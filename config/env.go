@@ -5,6 +5,7 @@ import (
 	"fmt"
 	errPackage "github.com/MarlonG1/api-facturacion-sv/config/error"
 	"github.com/spf13/viper"
+	"math"
 	"reflect"
 	"regexp"
 	"strings"
@@ -101,6 +102,18 @@ var (
 	//   - 192.168.1          (falta un octeto)
 	//   - 192.168.1.1.1      (demasiados octetos)
 	HostPattern = "^(localhost|((25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)\\.){3}(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)|((?:[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?\\.)+[a-zA-Z]{2,}))$"
+
+	// TimeWindowsPattern es un regex para validar una lista de ventanas horarias separadas por comas, cada una con
+	// el formato HH:MM-HH:MM en formato de 24 horas. Una ventana cuyo fin es anterior a su inicio cruza la medianoche.
+	// Casos válidos:
+	//   - 08:00-17:00
+	//   - 22:00-05:00
+	//   - 06:00-09:00,13:00-15:30
+	// Casos inválidos:
+	//   - 8:00-17:00 (La hora debe tener dos dígitos)
+	//   - 24:00-05:00 (Hora fuera de rango)
+	//   - 08:00 (Falta el fin de la ventana)
+	TimeWindowsPattern = "^([01]\\d|2[0-3]):[0-5]\\d-([01]\\d|2[0-3]):[0-5]\\d(,([01]\\d|2[0-3]):[0-5]\\d-([01]\\d|2[0-3]):[0-5]\\d)*$"
 )
 
 var (
@@ -111,8 +124,61 @@ var (
 		"mysql":    true,
 		"postgres": true,
 	}
+
+	// AvailableSignerModes contiene los modos de firma soportados.
+	//   - external: firma mediante el servicio firmador externo (SIGNER_PATH y SIGNER_HEALTH).
+	//   - native: firma en el mismo proceso con los certificados de SIGNER_CERTIFICATES_PATH.
+	AvailableSignerModes = map[string]bool{
+		SignerModeExternal: true,
+		SignerModeNative:   true,
+	}
 )
 
+const (
+	SignerModeExternal = "external"
+	SignerModeNative   = "native"
+)
+
+const (
+	DefaultSMTPPort        = "587"
+	DefaultMailMaxAttempts = 5
+)
+
+const (
+	DefaultEmissionWorkers     = 4
+	DefaultEmissionMaxAttempts = 5
+)
+
+const DefaultWebhookMaxAttempts = 8
+
+const (
+	DefaultRetransmissionCron                = "*/30 * * * *"
+	DefaultRetransmissionMaxExecutionMinutes = 10
+	// DefaultProductionRetransmissionWindows y DefaultTestingRetransmissionWindows son las ventanas horarias
+	// predeterminadas de la retransmisión según el ambiente de Hacienda
+	DefaultProductionRetransmissionWindows = "22:00-05:00"
+	DefaultTestingRetransmissionWindows    = "08:00-17:00"
+)
+
+const (
+	DefaultTransmissionMaxAttempts          = 3
+	DefaultTransmissionRetryIntervalSeconds = 5
+	DefaultTransmissionMaxIntervalSeconds   = 120
+	DefaultTransmissionBackoffFactor        = 2.0
+	DefaultTransmissionJitter               = 0.2
+	DefaultCircuitThreshold                 = 5
+	DefaultCircuitResetSeconds              = 60
+)
+
+// La transmisión completa, con todos sus reintentos, debe terminar antes de que expiren la reserva de la emisión y la
+// clave de idempotencia (5 minutos) y antes de que el outbox la considere interrumpida (30 minutos)
+const (
+	TransmissionRequestTimeoutSeconds = 30
+	MaxTransmissionDurationSeconds    = 240
+)
+
+const DefaultCredentialsGracePeriodMinutes = 60
+
 var EnvConfig *envConfig
 var Server *server
 var Database *database
@@ -120,6 +186,12 @@ var Redis *redis
 var Log *log
 var Signer *signer
 var MHPaths *mhPaths
+var Mail *mail
+var Emission *emission
+var Webhook *webhook
+var Retransmission *retransmission
+var Transmission *transmission
+var Credentials *credentials
 
 // InitEnvTesting inicializa la configuración del entorno de pruebas
 func InitEnvTesting() {
@@ -130,6 +202,12 @@ func InitEnvTesting() {
 	Log = &EnvConfig.Log
 	Signer = &EnvConfig.Signer
 	MHPaths = &EnvConfig.MHPaths
+	Mail = &EnvConfig.Mail
+	Emission = &EnvConfig.Emission
+	Webhook = &EnvConfig.Webhook
+	Retransmission = &EnvConfig.Retransmission
+	Transmission = &EnvConfig.Transmission
+	Credentials = &EnvConfig.Credentials
 
 	// Configurar a modo de prueba
 	Server.AmbientCode = "00"
@@ -177,6 +255,12 @@ func InitEnvConfig(rootPath string) error {
 	Log = &EnvConfig.Log
 	Signer = &EnvConfig.Signer
 	MHPaths = &EnvConfig.MHPaths
+	Mail = &EnvConfig.Mail
+	Emission = &EnvConfig.Emission
+	Webhook = &EnvConfig.Webhook
+	Retransmission = &EnvConfig.Retransmission
+	Transmission = &EnvConfig.Transmission
+	Credentials = &EnvConfig.Credentials
 
 	return nil
 }
@@ -203,7 +287,31 @@ func ValidateConfig() error {
 		return err
 	}
 
-	if err := validateSignerFields(); err != nil {
+	if err := ValidateSignerFields(); err != nil {
+		return err
+	}
+
+	if err := validateMailFields(); err != nil {
+		return err
+	}
+
+	if err := validateEmissionFields(); err != nil {
+		return err
+	}
+
+	if err := validateWebhookFields(); err != nil {
+		return err
+	}
+
+	if err := validateRetransmissionFields(); err != nil {
+		return err
+	}
+
+	if err := validateTransmissionFields(); err != nil {
+		return err
+	}
+
+	if err := validateCredentialsFields(); err != nil {
 		return err
 	}
 
@@ -311,23 +419,250 @@ func validateMHConfigFields() error {
 	return nil
 }
 
-// validateSignerFields valida los campos de la estructura Signer
-func validateSignerFields() error {
-	v := reflect.ValueOf(EnvConfig.Signer)
+// ValidateSignerFields valida los campos de la estructura Signer según el modo de firma configurado.
+// Si SIGNER_MODE no se define, se asume el modo externo para mantener la configuración existente.
+func ValidateSignerFields() error {
+	if EnvConfig.Signer.Mode == "" {
+		EnvConfig.Signer.Mode = SignerModeExternal
+	}
+
+	if !AvailableSignerModes[EnvConfig.Signer.Mode] {
+		return fmt.Errorf("SIGNER_MODE must be a valid mode")
+	}
+
+	// SIGNER_CERTIFICATES_KEY es opcional, pero si se define debe ser lo suficientemente larga para cifrar las llaves privadas
+	if EnvConfig.Signer.CertificatesKey != "" && len(EnvConfig.Signer.CertificatesKey) < 32 {
+		return fmt.Errorf("SIGNER_CERTIFICATES_KEY must have at least 32 characters")
+	}
+
+	if EnvConfig.Signer.Mode == SignerModeNative {
+		if EnvConfig.Signer.CertificatesPath == "" {
+			return fmt.Errorf("SIGNER_CERTIFICATES_PATH is required")
+		}
+		return nil
+	}
+
+	urls := []struct{ name, value string }{
+		{"SIGNER_PATH", EnvConfig.Signer.Path},
+		{"SIGNER_HEALTH", EnvConfig.Signer.Health},
+	}
+	for _, u := range urls {
+		if u.value == "" {
+			return fmt.Errorf("%s is required", u.name)
+		}
+
+		if !matchPattern(URLPattern, u.value) {
+			return fmt.Errorf("%s must be a valid URL", u.name)
+		}
+	}
+
+	return nil
+}
+
+// validateMailFields valida los campos de la estructura Mail. La configuración es opcional,
+// pero si se define SMTP_HOST también se requiere SMTP_FROM.
+func validateMailFields() error {
+	if EnvConfig.Mail.Host == "" {
+		return nil
+	}
 
-	if err := validateEnvVariables(v, nil, nil); err != nil {
-		return err
+	if !matchPattern(HostPattern, EnvConfig.Mail.Host) {
+		return fmt.Errorf("SMTP_HOST must be a valid host")
 	}
 
-	for i := 0; i < v.NumField(); i++ {
-		t := v.Type()
-		f := v.Field(i)
+	if EnvConfig.Mail.Port == "" {
+		EnvConfig.Mail.Port = DefaultSMTPPort
+	}
 
-		if !matchPattern(URLPattern, f.String()) {
-			return fmt.Errorf("%s must be a valid URL", strings.ToUpper(t.Field(i).Name))
+	if !matchPattern(PortPattern, EnvConfig.Mail.Port) {
+		return fmt.Errorf("SMTP_PORT must be a valid port")
+	}
+
+	if EnvConfig.Mail.From == "" {
+		return fmt.Errorf("SMTP_FROM is required")
+	}
+
+	if EnvConfig.Mail.MaxAttempts == 0 {
+		EnvConfig.Mail.MaxAttempts = DefaultMailMaxAttempts
+	}
+
+	if EnvConfig.Mail.MaxAttempts < 1 || EnvConfig.Mail.MaxAttempts > 10 {
+		return fmt.Errorf("SMTP_MAX_ATTEMPTS must be between 1 and 10")
+	}
+
+	return nil
+}
+
+// validateEmissionFields valida los campos de la estructura Emission y asigna los valores por defecto
+func validateEmissionFields() error {
+	if EnvConfig.Emission.Workers == 0 {
+		EnvConfig.Emission.Workers = DefaultEmissionWorkers
+	}
+
+	if EnvConfig.Emission.Workers < 1 || EnvConfig.Emission.Workers > 32 {
+		return fmt.Errorf("EMISSION_WORKERS must be between 1 and 32")
+	}
+
+	if EnvConfig.Emission.MaxAttempts == 0 {
+		EnvConfig.Emission.MaxAttempts = DefaultEmissionMaxAttempts
+	}
+
+	if EnvConfig.Emission.MaxAttempts < 1 || EnvConfig.Emission.MaxAttempts > 10 {
+		return fmt.Errorf("EMISSION_MAX_ATTEMPTS must be between 1 and 10")
+	}
+
+	return nil
+}
+
+// validateWebhookFields valida los campos de la estructura Webhook y asigna los valores por defecto
+func validateWebhookFields() error {
+	if EnvConfig.Webhook.MaxAttempts == 0 {
+		EnvConfig.Webhook.MaxAttempts = DefaultWebhookMaxAttempts
+	}
+
+	if EnvConfig.Webhook.MaxAttempts < 1 || EnvConfig.Webhook.MaxAttempts > 20 {
+		return fmt.Errorf("WEBHOOK_MAX_ATTEMPTS must be between 1 and 20")
+	}
+
+	// WEBHOOK_SECRETS_KEY cifra los secretos de firma de las suscripciones, es independiente de JWT_SECRET para que
+	// rotar la firma de los tokens no impida descifrarlos
+	if len(EnvConfig.Webhook.SecretsKey) < 32 {
+		return fmt.Errorf("WEBHOOK_SECRETS_KEY must have at least 32 characters")
+	}
+
+	if EnvConfig.Webhook.SecretsKey == EnvConfig.Server.JWTSecret {
+		return fmt.Errorf("WEBHOOK_SECRETS_KEY must be different from JWT_SECRET")
+	}
+
+	return nil
+}
+
+// validateRetransmissionFields valida los campos de la estructura Retransmission y asigna los valores por defecto.
+// Las ventanas horarias predeterminadas dependen del ambiente y el tamaño de lote no puede superar MH_MAX_BATCH_SIZE.
+// La expresión cron se valida al programar el job.
+func validateRetransmissionFields() error {
+	if EnvConfig.Retransmission.Cron == "" {
+		EnvConfig.Retransmission.Cron = DefaultRetransmissionCron
+	}
+
+	if EnvConfig.Retransmission.Windows == "" {
+		EnvConfig.Retransmission.Windows = DefaultTestingRetransmissionWindows
+		if EnvConfig.Server.AmbientCode == "01" {
+			EnvConfig.Retransmission.Windows = DefaultProductionRetransmissionWindows
 		}
 	}
 
+	if !matchPattern(TimeWindowsPattern, EnvConfig.Retransmission.Windows) {
+		return fmt.Errorf("RETRANSMISSION_WINDOWS must be a comma separated list of HH:MM-HH:MM windows")
+	}
+
+	if EnvConfig.Retransmission.MaxExecutionMinutes == 0 {
+		EnvConfig.Retransmission.MaxExecutionMinutes = DefaultRetransmissionMaxExecutionMinutes
+	}
+
+	if EnvConfig.Retransmission.MaxExecutionMinutes < 1 || EnvConfig.Retransmission.MaxExecutionMinutes > 120 {
+		return fmt.Errorf("RETRANSMISSION_MAX_EXECUTION_MINUTES must be between 1 and 120")
+	}
+
+	if EnvConfig.Retransmission.BatchSize == 0 {
+		EnvConfig.Retransmission.BatchSize = EnvConfig.Server.MaxBatchSize
+	}
+
+	if EnvConfig.Retransmission.BatchSize < 1 || EnvConfig.Retransmission.BatchSize > EnvConfig.Server.MaxBatchSize {
+		return fmt.Errorf("RETRANSMISSION_BATCH_SIZE must be between 1 and MH_MAX_BATCH_SIZE")
+	}
+
+	return nil
+}
+
+// validateTransmissionFields valida los campos de la estructura Transmission y asigna los valores por defecto
+func validateTransmissionFields() error {
+	if EnvConfig.Transmission.MaxAttempts == 0 {
+		EnvConfig.Transmission.MaxAttempts = DefaultTransmissionMaxAttempts
+	}
+
+	if EnvConfig.Transmission.MaxAttempts < 1 || EnvConfig.Transmission.MaxAttempts > 10 {
+		return fmt.Errorf("TRANSMISSION_MAX_ATTEMPTS must be between 1 and 10")
+	}
+
+	if EnvConfig.Transmission.RetryIntervalSeconds == 0 {
+		EnvConfig.Transmission.RetryIntervalSeconds = DefaultTransmissionRetryIntervalSeconds
+	}
+
+	if EnvConfig.Transmission.MaxIntervalSeconds == 0 {
+		EnvConfig.Transmission.MaxIntervalSeconds = DefaultTransmissionMaxIntervalSeconds
+	}
+
+	if EnvConfig.Transmission.RetryIntervalSeconds < 1 || EnvConfig.Transmission.MaxIntervalSeconds > 600 ||
+		EnvConfig.Transmission.RetryIntervalSeconds > EnvConfig.Transmission.MaxIntervalSeconds {
+		return fmt.Errorf("TRANSMISSION_RETRY_INTERVAL_SECONDS must be at least 1 and not exceed TRANSMISSION_MAX_INTERVAL_SECONDS, which must be at most 600")
+	}
+
+	if EnvConfig.Transmission.BackoffFactor == 0 {
+		EnvConfig.Transmission.BackoffFactor = DefaultTransmissionBackoffFactor
+	}
+
+	if EnvConfig.Transmission.BackoffFactor < 1 || EnvConfig.Transmission.BackoffFactor > 10 {
+		return fmt.Errorf("TRANSMISSION_BACKOFF_FACTOR must be between 1 and 10")
+	}
+
+	if EnvConfig.Transmission.Jitter == 0 {
+		EnvConfig.Transmission.Jitter = DefaultTransmissionJitter
+	}
+
+	if EnvConfig.Transmission.Jitter < 0 || EnvConfig.Transmission.Jitter > 1 {
+		return fmt.Errorf("TRANSMISSION_JITTER must be between 0 and 1")
+	}
+
+	if worst := transmissionWorstCaseSeconds(); worst > MaxTransmissionDurationSeconds {
+		return fmt.Errorf("transmission retry policy may take up to %.0f seconds, which exceeds the maximum of %d seconds; "+
+			"reduce TRANSMISSION_MAX_ATTEMPTS or the retry intervals", worst, MaxTransmissionDurationSeconds)
+	}
+
+	if EnvConfig.Transmission.CircuitThreshold == 0 {
+		EnvConfig.Transmission.CircuitThreshold = DefaultCircuitThreshold
+	}
+
+	if EnvConfig.Transmission.CircuitThreshold < 1 || EnvConfig.Transmission.CircuitThreshold > 100 {
+		return fmt.Errorf("CIRCUIT_FAILURE_THRESHOLD must be between 1 and 100")
+	}
+
+	if EnvConfig.Transmission.CircuitResetSeconds == 0 {
+		EnvConfig.Transmission.CircuitResetSeconds = DefaultCircuitResetSeconds
+	}
+
+	if EnvConfig.Transmission.CircuitResetSeconds < 5 || EnvConfig.Transmission.CircuitResetSeconds > 3600 {
+		return fmt.Errorf("CIRCUIT_RESET_SECONDS must be between 5 and 3600")
+	}
+
+	return nil
+}
+
+// transmissionWorstCaseSeconds calcula la duración máxima de una transmisión con la política de reintentos configurada:
+// cada intento agota el timeout de la petición y cada reintento espera el intervalo máximo con jitter y consulta el estado
+func transmissionWorstCaseSeconds() float64 {
+	t := EnvConfig.Transmission
+	total := float64(t.MaxAttempts * TransmissionRequestTimeoutSeconds)
+
+	interval := float64(t.RetryIntervalSeconds)
+	for retry := 1; retry < t.MaxAttempts; retry++ {
+		total += math.Min(interval, float64(t.MaxIntervalSeconds))*(1+t.Jitter) + TransmissionRequestTimeoutSeconds
+		interval *= t.BackoffFactor
+	}
+
+	return total
+}
+
+// validateCredentialsFields valida los campos de la estructura Credentials y asigna los valores por defecto
+func validateCredentialsFields() error {
+	if EnvConfig.Credentials.GracePeriodMinutes == 0 {
+		EnvConfig.Credentials.GracePeriodMinutes = DefaultCredentialsGracePeriodMinutes
+	}
+
+	if EnvConfig.Credentials.GracePeriodMinutes < 1 || EnvConfig.Credentials.GracePeriodMinutes > 10080 {
+		return fmt.Errorf("CREDENTIALS_GRACE_PERIOD_MINUTES must be between 1 and 10080")
+	}
+
 	return nil
 }
 
@@ -2,12 +2,18 @@ package config
 
 // envConfig es una estructura que contiene la configuración del archivo .env
 type envConfig struct {
-	Server   server
-	Database database
-	Redis    redis
-	Log      log
-	Signer   signer
-	MHPaths  mhPaths
+	Server         server
+	Database       database
+	Redis          redis
+	Log            log
+	Signer         signer
+	MHPaths        mhPaths
+	Mail           mail
+	Emission       emission
+	Webhook        webhook
+	Retransmission retransmission
+	Transmission   transmission
+	Credentials    credentials
 }
 
 // server es una estructura que contiene la configuración del servidor
@@ -50,8 +56,64 @@ type log struct {
 
 // signer es una estructura que contiene la configuración del firmante
 type signer struct {
-	Path   string `map-structure:"SIGNER_PATH"`
-	Health string `map-structure:"SIGNER_HEALTH"`
+	Mode             string `map-structure:"SIGNER_MODE"`
+	Path             string `map-structure:"SIGNER_PATH"`
+	Health           string `map-structure:"SIGNER_HEALTH"`
+	CertificatesPath string `map-structure:"SIGNER_CERTIFICATES_PATH"`
+	CertificatesKey  string `map-structure:"SIGNER_CERTIFICATES_KEY"`
+}
+
+// mail es una estructura que contiene la configuración del servidor SMTP para el envío de DTE a los receptores.
+// Si SMTP_HOST no se define, el envío de correos se deshabilita.
+type mail struct {
+	Host        string `map-structure:"SMTP_HOST"`
+	Port        string `map-structure:"SMTP_PORT"`
+	Username    string `map-structure:"SMTP_USERNAME"`
+	Password    string `map-structure:"SMTP_PASSWORD"`
+	From        string `map-structure:"SMTP_FROM"`
+	FromName    string `map-structure:"SMTP_FROM_NAME"`
+	MaxAttempts int    `map-structure:"SMTP_MAX_ATTEMPTS"`
+}
+
+// emission es una estructura que contiene la configuración de la emisión asíncrona de DTE
+type emission struct {
+	Workers     int `map-structure:"EMISSION_WORKERS"`
+	MaxAttempts int `map-structure:"EMISSION_MAX_ATTEMPTS"`
+}
+
+// webhook es una estructura que contiene la configuración del envío de webhooks a los usuarios
+type webhook struct {
+	MaxAttempts          int    `map-structure:"WEBHOOK_MAX_ATTEMPTS"`
+	AllowPrivateNetworks bool   `map-structure:"WEBHOOK_ALLOW_PRIVATE_NETWORKS"`
+	SecretsKey           string `map-structure:"WEBHOOK_SECRETS_KEY"`
+}
+
+// retransmission es una estructura que contiene la programación predeterminada del job de retransmisión de
+// documentos en contingencia. Cada usuario puede sobrescribirla con su propia programación.
+type retransmission struct {
+	Cron                string `map-structure:"RETRANSMISSION_CRON"`
+	Windows             string `map-structure:"RETRANSMISSION_WINDOWS"`
+	MaxExecutionMinutes int    `map-structure:"RETRANSMISSION_MAX_EXECUTION_MINUTES"`
+	BatchSize           int    `map-structure:"RETRANSMISSION_BATCH_SIZE"`
+}
+
+// transmission es una estructura que contiene la política de reintentos de las transmisiones a Hacienda y la
+// configuración de los circuit breakers de Hacienda y del firmador
+type transmission struct {
+	MaxAttempts          int     `map-structure:"TRANSMISSION_MAX_ATTEMPTS"`
+	RetryIntervalSeconds int     `map-structure:"TRANSMISSION_RETRY_INTERVAL_SECONDS"`
+	MaxIntervalSeconds   int     `map-structure:"TRANSMISSION_MAX_INTERVAL_SECONDS"`
+	BackoffFactor        float64 `map-structure:"TRANSMISSION_BACKOFF_FACTOR"`
+	Jitter               float64 `map-structure:"TRANSMISSION_JITTER"`
+	CircuitThreshold     int     `map-structure:"CIRCUIT_FAILURE_THRESHOLD"`
+	CircuitResetSeconds  int     `map-structure:"CIRCUIT_RESET_SECONDS"`
+}
+
+// credentials es una estructura que contiene la configuración de la rotación de las credenciales de las sucursales.
+// GracePeriodMinutes es el tiempo predeterminado y máximo durante el que las credenciales anteriores siguen siendo
+// válidas después de una rotación.
+type credentials struct {
+	GracePeriodMinutes int `map-structure:"CREDENTIALS_GRACE_PERIOD_MINUTES"`
 }
 
 // mhPaths es una estructura que contiene las rutas de los servicios de MH
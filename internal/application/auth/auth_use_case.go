@@ -4,7 +4,9 @@ import (
 	"context"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
@@ -13,12 +15,14 @@ import (
 type AuthUseCase struct {
 	authManager  auth.AuthManager
 	cryptManager ports.CryptManager
+	events       events.EventPublisher
 }
 
-func NewAuthUseCase(authManager auth.AuthManager, cryptManager ports.CryptManager) *AuthUseCase {
+func NewAuthUseCase(authManager auth.AuthManager, cryptManager ports.CryptManager, eventPublisher events.EventPublisher) *AuthUseCase {
 	return &AuthUseCase{
 		authManager:  authManager,
 		cryptManager: cryptManager,
+		events:       eventPublisher,
 	}
 }
 
@@ -52,8 +56,13 @@ func (a *AuthUseCase) Register(ctx context.Context, user *user.User) ([]user.Lis
 		return nil, shared_error.NewFormattedGeneralServiceError("AuthUseCase", "Register", "FailedToCreateUser")
 	}
 
-	// 3. Asignar las API KEYS y API SECRETS a las sucursales del usuario
+	// 3. Asignar las API KEYS y API SECRETS a las sucursales del usuario, los API SECRETS se almacenan como hash y
+	// solo se retornan en texto plano en esta respuesta
 	user.SetBranchesKeysAndSecrets(keys, secrets)
+	branches := user.ListBranches()
+	for i := range user.BranchOffices {
+		user.BranchOffices[i].APISecret = a.cryptManager.HashSecret(secrets[i])
+	}
 
 	//4. Crear el usuario en la base de datos
 	if err = a.authManager.Create(ctx, user); err != nil {
@@ -63,5 +72,17 @@ func (a *AuthUseCase) Register(ctx context.Context, user *user.User) ([]user.Lis
 		return nil, shared_error.NewFormattedGeneralServiceWithError("AuthUseCase", "Register", err, "FailedToCreateUser")
 	}
 
-	return user.ListBranches(), nil
+	// 5. Publicar el registro del usuario en su casa matriz
+	if a.events != nil {
+		if matrix, err := user.GetBranchOfficeMatrix(); err == nil {
+			a.events.Publish(ctx, matrix.ID, event.UserRegistered, event.UserRegisteredPayload{
+				NIT:          user.NIT,
+				BusinessName: user.Business,
+				Email:        user.Email,
+				Branches:     len(user.BranchOffices),
+			})
+		}
+	}
+
+	return branches, nil
 }
@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type BranchUseCase struct {
+	branchManager auth.BranchManager
+	gracePeriod   time.Duration
+}
+
+// NewBranchUseCase crea el caso de uso de administración de sucursales. gracePeriod es el tiempo predeterminado y
+// máximo durante el que las credenciales anteriores a una rotación siguen siendo válidas.
+func NewBranchUseCase(branchManager auth.BranchManager, gracePeriod time.Duration) *BranchUseCase {
+	return &BranchUseCase{
+		branchManager: branchManager,
+		gracePeriod:   gracePeriod,
+	}
+}
+
+// List obtiene las sucursales del usuario autenticado
+func (u *BranchUseCase) List(ctx context.Context) ([]user.BranchOfficeResponse, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 1. Obtener las sucursales
+	branches, err := u.branchManager.ListBranches(ctx, claims.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]user.BranchOfficeResponse, len(branches))
+	for i := range branches {
+		response[i] = branches[i].ToResponse()
+	}
+
+	return response, nil
+}
+
+// Create registra una sucursal del usuario autenticado y retorna sus credenciales
+func (u *BranchUseCase) Create(ctx context.Context, req *structs.BranchOfficeRequest) (*user.BranchOfficeResponse, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 1. Registrar la sucursal
+	branch, err := u.branchManager.CreateBranch(ctx, claims.ClientID, mapBranchInput(req))
+	if err != nil {
+		return nil, err
+	}
+
+	response := branch.ToResponse()
+	response.APISecret = branch.APISecret
+	return &response, nil
+}
+
+// Update modifica una sucursal del usuario autenticado
+func (u *BranchUseCase) Update(ctx context.Context, id string, req *structs.BranchOfficeRequest) (*user.BranchOfficeResponse, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 1. Validar el identificador de la sucursal
+	branchID, err := parseBranchID("Update", id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Modificar la sucursal
+	branch, err := u.branchManager.UpdateBranch(ctx, claims.ClientID, branchID, mapBranchInput(req))
+	if err != nil {
+		return nil, err
+	}
+
+	response := branch.ToResponse()
+	return &response, nil
+}
+
+// SetStatus activa o desactiva una sucursal del usuario autenticado
+func (u *BranchUseCase) SetStatus(ctx context.Context, id string, active bool) (*user.BranchOfficeResponse, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 1. Validar el identificador de la sucursal
+	branchID, err := parseBranchID("SetStatus", id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Actualizar el estado de la sucursal
+	branch, err := u.branchManager.SetBranchStatus(ctx, claims.ClientID, branchID, active)
+	if err != nil {
+		return nil, err
+	}
+
+	response := branch.ToResponse()
+	return &response, nil
+}
+
+// RotateCredentials genera credenciales nuevas para una sucursal del usuario autenticado
+func (u *BranchUseCase) RotateCredentials(ctx context.Context, id string, req *structs.CredentialsRotationRequest) (*models.RotatedCredentials, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 1. Validar el identificador de la sucursal y el periodo de gracia
+	branchID, err := parseBranchID("RotateCredentials", id)
+	if err != nil {
+		return nil, err
+	}
+
+	gracePeriod := u.gracePeriod
+	if req.GracePeriodMinutes != nil {
+		gracePeriod = time.Duration(*req.GracePeriodMinutes) * time.Minute
+		if gracePeriod < 0 || gracePeriod > u.gracePeriod {
+			return nil, shared_error.NewFormattedGeneralServiceError("BranchUseCase", "RotateCredentials", "InvalidCredentialsGracePeriod", int(u.gracePeriod.Minutes()))
+		}
+	}
+
+	// 2. Rotar las credenciales de la sucursal
+	return u.branchManager.RotateCredentials(ctx, claims.ClientID, branchID, gracePeriod)
+}
+
+func mapBranchInput(req *structs.BranchOfficeRequest) *models.BranchInput {
+	input := &models.BranchInput{
+		EstablishmentType:   req.EstablishmentType,
+		EstablishmentCode:   req.EstablishmentCode,
+		EstablishmentCodeMH: req.EstablishmentCodeMH,
+		POSCode:             req.POSCode,
+		POSCodeMH:           req.POSCodeMH,
+		Email:               req.Email,
+		Phone:               req.Phone,
+		AsyncEmission:       req.AsyncEmission,
+		Role:                req.Role,
+		Scopes:              req.Scopes,
+	}
+
+	if req.Address != nil {
+		input.Address = &user.Address{
+			Department:   req.Address.Department,
+			Municipality: req.Address.Municipality,
+			Complement:   req.Address.Complement,
+		}
+	}
+
+	return input
+}
+
+func parseBranchID(operation, id string) (uint, error) {
+	value, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, shared_error.NewFormattedGeneralServiceError("BranchUseCase", operation, "InvalidFieldFormat", "id", "number")
+	}
+
+	return uint(value), nil
+}
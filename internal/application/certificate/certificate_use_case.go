@@ -0,0 +1,103 @@
+package certificate
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate"
+	certModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type CertificateUseCase struct {
+	certificateManager certificate.CertificateManager
+}
+
+func NewCertificateUseCase(certificateManager certificate.CertificateManager) *CertificateUseCase {
+	return &CertificateUseCase{
+		certificateManager: certificateManager,
+	}
+}
+
+// Upload registra el primer certificado de firma del NIT autenticado
+func (u *CertificateUseCase) Upload(ctx context.Context, req *structs.UploadCertificateRequest) (*certModels.SigningCertificate, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Convertir la solicitud al modelo de carga
+	upload, err := mapCertificateUpload(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Registrar el certificado
+	return u.certificateManager.Upload(ctx, claims.ClientID, claims.NIT, upload)
+}
+
+// Rotate reemplaza el certificado de firma activo del NIT autenticado
+func (u *CertificateUseCase) Rotate(ctx context.Context, req *structs.UploadCertificateRequest) (*certModels.SigningCertificate, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Convertir la solicitud al modelo de carga
+	upload, err := mapCertificateUpload(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Renovar el certificado
+	return u.certificateManager.Rotate(ctx, claims.ClientID, claims.NIT, upload)
+}
+
+// List obtiene los certificados registrados por el usuario autenticado
+func (u *CertificateUseCase) List(ctx context.Context) ([]certModels.SigningCertificate, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.certificateManager.List(ctx, claims.ClientID)
+}
+
+// Deactivate desactiva un certificado del usuario autenticado
+func (u *CertificateUseCase) Deactivate(ctx context.Context, id string) error {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Validar el identificador del certificado
+	certID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceError("CertificateUseCase", "Deactivate", "InvalidFieldFormat", "id", "number")
+	}
+
+	// 3. Desactivar el certificado
+	return u.certificateManager.Deactivate(ctx, claims.ClientID, uint(certID))
+}
+
+// mapCertificateUpload valida y convierte la solicitud de carga de un certificado
+func mapCertificateUpload(req *structs.UploadCertificateRequest) (*certModels.CertificateUpload, error) {
+	if req == nil || strings.TrimSpace(req.Certificate) == "" || req.PasswordPri == "" {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateUseCase", "MapCertificateUpload", "MissingCertificateData")
+	}
+
+	content, err := base64.StdEncoding.DecodeString(strings.TrimSpace(req.Certificate))
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateUseCase", "MapCertificateUpload", "InvalidFieldFormat", "certificate", "base64")
+	}
+
+	upload := &certModels.CertificateUpload{
+		Content:     content,
+		PasswordPri: req.PasswordPri,
+	}
+
+	if req.ExpiresAt != nil && *req.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.DateOnly, *req.ExpiresAt)
+		if err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceError("CertificateUseCase", "MapCertificateUpload", "InvalidFieldFormat", "expires_at", "YYYY-MM-DD")
+		}
+		upload.ExpiresAt = &expiresAt
+	}
+
+	return upload, nil
+}
@@ -0,0 +1,123 @@
+package contingency
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	contingencyModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type ContingencyUseCase struct {
+	contingencyManager contingency.ContingencyManager
+	scheduleManager    contingency.RetransmissionScheduleManager
+}
+
+func NewContingencyUseCase(contingencyManager contingency.ContingencyManager, scheduleManager contingency.RetransmissionScheduleManager) *ContingencyUseCase {
+	return &ContingencyUseCase{
+		contingencyManager: contingencyManager,
+		scheduleManager:    scheduleManager,
+	}
+}
+
+// List obtiene los documentos en contingencia de las sucursales del usuario autenticado, del más antiguo al más
+// reciente, con el tiempo restante para transmitirlos
+func (u *ContingencyUseCase) List(ctx context.Context, req *structs.ContingencyListRequest) (*contingencyModels.ContingencyQueuePage, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Validar los filtros
+	filters, err := parseContingencyFilters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Obtener los documentos
+	return u.contingencyManager.ListDocuments(ctx, claims.ClientID, filters)
+}
+
+// Get obtiene un documento en contingencia del usuario autenticado
+func (u *ContingencyUseCase) Get(ctx context.Context, id string) (*contingencyModels.QueuedDocument, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.contingencyManager.GetDocument(ctx, claims.ClientID, id)
+}
+
+// Retransmit inicia la retransmisión de los documentos pendientes del NIT del usuario autenticado con el tamaño de
+// lote de su programación
+func (u *ContingencyUseCase) Retransmit(ctx context.Context) (*contingencyModels.RetransmissionResult, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Obtener la programación vigente del usuario
+	schedule, err := u.scheduleManager.Get(ctx, claims.ClientID, claims.NIT)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Iniciar la retransmisión
+	return u.contingencyManager.RetransmitDocumentsByNIT(ctx, claims.NIT, schedule.BatchSize)
+}
+
+// Reclassify cambia el tipo y el motivo de contingencia de un documento pendiente del usuario autenticado
+func (u *ContingencyUseCase) Reclassify(ctx context.Context, id string, req *structs.ContingencyReclassifyRequest) (*contingencyModels.QueuedDocument, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.contingencyManager.ReclassifyDocument(ctx, claims.ClientID, id, req.ContingencyType, req.Reason)
+}
+
+// Cancel retira un documento pendiente del usuario autenticado de la cola de contingencia
+func (u *ContingencyUseCase) Cancel(ctx context.Context, id string, req *structs.ContingencyCancelRequest) error {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.contingencyManager.CancelDocument(ctx, claims.ClientID, id, req.Reason)
+}
+
+// parseContingencyFilters convierte los parámetros de la consulta en los filtros de documentos en contingencia
+func parseContingencyFilters(req *structs.ContingencyListRequest) (*contingencyModels.ContingencyFilters, error) {
+	filters := &contingencyModels.ContingencyFilters{
+		Status: constants.DocumentPending,
+	}
+
+	if req.Status != "" {
+		status := strings.ToUpper(strings.TrimSpace(req.Status))
+		if !constants.ValidReceiverDocumentStates[status] {
+			return nil, shared_error.NewFormattedGeneralServiceError("ContingencyUseCase", "List", "InvalidQueryParam", "status", "PENDING, RECEIVED, REJECTED or INVALIDATED")
+		}
+		filters.Status = status
+	}
+
+	if req.BranchID != "" {
+		branchID, err := strconv.ParseUint(req.BranchID, 10, 64)
+		if err != nil || branchID == 0 {
+			return nil, shared_error.NewFormattedGeneralServiceError("ContingencyUseCase", "List", "InvalidQueryParam", "branch_id", "a positive number")
+		}
+		id := uint(branchID)
+		filters.BranchID = &id
+	}
+
+	var err error
+	if filters.Page, err = parsePositive("page", req.Page); err != nil {
+		return nil, err
+	}
+	if filters.PageSize, err = parsePositive("page_size", req.PageSize); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+func parsePositive(param, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 1 {
+		return 0, shared_error.NewFormattedGeneralServiceError("ContingencyUseCase", "List", "InvalidQueryParam", param, "a positive number")
+	}
+
+	return parsed, nil
+}
@@ -2,54 +2,104 @@ package dte
 
 import (
 	"context"
-	"fmt"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
-	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/credit_note/credit_note_models"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 )
 
 // DTEOperations define operaciones específicas para cada tipo de DTE
 type DTEOperations struct{}
 
-type AdditionalOperationsFunc func(ctx context.Context, result interface{}, branchID uint, mhModel interface{}) error
+// AdditionalOperationsFunc ejecuta las operaciones de un documento recibido por Hacienda a partir de su modelo de
+// Hacienda, de modo que puedan reintentarse desde el outbox con el documento almacenado
+type AdditionalOperationsFunc func(ctx context.Context, branchID uint, mhModel interface{}) error
 
 // NewDTEOperations crea una nueva instancia de DTEOperations
 func NewDTEOperations() *DTEOperations {
 	return &DTEOperations{}
 }
 
-// GetCreditNoteOperations devuelve las operaciones adicionales para notas de crédito
-func (o *DTEOperations) GetCreditNoteOperations(dteService dte_documents.DTEManager) AdditionalOperationsFunc {
-	return func(ctx context.Context, result interface{}, branchID uint, mhModel interface{}) error {
-		creditNote, ok := result.(*credit_note_models.CreditNoteModel)
-		if !ok {
-			return fmt.Errorf("invalid result type")
-		}
-
-		for _, relatedDoc := range creditNote.GetRelatedDocuments() {
-			if relatedDoc.GetGenerationType() == constants.ElectronicDocument {
-				err := dteService.GenerateBalanceTransaction(
-					ctx,
-					branchID,
-					constants.NotaCreditoElectronica,
-					relatedDoc.GetDocumentNumber(),
-					creditNote.GetIdentification().GetGenerationCode(),
-					mhModel,
-				)
-				if err != nil {
-					logs.Warn("Failed to generate balance transaction", map[string]interface{}{"error": err.Error()})
-					return err
+// GetCreditNoteOperations devuelve las operaciones adicionales para notas de crédito. Si la nota de crédito agota el
+// saldo de un documento relacionado, se publica el evento de saldo agotado.
+func (o *DTEOperations) GetCreditNoteOperations(dteService dte_documents.DTEManager, eventPublisher events.EventPublisher) AdditionalOperationsFunc {
+	return func(ctx context.Context, branchID uint, mhModel interface{}) error {
+		return generateBalanceTransactions(ctx, dteService, branchID, constants.NotaCreditoElectronica, mhModel,
+			func(generationCode, originalDTE string) {
+				if eventPublisher != nil {
+					publishBalanceExhausted(ctx, dteService, eventPublisher, branchID, originalDTE, generationCode)
 				}
-			}
-		}
-		return nil
+			})
+	}
+}
+
+// publishBalanceExhausted publica el evento de saldo agotado si el documento original ya no tiene saldo disponible
+func publishBalanceExhausted(ctx context.Context, dteService dte_documents.DTEManager, eventPublisher events.EventPublisher, branchID uint, originalDTE, adjustmentDTE string) {
+	balance, err := dteService.GetBalanceControl(ctx, branchID, originalDTE)
+	if err != nil {
+		logs.Warn("Failed to get balance control for event", map[string]interface{}{
+			"originalDTE": originalDTE,
+			"error":       err.Error(),
+		})
+		return
+	}
+
+	if balance.IsExhausted() {
+		eventPublisher.Publish(ctx, branchID, event.CreditNoteBalanceExhausted, event.BalancePayload{
+			OriginalGenerationCode:   originalDTE,
+			AdjustmentGenerationCode: adjustmentDTE,
+		})
+	}
+}
+
+// GetDebitNoteOperations devuelve las operaciones adicionales para notas de débito
+func (o *DTEOperations) GetDebitNoteOperations(dteService dte_documents.DTEManager) AdditionalOperationsFunc {
+	return func(ctx context.Context, branchID uint, mhModel interface{}) error {
+		return generateBalanceTransactions(ctx, dteService, branchID, constants.NotaDebitoElectronica, mhModel, nil)
 	}
 }
 
 // GetNoOperation devuelve una función vacía para DTEs sin operaciones adicionales
 func (o *DTEOperations) GetNoOperation() AdditionalOperationsFunc {
-	return func(ctx context.Context, result interface{}, branchID uint, mhModel interface{}) error {
+	return func(ctx context.Context, branchID uint, mhModel interface{}) error {
 		return nil
 	}
 }
+
+// generateBalanceTransactions registra la transacción de saldo del documento de ajuste en cada documento relacionado
+// emitido electrónicamente, ejecutando afterEach tras cada transacción
+func generateBalanceTransactions(ctx context.Context, dteService dte_documents.DTEManager, branchID uint, transactionType string, mhModel interface{}, afterEach func(generationCode, originalDTE string)) error {
+	// 1. Obtener la identificación y los documentos relacionados del documento de ajuste
+	identification, err := utils.ExtractAuxiliarIdentification(mhModel)
+	if err != nil {
+		return err
+	}
+
+	related, err := utils.ExtractRelatedDocAndItems(mhModel)
+	if err != nil {
+		return err
+	}
+
+	// 2. Registrar la transacción en cada documento relacionado
+	generationCode := identification.Identification.GenerationCode
+	for _, relatedDoc := range related.RelatedDocs {
+		if relatedDoc.GenerationType != constants.ElectronicDocument {
+			continue
+		}
+
+		err = dteService.GenerateBalanceTransaction(ctx, branchID, transactionType, relatedDoc.DocumentNumber, generationCode, mhModel)
+		if err != nil {
+			logs.Warn("Failed to generate balance transaction", map[string]interface{}{"error": err.Error()})
+			return err
+		}
+
+		if afterEach != nil {
+			afterEach(generationCode, relatedDoc.DocumentNumber)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package dte
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	appPorts "github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission"
+	emissionModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+// EmissionStatusPath es la ruta en la que se consulta el estado de un documento emitido de forma asíncrona
+const EmissionStatusPath = "/api/v1/dte/%s/status"
+
+// AsyncEmissionUseCase transmite los documentos de la cola de emisión asíncrona y consulta su estado
+type AsyncEmissionUseCase struct {
+	authService        auth.AuthManager
+	dteService         dte_documents.DTEManager
+	transmitter        appPorts.BaseTransmitter
+	queue              emission.EmissionQueueManager
+	contingencyService contingency.ContingencyManager
+	classifier         appPorts.ContingencyClassifier
+	notifier           appPorts.DTENotifier
+	events             events.EventPublisher
+}
+
+// NewAsyncEmissionUseCase crea el caso de uso de emisión asíncrona
+func NewAsyncEmissionUseCase(
+	authService auth.AuthManager,
+	dteService dte_documents.DTEManager,
+	transmitter appPorts.BaseTransmitter,
+	queue emission.EmissionQueueManager,
+	contingencyService contingency.ContingencyManager,
+	classifier appPorts.ContingencyClassifier,
+	notifier appPorts.DTENotifier,
+	eventPublisher events.EventPublisher,
+) *AsyncEmissionUseCase {
+	return &AsyncEmissionUseCase{
+		authService:        authService,
+		dteService:         dteService,
+		transmitter:        transmitter,
+		queue:              queue,
+		contingencyService: contingencyService,
+		classifier:         classifier,
+		notifier:           notifier,
+		events:             eventPublisher,
+	}
+}
+
+// ProcessNext reserva y transmite el siguiente documento de la cola. Los documentos recibidos se marcan como
+// recibidos, los rechazados como rechazados y los que fallan por causas de contingencia pasan a contingencia; el
+// resto de errores se reintentan hasta agotar los intentos. Los documentos de una sucursal sin credenciales de
+// Hacienda se reprograman hasta que vuelva a iniciar sesión.
+func (u *AsyncEmissionUseCase) ProcessNext(ctx context.Context) (bool, error) {
+	// 1. Reservar el siguiente job de la cola
+	job, err := u.queue.ClaimNext(ctx)
+	if err != nil {
+		return false, err
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	// 2. Reconstruir el contexto de la sucursal que emitió el documento con su token de sistema, que no depende del
+	// token de la solicitud original
+	branch, err := u.authService.GetBranchByBranchID(ctx, job.BranchID)
+	if err != nil {
+		return true, u.queue.Fail(ctx, job, err, true)
+	}
+
+	claims := &models.AuthClaims{
+		ClientID: branch.User.ID,
+		BranchID: branch.ID,
+		AuthType: branch.User.AuthType,
+		NIT:      branch.User.NIT,
+	}
+	token := u.authService.GetBranchToken(branch.ID)
+	ctx = context.WithValue(ctx, "claims", claims)
+	ctx = context.WithValue(ctx, "token", token)
+
+	// 3. Esperar sin consumir intentos a que la sucursal vuelva a iniciar sesión si sus credenciales de Hacienda
+	// expiraron o se eliminaron al desactivarla o rotar sus credenciales
+	hasCredentials, err := u.authService.HasBranchCredentials(branch.ID)
+	if err != nil {
+		return true, u.queue.Fail(ctx, job, err, true)
+	}
+	if !hasCredentials {
+		logs.Warn("Branch has no Hacienda credentials, rescheduling asynchronous document", map[string]interface{}{
+			"generationCode": job.DocumentID,
+			"branchID":       branch.ID,
+		})
+		cause := fmt.Errorf("branch %d has no Hacienda credentials until its next login", branch.ID)
+		return true, u.queue.Reschedule(ctx, job, cause, emission.CredentialsWaitDelay)
+	}
+
+	// 4. Obtener el documento pendiente
+	document, err := u.dteService.GetByGenerationCode(ctx, job.BranchID, job.DocumentID)
+	if err != nil {
+		return true, u.queue.Fail(ctx, job, err, true)
+	}
+
+	if document.Details.Status != constants.DocumentPending || document.Details.Transmission != constants.TransmissionNormal {
+		logs.Warn("Document is no longer pending normal transmission, skipping", map[string]interface{}{
+			"generationCode": job.DocumentID,
+			"status":         document.Details.Status,
+			"transmission":   document.Details.Transmission,
+		})
+		return true, u.queue.Finish(ctx, job, emissionModels.JobCompleted)
+	}
+
+	var mhModel map[string]interface{}
+	if err = json.Unmarshal([]byte(document.Details.JSONData), &mhModel); err != nil {
+		return true, u.queue.Fail(ctx, job, err, false)
+	}
+
+	// 5. Transmitir el documento
+	result, err := u.transmitter.RetryTransmission(ctx, mhModel, token, claims.NIT)
+	if err == nil && result.Status != ReceivedStatus {
+		err = fmt.Errorf("document was not processed by Hacienda, status %s: %s", result.Status, result.MessageDesc)
+	}
+	if err != nil {
+		return true, u.handleTransmissionError(ctx, job, err)
+	}
+
+	// 6. Marcar el documento como recibido con el sello de recepción en su apéndice
+	if err = u.dteService.MarkReceived(ctx, job.BranchID, document, result.ReceptionStamp); err != nil {
+		return true, u.queue.Fail(ctx, job, err, true)
+	}
+
+	if err = u.queue.Finish(ctx, job, emissionModels.JobCompleted); err != nil {
+		return true, err
+	}
+
+	logs.Info("Asynchronous document transmitted successfully", map[string]interface{}{
+		"generationCode": job.DocumentID,
+		"attempts":       job.Attempts,
+	})
+
+	// 7. Enviar el documento al correo del receptor
+	if u.notifier != nil {
+		u.notifier.NotifyIssued(ctx, job.DocumentID)
+	}
+
+	// 8. Publicar el evento de recepción
+	if u.events != nil {
+		u.events.Publish(ctx, job.BranchID, event.DTEReceived, event.DTEPayload{
+			GenerationCode: job.DocumentID,
+			ControlNumber:  document.Details.ControlNumber,
+			DTEType:        job.DTEType,
+			Status:         constants.DocumentReceived,
+			ReceptionStamp: result.ReceptionStamp,
+		})
+	}
+
+	return true, nil
+}
+
+// handleTransmissionError registra el resultado de una transmisión fallida
+func (u *AsyncEmissionUseCase) handleTransmissionError(ctx context.Context, job *emissionModels.EmissionJob, cause error) error {
+	logs.Warn("Asynchronous document transmission failed", map[string]interface{}{
+		"generationCode": job.DocumentID,
+		"attempts":       job.Attempts,
+		"error":          cause.Error(),
+	})
+
+	// 1. Documento rechazado por Hacienda, no se reintenta
+	if u.classifier.IsRejected(cause) {
+		err := u.dteService.UpdateDTE(ctx, job.BranchID, dte.DTEDetails{
+			ID:     job.DocumentID,
+			Status: constants.DocumentRejected,
+		})
+		if err != nil {
+			return u.queue.Fail(ctx, job, err, true)
+		}
+
+		if u.events != nil {
+			u.events.Publish(ctx, job.BranchID, event.DTERejected, event.DTEPayload{
+				GenerationCode: job.DocumentID,
+				DTEType:        job.DTEType,
+				Status:         constants.DocumentRejected,
+				Reason:         cause.Error(),
+			})
+		}
+		return u.queue.Fail(ctx, job, cause, false)
+	}
+
+	// 2. Error de contingencia, el documento será retransmitido por el job de contingencia
+	contingencyType, reason := u.classifier.Classify(cause)
+	if contingencyType != nil && reason != nil {
+		if err := u.contingencyService.MoveToContingency(ctx, job.BranchID, job.DocumentID, *contingencyType, *reason); err != nil {
+			return u.queue.Fail(ctx, job, errors.Join(cause, err), true)
+		}
+		return u.queue.Finish(ctx, job, emissionModels.JobContingency)
+	}
+
+	// 3. Cualquier otro error se reintenta
+	return u.queue.Fail(ctx, job, cause, true)
+}
+
+// GetStatus obtiene el estado de un documento y de su transmisión asíncrona
+func (u *AsyncEmissionUseCase) GetStatus(ctx context.Context, generationCode string) (*emissionModels.EmissionStatus, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Obtener el documento
+	document, err := u.dteService.GetByGenerationCode(ctx, claims.BranchID, generationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Obtener el job de emisión, si el documento se emitió de forma asíncrona
+	job, err := u.queue.GetJob(ctx, claims.BranchID, generationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emissionModels.EmissionStatus{
+		GenerationCode: document.Details.ID,
+		ControlNumber:  document.Details.ControlNumber,
+		DTEType:        document.Details.DTEType,
+		Status:         document.Details.Status,
+		Transmission:   document.Details.Transmission,
+		ReceptionStamp: document.Details.ReceptionStamp,
+		Job:            job,
+	}, nil
+}
@@ -3,34 +3,37 @@ package dte
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"time"
 
 	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
 )
 
-const (
-	MaxRetries     = 2
-	MaxTimeout     = 8
-	ReceivedStatus = "PROCESADO"
-)
+const ReceivedStatus = "PROCESADO"
 
 // BaseTransmitter encapsula solo la lógica común de retransmisión
 type BaseTransmitter struct {
 	transmitter ports.DTETransmitter
 	signer      ports.SignerManager
+	config      *models.TransmissionConfig
 }
 
-func NewBaseTransmitter(transmitter ports.DTETransmitter, signer ports.SignerManager) ports.BaseTransmitter {
+// NewBaseTransmitter crea el transmisor con la política de reintentos de la configuración de transmisión
+func NewBaseTransmitter(transmitter ports.DTETransmitter, signer ports.SignerManager, config *models.TransmissionConfig) ports.BaseTransmitter {
 	return &BaseTransmitter{
 		transmitter: transmitter,
 		signer:      signer,
+		config:      config,
 	}
 }
 
-// RetryTransmission maneja la lógica de reintentos y verificación
+// RetryTransmission firma y transmite el documento aplicando la política de reintentos. Se reintenta cuando Hacienda
+// no recibe el documento o no está disponible; antes de cada reintento se consulta si el intento anterior ya fue
+// recibido. Un rechazo, un circuit breaker abierto o la cancelación del contexto terminan los reintentos.
 func (bt *BaseTransmitter) RetryTransmission(ctx context.Context, document interface{}, token string, nit string) (*models.TransmitResult, error) {
 	jsonData, err := json.Marshal(document)
 	if err != nil {
@@ -49,42 +52,42 @@ func (bt *BaseTransmitter) RetryTransmission(ctx context.Context, document inter
 		return nil, err
 	}
 
-	logs.Info("First attempt to transmit document")
-	// 1. Primer intento de transmisión
-	result, err := bt.transmitter.Transmit(ctx, document, signedDoc, token)
-	if err == nil && result.Status == ReceivedStatus {
-		logs.Info("Document received on first attempt")
-		return result, nil
-	}
-
-	if err != nil {
-		logs.Error("Failed to transmit document", map[string]interface{}{
-			"error": err.Error(),
-		})
-		return result, err
-	}
+	policy := bt.config.GetRetryPolicy()
+	var result *models.TransmitResult
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			// 1. Esperar antes del reintento y verificar si Hacienda ya recibió el documento
+			logs.Info(fmt.Sprintf("Retry %d of %d", attempt-1, policy.MaxAttempts-1), nil)
+			if waitErr := policy.Wait(ctx, attempt-1); waitErr != nil {
+				return nil, waitErr
+			}
 
-	logs.Info("Check status of document")
-	// 2. Verificar estado actual
-	statusResult, err := bt.CheckStatus(ctx, document, nit)
-	if err == nil && statusResult.Status == ReceivedStatus {
-		logs.Info("Document already received")
-		return statusResult, nil
-	}
+			statusResult, statusErr := bt.CheckStatus(ctx, document, nit)
+			if statusErr == nil && statusResult.Status == ReceivedStatus {
+				logs.Info("Document already received")
+				return statusResult, nil
+			}
+		}
 
-	// 3. Aplicar política de reintentos
-	logs.Info("Starting retries because document was not received")
-	retryCount := 0
-	for retryCount < MaxRetries {
-		logs.Info(fmt.Sprintf("Retry %d of %d", retryCount+1, MaxRetries), nil)
+		// 2. Transmitir el documento, a menos que el circuito esté abierto la solicitud pudo llegar a Hacienda
 		result, err = bt.transmitter.Transmit(ctx, document, signedDoc, token)
+		if !isCircuitOpenError(err) {
+			idempotency.MarkTransmitted(ctx)
+		}
 		if err == nil && result.Status == ReceivedStatus {
-			logs.Info("Document received on retry")
+			logs.Info("Document received", map[string]interface{}{"attempt": attempt})
 			return result, nil
 		}
 
-		retryCount++
-		time.Sleep(MaxTimeout * time.Second)
+		if err != nil {
+			logs.Error("Failed to transmit document", map[string]interface{}{
+				"attempt": attempt,
+				"error":   err.Error(),
+			})
+			if !isRetryableTransmissionError(err) {
+				return result, err
+			}
+		}
 	}
 
 	logs.Info("Document was not received")
@@ -94,3 +97,16 @@ func (bt *BaseTransmitter) RetryTransmission(ctx context.Context, document inter
 func (bt *BaseTransmitter) CheckStatus(ctx context.Context, document interface{}, nit string) (*models.TransmitResult, error) {
 	return bt.transmitter.CheckDocumentStatus(ctx, document, nit)
 }
+
+// isRetryableTransmissionError indica si la transmisión puede reintentarse: solo cuando Hacienda no estuvo disponible
+// y su circuit breaker sigue permitiendo solicitudes
+func isRetryableTransmissionError(err error) bool {
+	var unavailableErr *dte_errors.ServiceUnavailableError
+	return errors.As(err, &unavailableErr) && !unavailableErr.CircuitOpen
+}
+
+// isCircuitOpenError indica si la solicitud no se envió porque el circuit breaker del servicio estaba abierto
+func isCircuitOpenError(err error) bool {
+	var unavailableErr *dte_errors.ServiceUnavailableError
+	return errors.As(err, &unavailableErr) && unavailableErr.CircuitOpen
+}
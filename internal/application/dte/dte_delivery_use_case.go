@@ -0,0 +1,328 @@
+package dte
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	notificationModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/notification"
+	deliveryModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/notification/models"
+	domainPorts "github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+// DeliveryTimeout es el tiempo máximo para generar y enviar un DTE por correo
+const DeliveryTimeout = 2 * time.Minute
+
+// DTEDeliveryUseCase envía los DTE emitidos, en formato JSON firmado y PDF, al correo de su receptor
+type DTEDeliveryUseCase struct {
+	dteService      dte_documents.DTEManager
+	deliveryManager notification.DeliveryManager
+	pdfUseCase      *DTEPDFUseCase
+	signer          ports.SignerManager
+	mailSender      domainPorts.MailSender
+}
+
+// NewDTEDeliveryUseCase crea el caso de uso de envío. Si mailSender es nil el envío de correos está deshabilitado.
+func NewDTEDeliveryUseCase(
+	dteService dte_documents.DTEManager,
+	deliveryManager notification.DeliveryManager,
+	pdfUseCase *DTEPDFUseCase,
+	signer ports.SignerManager,
+	mailSender domainPorts.MailSender,
+) *DTEDeliveryUseCase {
+	return &DTEDeliveryUseCase{
+		dteService:      dteService,
+		deliveryManager: deliveryManager,
+		pdfUseCase:      pdfUseCase,
+		signer:          signer,
+		mailSender:      mailSender,
+	}
+}
+
+// NotifyIssued registra el envío del DTE al correo de su receptor y lo realiza en segundo plano para no retrasar
+// la respuesta de la emisión. Si el envío falla, el job de reintentos lo volverá a intentar.
+func (u *DTEDeliveryUseCase) NotifyIssued(ctx context.Context, generationCode string) {
+	if u.mailSender == nil {
+		return
+	}
+
+	// 1. Obtener los claims y el documento almacenado
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	document, err := u.dteService.GetByGenerationCode(ctx, claims.BranchID, generationCode)
+	if err != nil {
+		logs.Error("Failed to get document for email delivery", map[string]interface{}{
+			"generationCode": generationCode,
+			"error":          err.Error(),
+		})
+		return
+	}
+
+	// 2. Verificar que el receptor tenga un correo registrado
+	recipient := receiverEmail(document)
+	if recipient == "" {
+		logs.Info("Document receiver has no email, skipping delivery", map[string]interface{}{
+			"generationCode": generationCode,
+		})
+		return
+	}
+
+	// 3. Registrar la notificación y enviarla en segundo plano
+	userNotification, err := u.register(ctx, claims, document, recipient)
+	if err != nil {
+		logs.Error("Failed to register email delivery", map[string]interface{}{
+			"generationCode": generationCode,
+			"error":          err.Error(),
+		})
+		return
+	}
+
+	go func() {
+		deliveryCtx, cancel := context.WithTimeout(context.Background(), DeliveryTimeout)
+		defer cancel()
+
+		_ = u.deliver(deliveryCtx, userNotification, document)
+	}()
+}
+
+// Resend vuelve a enviar un DTE de la sucursal autenticada. Si no se indica un correo se usa el del receptor.
+func (u *DTEDeliveryUseCase) Resend(ctx context.Context, generationCode, email string) (*notificationModels.UserNotification, error) {
+	// 1. Verificar que el envío de correos esté configurado
+	if u.mailSender == nil {
+		return nil, shared_error.NewFormattedGeneralServiceError("DTEDeliveryUseCase", "Resend", "MailNotConfigured")
+	}
+
+	// 2. Obtener los claims y el documento almacenado
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	document, err := u.dteService.GetByGenerationCode(ctx, claims.BranchID, generationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Determinar el destinatario
+	recipient := strings.TrimSpace(email)
+	if recipient == "" {
+		recipient = receiverEmail(document)
+	}
+	if recipient == "" {
+		return nil, shared_error.NewFormattedGeneralServiceError("DTEDeliveryUseCase", "Resend", "MissingReceiverEmail", generationCode)
+	}
+	if _, err = mail.ParseAddress(recipient); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceError("DTEDeliveryUseCase", "Resend", "InvalidFieldFormat", "email", "email")
+	}
+
+	// 4. Registrar y realizar el envío. Un envío fallido queda pendiente para el job de reintentos.
+	userNotification, err := u.register(ctx, claims, document, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.deliver(ctx, userNotification, document); err != nil {
+		return nil, err
+	}
+
+	return userNotification, nil
+}
+
+// RetryPending reintenta los envíos pendientes cuyo próximo intento ya se cumplió
+func (u *DTEDeliveryUseCase) RetryPending(ctx context.Context) (int, error) {
+	if u.mailSender == nil {
+		return 0, nil
+	}
+
+	pending, err := u.deliveryManager.GetPendingEmails(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+
+		userNotification := &pending[i]
+		document, err := u.dteService.GetByGenerationCode(ctx, userNotification.BranchID, userNotification.ReferenceID)
+		if err != nil {
+			logs.Error("Failed to get document for email retry", map[string]interface{}{
+				"notificationID": userNotification.ID,
+				"error":          err.Error(),
+			})
+			continue
+		}
+
+		if err = u.deliver(ctx, userNotification, document); err == nil && userNotification.DeliveryStatus == notificationModels.DeliverySent {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// register registra la notificación pendiente de envío junto al evento que la origina
+func (u *DTEDeliveryUseCase) register(ctx context.Context, claims *models.AuthClaims, document *dte.DTEDocument, recipient string) (*notificationModels.UserNotification, error) {
+	return u.deliveryManager.RegisterEmail(ctx, &deliveryModels.EmailDelivery{
+		UserID:      claims.ClientID,
+		BranchID:    claims.BranchID,
+		ReferenceID: document.Details.ID,
+		Recipient:   recipient,
+		Subject:     emailSubject(document),
+		EventType:   event.DTEDeliveryRequested,
+		Payload: map[string]interface{}{
+			"generation_code": document.Details.ID,
+			"dte_type":        document.Details.DTEType,
+			"control_number":  document.Details.ControlNumber,
+			"recipient":       recipient,
+		},
+	})
+}
+
+// deliver reserva la notificación, genera los archivos del documento y los envía. El resultado del envío queda
+// registrado en la notificación; solo se retorna error si no pudo actualizarse su estado.
+func (u *DTEDeliveryUseCase) deliver(ctx context.Context, userNotification *notificationModels.UserNotification, document *dte.DTEDocument) error {
+	// 1. Reservar la notificación para que no se envíe dos veces
+	claimed, err := u.deliveryManager.Claim(ctx, userNotification)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	// 2. Generar los archivos y enviar el correo
+	sendErr := u.send(ctx, userNotification, document)
+	if sendErr != nil {
+		logs.Warn("Failed to send DTE by email", map[string]interface{}{
+			"notificationID": userNotification.ID,
+			"generationCode": userNotification.ReferenceID,
+			"attempt":        userNotification.Attempts + 1,
+			"error":          sendErr.Error(),
+		})
+		return u.deliveryManager.MarkFailed(ctx, userNotification, sendErr)
+	}
+
+	logs.Info("DTE sent by email", map[string]interface{}{
+		"notificationID": userNotification.ID,
+		"generationCode": userNotification.ReferenceID,
+	})
+	return u.deliveryManager.MarkSent(ctx, userNotification)
+}
+
+// send genera el JSON firmado y el PDF del documento y los envía al destinatario de la notificación
+func (u *DTEDeliveryUseCase) send(ctx context.Context, userNotification *notificationModels.UserNotification, document *dte.DTEDocument) error {
+	var jsonData map[string]interface{}
+	if err := json.Unmarshal([]byte(document.Details.JSONData), &jsonData); err != nil {
+		return fmt.Errorf("error decoding document: %w", err)
+	}
+
+	// 1. Firmar el documento con el certificado de su emisor
+	issuer := section(jsonData, "emisor", "donatario")
+	signature, err := u.signer.SignDTE(ctx, json.RawMessage(document.Details.JSONData), stringField(issuer, "nit"))
+	if err != nil {
+		return fmt.Errorf("error signing document: %w", err)
+	}
+
+	signedJSON, err := signedDocument(document, signature)
+	if err != nil {
+		return fmt.Errorf("error building signed document: %w", err)
+	}
+
+	// 2. Generar la versión legible
+	pdf, err := u.pdfUseCase.Render(ctx, userNotification.UserID, document)
+	if err != nil {
+		return fmt.Errorf("error generating PDF: %w", err)
+	}
+
+	// 3. Enviar el correo con ambos archivos
+	return u.mailSender.Send(ctx, &notificationModels.Email{
+		To:      []string{userNotification.Recipient},
+		Subject: userNotification.Message,
+		Body:    emailBody(document, jsonData, stringField(issuer, "nombre")),
+		Attachments: []notificationModels.Attachment{
+			{Filename: document.Details.ID + ".json", ContentType: "application/json", Content: signedJSON},
+			{Filename: document.Details.ID + ".pdf", ContentType: "application/pdf", Content: pdf},
+		},
+	})
+}
+
+// receiverEmail obtiene el correo del receptor del documento, según la sección que lo contiene en cada tipo de DTE
+func receiverEmail(document *dte.DTEDocument) string {
+	var jsonData map[string]interface{}
+	if err := json.Unmarshal([]byte(document.Details.JSONData), &jsonData); err != nil {
+		return ""
+	}
+
+	receiver := section(jsonData, "receptor", "sujetoExcluido", "donante")
+	return strings.TrimSpace(stringField(receiver, "correo"))
+}
+
+// signedDocument agrega la firma electrónica y el sello de recepción al JSON del documento conservando el orden
+// de sus campos
+func signedDocument(document *dte.DTEDocument, signature string) ([]byte, error) {
+	content := strings.TrimSpace(document.Details.JSONData)
+	if !strings.HasSuffix(content, "}") {
+		return nil, fmt.Errorf("document is not a JSON object")
+	}
+
+	signatureJSON, err := json.Marshal(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	var builder strings.Builder
+	builder.WriteString(strings.TrimSuffix(content, "}"))
+	builder.WriteString(`,"firmaElectronica":`)
+	builder.Write(signatureJSON)
+
+	if document.Details.ReceptionStamp != nil {
+		stampJSON, err := json.Marshal(*document.Details.ReceptionStamp)
+		if err != nil {
+			return nil, err
+		}
+		builder.WriteString(`,"selloRecibido":`)
+		builder.Write(stampJSON)
+	}
+	builder.WriteString("}")
+
+	return []byte(builder.String()), nil
+}
+
+func emailSubject(document *dte.DTEDocument) string {
+	return fmt.Sprintf("%s %s", constants.DTETypeNames[document.Details.DTEType], document.Details.ControlNumber)
+}
+
+func emailBody(document *dte.DTEDocument, jsonData map[string]interface{}, issuerName string) string {
+	identification := section(jsonData, "identificacion")
+
+	return fmt.Sprintf(`Estimado cliente:
+
+%s le ha emitido el siguiente Documento Tributario Electrónico:
+
+Tipo de documento: %s
+Número de control: %s
+Código de generación: %s
+Fecha de emisión: %s
+
+Se adjunta el documento en formato JSON firmado y su versión legible en PDF.
+Puede consultar su validez en el portal del Ministerio de Hacienda:
+%s
+`,
+		issuerName,
+		constants.DTETypeNames[document.Details.DTEType],
+		document.Details.ControlNumber,
+		document.Details.ID,
+		stringField(identification, "fecEmi"),
+		qrLink(document, jsonData),
+	)
+}
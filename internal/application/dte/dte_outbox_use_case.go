@@ -0,0 +1,208 @@
+package dte
+
+import (
+	"context"
+	"encoding/json"
+
+	appPorts "github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox"
+	outboxModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox/models"
+	transmitterModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+// OutboxBatchSize es la cantidad máxima de documentos del outbox que se completan en cada ejecución del job
+const OutboxBatchSize = 50
+
+// DTEOutboxUseCase completa los documentos recibidos por Hacienda a partir del outbox: los almacena, ejecuta sus
+// operaciones adicionales, los envía al correo del receptor y publica su evento de recepción. Cada paso se registra en
+// el outbox, por lo que un documento cuyo almacenamiento u operaciones fallaron se reintenta desde el paso pendiente.
+type DTEOutboxUseCase struct {
+	authService auth.AuthManager
+	dteService  dte_documents.DTEManager
+	outbox      outbox.OutboxManager
+	notifier    appPorts.DTENotifier
+	events      events.EventPublisher
+	operations  map[string]AdditionalOperationsFunc
+}
+
+// NewDTEOutboxUseCase crea el caso de uso del outbox
+func NewDTEOutboxUseCase(
+	authService auth.AuthManager,
+	dteService dte_documents.DTEManager,
+	outboxManager outbox.OutboxManager,
+	notifier appPorts.DTENotifier,
+	eventPublisher events.EventPublisher,
+) *DTEOutboxUseCase {
+	return &DTEOutboxUseCase{
+		authService: authService,
+		dteService:  dteService,
+		outbox:      outboxManager,
+		notifier:    notifier,
+		events:      eventPublisher,
+		operations:  make(map[string]AdditionalOperationsFunc),
+	}
+}
+
+// RegisterOperations configura las operaciones adicionales de un tipo de DTE
+func (u *DTEOutboxUseCase) RegisterOperations(dteType string, operations AdditionalOperationsFunc) {
+	u.operations[dteType] = operations
+}
+
+// Register registra la intención de transmitir el documento antes de enviarlo a Hacienda
+func (u *DTEOutboxUseCase) Register(ctx context.Context, branchID uint, mhModel interface{}) (*outboxModels.OutboxEntry, error) {
+	return u.outbox.Register(ctx, branchID, mhModel)
+}
+
+// NotTransmitted registra que Hacienda no recibió el documento, un error al registrarlo solo se registra en los logs
+func (u *DTEOutboxUseCase) NotTransmitted(ctx context.Context, entry *outboxModels.OutboxEntry, cause error) {
+	if err := u.outbox.RecordNotTransmitted(ctx, entry, cause); err != nil {
+		logs.Error("Failed to record not transmitted outbox entry", map[string]interface{}{
+			"generationCode": entry.GenerationCode,
+			"error":          err.Error(),
+		})
+	}
+}
+
+// Complete registra el resultado de la transmisión y completa el documento recibido por Hacienda. Si el resultado no
+// pudo registrarse el documento sigue pendiente en el outbox y la conciliación con Hacienda lo retoma.
+func (u *DTEOutboxUseCase) Complete(ctx context.Context, entry *outboxModels.OutboxEntry, result *transmitterModels.TransmitResult, mhModel interface{}) error {
+	if err := u.outbox.RecordTransmission(ctx, entry, result); err != nil {
+		return err
+	}
+
+	return u.complete(ctx, entry, mhModel)
+}
+
+// ProcessPending completa los documentos recibidos por Hacienda cuyo almacenamiento u operaciones adicionales
+// fallaron y cuyo próximo intento ya se cumplió
+func (u *DTEOutboxUseCase) ProcessPending(ctx context.Context) (int, error) {
+	// 1. Obtener los documentos pendientes de completarse
+	entries, err := u.outbox.GetDue(ctx, OutboxBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for i := range entries {
+		if err = ctx.Err(); err != nil {
+			return completed, err
+		}
+
+		entry := &entries[i]
+
+		// 2. Reservar el documento, otra ejecución o la solicitud que lo transmitió pudo haberlo tomado
+		claimed, err := u.outbox.Claim(ctx, entry)
+		if err != nil {
+			return completed, err
+		}
+		if !claimed {
+			continue
+		}
+
+		// 3. Reconstruir el contexto de la sucursal que emitió el documento
+		branch, err := u.authService.GetBranchByBranchID(ctx, entry.BranchID)
+		if err != nil {
+			_ = u.outbox.Retry(ctx, entry, err)
+			continue
+		}
+
+		entryCtx := context.WithValue(ctx, "claims", &models.AuthClaims{
+			ClientID: branch.User.ID,
+			BranchID: branch.ID,
+			AuthType: branch.User.AuthType,
+			NIT:      branch.User.NIT,
+		})
+
+		// 4. Completar el documento a partir del modelo de Hacienda registrado
+		var mhModel map[string]interface{}
+		if err = json.Unmarshal([]byte(entry.Document), &mhModel); err != nil {
+			_ = u.outbox.Retry(entryCtx, entry, err)
+			continue
+		}
+
+		if err = u.complete(entryCtx, entry, mhModel); err != nil {
+			logs.Warn("Outbox entry could not be completed, it will be retried", map[string]interface{}{
+				"generationCode": entry.GenerationCode,
+				"status":         entry.Status,
+				"attempts":       entry.Attempts,
+				"error":          err.Error(),
+			})
+			continue
+		}
+		completed++
+	}
+
+	return completed, nil
+}
+
+// complete almacena el documento y ejecuta sus operaciones adicionales continuando desde el estado registrado en el
+// outbox. Una vez completado envía el documento al correo del receptor y publica el evento de recepción.
+func (u *DTEOutboxUseCase) complete(ctx context.Context, entry *outboxModels.OutboxEntry, mhModel interface{}) error {
+	// 1. Almacenar el documento recibido por Hacienda
+	if entry.Status == outboxModels.EntryTransmitted {
+		if err := u.save(ctx, entry, mhModel); err != nil {
+			return u.retry(ctx, entry, err)
+		}
+		if err := u.outbox.Advance(ctx, entry, outboxModels.EntrySaved); err != nil {
+			return err
+		}
+	}
+
+	// 2. Ejecutar las operaciones adicionales del tipo de documento
+	if entry.Status == outboxModels.EntrySaved {
+		if operations, ok := u.operations[entry.DTEType]; ok {
+			if err := operations(ctx, entry.BranchID, mhModel); err != nil {
+				return u.retry(ctx, entry, err)
+			}
+		}
+		if err := u.outbox.Advance(ctx, entry, outboxModels.EntryCompleted); err != nil {
+			return err
+		}
+	}
+
+	// 3. Enviar el documento al correo del receptor
+	if u.notifier != nil {
+		u.notifier.NotifyIssued(ctx, entry.GenerationCode)
+	}
+
+	// 4. Publicar el evento de recepción
+	if u.events != nil {
+		u.events.Publish(ctx, entry.BranchID, event.DTEReceived, receivedPayload(mhModel, entry.GenerationCode, entry.ReceptionStamp))
+	}
+
+	return nil
+}
+
+// save almacena el documento como recibido. Si el almacenamiento falla porque un intento anterior ya lo almacenó sin
+// registrarlo en el outbox, el documento se considera almacenado.
+func (u *DTEOutboxUseCase) save(ctx context.Context, entry *outboxModels.OutboxEntry, mhModel interface{}) error {
+	err := u.dteService.Create(ctx, mhModel, constants.TransmissionNormal, constants.DocumentReceived, entry.ReceptionStamp)
+	if err == nil {
+		return nil
+	}
+
+	if _, getErr := u.dteService.GetByGenerationCode(ctx, entry.BranchID, entry.GenerationCode); getErr == nil {
+		return nil
+	}
+
+	return err
+}
+
+// retry reprograma el documento en el outbox y retorna la causa del intento fallido
+func (u *DTEOutboxUseCase) retry(ctx context.Context, entry *outboxModels.OutboxEntry, cause error) error {
+	if err := u.outbox.Retry(ctx, entry, cause); err != nil {
+		logs.Error("Failed to reschedule outbox entry", map[string]interface{}{
+			"generationCode": entry.GenerationCode,
+			"error":          err.Error(),
+		})
+	}
+
+	return cause
+}
@@ -0,0 +1,123 @@
+package dte
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/config"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template"
+	templateModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type DTEPDFUseCase struct {
+	dteService      dte_documents.DTEManager
+	templateManager pdf_template.PDFTemplateManager
+	renderer        ports.DTEPDFRenderer
+}
+
+func NewDTEPDFUseCase(dteService dte_documents.DTEManager, templateManager pdf_template.PDFTemplateManager, renderer ports.DTEPDFRenderer) *DTEPDFUseCase {
+	return &DTEPDFUseCase{
+		dteService:      dteService,
+		templateManager: templateManager,
+		renderer:        renderer,
+	}
+}
+
+// GeneratePDF genera la versión legible de un DTE de la sucursal autenticada
+func (u *DTEPDFUseCase) GeneratePDF(ctx context.Context, generationCode string) ([]byte, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Obtener el documento almacenado
+	document, err := u.dteService.GetByGenerationCode(ctx, claims.BranchID, generationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Generar el PDF con la plantilla del usuario
+	return u.Render(ctx, claims.ClientID, document)
+}
+
+// Render genera la versión legible de un documento almacenado con la plantilla del usuario indicado
+func (u *DTEPDFUseCase) Render(ctx context.Context, userID uint, document *dte.DTEDocument) ([]byte, error) {
+	var jsonData map[string]interface{}
+	if err := json.Unmarshal([]byte(document.Details.JSONData), &jsonData); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DTEPDFUseCase", "Render", err, "FailedToGeneratePDF", document.Details.ID)
+	}
+
+	// 1. Obtener la plantilla del usuario para el logo y el pie de página
+	template, err := u.templateManager.GetTemplate(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	identification := section(jsonData, "identificacion")
+	issuer := section(jsonData, "emisor", "donatario")
+
+	footer := u.templateManager.RenderFooter(template, templateModels.FooterData{
+		IssuerName:     stringField(issuer, "nombre"),
+		IssuerNIT:      stringField(issuer, "nit"),
+		DTEType:        document.Details.DTEType,
+		DTETypeName:    constants.DTETypeNames[document.Details.DTEType],
+		ControlNumber:  document.Details.ControlNumber,
+		GenerationCode: document.Details.ID,
+		EmissionDate:   stringField(identification, "fecEmi"),
+	})
+
+	// 2. Generar el PDF
+	pdf, err := u.renderer.Render(&dte.PrintableDTE{
+		DTEType:        document.Details.DTEType,
+		Status:         document.Details.Status,
+		Transmission:   document.Details.Transmission,
+		ReceptionStamp: document.Details.ReceptionStamp,
+		QRLink:         qrLink(document, jsonData),
+		Document:       jsonData,
+		Logo:           template.Logo,
+		Footer:         footer,
+	})
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DTEPDFUseCase", "Render", err, "FailedToGeneratePDF", document.Details.ID)
+	}
+
+	return pdf, nil
+}
+
+// qrLink genera el enlace de consulta pública con el ambiente y la fecha de emisión del documento
+func qrLink(document *dte.DTEDocument, jsonData map[string]interface{}) string {
+	identification := section(jsonData, "identificacion")
+
+	ambient := stringField(identification, "ambiente")
+	if ambient == "" {
+		ambient = config.Server.AmbientCode
+	}
+
+	emissionDate, err := time.Parse("2006-01-02", stringField(identification, "fecEmi"))
+	if err != nil {
+		emissionDate = document.CreatedAt
+	}
+
+	return response.GenerateQRLink(ambient, document.Details.ID, emissionDate)
+}
+
+// section obtiene la primera sección del documento que exista entre las llaves indicadas
+func section(document map[string]interface{}, keys ...string) map[string]interface{} {
+	for _, key := range keys {
+		if value, ok := document[key].(map[string]interface{}); ok {
+			return value
+		}
+	}
+	return nil
+}
+
+func stringField(data map[string]interface{}, key string) string {
+	value, _ := data[key].(string)
+	return value
+}
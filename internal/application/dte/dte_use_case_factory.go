@@ -3,8 +3,12 @@ package dte
 import (
 	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/invalidation"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
 	domainPort "github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper"
 )
@@ -16,6 +20,10 @@ type DTEUseCaseFactory struct {
 	transmitter       ports.BaseTransmitter
 	mapperFactory     *mapper.MapperFactory
 	operationsFactory *DTEOperations
+	notifier          ports.DTENotifier
+	queue             emission.EmissionQueueManager
+	events            events.EventPublisher
+	outbox            *DTEOutboxUseCase
 }
 
 // NewDTEUseCaseFactory crea una nueva instancia de DTEUseCaseFactory
@@ -23,16 +31,35 @@ func NewDTEUseCaseFactory(
 	authService auth.AuthManager,
 	dteService dte_documents.DTEManager,
 	transmitter ports.BaseTransmitter,
+	notifier ports.DTENotifier,
+	queue emission.EmissionQueueManager,
+	eventPublisher events.EventPublisher,
+	outboxManager outbox.OutboxManager,
 ) *DTEUseCaseFactory {
+	// 1. Crear el outbox con las operaciones adicionales de los documentos que las requieren
+	operationsFactory := NewDTEOperations()
+	outboxUseCase := NewDTEOutboxUseCase(authService, dteService, outboxManager, notifier, eventPublisher)
+	outboxUseCase.RegisterOperations(constants.NotaCreditoElectronica, operationsFactory.GetCreditNoteOperations(dteService, eventPublisher))
+	outboxUseCase.RegisterOperations(constants.NotaDebitoElectronica, operationsFactory.GetDebitNoteOperations(dteService))
+
 	return &DTEUseCaseFactory{
 		authService:       authService,
 		dteService:        dteService,
 		transmitter:       transmitter,
 		mapperFactory:     mapper.NewMapperFactory(),
-		operationsFactory: NewDTEOperations(),
+		operationsFactory: operationsFactory,
+		notifier:          notifier,
+		queue:             queue,
+		events:            eventPublisher,
+		outbox:            outboxUseCase,
 	}
 }
 
+// OutboxUseCase obtiene el caso de uso con el que se completan los documentos pendientes del outbox
+func (f *DTEUseCaseFactory) OutboxUseCase() *DTEOutboxUseCase {
+	return f.outbox
+}
+
 // CreateInvoiceUseCase crea un caso de uso para facturas
 func (f *DTEUseCaseFactory) CreateInvoiceUseCase(invoiceService domainPort.DTEService) *GenericDTEUseCase {
 	return NewGenericDTEUseCase(
@@ -43,7 +70,7 @@ func (f *DTEUseCaseFactory) CreateInvoiceUseCase(invoiceService domainPort.DTESe
 		f.mapperFactory.CreateInvoiceMapperAdapter(),
 		f.mapperFactory.GetInvoiceResponseMapper(),
 		f.operationsFactory.GetNoOperation(),
-	)
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox).WithEmissionQueue(f.queue)
 }
 
 // CreateCCFUseCase crea un caso de uso para CCF
@@ -56,10 +83,11 @@ func (f *DTEUseCaseFactory) CreateCCFUseCase(ccfService domainPort.DTEService) *
 		f.mapperFactory.CreateCCFMapperAdapter(),
 		f.mapperFactory.GetCCFResponseMapper(),
 		f.operationsFactory.GetNoOperation(),
-	)
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox).WithEmissionQueue(f.queue)
 }
 
-// CreateCreditNoteUseCase crea un caso de uso para notas de crédito
+// CreateCreditNoteUseCase crea un caso de uso para notas de crédito. Las notas de crédito y débito se emiten siempre
+// de forma síncrona, ya que sus operaciones de saldo requieren el documento recibido por Hacienda.
 func (f *DTEUseCaseFactory) CreateCreditNoteUseCase(creditNoteService domainPort.DTEService) *GenericDTEUseCase {
 	return NewGenericDTEUseCase(
 		f.authService,
@@ -68,8 +96,99 @@ func (f *DTEUseCaseFactory) CreateCreditNoteUseCase(creditNoteService domainPort
 		creditNoteService,
 		f.mapperFactory.CreateCreditNoteMapperAdapter(),
 		f.mapperFactory.GetCreditNoteResponseMapper(),
-		f.operationsFactory.GetCreditNoteOperations(f.dteService),
-	)
+		f.operationsFactory.GetCreditNoteOperations(f.dteService, f.events),
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox)
+}
+
+// CreateDebitNoteUseCase crea un caso de uso para notas de débito
+func (f *DTEUseCaseFactory) CreateDebitNoteUseCase(debitNoteService domainPort.DTEService) *GenericDTEUseCase {
+	return NewGenericDTEUseCase(
+		f.authService,
+		f.dteService,
+		f.transmitter,
+		debitNoteService,
+		f.mapperFactory.CreateDebitNoteMapperAdapter(),
+		f.mapperFactory.GetDebitNoteResponseMapper(),
+		f.operationsFactory.GetDebitNoteOperations(f.dteService),
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox)
+}
+
+// CreateFSEUseCase crea un caso de uso para facturas sujeto excluido
+func (f *DTEUseCaseFactory) CreateFSEUseCase(fseService domainPort.DTEService) *GenericDTEUseCase {
+	return NewGenericDTEUseCase(
+		f.authService,
+		f.dteService,
+		f.transmitter,
+		fseService,
+		f.mapperFactory.CreateFSEMapperAdapter(),
+		f.mapperFactory.GetFSEResponseMapper(),
+		f.operationsFactory.GetNoOperation(),
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox).WithEmissionQueue(f.queue)
+}
+
+// CreateExportInvoiceUseCase crea un caso de uso para facturas de exportación
+func (f *DTEUseCaseFactory) CreateExportInvoiceUseCase(exportInvoiceService domainPort.DTEService) *GenericDTEUseCase {
+	return NewGenericDTEUseCase(
+		f.authService,
+		f.dteService,
+		f.transmitter,
+		exportInvoiceService,
+		f.mapperFactory.CreateExportInvoiceMapperAdapter(),
+		f.mapperFactory.GetExportInvoiceResponseMapper(),
+		f.operationsFactory.GetNoOperation(),
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox).WithEmissionQueue(f.queue)
+}
+
+// CreateRemissionNoteUseCase crea un caso de uso para notas de remisión
+func (f *DTEUseCaseFactory) CreateRemissionNoteUseCase(remissionNoteService domainPort.DTEService) *GenericDTEUseCase {
+	return NewGenericDTEUseCase(
+		f.authService,
+		f.dteService,
+		f.transmitter,
+		remissionNoteService,
+		f.mapperFactory.CreateRemissionNoteMapperAdapter(),
+		f.mapperFactory.GetRemissionNoteResponseMapper(),
+		f.operationsFactory.GetNoOperation(),
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox).WithEmissionQueue(f.queue)
+}
+
+// CreateLiquidationUseCase crea un caso de uso para comprobantes de liquidación
+func (f *DTEUseCaseFactory) CreateLiquidationUseCase(liquidationService domainPort.DTEService) *GenericDTEUseCase {
+	return NewGenericDTEUseCase(
+		f.authService,
+		f.dteService,
+		f.transmitter,
+		liquidationService,
+		f.mapperFactory.CreateLiquidationMapperAdapter(),
+		f.mapperFactory.GetLiquidationResponseMapper(),
+		f.operationsFactory.GetNoOperation(),
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox).WithEmissionQueue(f.queue)
+}
+
+// CreateAccountingLiquidationUseCase crea un caso de uso para documentos contables de liquidación
+func (f *DTEUseCaseFactory) CreateAccountingLiquidationUseCase(accountingLiquidationService domainPort.DTEService) *GenericDTEUseCase {
+	return NewGenericDTEUseCase(
+		f.authService,
+		f.dteService,
+		f.transmitter,
+		accountingLiquidationService,
+		f.mapperFactory.CreateAccountingLiquidationMapperAdapter(),
+		f.mapperFactory.GetAccountingLiquidationResponseMapper(),
+		f.operationsFactory.GetNoOperation(),
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox).WithEmissionQueue(f.queue)
+}
+
+// CreateDonationUseCase crea un caso de uso para comprobantes de donación
+func (f *DTEUseCaseFactory) CreateDonationUseCase(donationService domainPort.DTEService) *GenericDTEUseCase {
+	return NewGenericDTEUseCase(
+		f.authService,
+		f.dteService,
+		f.transmitter,
+		donationService,
+		f.mapperFactory.CreateDonationMapperAdapter(),
+		f.mapperFactory.GetDonationResponseMapper(),
+		f.operationsFactory.GetNoOperation(),
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox).WithEmissionQueue(f.queue)
 }
 
 // CreateRetentionUseCase crea un caso de uso para retenciones
@@ -82,7 +201,7 @@ func (f *DTEUseCaseFactory) CreateRetentionUseCase(retentionService domainPort.D
 		f.mapperFactory.CreateRetentionMapperAdapter(),
 		f.mapperFactory.GetRetentionResponseMapper(),
 		f.operationsFactory.GetNoOperation(),
-	)
+	).WithNotifier(f.notifier).WithEvents(f.events).WithOutbox(f.outbox).WithEmissionQueue(f.queue)
 }
 
 func (f *DTEUseCaseFactory) CreateInvalidationUseCase(
@@ -93,5 +212,5 @@ func (f *DTEUseCaseFactory) CreateInvalidationUseCase(
 		invalidationManager,
 		f.authService,
 		f.transmitter,
-	)
+	).WithEvents(f.events)
 }
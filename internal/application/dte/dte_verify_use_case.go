@@ -0,0 +1,89 @@
+package dte
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/jws"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type DTEVerifyUseCase struct {
+	dteService  dte_documents.DTEManager
+	keyProvider ports.PublicKeyProvider
+}
+
+func NewDTEVerifyUseCase(dteService dte_documents.DTEManager, keyProvider ports.PublicKeyProvider) *DTEVerifyUseCase {
+	return &DTEVerifyUseCase{
+		dteService:  dteService,
+		keyProvider: keyProvider,
+	}
+}
+
+// Verify verifica la firma de un DTE con el certificado de su emisor y, si el documento pertenece a la
+// sucursal autenticada, compara el contenido firmado con el DTE almacenado
+func (u *DTEVerifyUseCase) Verify(ctx context.Context, req structs.VerifyDTERequest) (*dte.DTEVerification, error) {
+	// 1. Separar el JWS compacto
+	if strings.TrimSpace(req.SignedDocument) == "" {
+		return nil, shared_error.NewFormattedGeneralServiceError("DTEVerifyUseCase", "Verify", "MissingSignedDocument")
+	}
+
+	signed, err := jws.Parse(req.SignedDocument)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DTEVerifyUseCase", "Verify", err, "InvalidSignedDocument")
+	}
+
+	// 2. Extraer la identificación y el emisor del contenido firmado
+	identification, err := utils.ExtractAuxiliarIdentificationFromStringJSON(string(signed.Payload))
+	if err != nil || identification.GetIssuerNIT() == "" {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DTEVerifyUseCase", "Verify", err, "InvalidSignedDocument")
+	}
+
+	result := &dte.DTEVerification{
+		Algorithm:      signed.Header.Algorithm,
+		IssuerNIT:      identification.GetIssuerNIT(),
+		DTEType:        identification.Identification.DTEType,
+		GenerationCode: identification.Identification.GenerationCode,
+		ControlNumber:  identification.Identification.ControlNumber,
+	}
+
+	// 3. Verificar la firma con la llave pública del emisor
+	key, err := u.keyProvider.GetPublicKey(ctx, result.IssuerNIT)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := signed.Verify(key); err != nil {
+		logs.Warn("Signed DTE failed signature verification", map[string]interface{}{
+			"generationCode": result.GenerationCode,
+			"error":          err.Error(),
+		})
+	} else {
+		result.SignatureValid = true
+	}
+
+	// 4. Comparar el contenido firmado con el DTE almacenado de la sucursal
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	stored, err := u.dteService.GetByGenerationCode(ctx, claims.BranchID, result.GenerationCode)
+	if err == nil && stored != nil && stored.Details != nil {
+		result.DocumentFound = true
+
+		result.Differences, err = jws.ComparePayload(signed.Payload, []byte(stored.Details.JSONData))
+		if err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("DTEVerifyUseCase", "Verify", err, "FailedToVerifyDTE")
+		}
+		result.PayloadMatches = len(result.Differences) == 0
+	}
+
+	// 5. El documento es válido si la firma es correcta y, de existir, coincide con el almacenado
+	result.Valid = result.SignatureValid && (!result.DocumentFound || result.PayloadMatches)
+
+	return result, nil
+}
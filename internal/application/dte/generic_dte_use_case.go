@@ -2,13 +2,19 @@ package dte
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/MarlonG1/api-facturacion-sv/config"
 	appPorts "github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
 	transmissionPorts "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission"
+	emissionModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission/models"
+	outboxModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper"
@@ -25,6 +31,10 @@ type GenericDTEUseCase struct {
 	mapper         mapper.DTEMapper
 	responseMapper mapper.ResponseMapperFunc
 	additionalOps  AdditionalOperationsFunc
+	notifier       appPorts.DTENotifier
+	queue          emission.EmissionQueueManager
+	events         events.EventPublisher
+	outbox         *DTEOutboxUseCase
 }
 
 // NewGenericDTEUseCase crea una nueva instancia de GenericDTEUseCase
@@ -48,8 +58,180 @@ func NewGenericDTEUseCase(
 	}
 }
 
+// WithNotifier configura el envío del DTE a su receptor una vez emitido
+func (u *GenericDTEUseCase) WithNotifier(notifier appPorts.DTENotifier) *GenericDTEUseCase {
+	u.notifier = notifier
+	return u
+}
+
+// WithEmissionQueue habilita la emisión asíncrona, en la que el documento se transmite desde la cola de emisión
+func (u *GenericDTEUseCase) WithEmissionQueue(queue emission.EmissionQueueManager) *GenericDTEUseCase {
+	u.queue = queue
+	return u
+}
+
+// WithEvents configura la publicación de los eventos de dominio de los documentos recibidos
+func (u *GenericDTEUseCase) WithEvents(eventPublisher events.EventPublisher) *GenericDTEUseCase {
+	u.events = eventPublisher
+	return u
+}
+
+// WithOutbox configura el outbox con el que se completan los documentos recibidos por Hacienda: el documento se
+// registra antes de transmitirse y se almacena y ejecuta sus operaciones adicionales desde el outbox
+func (u *GenericDTEUseCase) WithOutbox(outbox *DTEOutboxUseCase) *GenericDTEUseCase {
+	u.outbox = outbox
+	return u
+}
+
+// preparedDTE contiene el documento generado y listo para transmitirse
+type preparedDTE struct {
+	claims         *models.AuthClaims
+	token          string
+	result         interface{}
+	mhModel        interface{}
+	generationCode string
+	options        *response.SuccessOptions
+}
+
 // Create procesa cualquier tipo de DTE utilizando un flujo genérico
 func (u *GenericDTEUseCase) Create(ctx context.Context, req interface{}) (interface{}, *response.SuccessOptions, error) {
+	// 1. Generar el documento a partir de la solicitud
+	prepared, err := u.prepare(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	claims, mhModel, options := prepared.claims, prepared.mhModel, prepared.options
+
+	// 2. Registrar en el outbox la intención de transmitir el documento
+	var entry *outboxModels.OutboxEntry
+	if u.outbox != nil {
+		entry, err = u.outbox.Register(ctx, claims.BranchID, mhModel)
+		if err != nil {
+			logs.Error("Error registering document in outbox", map[string]interface{}{"error": err.Error()})
+			return mhModel, options, err
+		}
+	}
+
+	// 3. Comenzar la transmisión del documento
+	transmitResult, err := u.transmitter.RetryTransmission(ctx, mhModel, prepared.token, claims.NIT)
+	if err != nil {
+		logs.Error("Error transmitting document", map[string]interface{}{"error": err.Error()})
+		if entry != nil {
+			u.outbox.NotTransmitted(ctx, entry, err)
+		}
+		return mhModel, options, err
+	}
+	options.ReceptionStamp = transmitResult.ReceptionStamp
+
+	// 4. Completar el documento desde el outbox. El documento ya fue recibido por Hacienda, por lo que si su
+	// almacenamiento o sus operaciones adicionales fallan se responde como recibido y el outbox los reintenta
+	if entry != nil {
+		if err = u.outbox.Complete(ctx, entry, transmitResult, mhModel); err != nil {
+			logs.Warn("Document received by Hacienda is pending completion in outbox", map[string]interface{}{
+				"generationCode": prepared.generationCode,
+				"status":         entry.Status,
+				"error":          err.Error(),
+			})
+		}
+		return mhModel, options, nil
+	}
+
+	// 5. Sin outbox, guardar el documento en la base de datos
+	err = u.dteService.Create(ctx, mhModel, constants.TransmissionNormal, constants.DocumentReceived, transmitResult.ReceptionStamp)
+	if err != nil {
+		logs.Error("Error saving document in database", map[string]interface{}{"error": err.Error()})
+		return mhModel, options, err
+	}
+
+	// 6. Ejecutar operaciones adicionales específicas (si las hay)
+	if u.additionalOps != nil {
+		err = u.additionalOps(ctx, claims.BranchID, mhModel)
+		if err != nil {
+			logs.Error("Error executing additional operations", map[string]interface{}{"error": err.Error()})
+			return mhModel, options, err
+		}
+	}
+
+	// 7. Enviar el documento al correo del receptor
+	if u.notifier != nil {
+		u.notifier.NotifyIssued(ctx, prepared.generationCode)
+	}
+
+	// 8. Publicar el evento de recepción
+	if u.events != nil {
+		u.events.Publish(ctx, claims.BranchID, event.DTEReceived, receivedPayload(mhModel, prepared.generationCode, transmitResult.ReceptionStamp))
+	}
+
+	return mhModel, options, nil
+}
+
+// IsAsync indica si la solicitud debe emitirse de forma asíncrona. El parámetro async de la solicitud tiene prioridad
+// sobre la configuración de la sucursal; los documentos sin cola de emisión siempre se emiten de forma síncrona.
+func (u *GenericDTEUseCase) IsAsync(ctx context.Context, requested string) bool {
+	if u.queue == nil {
+		return false
+	}
+
+	switch requested {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	branch, err := u.authService.GetBranchByBranchID(ctx, claims.BranchID)
+	if err != nil {
+		logs.Warn("Failed to get branch emission mode, using synchronous emission", map[string]interface{}{
+			"branchID": claims.BranchID,
+			"error":    err.Error(),
+		})
+		return false
+	}
+
+	return branch.AsyncEmission
+}
+
+// CreateAsync genera el documento, lo almacena como pendiente y lo agrega a la cola de emisión. La transmisión a
+// Hacienda la realizan los workers de emisión.
+func (u *GenericDTEUseCase) CreateAsync(ctx context.Context, req interface{}) (*emissionModels.AsyncEmissionResult, error) {
+	// 1. Generar el documento a partir de la solicitud
+	prepared, err := u.prepare(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	dteInfo, err := utils.ExtractAuxiliarIdentification(prepared.mhModel)
+	if err != nil {
+		logs.Error("Error extracting document identification", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 2. Guardar el documento como pendiente de transmisión
+	err = u.dteService.Create(ctx, prepared.mhModel, constants.TransmissionNormal, constants.DocumentPending, nil)
+	if err != nil {
+		logs.Error("Error saving document in database", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 3. Agregar el documento a la cola de emisión
+	err = u.queue.Enqueue(ctx, prepared.claims.BranchID, prepared.generationCode, dteInfo.Identification.DTEType)
+	if err != nil {
+		logs.Error("Error enqueuing document for emission", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	return &emissionModels.AsyncEmissionResult{
+		GenerationCode: prepared.generationCode,
+		ControlNumber:  dteInfo.Identification.ControlNumber,
+		DTEType:        dteInfo.Identification.DTEType,
+		Status:         constants.DocumentPending,
+		StatusURL:      fmt.Sprintf(EmissionStatusPath, prepared.generationCode),
+	}, nil
+}
+
+// prepare genera el documento a partir de la solicitud, consumiendo su número de control
+func (u *GenericDTEUseCase) prepare(ctx context.Context, req interface{}) (*preparedDTE, error) {
 	// 1. Obtener los claims y el token del contexto
 	claims := ctx.Value("claims").(*models.AuthClaims)
 	token := ctx.Value("token").(string)
@@ -58,21 +240,21 @@ func (u *GenericDTEUseCase) Create(ctx context.Context, req interface{}) (interf
 	issuer, err := u.authService.GetIssuer(ctx, claims.BranchID)
 	if err != nil {
 		logs.Error("Error getting issuer information", map[string]interface{}{"error": err.Error()})
-		return nil, nil, err
+		return nil, err
 	}
 
 	// 3. Mapear a modelo de dominio
 	domainModel, err := u.mapper.MapToDomainModel(req, issuer)
 	if err != nil {
 		logs.Error("Error mapping to domain model", map[string]interface{}{"error": err.Error()})
-		return nil, nil, err
+		return nil, err
 	}
 
 	// 4. Crear DTE a nivel de servicio
 	result, err := u.service.Create(ctx, domainModel, claims.BranchID)
 	if err != nil {
 		logs.Error("Error creating DTE at service level", map[string]interface{}{"error": err.Error()})
-		return nil, nil, err
+		return nil, err
 	}
 
 	// 5. Mapear a modelo de hacienda
@@ -82,7 +264,7 @@ func (u *GenericDTEUseCase) Create(ctx context.Context, req interface{}) (interf
 	generationCode, err := extractGenerationCode(mhModel)
 	if err != nil {
 		logs.Error("Error extracting generation code", map[string]interface{}{"error": err.Error()})
-		return nil, nil, err
+		return nil, err
 	}
 
 	// 7. Configurar detalles de respuesta
@@ -92,31 +274,30 @@ func (u *GenericDTEUseCase) Create(ctx context.Context, req interface{}) (interf
 		EmissionDate:   utils.TimeNow(),
 	}
 
-	// 8. Comenzar la transmisión del documento
-	transmitResult, err := u.transmitter.RetryTransmission(ctx, mhModel, token, claims.NIT)
-	if err != nil {
-		logs.Error("Error transmitting document", map[string]interface{}{"error": err.Error()})
-		return mhModel, options, err
-	}
-	options.ReceptionStamp = transmitResult.ReceptionStamp
+	return &preparedDTE{
+		claims:         claims,
+		token:          token,
+		result:         result,
+		mhModel:        mhModel,
+		generationCode: generationCode,
+		options:        options,
+	}, nil
+}
 
-	// 9. Guardar el documento en la base de datos
-	err = u.dteService.Create(ctx, mhModel, constants.TransmissionNormal, constants.DocumentReceived, transmitResult.ReceptionStamp)
-	if err != nil {
-		logs.Error("Error saving document in database", map[string]interface{}{"error": err.Error()})
-		return mhModel, options, err
+// receivedPayload construye el contenido del evento de un documento recibido por Hacienda
+func receivedPayload(mhModel interface{}, generationCode string, receptionStamp *string) event.DTEPayload {
+	data := event.DTEPayload{
+		GenerationCode: generationCode,
+		Status:         constants.DocumentReceived,
+		ReceptionStamp: receptionStamp,
 	}
 
-	// 10. Ejecutar operaciones adicionales específicas (si las hay)
-	if u.additionalOps != nil {
-		err = u.additionalOps(ctx, result, claims.BranchID, mhModel)
-		if err != nil {
-			logs.Error("Error executing additional operations", map[string]interface{}{"error": err.Error()})
-			return mhModel, options, err
-		}
+	if dteInfo, err := utils.ExtractAuxiliarIdentification(mhModel); err == nil {
+		data.ControlNumber = dteInfo.Identification.ControlNumber
+		data.DTEType = dteInfo.Identification.DTEType
 	}
 
-	return mhModel, options, nil
+	return data
 }
 
 // extractGenerationCode extrae el código de generación usando reflexión
@@ -7,9 +7,12 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
 	authManager "github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
 	dteInterfaces "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/invalidation"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper"
@@ -23,6 +26,7 @@ type InvalidationUseCase struct {
 	invalidationManager invalidation.InvalidationManager
 	mapper              *request_mapper.InvalidationMapper
 	transmitter         ports.BaseTransmitter
+	events              events.EventPublisher
 }
 
 func NewInvalidationUseCase(dteManager dteInterfaces.DTEManager, invalidationManager invalidation.InvalidationManager, authManager authManager.AuthManager, transmitter ports.BaseTransmitter) *InvalidationUseCase {
@@ -35,6 +39,12 @@ func NewInvalidationUseCase(dteManager dteInterfaces.DTEManager, invalidationMan
 	}
 }
 
+// WithEvents configura la publicación de los eventos de dominio de los documentos invalidados
+func (u *InvalidationUseCase) WithEvents(eventPublisher events.EventPublisher) *InvalidationUseCase {
+	u.events = eventPublisher
+	return u
+}
+
 func (u *InvalidationUseCase) InvalidateDocument(ctx context.Context, request structs.CreateInvalidationRequest) (*structs2.InvalidationResponse, error) {
 	// 1. Sacar los claims y el token del contexto
 	claims := ctx.Value("claims").(*models.AuthClaims)
@@ -99,5 +109,15 @@ func (u *InvalidationUseCase) InvalidateDocument(ctx context.Context, request st
 		return nil, err
 	}
 
+	// 11. Publicar el evento de invalidación
+	if u.events != nil {
+		u.events.Publish(ctx, claims.BranchID, event.DTEInvalidated, event.DTEPayload{
+			GenerationCode: request.GenerationCode,
+			ControlNumber:  originalDTE.Details.ControlNumber,
+			DTEType:        originalDTE.Details.DTEType,
+			Status:         constants.DocumentInvalid,
+		})
+	}
+
 	return mhInvalidation, nil
 }
@@ -0,0 +1,163 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	notificationModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/notification"
+	domainPorts "github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+// NotificationTimeout es el tiempo máximo para enviar las notificaciones de un evento
+const NotificationTimeout = time.Minute
+
+// notifiableEvents contiene el asunto del correo de los eventos que se notifican a los usuarios notificables. El
+// resto de eventos solo se registran y se entregan a los webhooks.
+var notifiableEvents = map[string]string{
+	event.DTERejected:                "Documento rechazado por Hacienda",
+	event.ContingencyBatchProcessed:  "Lote de contingencia procesado",
+	event.DTEInvalidated:             "Documento invalidado",
+	event.CreditNoteBalanceExhausted: "Saldo de documento agotado por notas de crédito",
+	event.CertificateExpiring:        "Certificado de firma próximo a vencer",
+	event.UserRegistered:             "Bienvenido al servicio de facturación electrónica",
+}
+
+// EventNotifier es el suscriptor del bus de eventos que envía por correo los eventos relevantes a los usuarios
+// notificables del dueño de la sucursal
+type EventNotifier struct {
+	deliveryManager notification.DeliveryManager
+	mailSender      domainPorts.MailSender
+}
+
+// NewEventNotifier crea el suscriptor de notificaciones. Si mailSender es nil el envío de correos está deshabilitado.
+func NewEventNotifier(deliveryManager notification.DeliveryManager, mailSender domainPorts.MailSender) *EventNotifier {
+	return &EventNotifier{
+		deliveryManager: deliveryManager,
+		mailSender:      mailSender,
+	}
+}
+
+// Handle registra las notificaciones del evento y las envía en segundo plano. Si un envío falla, el job de
+// reintentos lo volverá a intentar.
+func (n *EventNotifier) Handle(ctx context.Context, domainEvent event.DomainEvent) {
+	if n.mailSender == nil {
+		return
+	}
+	if _, ok := notifiableEvents[domainEvent.EventType]; !ok {
+		return
+	}
+
+	// 1. Registrar una notificación por cada usuario notificable
+	userNotifications, err := n.deliveryManager.RegisterEventEmails(ctx, &domainEvent, eventMessage(&domainEvent))
+	if err != nil {
+		logs.Error("Failed to register event notifications", map[string]interface{}{
+			"eventID":   domainEvent.ID,
+			"eventType": domainEvent.EventType,
+			"error":     err.Error(),
+		})
+		return
+	}
+	if len(userNotifications) == 0 {
+		return
+	}
+
+	// 2. Enviar las notificaciones sin retrasar la operación que originó el evento
+	go func() {
+		deliveryCtx, cancel := context.WithTimeout(context.Background(), NotificationTimeout)
+		defer cancel()
+
+		for _, userNotification := range userNotifications {
+			_ = n.deliver(deliveryCtx, userNotification)
+		}
+	}()
+}
+
+// RetryPending reintenta las notificaciones de eventos pendientes cuyo próximo intento ya se cumplió
+func (n *EventNotifier) RetryPending(ctx context.Context) (int, error) {
+	if n.mailSender == nil {
+		return 0, nil
+	}
+
+	pending, err := n.deliveryManager.GetPendingEventEmails(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+
+		userNotification := &pending[i]
+		if err = n.deliver(ctx, userNotification); err == nil && userNotification.DeliveryStatus == notificationModels.DeliverySent {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// deliver reserva la notificación y la envía. El resultado del envío queda registrado en la notificación; solo se
+// retorna error si no pudo actualizarse su estado.
+func (n *EventNotifier) deliver(ctx context.Context, userNotification *notificationModels.UserNotification) error {
+	// 1. Reservar la notificación para que no se envíe dos veces
+	claimed, err := n.deliveryManager.Claim(ctx, userNotification)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	// 2. Enviar el correo
+	sendErr := n.mailSender.Send(ctx, &notificationModels.Email{
+		To:      []string{userNotification.Recipient},
+		Subject: notifiableEvents[userNotification.EventType],
+		Body:    userNotification.Message,
+	})
+	if sendErr != nil {
+		logs.Warn("Failed to send event notification", map[string]interface{}{
+			"notificationID": userNotification.ID,
+			"eventID":        userNotification.EventID,
+			"attempt":        userNotification.Attempts + 1,
+			"error":          sendErr.Error(),
+		})
+		return n.deliveryManager.MarkFailed(ctx, userNotification, sendErr)
+	}
+
+	return n.deliveryManager.MarkSent(ctx, userNotification)
+}
+
+// eventMessage construye el cuerpo del correo con el detalle del evento
+func eventMessage(domainEvent *event.DomainEvent) string {
+	detail := domainEvent.Payload
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(domainEvent.Payload), "", "  "); err == nil {
+		detail = indented.String()
+	}
+
+	return fmt.Sprintf(`Estimado cliente:
+
+Se registró el siguiente evento en su sucursal %d:
+
+Evento: %s
+Fecha: %s
+
+Detalle:
+%s
+
+Puede consultar el historial de eventos de sus sucursales en GET /api/v1/events.
+`,
+		domainEvent.BranchID,
+		notifiableEvents[domainEvent.EventType],
+		domainEvent.OccurredAt,
+		detail,
+	)
+}
@@ -0,0 +1,135 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	eventModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/events/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+// EventResponse representa un evento de dominio con su contenido como JSON
+type EventResponse struct {
+	ID         uint            `json:"id"`
+	BranchID   uint            `json:"branch_id"`
+	EventType  string          `json:"event_type"`
+	Payload    json.RawMessage `json:"payload"`
+	OccurredAt string          `json:"occurred_at"`
+}
+
+// EventListResponse representa una página de eventos de dominio
+type EventListResponse struct {
+	Events     []EventResponse             `json:"events"`
+	Pagination eventModels.EventPagination `json:"pagination"`
+}
+
+type EventUseCase struct {
+	eventManager events.EventManager
+}
+
+func NewEventUseCase(eventManager events.EventManager) *EventUseCase {
+	return &EventUseCase{
+		eventManager: eventManager,
+	}
+}
+
+// List obtiene los eventos de las sucursales del usuario autenticado, del más reciente al más antiguo
+func (u *EventUseCase) List(ctx context.Context, req *structs.EventListRequest) (*EventListResponse, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Validar los filtros
+	filters, err := parseEventFilters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Obtener los eventos
+	page, err := u.eventManager.List(ctx, claims.ClientID, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EventListResponse{
+		Events:     make([]EventResponse, len(page.Events)),
+		Pagination: page.Pagination,
+	}
+	for i, domainEvent := range page.Events {
+		response.Events[i] = EventResponse{
+			ID:         domainEvent.ID,
+			BranchID:   domainEvent.BranchID,
+			EventType:  domainEvent.EventType,
+			Payload:    json.RawMessage(domainEvent.Payload),
+			OccurredAt: domainEvent.OccurredAt,
+		}
+	}
+
+	return response, nil
+}
+
+// parseEventFilters convierte los parámetros de la consulta en los filtros de eventos
+func parseEventFilters(req *structs.EventListRequest) (*eventModels.EventFilters, error) {
+	filters := &eventModels.EventFilters{
+		EventType: strings.ToUpper(strings.TrimSpace(req.EventType)),
+	}
+
+	if req.BranchID != "" {
+		branchID, err := strconv.ParseUint(req.BranchID, 10, 64)
+		if err != nil || branchID == 0 {
+			return nil, shared_error.NewFormattedGeneralServiceError("EventUseCase", "List", "InvalidQueryParam", "branch_id", "a positive number")
+		}
+		id := uint(branchID)
+		filters.BranchID = &id
+	}
+
+	startDate, err := parseEventDate("startDate", req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseEventDate("endDate", req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	filters.StartDate, filters.EndDate = startDate, endDate
+
+	if filters.Page, err = parsePositive("page", req.Page); err != nil {
+		return nil, err
+	}
+	if filters.PageSize, err = parsePositive("page_size", req.PageSize); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+func parseEventDate(param, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceError("EventUseCase", "List", "InvalidQueryParam", param, "RFC3339")
+	}
+
+	return &parsed, nil
+}
+
+func parsePositive(param, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 1 {
+		return 0, shared_error.NewFormattedGeneralServiceError("EventUseCase", "List", "InvalidQueryParam", param, "a positive number")
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	contingencyModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	domainJobs "github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs"
+	jobModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+type JobUseCase struct {
+	monitor         domainJobs.JobMonitor
+	scheduleManager contingency.RetransmissionScheduleManager
+}
+
+func NewJobUseCase(monitor domainJobs.JobMonitor, scheduleManager contingency.RetransmissionScheduleManager) *JobUseCase {
+	return &JobUseCase{
+		monitor:         monitor,
+		scheduleManager: scheduleManager,
+	}
+}
+
+// Status obtiene el estado de los jobs programados en esta instancia y la réplica que tiene el bloqueo de cada uno. De
+// los jobs de retransmisión propios de cada NIT solo se incluye el del usuario autenticado.
+func (u *JobUseCase) Status(ctx context.Context) *jobModels.JobsStatus {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	tenantPrefix := contingency.TenantJobName("")
+	ownJob := contingency.TenantJobName(claims.NIT)
+
+	status := u.monitor.List(ctx)
+	jobs := make([]jobModels.JobStatus, 0, len(status.Jobs))
+	for _, job := range status.Jobs {
+		if strings.HasPrefix(job.Name, tenantPrefix) && job.Name != ownJob {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	status.Jobs = jobs
+
+	return status
+}
+
+// GetSchedule obtiene la programación de retransmisión vigente del usuario autenticado
+func (u *JobUseCase) GetSchedule(ctx context.Context) (*contingencyModels.RetransmissionSchedule, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.scheduleManager.Get(ctx, claims.ClientID, claims.NIT)
+}
+
+// UpdateSchedule guarda la programación de retransmisión propia del usuario autenticado, los campos omitidos conservan
+// su valor vigente
+func (u *JobUseCase) UpdateSchedule(ctx context.Context, req *structs.RetransmissionScheduleRequest) (*contingencyModels.RetransmissionSchedule, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.scheduleManager.Save(ctx, claims.ClientID, claims.NIT, &contingencyModels.RetransmissionScheduleInput{
+		Cron:                req.Cron,
+		Windows:             req.Windows,
+		BatchSize:           req.BatchSize,
+		MaxExecutionMinutes: req.MaxExecutionMinutes,
+	})
+}
+
+// ResetSchedule elimina la programación propia del usuario autenticado para que utilice la predeterminada
+func (u *JobUseCase) ResetSchedule(ctx context.Context) (*contingencyModels.RetransmissionSchedule, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.scheduleManager.Reset(ctx, claims.ClientID, claims.NIT)
+}
@@ -0,0 +1,52 @@
+package pdf_template
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template"
+	templateModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type PDFTemplateUseCase struct {
+	templateManager pdf_template.PDFTemplateManager
+}
+
+func NewPDFTemplateUseCase(templateManager pdf_template.PDFTemplateManager) *PDFTemplateUseCase {
+	return &PDFTemplateUseCase{
+		templateManager: templateManager,
+	}
+}
+
+// Get obtiene la plantilla de la versión legible del usuario autenticado
+func (u *PDFTemplateUseCase) Get(ctx context.Context) (*templateModels.PDFTemplate, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.templateManager.GetTemplate(ctx, claims.ClientID)
+}
+
+// Save reemplaza el logo y el pie de página de la versión legible del usuario autenticado
+func (u *PDFTemplateUseCase) Save(ctx context.Context, req *structs.SavePDFTemplateRequest) (*templateModels.PDFTemplate, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Decodificar el logo, si se proporciona
+	tpl := &templateModels.PDFTemplate{
+		UserID: claims.ClientID,
+		Footer: req.Footer,
+	}
+
+	if logo := strings.TrimSpace(req.Logo); logo != "" {
+		content, err := base64.StdEncoding.DecodeString(logo)
+		if err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceError("PDFTemplateUseCase", "Save", "InvalidFieldFormat", "logo", "base64")
+		}
+		tpl.Logo = content
+	}
+
+	// 3. Almacenar la plantilla
+	return u.templateManager.SaveTemplate(ctx, tpl)
+}
@@ -0,0 +1,6 @@
+package ports
+
+type ContingencyClassifier interface {
+	Classify(err error) (*int8, *string) // Classify retorna el tipo y motivo de contingencia de un error de transmisión, o nil si no aplica
+	IsRejected(err error) bool           // IsRejected indica si el error corresponde a un documento rechazado por Hacienda
+}
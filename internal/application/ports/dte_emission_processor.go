@@ -0,0 +1,7 @@
+package ports
+
+import "context"
+
+type DTEEmissionProcessor interface {
+	ProcessNext(ctx context.Context) (bool, error) // ProcessNext transmite el siguiente documento de la cola asíncrona, retorna false si la cola está vacía
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "context"
+
+type DTENotifier interface {
+	NotifyIssued(ctx context.Context, generationCode string) // NotifyIssued programa el envío de un DTE recién emitido al correo de su receptor
+	RetryPending(ctx context.Context) (int, error)           // RetryPending reintenta los envíos pendientes y retorna cuántos fueron entregados
+}
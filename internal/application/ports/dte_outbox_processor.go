@@ -0,0 +1,7 @@
+package ports
+
+import "context"
+
+type DTEOutboxProcessor interface {
+	ProcessPending(ctx context.Context) (int, error) // ProcessPending completa los documentos recibidos por Hacienda pendientes del outbox, retorna cuántos se completaron
+}
@@ -0,0 +1,7 @@
+package ports
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+
+type DTEPDFRenderer interface {
+	Render(document *dte.PrintableDTE) ([]byte, error) // Render genera la versión legible de un DTE en formato PDF
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "context"
+
+// NotificationRetrier determina el comportamiento de un servicio que reintenta sus notificaciones pendientes
+type NotificationRetrier interface {
+	RetryPending(ctx context.Context) (int, error) // RetryPending reintenta los envíos pendientes y retorna cuántos fueron entregados
+}
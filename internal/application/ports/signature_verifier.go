@@ -0,0 +1,10 @@
+package ports
+
+import (
+	"context"
+	"crypto/rsa"
+)
+
+type PublicKeyProvider interface {
+	GetPublicKey(ctx context.Context, nit string) (*rsa.PublicKey, error) // GetPublicKey obtiene la llave pública del certificado de un emisor
+}
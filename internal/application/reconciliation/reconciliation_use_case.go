@@ -0,0 +1,95 @@
+package reconciliation
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation"
+	reconciliationModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type ReconciliationUseCase struct {
+	reconciliationManager reconciliation.ReconciliationManager
+}
+
+func NewReconciliationUseCase(reconciliationManager reconciliation.ReconciliationManager) *ReconciliationUseCase {
+	return &ReconciliationUseCase{
+		reconciliationManager: reconciliationManager,
+	}
+}
+
+// ListDiscrepancies obtiene las discrepancias encontradas por la conciliación en las sucursales del usuario autenticado,
+// de la más reciente a la más antigua
+func (u *ReconciliationUseCase) ListDiscrepancies(ctx context.Context, req *structs.ReconciliationDiscrepancyRequest) (*reconciliationModels.DiscrepancyPage, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Validar los filtros
+	filters, err := parseDiscrepancyFilters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Obtener las discrepancias
+	return u.reconciliationManager.ListDiscrepancies(ctx, claims.ClientID, filters)
+}
+
+// parseDiscrepancyFilters convierte los parámetros de la consulta en los filtros de discrepancias
+func parseDiscrepancyFilters(req *structs.ReconciliationDiscrepancyRequest) (*reconciliationModels.DiscrepancyFilters, error) {
+	filters := &reconciliationModels.DiscrepancyFilters{
+		Source: strings.ToUpper(strings.TrimSpace(req.Source)),
+		Action: strings.ToUpper(strings.TrimSpace(req.Action)),
+	}
+
+	if req.BranchID != "" {
+		branchID, err := strconv.ParseUint(req.BranchID, 10, 64)
+		if err != nil || branchID == 0 {
+			return nil, shared_error.NewFormattedGeneralServiceError("ReconciliationUseCase", "ListDiscrepancies", "InvalidQueryParam", "branch_id", "a positive number")
+		}
+		id := uint(branchID)
+		filters.BranchID = &id
+	}
+
+	switch filters.Source {
+	case "", reconciliationModels.SourcePendingDocument, reconciliationModels.SourceFailedSequence, reconciliationModels.SourceOutboxIntent:
+	default:
+		return nil, shared_error.NewFormattedGeneralServiceError("ReconciliationUseCase", "ListDiscrepancies", "InvalidQueryParam", "source",
+			reconciliationModels.SourcePendingDocument+", "+reconciliationModels.SourceFailedSequence+" or "+reconciliationModels.SourceOutboxIntent)
+	}
+
+	switch filters.Action {
+	case "", reconciliationModels.ActionMarkedReceived, reconciliationModels.ActionMarkedRejected, reconciliationModels.ActionRecovered,
+		reconciliationModels.ActionResumed:
+	default:
+		return nil, shared_error.NewFormattedGeneralServiceError("ReconciliationUseCase", "ListDiscrepancies", "InvalidQueryParam", "action",
+			reconciliationModels.ActionMarkedReceived+", "+reconciliationModels.ActionMarkedRejected+", "+reconciliationModels.ActionRecovered+
+				" or "+reconciliationModels.ActionResumed)
+	}
+
+	var err error
+	if filters.Page, err = parsePositive("page", req.Page); err != nil {
+		return nil, err
+	}
+	if filters.PageSize, err = parsePositive("page_size", req.PageSize); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+func parsePositive(param, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 1 {
+		return 0, shared_error.NewFormattedGeneralServiceError("ReconciliationUseCase", "ListDiscrepancies", "InvalidQueryParam", param, "a positive number")
+	}
+
+	return parsed, nil
+}
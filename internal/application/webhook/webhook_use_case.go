@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
+	webhookModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+const (
+	// DefaultDeliveriesLimit es la cantidad de entregas retornadas si no se indica un límite
+	DefaultDeliveriesLimit = 50
+	// MaxDeliveriesLimit es la cantidad máxima de entregas retornadas por consulta
+	MaxDeliveriesLimit = 200
+)
+
+type WebhookUseCase struct {
+	webhookManager webhook.WebhookManager
+}
+
+func NewWebhookUseCase(webhookManager webhook.WebhookManager) *WebhookUseCase {
+	return &WebhookUseCase{
+		webhookManager: webhookManager,
+	}
+}
+
+// Create registra una suscripción del usuario autenticado
+func (u *WebhookUseCase) Create(ctx context.Context, req *structs.WebhookSubscriptionRequest) (*webhookModels.WebhookSubscription, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.webhookManager.CreateSubscription(ctx, claims.ClientID, mapSubscriptionInput(req))
+}
+
+// List obtiene las suscripciones del usuario autenticado
+func (u *WebhookUseCase) List(ctx context.Context) ([]webhookModels.WebhookSubscription, error) {
+	claims := ctx.Value("claims").(*models.AuthClaims)
+	return u.webhookManager.ListSubscriptions(ctx, claims.ClientID)
+}
+
+// Update modifica una suscripción del usuario autenticado
+func (u *WebhookUseCase) Update(ctx context.Context, id string, req *structs.WebhookSubscriptionRequest) (*webhookModels.WebhookSubscription, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Validar el identificador de la suscripción
+	subscriptionID, err := parseID("Update", id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Modificar la suscripción
+	return u.webhookManager.UpdateSubscription(ctx, claims.ClientID, subscriptionID, mapSubscriptionInput(req))
+}
+
+// Delete elimina una suscripción del usuario autenticado
+func (u *WebhookUseCase) Delete(ctx context.Context, id string) error {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Validar el identificador de la suscripción
+	subscriptionID, err := parseID("Delete", id)
+	if err != nil {
+		return err
+	}
+
+	// 3. Eliminar la suscripción
+	return u.webhookManager.DeleteSubscription(ctx, claims.ClientID, subscriptionID)
+}
+
+// ListDeliveries obtiene el registro de entregas del usuario autenticado, filtrado por suscripción, estado y evento
+func (u *WebhookUseCase) ListDeliveries(ctx context.Context, subscriptionID, status, eventType, limit string) ([]webhookModels.WebhookDelivery, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Validar los filtros
+	filters := &webhookModels.DeliveryFilters{
+		Status:    status,
+		EventType: eventType,
+		Limit:     DefaultDeliveriesLimit,
+	}
+
+	if subscriptionID != "" {
+		id, err := parseID("ListDeliveries", subscriptionID)
+		if err != nil {
+			return nil, err
+		}
+		filters.SubscriptionID = &id
+	}
+
+	if limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil || value < 1 {
+			return nil, shared_error.NewFormattedGeneralServiceError("WebhookUseCase", "ListDeliveries", "InvalidFieldFormat", "limit", "positive number")
+		}
+		filters.Limit = min(value, MaxDeliveriesLimit)
+	}
+
+	// 3. Obtener las entregas
+	return u.webhookManager.ListDeliveries(ctx, claims.ClientID, filters)
+}
+
+// Replay reenvía una entrega del usuario autenticado
+func (u *WebhookUseCase) Replay(ctx context.Context, id string) (*webhookModels.WebhookDelivery, error) {
+	// 1. Obtener los claims del contexto
+	claims := ctx.Value("claims").(*models.AuthClaims)
+
+	// 2. Validar el identificador de la entrega
+	deliveryID, err := parseID("Replay", id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Reenviar la entrega
+	return u.webhookManager.Replay(ctx, claims.ClientID, deliveryID)
+}
+
+func mapSubscriptionInput(req *structs.WebhookSubscriptionRequest) *webhookModels.SubscriptionInput {
+	return &webhookModels.SubscriptionInput{
+		URL:      req.URL,
+		Events:   req.Events,
+		Secret:   req.Secret,
+		IsActive: req.IsActive,
+	}
+}
+
+func parseID(operation, id string) (uint, error) {
+	value, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, shared_error.NewFormattedGeneralServiceError("WebhookUseCase", operation, "InvalidFieldFormat", "id", "number")
+	}
+
+	return uint(value), nil
+}
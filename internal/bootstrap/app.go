@@ -18,6 +18,7 @@ import (
 	errPackage "github.com/MarlonG1/api-facturacion-sv/config/error"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/server"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/jobs"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 )
@@ -27,6 +28,7 @@ type Application struct {
 	server       *server.Server
 	container    *containers.Container
 	dbConnection *drivers.DbConnection
+	emissionPool *jobs.EmissionWorkerPool
 }
 
 // SupportedDrivers contiene la configuración de drivers de base de datos soportados
@@ -89,12 +91,19 @@ func (app *Application) Initialize() error {
 	app.server = server.Initialize(app.container)
 
 	// 8. Inicializar los jobs
-	err = setup.SetupJobs(app.container.Services().ContingencyManager(), config.Server.AmbientCode, app.dbConnection)
+	err = setup.SetupJobs(app.container.Services().ContingencyManager(), app.container.Services().RetransmissionScheduleManager(),
+		app.container.Services().CertificateManager(), app.container.UseCases().DTEDeliveryUseCase(), app.container.UseCases().EventNotifier(),
+		app.container.Services().WebhookManager(), app.container.Services().ReconciliationManager(), app.container.UseCases().DTEOutboxUseCase(),
+		app.container.Services().JobMonitor(), app.dbConnection)
 	if err != nil {
 		logs.Error("Failed to setup jobs", map[string]interface{}{"error": err.Error()})
 		return fmt.Errorf("error setting up jobs: %w", err)
 	}
 
+	// 9. Iniciar los workers de emisión asíncrona
+	app.emissionPool = jobs.NewEmissionWorkerPool(app.container.UseCases().AsyncEmissionUseCase(), config.Emission.Workers)
+	app.emissionPool.Start()
+
 	return nil
 }
 
@@ -131,6 +140,9 @@ func (app *Application) Start() error {
 			return fmt.Errorf("server shutdown error: %w", err)
 		}
 
+		// Detener los workers de emisión antes de cerrar la base de datos
+		app.emissionPool.Stop(ctx)
+
 		// Cerrar la conexión a la base de datos
 		if err := app.dbConnection.Close(); err != nil {
 			logs.Error("Database connection close error", map[string]interface{}{"error": err.Error()})
@@ -11,12 +11,20 @@ type HandlerContainer struct {
 	useCases *UseCaseContainer
 	services *ServicesContainer
 
-	authHandler        *handlers.AuthHandler
-	dteHandler         *handlers.DTEHandler
-	healthHandler      *handlers.HealthHandler
-	testHandler        *handlers.TestHandler
-	metricsHandler     *handlers.MetricsHandler
-	contingencyHandler *helpers.ContingencyHandler
+	authHandler             *handlers.AuthHandler
+	branchHandler           *handlers.BranchHandler
+	dteHandler              *handlers.DTEHandler
+	healthHandler           *handlers.HealthHandler
+	testHandler             *handlers.TestHandler
+	metricsHandler          *handlers.MetricsHandler
+	certificateHandler      *handlers.CertificateHandler
+	webhookHandler          *handlers.WebhookHandler
+	jobHandler              *handlers.JobHandler
+	eventHandler            *handlers.EventHandler
+	reconciliationHandler   *handlers.ReconciliationHandler
+	contingencyQueueHandler *handlers.ContingencyHandler
+	pdfTemplateHandler      *handlers.PDFTemplateHandler
+	contingencyHandler      *helpers.ContingencyHandler
 }
 
 func NewHandlerContainer(useCases *UseCaseContainer, services *ServicesContainer) *HandlerContainer {
@@ -27,19 +35,29 @@ func NewHandlerContainer(useCases *UseCaseContainer, services *ServicesContainer
 }
 
 func (c *HandlerContainer) Initialize() {
-	c.contingencyHandler = helpers.NewContingencyHandler(c.services.contingencyManager)
+	c.contingencyHandler = helpers.NewContingencyHandler(c.services.contingencyManager).
+		WithCircuit(c.services.Circuit(constants.CircuitHaciendaReception))
 	c.healthHandler = handlers.NewHealthHandler(c.services.HealthManager())
 	c.testHandler = handlers.NewTestHandler(c.services.TestManager())
 	c.authHandler = handlers.NewAuthHandler(c.useCases.AuthUseCase())
+	c.branchHandler = handlers.NewBranchHandler(c.useCases.BranchUseCase())
 	c.metricsHandler = handlers.NewMetricsHandler(c.services.MetricsManager())
-	c.dteHandler = handlers.NewDTEHandler(c.useCases.DTEConsultUseCase(), c.useCases.InvalidationUseCase(),
+	c.certificateHandler = handlers.NewCertificateHandler(c.useCases.CertificateUseCase())
+	c.webhookHandler = handlers.NewWebhookHandler(c.useCases.WebhookUseCase())
+	c.jobHandler = handlers.NewJobHandler(c.useCases.JobUseCase())
+	c.eventHandler = handlers.NewEventHandler(c.useCases.EventUseCase())
+	c.reconciliationHandler = handlers.NewReconciliationHandler(c.useCases.ReconciliationUseCase())
+	c.contingencyQueueHandler = handlers.NewContingencyHandler(c.useCases.ContingencyUseCase())
+	c.pdfTemplateHandler = handlers.NewPDFTemplateHandler(c.useCases.PDFTemplateUseCase())
+	c.dteHandler = handlers.NewDTEHandler(c.useCases.DTEConsultUseCase(), c.useCases.InvalidationUseCase(), c.useCases.DTEVerifyUseCase(),
+		c.useCases.DTEPDFUseCase(), c.useCases.DTEDeliveryUseCase(), c.useCases.AsyncEmissionUseCase(),
 		c.initializeGenericCreatorHandler(c.contingencyHandler),
 	)
 }
 
 func (c *HandlerContainer) initializeGenericCreatorHandler(contingencyHandler *helpers.ContingencyHandler) *handlers.GenericCreatorDTEHandler {
 	// Crear el handler genérico
-	genericHandler := handlers.NewGenericDTEHandler(contingencyHandler)
+	genericHandler := handlers.NewGenericDTEHandler(contingencyHandler).WithIdempotency(c.services.IdempotencyManager())
 
 	// Registrar los tipos de documentos
 	genericHandler.RegisterDocument("/dte/invoices", helpers.DocumentConfig{
@@ -63,6 +81,55 @@ func (c *HandlerContainer) initializeGenericCreatorHandler(contingencyHandler *h
 		UsesContingency: false, // TODO: activar cuando Hacienda resuelva el problema
 	})
 
+	genericHandler.RegisterDocument("/dte/debitnote", helpers.DocumentConfig{
+		UseCase:         c.useCases.DebitNoteUseCase(),
+		RequestType:     &structs.CreateDebitNoteRequest{},
+		DocumentType:    constants.NotaDebitoElectronica,
+		UsesContingency: false, // TODO: activar cuando Hacienda resuelva el problema
+	})
+
+	genericHandler.RegisterDocument("/dte/fse", helpers.DocumentConfig{
+		UseCase:         c.useCases.FSEUseCase(),
+		RequestType:     &structs.CreateFSERequest{},
+		DocumentType:    constants.FacturaSujetoExcluidoElectronica,
+		UsesContingency: true,
+	})
+
+	genericHandler.RegisterDocument("/dte/export", helpers.DocumentConfig{
+		UseCase:         c.useCases.ExportInvoiceUseCase(),
+		RequestType:     &structs.CreateExportInvoiceRequest{},
+		DocumentType:    constants.FacturaExportacionElectronica,
+		UsesContingency: true,
+	})
+
+	genericHandler.RegisterDocument("/dte/remission", helpers.DocumentConfig{
+		UseCase:         c.useCases.RemissionNoteUseCase(),
+		RequestType:     &structs.CreateRemissionNoteRequest{},
+		DocumentType:    constants.NotaRemisionElectronica,
+		UsesContingency: true,
+	})
+
+	genericHandler.RegisterDocument("/dte/liquidation", helpers.DocumentConfig{
+		UseCase:         c.useCases.LiquidationUseCase(),
+		RequestType:     &structs.CreateLiquidationRequest{},
+		DocumentType:    constants.ComprobanteLiquidacionElectronico,
+		UsesContingency: true,
+	})
+
+	genericHandler.RegisterDocument("/dte/accounting-liquidation", helpers.DocumentConfig{
+		UseCase:         c.useCases.AccountingLiquidationUseCase(),
+		RequestType:     &structs.CreateAccountingLiquidationRequest{},
+		DocumentType:    constants.DocContableLiquidacionElectronico,
+		UsesContingency: false,
+	})
+
+	genericHandler.RegisterDocument("/dte/donation", helpers.DocumentConfig{
+		UseCase:         c.useCases.DonationUseCase(),
+		RequestType:     &structs.CreateDonationRequest{},
+		DocumentType:    constants.ComprobanteDonacionElectronico,
+		UsesContingency: false,
+	})
+
 	genericHandler.RegisterDocument("/dte/retention", helpers.DocumentConfig{
 		UseCase:         c.useCases.RetentionUseCase(),
 		RequestType:     &structs.CreateRetentionRequest{},
@@ -77,6 +144,14 @@ func (c *HandlerContainer) MetricsHandler() *handlers.MetricsHandler {
 	return c.metricsHandler
 }
 
+func (c *HandlerContainer) CertificateHandler() *handlers.CertificateHandler {
+	return c.certificateHandler
+}
+
+func (c *HandlerContainer) PDFTemplateHandler() *handlers.PDFTemplateHandler {
+	return c.pdfTemplateHandler
+}
+
 func (c *HandlerContainer) HealthHandler() *handlers.HealthHandler {
 	return c.healthHandler
 }
@@ -92,3 +167,27 @@ func (c *HandlerContainer) DTEHandler() *handlers.DTEHandler {
 func (c *HandlerContainer) AuthHandler() *handlers.AuthHandler {
 	return c.authHandler
 }
+
+func (c *HandlerContainer) BranchHandler() *handlers.BranchHandler {
+	return c.branchHandler
+}
+
+func (c *HandlerContainer) JobHandler() *handlers.JobHandler {
+	return c.jobHandler
+}
+
+func (c *HandlerContainer) WebhookHandler() *handlers.WebhookHandler {
+	return c.webhookHandler
+}
+
+func (c *HandlerContainer) EventHandler() *handlers.EventHandler {
+	return c.eventHandler
+}
+
+func (c *HandlerContainer) ReconciliationHandler() *handlers.ReconciliationHandler {
+	return c.reconciliationHandler
+}
+
+func (c *HandlerContainer) ContingencyQueueHandler() *handlers.ContingencyHandler {
+	return c.contingencyQueueHandler
+}
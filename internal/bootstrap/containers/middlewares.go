@@ -11,6 +11,7 @@ type MiddlewareContainer struct {
 
 	corsMid    *middleware.CorsMiddleware
 	authMid    *middleware.AuthMiddleware
+	authzMid   *middleware.AuthorizationMiddleware
 	tokenMid   *middleware.TokenExtractor
 	errorMid   *middleware.ErrorMiddleware
 	metricMid  *middleware.MetricsMiddleware
@@ -34,6 +35,7 @@ func (c *MiddlewareContainer) Initialize() {
 	c.tokenMid = middleware.NewTokenExtractor()
 	c.errorMid = middleware.NewErrorMiddleware()
 	c.authMid = middleware.NewAuthMiddleware(c.services.TokenManager())
+	c.authzMid = middleware.NewAuthorizationMiddleware()
 	c.metricMid = middleware.NewMetricsMiddleware(c.services.CacheManager())
 	c.dbMid = middleware.NewDBConnectionMiddleware(c.connection)
 	c.timeoutMid = middleware.NewTimeoutMiddleware()
@@ -54,6 +56,10 @@ func (c *MiddlewareContainer) AuthMiddleware() *middleware.AuthMiddleware {
 	return c.authMid
 }
 
+func (c *MiddlewareContainer) AuthorizationMiddleware() *middleware.AuthorizationMiddleware {
+	return c.authzMid
+}
+
 func (c *MiddlewareContainer) TokenExtractor() *middleware.TokenExtractor {
 	return c.tokenMid
 }
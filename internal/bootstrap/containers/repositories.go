@@ -3,9 +3,17 @@ package containers
 import (
 	"github.com/MarlonG1/api-facturacion-sv/config/drivers"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate"
 	contiPorts "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
 	dtePorts "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/notification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/repositories"
 	"gorm.io/gorm"
 )
@@ -19,6 +27,15 @@ type RepositoryContainer struct {
 	failedSequentialNumberRepo ports.FailedSequenceNumberRepositoryPort
 	dteRepo                    dtePorts.DTERepositoryPort
 	contingencyRepo            contiPorts.ContingencyRepositoryPort
+	retransmissionScheduleRepo contiPorts.RetransmissionScheduleRepositoryPort
+	certificateRepo            certificate.CertificateRepositoryPort
+	pdfTemplateRepo            pdf_template.PDFTemplateRepositoryPort
+	notificationRepo           notification.NotificationRepositoryPort
+	emissionJobRepo            emission.EmissionRepositoryPort
+	outboxRepo                 outbox.OutboxRepositoryPort
+	webhookRepo                webhook.WebhookRepositoryPort
+	eventRepo                  events.EventRepositoryPort
+	reconciliationRepo         reconciliation.ReconciliationRepositoryPort
 }
 
 func NewRepositoryContainer(connection *drivers.DbConnection) *RepositoryContainer {
@@ -33,13 +50,58 @@ func (c *RepositoryContainer) Initialize() {
 	c.sequentialNumberRepo = repositories.NewControlNumberRepository(c.db)
 	c.dteRepo = repositories.NewDTERepository(c.db)
 	c.contingencyRepo = repositories.NewContingencyRepository(c.db)
+	c.retransmissionScheduleRepo = repositories.NewRetransmissionScheduleRepository(c.db)
 	c.failedSequentialNumberRepo = repositories.NewFailedSequenceNumberRepository(c.db)
+	c.certificateRepo = repositories.NewCertificateRepository(c.db)
+	c.pdfTemplateRepo = repositories.NewPDFTemplateRepository(c.db)
+	c.notificationRepo = repositories.NewNotificationRepository(c.db)
+	c.emissionJobRepo = repositories.NewEmissionJobRepository(c.db)
+	c.outboxRepo = repositories.NewOutboxRepository(c.db)
+	c.webhookRepo = repositories.NewWebhookRepository(c.db)
+	c.eventRepo = repositories.NewEventRepository(c.db)
+	c.reconciliationRepo = repositories.NewReconciliationRepository(c.db)
+}
+
+func (c *RepositoryContainer) ReconciliationRepo() reconciliation.ReconciliationRepositoryPort {
+	return c.reconciliationRepo
+}
+
+func (c *RepositoryContainer) EventRepo() events.EventRepositoryPort {
+	return c.eventRepo
+}
+
+func (c *RepositoryContainer) WebhookRepo() webhook.WebhookRepositoryPort {
+	return c.webhookRepo
+}
+
+func (c *RepositoryContainer) EmissionJobRepo() emission.EmissionRepositoryPort {
+	return c.emissionJobRepo
+}
+
+func (c *RepositoryContainer) OutboxRepo() outbox.OutboxRepositoryPort {
+	return c.outboxRepo
+}
+
+func (c *RepositoryContainer) NotificationRepo() notification.NotificationRepositoryPort {
+	return c.notificationRepo
+}
+
+func (c *RepositoryContainer) PDFTemplateRepo() pdf_template.PDFTemplateRepositoryPort {
+	return c.pdfTemplateRepo
+}
+
+func (c *RepositoryContainer) CertificateRepo() certificate.CertificateRepositoryPort {
+	return c.certificateRepo
 }
 
 func (c *RepositoryContainer) FailedSequentialNumberRepo() ports.FailedSequenceNumberRepositoryPort {
 	return c.failedSequentialNumberRepo
 }
 
+func (c *RepositoryContainer) RetransmissionScheduleRepo() contiPorts.RetransmissionScheduleRepositoryPort {
+	return c.retransmissionScheduleRepo
+}
+
 func (c *RepositoryContainer) ContingencyRepo() contiPorts.ContingencyRepositoryPort {
 	return c.contingencyRepo
 }
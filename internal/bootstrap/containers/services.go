@@ -1,61 +1,110 @@
 package containers
 
 import (
-	"time"
-
 	"github.com/MarlonG1/api-facturacion-sv/config"
 	appPorts "github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	authService "github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/service"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/service/strategies"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/ccf"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	contingencyModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/credit_note"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/debit_note"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/invalidation"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/invoice"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/retention"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/health"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency"
+	domainJobs "github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/metrics"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/notification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/test_endpoint"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/cache"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/circuit"
 	adapterContingecy "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/contingency"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/crypt"
 	adapterHealth "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/health"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/mail"
 	adapterMetric "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/metrics"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/printing"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/signing"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/signing/signer"
 	adapterTest "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/test_endpoint"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/tokens"
 	adapterTransmitter "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/transmitter"
 	batch "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/transmitter/batch"
+	adapterWebhook "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/webhook"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/jobs"
 )
 
 type ServicesContainer struct {
 	repos *RepositoryContainer
 
-	cacheManager            ports.CacheManager
-	tokenManager            ports.TokenManager
-	authManager             auth.AuthManager
-	cryptManager            ports.CryptManager
-	transmitterManager      appPorts.DTETransmitter
-	haciendaAuthManager     appPorts.HaciendaAuthManager
-	signerManager           appPorts.SignerManager
-	dteManager              dte_documents.DTEManager
-	sequentialManager       dte_documents.SequentialNumberManager
-	invalidationManager     invalidation.InvalidationManager
-	transmitterBatchManager transmitter.BatchTransmitterPort
-	contingencyEventManager contingency.ContingencyEventSender
-	contingencyManager      contingency.ContingencyManager
-	healthManager           health.HealthManager
-	testManager             test_endpoint.TestManager
-	metricsManager          metrics.MetricsManager
-	invoiceManager          ports.DTEService
-	ccfManager              ports.DTEService
-	retentionManager        ports.DTEService
-	creditNoteManager       ports.DTEService
+	transmissionConfig *models.TransmissionConfig
+	circuits           map[string]ports.CircuitManager
+
+	cacheManager                  ports.CacheManager
+	tokenManager                  ports.TokenManager
+	authManager                   auth.AuthManager
+	branchManager                 auth.BranchManager
+	cryptManager                  ports.CryptManager
+	transmitterManager            appPorts.DTETransmitter
+	haciendaAuthManager           appPorts.HaciendaAuthManager
+	signerManager                 appPorts.SignerManager
+	publicKeyProvider             appPorts.PublicKeyProvider
+	certificateManager            certificate.CertificateManager
+	pdfTemplateManager            pdf_template.PDFTemplateManager
+	pdfRenderer                   appPorts.DTEPDFRenderer
+	deliveryManager               notification.DeliveryManager
+	mailSender                    ports.MailSender
+	idempotencyManager            idempotency.IdempotencyManager
+	emissionQueueManager          emission.EmissionQueueManager
+	outboxManager                 outbox.OutboxManager
+	webhookManager                webhook.WebhookManager
+	eventManager                  events.EventManager
+	dteManager                    dte_documents.DTEManager
+	sequentialManager             dte_documents.SequentialNumberManager
+	invalidationManager           invalidation.InvalidationManager
+	transmitterBatchManager       transmitter.BatchTransmitterPort
+	contingencyEventManager       contingency.ContingencyEventSender
+	contingencyManager            contingency.ContingencyManager
+	retransmissionScheduleManager contingency.RetransmissionScheduleManager
+	reconciliationManager         reconciliation.ReconciliationManager
+	jobMonitor                    domainJobs.JobMonitor
+	healthManager                 health.HealthManager
+	testManager                   test_endpoint.TestManager
+	metricsManager                metrics.MetricsManager
+	invoiceManager                ports.DTEService
+	ccfManager                    ports.DTEService
+	retentionManager              ports.DTEService
+	creditNoteManager             ports.DTEService
+	debitNoteManager              ports.DTEService
+	fseManager                    ports.DTEService
+	exportInvoiceManager          ports.DTEService
+	remissionNoteManager          ports.DTEService
+	liquidationManager            ports.DTEService
+	accountingLiquidationManager  ports.DTEService
+	donationManager               ports.DTEService
 }
 
 func NewServicesContainer(repos *RepositoryContainer) *ServicesContainer {
@@ -73,32 +122,76 @@ func (c *ServicesContainer) Initialize() error {
 		return err
 	}
 
+	c.eventManager = events.NewEventBus(c.repos.EventRepo())
 	c.tokenManager = tokens.NewJWTService(config.Server.JWTSecret, c.cacheManager)
-	c.authManager = strategies.NewAuthService(c.tokenManager, c.repos.AuthRepo(), c.cacheManager)
-	c.signerManager = signer.NewDTESigner(c.repos.AuthRepo())
-	c.haciendaAuthManager = signing.NewHaciendaAuthService(c.cacheManager, c.authManager)
-	c.transmitterManager = adapterTransmitter.NewMHTransmitter(c.haciendaAuthManager, c.repos.FailedSequentialNumberRepo())
+	c.authManager = strategies.NewAuthService(c.tokenManager, c.repos.AuthRepo(), c.cacheManager, c.cryptManager)
+	c.branchManager = authService.NewBranchService(c.repos.AuthRepo(), c.cryptManager, c.tokenManager, c.authManager)
+	c.certificateManager = certificate.NewCertificateService(c.repos.CertificateRepo(), c.repos.AuthRepo(),
+		signer.NewCertificateInspector(), c.cryptManager, config.Signer.CertificatesKey,
+		config.Signer.Mode == config.SignerModeNative, c.eventManager)
+	// El directorio de certificados solo es obligatorio con el firmador nativo, sin él solo se usan los registrados
+	var certificateFallback signer.CertificateSource
+	if config.Signer.CertificatesPath != "" {
+		certificateFallback = signer.NewFileCertificateSource(config.Signer.CertificatesPath)
+	}
+	certificateSource := signer.NewManagedCertificateSource(c.certificateManager, certificateFallback)
+	c.transmissionConfig = models.NewTransmissionConfig()
+	c.circuits = make(map[string]ports.CircuitManager)
+	for _, component := range []string{constants.CircuitHaciendaReception, constants.CircuitHaciendaAuth, constants.CircuitSigner} {
+		c.circuits[component] = circuit.NewCircuitBreaker(c.transmissionConfig.CircuitThreshold, c.transmissionConfig.CircuitResetTime)
+	}
+	c.signerManager = signer.NewSignerManager(c.repos.AuthRepo(), certificateSource, c.circuits[constants.CircuitSigner])
+	c.publicKeyProvider = signer.NewCertificateKeyProvider(certificateSource)
+	c.haciendaAuthManager = signing.NewHaciendaAuthService(c.cacheManager, c.authManager, c.circuits[constants.CircuitHaciendaAuth])
+	c.transmitterManager = adapterTransmitter.NewMHTransmitter(c.haciendaAuthManager, c.repos.FailedSequentialNumberRepo(),
+		c.circuits[constants.CircuitHaciendaReception])
 	c.dteManager = dte_documents.NewDTEService(c.repos.DTERepo())
+	c.pdfTemplateManager = pdf_template.NewPDFTemplateService(c.repos.PDFTemplateRepo())
+	c.pdfRenderer = printing.NewDTEPDFRenderer()
+	c.deliveryManager = notification.NewDeliveryService(c.repos.NotificationRepo(), config.Mail.MaxAttempts)
+	if config.Mail.Host != "" {
+		c.mailSender = mail.NewSMTPSender(mail.SMTPConfig{
+			Host:     config.Mail.Host,
+			Port:     config.Mail.Port,
+			Username: config.Mail.Username,
+			Password: config.Mail.Password,
+			From:     config.Mail.From,
+			FromName: config.Mail.FromName,
+		})
+	}
+	c.idempotencyManager = idempotency.NewIdempotencyService(cache.NewRedisIdempotencyStore(c.cacheManager))
+	c.emissionQueueManager = emission.NewEmissionQueueService(c.repos.EmissionJobRepo(), config.Emission.MaxAttempts)
+	c.webhookManager = webhook.NewWebhookService(c.repos.WebhookRepo(),
+		adapterWebhook.NewHTTPSender(config.Webhook.AllowPrivateNetworks), c.cryptManager, config.Webhook.SecretsKey,
+		config.Webhook.MaxAttempts, config.Webhook.AllowPrivateNetworks)
+	c.eventManager.Subscribe(event.AllEvents, webhook.EventHandler(c.webhookManager))
 	c.sequentialManager = dte_documents.NewSequentialNumberService(c.repos.SequentialNumberRepo(), c.repos.AuthRepo())
-	c.invoiceManager = invoice.NewInvoiceService(c.sequentialManager)
-	c.ccfManager = ccf.NewCCFService(c.sequentialManager)
+	c.invoiceManager = invoice.NewInvoiceService(c.sequentialManager, c.dteManager)
+	c.ccfManager = ccf.NewCCFService(c.sequentialManager, c.dteManager)
 	c.invalidationManager = invalidation.NewInvalidationService(c.dteManager)
 	c.retentionManager = retention.NewRetentionService(c.sequentialManager, c.dteManager)
 	c.creditNoteManager = credit_note.NewCreditNoteService(c.sequentialManager, c.dteManager)
+	c.debitNoteManager = debit_note.NewDebitNoteService(c.sequentialManager, c.dteManager)
+	c.fseManager = fse.NewFSEService(c.sequentialManager, c.dteManager)
+	c.exportInvoiceManager = export_invoice.NewExportInvoiceService(c.sequentialManager, c.dteManager)
+	c.remissionNoteManager = remission_note.NewRemissionNoteService(c.sequentialManager, c.dteManager)
+	c.liquidationManager = liquidation.NewLiquidationService(c.sequentialManager, c.dteManager)
+	c.accountingLiquidationManager = accounting_liquidation.NewAccountingLiquidationService(c.sequentialManager, c.dteManager)
+	c.donationManager = donation.NewDonationService(c.sequentialManager, c.dteManager)
 	c.testManager = adapterTest.NewTestService(c.repos.db)
 	c.metricsManager = adapterMetric.NewMetricService(c.cacheManager)
 	c.healthManager = adapterHealth.NewHealthService(&adapterHealth.HealthServiceConfig{
-		DB: c.repos.db,
+		DB:       c.repos.db,
+		Circuits: c.circuits,
 	})
 
-	transmissionConf := models.NewTransmissionConfig(5*time.Second, 2*time.Minute, 2.0)
 	c.transmitterBatchManager = batch.NewBatchTransmitterService(
 		c.haciendaAuthManager,
 		c.signerManager,
 		c.repos.ContingencyRepo(),
-		transmissionConf,
-		&transmitter.RealTimeProvider{},
+		c.transmissionConfig,
 		c.repos.connection,
+		c.eventManager,
 	)
 
 	c.contingencyEventManager = adapterContingecy.NewContingencyEventService(
@@ -107,7 +200,6 @@ func (c *ServicesContainer) Initialize() error {
 		c.cacheManager,
 		c.tokenManager,
 		c.signerManager,
-		c.repos.ContingencyRepo(),
 		&transmitter.RealTimeProvider{},
 		c.repos.connection,
 	)
@@ -123,16 +215,84 @@ func (c *ServicesContainer) Initialize() error {
 		c.transmitterBatchManager,
 		c.contingencyEventManager,
 		&transmitter.RealTimeProvider{},
-		transmissionConf,
+		c.transmissionConfig,
+		c.eventManager,
+	)
+
+	c.retransmissionScheduleManager = contingency.NewRetransmissionScheduleService(
+		c.repos.RetransmissionScheduleRepo(),
+		contingencyModels.RetransmissionSchedule{
+			Cron:                config.Retransmission.Cron,
+			Windows:             config.Retransmission.Windows,
+			BatchSize:           config.Retransmission.BatchSize,
+			MaxExecutionMinutes: config.Retransmission.MaxExecutionMinutes,
+		},
+		config.Server.MaxBatchSize,
+	)
+	c.outboxManager = outbox.NewOutboxService(c.repos.OutboxRepo())
+	c.reconciliationManager = reconciliation.NewReconciliationService(
+		c.repos.ReconciliationRepo(),
+		c.authManager,
+		c.dteManager,
+		c.transmitterManager,
+		c.cacheManager,
+		c.tokenManager,
+		c.eventManager,
+		c.outboxManager,
 	)
+	c.jobMonitor = jobs.NewJobMonitor(cache.NewRedisJobLocker(c.cacheManager), jobs.DefaultJobLeaseTTL)
 
 	return nil
 }
 
+func (c *ServicesContainer) RetransmissionScheduleManager() contingency.RetransmissionScheduleManager {
+	return c.retransmissionScheduleManager
+}
+
+func (c *ServicesContainer) ReconciliationManager() reconciliation.ReconciliationManager {
+	return c.reconciliationManager
+}
+
+func (c *ServicesContainer) OutboxManager() outbox.OutboxManager {
+	return c.outboxManager
+}
+
+func (c *ServicesContainer) JobMonitor() domainJobs.JobMonitor {
+	return c.jobMonitor
+}
+
 func (c *ServicesContainer) CreditNoteManager() ports.DTEService {
 	return c.creditNoteManager
 }
 
+func (c *ServicesContainer) DebitNoteManager() ports.DTEService {
+	return c.debitNoteManager
+}
+
+func (c *ServicesContainer) FSEManager() ports.DTEService {
+	return c.fseManager
+}
+
+func (c *ServicesContainer) ExportInvoiceManager() ports.DTEService {
+	return c.exportInvoiceManager
+}
+
+func (c *ServicesContainer) RemissionNoteManager() ports.DTEService {
+	return c.remissionNoteManager
+}
+
+func (c *ServicesContainer) LiquidationManager() ports.DTEService {
+	return c.liquidationManager
+}
+
+func (c *ServicesContainer) AccountingLiquidationManager() ports.DTEService {
+	return c.accountingLiquidationManager
+}
+
+func (c *ServicesContainer) DonationManager() ports.DTEService {
+	return c.donationManager
+}
+
 func (c *ServicesContainer) RetentionManager() ports.DTEService {
 	return c.retentionManager
 }
@@ -173,10 +333,59 @@ func (c *ServicesContainer) TransmitterManager() appPorts.DTETransmitter {
 	return c.transmitterManager
 }
 
+func (c *ServicesContainer) TransmissionConfig() *models.TransmissionConfig {
+	return c.transmissionConfig
+}
+
+func (c *ServicesContainer) Circuit(component string) ports.CircuitManager {
+	return c.circuits[component]
+}
+
 func (c *ServicesContainer) SignerManager() appPorts.SignerManager {
 	return c.signerManager
 }
 
+func (c *ServicesContainer) PublicKeyProvider() appPorts.PublicKeyProvider {
+	return c.publicKeyProvider
+}
+
+func (c *ServicesContainer) CertificateManager() certificate.CertificateManager {
+	return c.certificateManager
+}
+
+func (c *ServicesContainer) PDFTemplateManager() pdf_template.PDFTemplateManager {
+	return c.pdfTemplateManager
+}
+
+func (c *ServicesContainer) PDFRenderer() appPorts.DTEPDFRenderer {
+	return c.pdfRenderer
+}
+
+func (c *ServicesContainer) DeliveryManager() notification.DeliveryManager {
+	return c.deliveryManager
+}
+
+// MailSender obtiene el servicio de envío de correos, es nil si SMTP_HOST no está configurado
+func (c *ServicesContainer) MailSender() ports.MailSender {
+	return c.mailSender
+}
+
+func (c *ServicesContainer) IdempotencyManager() idempotency.IdempotencyManager {
+	return c.idempotencyManager
+}
+
+func (c *ServicesContainer) EmissionQueueManager() emission.EmissionQueueManager {
+	return c.emissionQueueManager
+}
+
+func (c *ServicesContainer) WebhookManager() webhook.WebhookManager {
+	return c.webhookManager
+}
+
+func (c *ServicesContainer) EventManager() events.EventManager {
+	return c.eventManager
+}
+
 func (c *ServicesContainer) HaciendaAuthManager() appPorts.HaciendaAuthManager {
 	return c.haciendaAuthManager
 }
@@ -193,6 +402,10 @@ func (c *ServicesContainer) AuthManager() auth.AuthManager {
 	return c.authManager
 }
 
+func (c *ServicesContainer) BranchManager() auth.BranchManager {
+	return c.branchManager
+}
+
 func (c *ServicesContainer) CryptManager() ports.CryptManager {
 	return c.cryptManager
 }
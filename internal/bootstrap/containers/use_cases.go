@@ -1,26 +1,59 @@
 package containers
 
 import (
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/config"
 	"github.com/MarlonG1/api-facturacion-sv/internal/application/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/certificate"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/contingency"
 	"github.com/MarlonG1/api-facturacion-sv/internal/application/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/events"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/jobs"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/pdf_template"
 	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/reconciliation"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/webhook"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/helpers"
 )
 
 type UseCaseContainer struct {
 	services *ServicesContainer
 
 	// Caso de uso especiales
-	dteConsult          *dte.DTEConsultUseCase
-	invalidationUseCase *dte.InvalidationUseCase
-	authUseCase         *auth.AuthUseCase
-	baseTransmitter     ports.BaseTransmitter
-	dteUseCaseFactory   *dte.DTEUseCaseFactory
+	dteConsult            *dte.DTEConsultUseCase
+	dteVerify             *dte.DTEVerifyUseCase
+	dtePDF                *dte.DTEPDFUseCase
+	dteDelivery           *dte.DTEDeliveryUseCase
+	asyncEmission         *dte.AsyncEmissionUseCase
+	pdfTemplateUseCase    *pdf_template.PDFTemplateUseCase
+	invalidationUseCase   *dte.InvalidationUseCase
+	authUseCase           *auth.AuthUseCase
+	branchUseCase         *auth.BranchUseCase
+	certificateUseCase    *certificate.CertificateUseCase
+	webhookUseCase        *webhook.WebhookUseCase
+	eventUseCase          *events.EventUseCase
+	eventNotifier         *events.EventNotifier
+	reconciliationUseCase *reconciliation.ReconciliationUseCase
+	contingencyUseCase    *contingency.ContingencyUseCase
+	jobUseCase            *jobs.JobUseCase
+	baseTransmitter       ports.BaseTransmitter
+	dteUseCaseFactory     *dte.DTEUseCaseFactory
 
 	// Casos de uso genéricos creacional
-	invoiceUseCase    *dte.GenericDTEUseCase
-	ccfUseCase        *dte.GenericDTEUseCase
-	retentionUseCase  *dte.GenericDTEUseCase
-	creditNoteUseCase *dte.GenericDTEUseCase
+	invoiceUseCase               *dte.GenericDTEUseCase
+	ccfUseCase                   *dte.GenericDTEUseCase
+	retentionUseCase             *dte.GenericDTEUseCase
+	creditNoteUseCase            *dte.GenericDTEUseCase
+	debitNoteUseCase             *dte.GenericDTEUseCase
+	fseUseCase                   *dte.GenericDTEUseCase
+	exportUseCase                *dte.GenericDTEUseCase
+	remissionUseCase             *dte.GenericDTEUseCase
+	liquidationUseCase           *dte.GenericDTEUseCase
+	accountingLiquidationUseCase *dte.GenericDTEUseCase
+	donationUseCase              *dte.GenericDTEUseCase
 }
 
 func NewUseCaseContainer(services *ServicesContainer) *UseCaseContainer {
@@ -30,20 +63,50 @@ func NewUseCaseContainer(services *ServicesContainer) *UseCaseContainer {
 }
 
 func (c *UseCaseContainer) Initialize() {
-	c.authUseCase = auth.NewAuthUseCase(c.services.AuthManager(), c.services.CryptManager())
-	c.baseTransmitter = dte.NewBaseTransmitter(c.services.TransmitterManager(), c.services.SignerManager())
+	c.authUseCase = auth.NewAuthUseCase(c.services.AuthManager(), c.services.CryptManager(), c.services.EventManager())
+	c.certificateUseCase = certificate.NewCertificateUseCase(c.services.CertificateManager())
+	c.branchUseCase = auth.NewBranchUseCase(c.services.BranchManager(),
+		time.Duration(config.Credentials.GracePeriodMinutes)*time.Minute)
+	c.webhookUseCase = webhook.NewWebhookUseCase(c.services.WebhookManager())
+	c.eventUseCase = events.NewEventUseCase(c.services.EventManager())
+	c.eventNotifier = events.NewEventNotifier(c.services.DeliveryManager(), c.services.MailSender())
+	c.services.EventManager().Subscribe(event.AllEvents, c.eventNotifier.Handle)
+	c.reconciliationUseCase = reconciliation.NewReconciliationUseCase(c.services.ReconciliationManager())
+	c.contingencyUseCase = contingency.NewContingencyUseCase(c.services.ContingencyManager(), c.services.RetransmissionScheduleManager())
+	c.jobUseCase = jobs.NewJobUseCase(c.services.JobMonitor(), c.services.RetransmissionScheduleManager())
+	c.baseTransmitter = dte.NewBaseTransmitter(c.services.TransmitterManager(), c.services.SignerManager(), c.services.TransmissionConfig())
 	c.dteConsult = dte.NewDTEConsultUseCase(c.services.DTEManager())
+	c.dteVerify = dte.NewDTEVerifyUseCase(c.services.DTEManager(), c.services.PublicKeyProvider())
+	c.dtePDF = dte.NewDTEPDFUseCase(c.services.DTEManager(), c.services.PDFTemplateManager(), c.services.PDFRenderer())
+	c.pdfTemplateUseCase = pdf_template.NewPDFTemplateUseCase(c.services.PDFTemplateManager())
+	c.dteDelivery = dte.NewDTEDeliveryUseCase(c.services.DTEManager(), c.services.DeliveryManager(), c.dtePDF,
+		c.services.SignerManager(), c.services.MailSender())
 
 	// Inicializar factory de casos de uso
 	c.dteUseCaseFactory = dte.NewDTEUseCaseFactory(
 		c.services.AuthManager(),
 		c.services.DTEManager(),
-		c.baseTransmitter)
+		c.baseTransmitter,
+		c.dteDelivery,
+		c.services.EmissionQueueManager(),
+		c.services.EventManager(),
+		c.services.OutboxManager())
+	c.asyncEmission = dte.NewAsyncEmissionUseCase(c.services.AuthManager(), c.services.DTEManager(), c.baseTransmitter,
+		c.services.EmissionQueueManager(), c.services.ContingencyManager(),
+		helpers.NewContingencyHandler(c.services.ContingencyManager()).WithCircuit(c.services.Circuit(constants.CircuitHaciendaReception)),
+		c.dteDelivery, c.services.EventManager())
 
 	c.invoiceUseCase = c.dteUseCaseFactory.CreateInvoiceUseCase(c.services.InvoiceService())
 	c.ccfUseCase = c.dteUseCaseFactory.CreateCCFUseCase(c.services.CCFService())
 	c.retentionUseCase = c.dteUseCaseFactory.CreateRetentionUseCase(c.services.RetentionManager())
 	c.creditNoteUseCase = c.dteUseCaseFactory.CreateCreditNoteUseCase(c.services.CreditNoteManager())
+	c.debitNoteUseCase = c.dteUseCaseFactory.CreateDebitNoteUseCase(c.services.DebitNoteManager())
+	c.fseUseCase = c.dteUseCaseFactory.CreateFSEUseCase(c.services.FSEManager())
+	c.exportUseCase = c.dteUseCaseFactory.CreateExportInvoiceUseCase(c.services.ExportInvoiceManager())
+	c.remissionUseCase = c.dteUseCaseFactory.CreateRemissionNoteUseCase(c.services.RemissionNoteManager())
+	c.liquidationUseCase = c.dteUseCaseFactory.CreateLiquidationUseCase(c.services.LiquidationManager())
+	c.accountingLiquidationUseCase = c.dteUseCaseFactory.CreateAccountingLiquidationUseCase(c.services.AccountingLiquidationManager())
+	c.donationUseCase = c.dteUseCaseFactory.CreateDonationUseCase(c.services.DonationManager())
 
 	// Crear el caso de uso específico para invalidación
 	c.invalidationUseCase = c.dteUseCaseFactory.CreateInvalidationUseCase(c.services.InvalidationManager())
@@ -54,6 +117,58 @@ func (c *UseCaseContainer) DTEConsultUseCase() *dte.DTEConsultUseCase {
 	return c.dteConsult
 }
 
+func (c *UseCaseContainer) CertificateUseCase() *certificate.CertificateUseCase {
+	return c.certificateUseCase
+}
+
+func (c *UseCaseContainer) DTEVerifyUseCase() *dte.DTEVerifyUseCase {
+	return c.dteVerify
+}
+
+func (c *UseCaseContainer) DTEPDFUseCase() *dte.DTEPDFUseCase {
+	return c.dtePDF
+}
+
+func (c *UseCaseContainer) DTEDeliveryUseCase() *dte.DTEDeliveryUseCase {
+	return c.dteDelivery
+}
+
+func (c *UseCaseContainer) AsyncEmissionUseCase() *dte.AsyncEmissionUseCase {
+	return c.asyncEmission
+}
+
+func (c *UseCaseContainer) DTEOutboxUseCase() *dte.DTEOutboxUseCase {
+	return c.dteUseCaseFactory.OutboxUseCase()
+}
+
+func (c *UseCaseContainer) WebhookUseCase() *webhook.WebhookUseCase {
+	return c.webhookUseCase
+}
+
+func (c *UseCaseContainer) EventUseCase() *events.EventUseCase {
+	return c.eventUseCase
+}
+
+func (c *UseCaseContainer) EventNotifier() *events.EventNotifier {
+	return c.eventNotifier
+}
+
+func (c *UseCaseContainer) ReconciliationUseCase() *reconciliation.ReconciliationUseCase {
+	return c.reconciliationUseCase
+}
+
+func (c *UseCaseContainer) JobUseCase() *jobs.JobUseCase {
+	return c.jobUseCase
+}
+
+func (c *UseCaseContainer) ContingencyUseCase() *contingency.ContingencyUseCase {
+	return c.contingencyUseCase
+}
+
+func (c *UseCaseContainer) PDFTemplateUseCase() *pdf_template.PDFTemplateUseCase {
+	return c.pdfTemplateUseCase
+}
+
 func (c *UseCaseContainer) InvoiceUseCase() *dte.GenericDTEUseCase {
 	return c.invoiceUseCase
 }
@@ -70,6 +185,34 @@ func (c *UseCaseContainer) CreditNoteUseCase() *dte.GenericDTEUseCase {
 	return c.creditNoteUseCase
 }
 
+func (c *UseCaseContainer) DebitNoteUseCase() *dte.GenericDTEUseCase {
+	return c.debitNoteUseCase
+}
+
+func (c *UseCaseContainer) FSEUseCase() *dte.GenericDTEUseCase {
+	return c.fseUseCase
+}
+
+func (c *UseCaseContainer) ExportInvoiceUseCase() *dte.GenericDTEUseCase {
+	return c.exportUseCase
+}
+
+func (c *UseCaseContainer) RemissionNoteUseCase() *dte.GenericDTEUseCase {
+	return c.remissionUseCase
+}
+
+func (c *UseCaseContainer) LiquidationUseCase() *dte.GenericDTEUseCase {
+	return c.liquidationUseCase
+}
+
+func (c *UseCaseContainer) AccountingLiquidationUseCase() *dte.GenericDTEUseCase {
+	return c.accountingLiquidationUseCase
+}
+
+func (c *UseCaseContainer) DonationUseCase() *dte.GenericDTEUseCase {
+	return c.donationUseCase
+}
+
 func (c *UseCaseContainer) InvalidationUseCase() *dte.InvalidationUseCase {
 	return c.invalidationUseCase
 }
@@ -77,3 +220,7 @@ func (c *UseCaseContainer) InvalidationUseCase() *dte.InvalidationUseCase {
 func (c *UseCaseContainer) AuthUseCase() *auth.AuthUseCase {
 	return c.authUseCase
 }
+
+func (c *UseCaseContainer) BranchUseCase() *auth.BranchUseCase {
+	return c.branchUseCase
+}
@@ -37,6 +37,15 @@ type AuthRepositoryPort interface {
 	DeleteBranchOffice(context.Context, uint, uint) error
 	// GetMatrixBranch obtiene la sucursal registrada como casa matriz
 	GetMatrixBranch(context.Context, uint) (*user.BranchOffice, error)
+	// ListBranchOffices obtiene todas las sucursales de un usuario, activas e inactivas
+	ListBranchOffices(context.Context, uint) ([]user.BranchOffice, error)
+	// CreateBranchOffice crea una sucursal de un usuario junto a su dirección
+	CreateBranchOffice(context.Context, uint, *user.BranchOffice) error
+	// SetBranchOfficeStatus activa o desactiva una sucursal de un usuario
+	SetBranchOfficeStatus(context.Context, uint, uint, bool) error
+	// RotateBranchCredentials reemplaza el API key y el hash del API secret de una sucursal, las credenciales
+	// actuales siguen siendo válidas hasta la fecha indicada
+	RotateBranchCredentials(ctx context.Context, userID, branchID uint, apiKey, apiSecret string, previousExpiresAt time.Time) error
 }
 
 // AuthStrategy define el comportamiento que debe implementar cada estrategia de autenticación
@@ -65,6 +74,28 @@ type AuthManager interface {
 	GetIssuer(ctx context.Context, branchID uint) (*dte.IssuerDTE, error)
 	// GetHaciendaCredentials obtiene las credenciales de hacienda segun el tipo de autenticación
 	GetHaciendaCredentials(ctx context.Context, nit, token string) (*models.HaciendaCredentials, error)
+	// GetBranchToken obtiene el token de sistema de la sucursal con el que los procesos en segundo plano obtienen sus
+	// credenciales de Hacienda
+	GetBranchToken(branchID uint) string
+	// HasBranchCredentials indica si la sucursal tiene credenciales de Hacienda almacenadas con su token de sistema
+	HasBranchCredentials(branchID uint) (bool, error)
+	// EvictBranchCredentials elimina las credenciales de Hacienda de la sucursal que usan los procesos en segundo plano
+	EvictBranchCredentials(branchID uint) error
 	// Create crea un usuario con sus sucursales
 	Create(ctx context.Context, user *user.User) error
 }
+
+// BranchManager define la administración de las sucursales de un usuario
+type BranchManager interface {
+	// ListBranches obtiene las sucursales del usuario sin sus API secrets
+	ListBranches(ctx context.Context, userID uint) ([]user.BranchOffice, error)
+	// CreateBranch registra una sucursal y retorna su API key y API secret
+	CreateBranch(ctx context.Context, userID uint, input *models.BranchInput) (*user.BranchOffice, error)
+	// UpdateBranch modifica los códigos, el contacto o la dirección de una sucursal
+	UpdateBranch(ctx context.Context, userID, branchID uint, input *models.BranchInput) (*user.BranchOffice, error)
+	// SetBranchStatus activa o desactiva una sucursal, la casa matriz no puede desactivarse
+	SetBranchStatus(ctx context.Context, userID, branchID uint, active bool) (*user.BranchOffice, error)
+	// RotateCredentials genera un API key y un API secret nuevos para la sucursal, revoca los tokens emitidos con
+	// las credenciales anteriores y las mantiene válidas durante el periodo de gracia
+	RotateCredentials(ctx context.Context, userID, branchID uint, gracePeriod time.Duration) (*models.RotatedCredentials, error)
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"slices"
 	"time"
 )
 
@@ -41,9 +42,16 @@ type AuthClaims struct {
 	BranchID  uint      `json:"branch_sub"`
 	AuthType  string    `json:"auth_type"`
 	NIT       string    `json:"nit"`
+	Role      string    `json:"role"`
+	Scopes    []string  `json:"scopes"`
 	ExpiresAt time.Time `json:"expires_at"`
 }
 
+// HasScope indica si el token autoriza las operaciones del scope
+func (c *AuthClaims) HasScope(scope string) bool {
+	return slices.Contains(c.Scopes, scope)
+}
+
 // HaciendaCredentials representa las credenciales de hacienda
 type HaciendaCredentials struct {
 	Username string `json:"username"`
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+)
+
+// BranchInput representa los datos para crear o modificar una sucursal. Al modificarla, los campos omitidos
+// conservan su valor actual; Scopes en nil conserva los scopes adicionales y una lista vacía los elimina.
+type BranchInput struct {
+	EstablishmentType   *string
+	EstablishmentCode   *string
+	EstablishmentCodeMH *string
+	POSCode             *string
+	POSCodeMH           *string
+	Email               *string
+	Phone               *string
+	AsyncEmission       *bool
+	Role                *string
+	Scopes              []string
+	Address             *user.Address
+}
+
+// RotatedCredentials representa las credenciales generadas al rotar las de una sucursal. APISecret solo se expone en
+// esta respuesta; las credenciales anteriores siguen siendo válidas hasta PreviousExpiresAt.
+type RotatedCredentials struct {
+	BranchID          uint      `json:"branch_id"`
+	APIKey            string    `json:"api_key"`
+	APISecret         string    `json:"api_secret"`
+	PreviousExpiresAt time.Time `json:"previous_expires_at"`
+	RevokedTokens     int       `json:"revoked_tokens"`
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type BranchService struct {
+	authRepo     auth.AuthRepositoryPort
+	cryptManager ports.CryptManager
+	tokenManager ports.TokenManager
+	authManager  auth.AuthManager
+}
+
+// NewBranchService crea el servicio de administración de sucursales. Las credenciales de las sucursales se generan y
+// se almacenan como hash con cryptManager; tokenManager revoca los tokens emitidos al rotarlas y authManager elimina
+// las credenciales de Hacienda que los procesos en segundo plano usan por sucursal.
+func NewBranchService(authRepo auth.AuthRepositoryPort, cryptManager ports.CryptManager, tokenManager ports.TokenManager, authManager auth.AuthManager) auth.BranchManager {
+	return &BranchService{
+		authRepo:     authRepo,
+		cryptManager: cryptManager,
+		tokenManager: tokenManager,
+		authManager:  authManager,
+	}
+}
+
+// ListBranches obtiene las sucursales del usuario sin sus API secrets
+func (s *BranchService) ListBranches(ctx context.Context, userID uint) ([]user.BranchOffice, error) {
+	branches, err := s.authRepo.ListBranchOffices(ctx, userID)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "ListBranches", err, "FailedToGetBranches")
+	}
+
+	for i := range branches {
+		branches[i].APISecret = ""
+	}
+
+	return branches, nil
+}
+
+// CreateBranch valida y registra una sucursal activa con credenciales nuevas. El API secret en texto plano solo se
+// retorna en esta operación.
+func (s *BranchService) CreateBranch(ctx context.Context, userID uint, input *models.BranchInput) (*user.BranchOffice, error) {
+	// 1. Validar el tipo de establecimiento, el usuario ya tiene su casa matriz
+	if input.EstablishmentType == nil || *input.EstablishmentType == "" {
+		return nil, dte_errors.NewValidationError("RequiredField", "establishment_type")
+	}
+	if *input.EstablishmentType == constants.CasaMatriz {
+		return nil, shared_error.NewFormattedGeneralServiceError("BranchService", "CreateBranch", "MatrixBranchAlreadyExists")
+	}
+
+	// 2. Construir y validar la sucursal, sus credenciales operan si no se indica su rol
+	branch := &user.BranchOffice{IsActive: true, Role: user.RoleOperator}
+	applyBranchInput(branch, input)
+	if err := validateBranch(branch); err != nil {
+		return nil, err
+	}
+
+	// 3. Generar el API key y el API secret de la sucursal
+	keys, secrets, err := s.cryptManager.GenerateBulkAPIKeys(1)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "CreateBranch", err, "FailedToSaveBranch")
+	}
+	branch.APIKey, branch.APISecret = keys[0], s.cryptManager.HashSecret(secrets[0])
+
+	// 4. Registrar la sucursal con el hash de su API secret
+	if err = s.authRepo.CreateBranchOffice(ctx, userID, branch); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "CreateBranch", err, "FailedToSaveBranch")
+	}
+	branch.APISecret = secrets[0]
+
+	logs.Info("Branch office created", map[string]interface{}{
+		"userID":   userID,
+		"branchID": branch.ID,
+	})
+
+	return branch, nil
+}
+
+// UpdateBranch modifica los códigos, el contacto, la dirección o los permisos de una sucursal. El tipo de
+// establecimiento y el rol de administrador de la casa matriz no pueden cambiarse y ninguna otra sucursal puede
+// convertirse en casa matriz.
+func (s *BranchService) UpdateBranch(ctx context.Context, userID, branchID uint, input *models.BranchInput) (*user.BranchOffice, error) {
+	// 1. Obtener la sucursal
+	branch, err := s.getBranch(ctx, "UpdateBranch", userID, branchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Verificar que el cambio conserve una única casa matriz
+	if input.EstablishmentType != nil && *input.EstablishmentType != branch.EstablishmentType &&
+		(branch.EstablishmentType == constants.CasaMatriz || *input.EstablishmentType == constants.CasaMatriz) {
+		return nil, shared_error.NewFormattedGeneralServiceError("BranchService", "UpdateBranch", "MatrixBranchTypeChange")
+	}
+	if input.Role != nil && *input.Role != user.RoleAdmin && branch.EstablishmentType == constants.CasaMatriz {
+		return nil, shared_error.NewFormattedGeneralServiceError("BranchService", "UpdateBranch", "MatrixBranchRoleChange")
+	}
+
+	// 3. Aplicar y validar los cambios
+	applyBranchInput(branch, input)
+	if err = validateBranch(branch); err != nil {
+		return nil, err
+	}
+
+	// 4. Guardar la sucursal
+	if err = s.authRepo.UpdateBranchOffices(ctx, userID, []user.BranchOffice{*branch}); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "UpdateBranch", err, "FailedToSaveBranch")
+	}
+
+	branch.APISecret = ""
+	return branch, nil
+}
+
+// SetBranchStatus activa o desactiva una sucursal. Una sucursal desactivada no puede iniciar sesión ni transmitir en
+// segundo plano con las credenciales de su último inicio de sesión; la casa matriz no puede desactivarse.
+func (s *BranchService) SetBranchStatus(ctx context.Context, userID, branchID uint, active bool) (*user.BranchOffice, error) {
+	// 1. Obtener la sucursal
+	branch, err := s.getBranch(ctx, "SetBranchStatus", userID, branchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Impedir que se desactive la casa matriz
+	if !active && branch.EstablishmentType == constants.CasaMatriz {
+		return nil, shared_error.NewFormattedGeneralServiceError("BranchService", "SetBranchStatus", "MatrixBranchCannotBeDeactivated")
+	}
+
+	// 3. Guardar el estado
+	if err = s.authRepo.SetBranchOfficeStatus(ctx, userID, branchID, active); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "SetBranchStatus", err, "FailedToSaveBranch")
+	}
+
+	// 4. Eliminar las credenciales de Hacienda de la sucursal desactivada
+	if !active {
+		if err = s.authManager.EvictBranchCredentials(branchID); err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "SetBranchStatus", err, "FailedToSaveBranch")
+		}
+	}
+
+	logs.Info("Branch office status updated", map[string]interface{}{
+		"userID":   userID,
+		"branchID": branchID,
+		"active":   active,
+	})
+
+	branch.IsActive = active
+	branch.APISecret = ""
+	return branch, nil
+}
+
+// RotateCredentials genera un API key y un API secret nuevos para la sucursal. Las credenciales anteriores siguen
+// siendo válidas durante gracePeriod; los tokens emitidos con ellas hasta ahora se revocan y las credenciales de
+// Hacienda de la sucursal se eliminan hasta su próximo inicio de sesión. El API secret en texto plano solo se retorna
+// en esta operación.
+func (s *BranchService) RotateCredentials(ctx context.Context, userID, branchID uint, gracePeriod time.Duration) (*models.RotatedCredentials, error) {
+	// 1. Obtener la sucursal
+	if _, err := s.getBranch(ctx, "RotateCredentials", userID, branchID); err != nil {
+		return nil, err
+	}
+
+	// 2. Generar las credenciales nuevas
+	keys, secrets, err := s.cryptManager.GenerateBulkAPIKeys(1)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "RotateCredentials", err, "FailedToRotateCredentials", branchID)
+	}
+
+	// 3. Reemplazar las credenciales conservando las anteriores durante el periodo de gracia
+	previousExpiresAt := utils.TimeNow().Add(gracePeriod)
+	if err = s.authRepo.RotateBranchCredentials(ctx, userID, branchID, keys[0], s.cryptManager.HashSecret(secrets[0]), previousExpiresAt); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "RotateCredentials", err, "FailedToRotateCredentials", branchID)
+	}
+
+	// 4. Revocar los tokens emitidos con las credenciales anteriores
+	revoked, err := s.tokenManager.RevokeIssuedTokens(branchID)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "RotateCredentials", err, "FailedToRotateCredentials", branchID)
+	}
+
+	// 5. Eliminar las credenciales de Hacienda que los procesos en segundo plano usan por sucursal
+	if err = s.authManager.EvictBranchCredentials(branchID); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", "RotateCredentials", err, "FailedToRotateCredentials", branchID)
+	}
+
+	logs.Info("Branch office credentials rotated", map[string]interface{}{
+		"userID":            userID,
+		"branchID":          branchID,
+		"previousExpiresAt": previousExpiresAt,
+		"revokedTokens":     revoked,
+	})
+
+	return &models.RotatedCredentials{
+		BranchID:          branchID,
+		APIKey:            keys[0],
+		APISecret:         secrets[0],
+		PreviousExpiresAt: previousExpiresAt,
+		RevokedTokens:     revoked,
+	}, nil
+}
+
+// getBranch obtiene una sucursal verificando que pertenezca al usuario
+func (s *BranchService) getBranch(ctx context.Context, operation string, userID, branchID uint) (*user.BranchOffice, error) {
+	branch, err := s.authRepo.GetBranchByBranchID(ctx, branchID)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrBranchOfficeNotFound) {
+			return nil, shared_error.NewFormattedGeneralServiceError("BranchService", operation, "BranchNotFound", branchID)
+		}
+		return nil, shared_error.NewFormattedGeneralServiceWithError("BranchService", operation, err, "FailedToGetBranches")
+	}
+
+	if branch.UserID != userID {
+		return nil, shared_error.NewFormattedGeneralServiceError("BranchService", operation, "BranchNotFound", branchID)
+	}
+
+	return branch, nil
+}
+
+// validateBranch valida la sucursal y que sus credenciales tengan un rol
+func validateBranch(branch *user.BranchOffice) error {
+	if err := branch.Validate(); err != nil {
+		return err
+	}
+
+	if !user.IsValidRole(branch.Role) {
+		return dte_errors.NewValidationError("InvalidField", "role")
+	}
+
+	return nil
+}
+
+// applyBranchInput asigna a la sucursal los campos proporcionados
+func applyBranchInput(branch *user.BranchOffice, input *models.BranchInput) {
+	if input.EstablishmentType != nil {
+		branch.EstablishmentType = *input.EstablishmentType
+	}
+	if input.EstablishmentCode != nil {
+		branch.EstablishmentCode = input.EstablishmentCode
+	}
+	if input.EstablishmentCodeMH != nil {
+		branch.EstablishmentCodeMH = input.EstablishmentCodeMH
+	}
+	if input.POSCode != nil {
+		branch.POSCode = input.POSCode
+	}
+	if input.POSCodeMH != nil {
+		branch.POSCodeMH = input.POSCodeMH
+	}
+	if input.Email != nil {
+		branch.Email = input.Email
+	}
+	if input.Phone != nil {
+		branch.Phone = input.Phone
+	}
+	if input.AsyncEmission != nil {
+		branch.AsyncEmission = *input.AsyncEmission
+	}
+	if input.Role != nil {
+		branch.Role = *input.Role
+	}
+	if input.Scopes != nil {
+		branch.Scopes = input.Scopes
+	}
+	if input.Address != nil {
+		branch.Address = input.Address
+	}
+}
@@ -2,10 +2,15 @@ package strategies
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/error"
 	"gorm.io/gorm"
 	"strings"
+	"time"
 
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/constants"
@@ -17,6 +22,10 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
 )
 
+// BranchCredentialsRetention es el tiempo que las credenciales de Hacienda de la sucursal se conservan después de que
+// expira el token con el que se autenticó, para que los procesos en segundo plano terminen sus transmisiones
+const BranchCredentialsRetention = 24 * time.Hour
+
 type AuthService struct {
 	strategies   map[string]auth.AuthStrategy
 	authRepo     auth.AuthRepositoryPort
@@ -28,10 +37,11 @@ func NewAuthService(
 	tokenService ports.TokenManager,
 	clientRepository auth.AuthRepositoryPort,
 	cacheService ports.CacheManager,
+	cryptManager ports.CryptManager,
 ) auth.AuthManager {
 	return &AuthService{
 		strategies: map[string]auth.AuthStrategy{
-			constants.StandardAuthType: NewStandardAuthStrategy(clientRepository, cacheService),
+			constants.StandardAuthType: NewStandardAuthStrategy(clientRepository, cacheService, cryptManager),
 		},
 		tokenService: tokenService,
 		authRepo:     clientRepository,
@@ -93,6 +103,12 @@ func (s *AuthService) Login(ctx context.Context, credentials *models.AuthCredent
 		return "", err
 	}
 
+	// 7. Guardar las credenciales de la sucursal para los procesos en segundo plano, no dependen del token
+	branchToken := s.GetBranchToken(claims.BranchID)
+	if err = s.cacheService.SetCredentials(branchToken, credentials.MHCredentials, tokenLifetime+BranchCredentialsRetention); err != nil {
+		return "", err
+	}
+
 	return token, nil
 }
 
@@ -117,6 +133,36 @@ func (s *AuthService) GetHaciendaCredentials(ctx context.Context, nit, token str
 	return strategy.GetHaciendaCredentials(token)
 }
 
+// GetBranchToken obtiene el token de sistema de la sucursal, derivado de la clave secreta del servidor. Los procesos en
+// segundo plano lo utilizan para obtener las credenciales de Hacienda de la sucursal, de modo que no dependen del token
+// de la solicitud original, que puede expirar o ser revocado al rotar las credenciales.
+func (s *AuthService) GetBranchToken(branchID uint) string {
+	mac := hmac.New(sha256.New, []byte(s.tokenService.GetSecretKey()))
+	mac.Write([]byte(fmt.Sprintf("branch:%d", branchID)))
+	return "branch:" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// HasBranchCredentials indica si las credenciales de Hacienda de la sucursal siguen almacenadas. Expiran cuando la
+// sucursal no inicia sesión durante BranchCredentialsRetention y se eliminan al desactivarla o rotar sus credenciales.
+func (s *AuthService) HasBranchCredentials(branchID uint) (bool, error) {
+	if _, err := s.cacheService.GetCredentials(s.GetBranchToken(branchID)); err != nil {
+		var serviceErr *shared_error.ServiceError
+		if errors.As(err, &serviceErr) && serviceErr.Code == "TokenNotExist" {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// EvictBranchCredentials elimina las credenciales de Hacienda almacenadas con el token de sistema de la sucursal. Se
+// usa al desactivar la sucursal o rotar sus credenciales, de modo que los procesos en segundo plano no sigan
+// transmitiendo con ellas hasta que la sucursal vuelva a iniciar sesión.
+func (s *AuthService) EvictBranchCredentials(branchID uint) error {
+	return s.cacheService.DeleteCredentials(s.GetBranchToken(branchID))
+}
+
 // GetIssuer retorna el emisor por su id de sucursal
 func (s *AuthService) GetIssuer(ctx context.Context, branchID uint) (*dte.IssuerDTE, error) {
 	return s.authRepo.GetIssuerInfoByBranchID(ctx, branchID)
@@ -11,18 +11,22 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 )
 
 type StandardAuthStrategy struct {
 	authRepo     auth.AuthRepositoryPort
 	cacheService ports.CacheManager
+	cryptManager ports.CryptManager
 }
 
-// NewStandardAuthStrategy crea una instancia de StandardAuthStrategy. Recibe un repositorio de clientes.
-func NewStandardAuthStrategy(repo auth.AuthRepositoryPort, cacheService ports.CacheManager) *StandardAuthStrategy {
+// NewStandardAuthStrategy crea una instancia de StandardAuthStrategy. Recibe un repositorio de clientes y el
+// cryptManager con el que se obtiene el hash de los API secrets.
+func NewStandardAuthStrategy(repo auth.AuthRepositoryPort, cacheService ports.CacheManager, cryptManager ports.CryptManager) *StandardAuthStrategy {
 	return &StandardAuthStrategy{
 		cacheService: cacheService,
 		authRepo:     repo,
+		cryptManager: cryptManager,
 	}
 }
 
@@ -74,8 +78,10 @@ func (s *StandardAuthStrategy) Authenticate(ctx context.Context, credentials *mo
 		)
 	}
 
-	// 2. Verificar credenciales
-	if subtle.ConstantTimeCompare([]byte(credentials.APISecret), []byte(branch.APISecret)) != 1 {
+	// 2. Verificar credenciales comparando el hash del API secret con el almacenado para el API key, el actual o el
+	// anterior a la última rotación durante su periodo de gracia
+	storedSecret, ok := branch.CredentialsSecret(credentials.APIKey, utils.TimeNow())
+	if !ok || subtle.ConstantTimeCompare([]byte(s.cryptManager.HashSecret(credentials.APISecret)), []byte(storedSecret)) != 1 {
 		logs.Error("Invalid credentials", map[string]interface{}{
 			"apiKey": credentials.APIKey,
 		})
@@ -112,12 +118,14 @@ func (s *StandardAuthStrategy) Authenticate(ctx context.Context, credentials *mo
 		)
 	}
 
-	// 5. Crear claims
+	// 5. Crear claims con el rol y los scopes de las credenciales de la sucursal
 	claims := &models.AuthClaims{
 		ClientID: user.ID,
 		BranchID: branch.ID,
 		AuthType: user.AuthType,
 		NIT:      user.NIT,
+		Role:     branch.Role,
+		Scopes:   branch.EffectiveScopes(),
 	}
 
 	logs.Info("Client authenticated successfully", map[string]interface{}{
@@ -127,6 +135,8 @@ func (s *StandardAuthStrategy) Authenticate(ctx context.Context, credentials *mo
 	return claims, nil
 }
 
+// GetTokenLifetime obtiene la duración de vida del token. Los tokens emitidos con las credenciales anteriores a una
+// rotación no superan su periodo de gracia.
 func (s *StandardAuthStrategy) GetTokenLifetime(credentials *models.AuthCredentials) (time.Duration, error) {
 	// 1. Obtener informacion del usuario
 	user, err := s.authRepo.GetByBranchApiKey(context.Background(), credentials.APIKey)
@@ -142,7 +152,28 @@ func (s *StandardAuthStrategy) GetTokenLifetime(credentials *models.AuthCredenti
 		)
 	}
 
-	return time.Duration(user.TokenLifetime) * 24 * time.Hour, nil
+	lifetime := time.Duration(user.TokenLifetime) * 24 * time.Hour
+
+	// 2. Limitar la duración al periodo de gracia de las credenciales anteriores
+	branch, err := s.authRepo.GetBranchByBranchApiKey(context.Background(), credentials.APIKey)
+	if err != nil {
+		logs.Error("Failed to get branch information", map[string]interface{}{
+			"apiKey": credentials.APIKey,
+			"error":  err.Error(),
+		})
+		return 0, shared_error.NewFormattedGeneralServiceError(
+			"StandardAuth",
+			"GetTokenLifetime",
+			"ServerError",
+		)
+	}
+
+	now := utils.TimeNow()
+	if branch.UsesPreviousCredentials(credentials.APIKey, now) {
+		lifetime = min(lifetime, branch.PreviousExpiresAt.Sub(now))
+	}
+
+	return lifetime, nil
 }
 
 // GetHaciendaCredentials obtiene las credenciales de Hacienda. Devuelve las credenciales de Hacienda.
@@ -0,0 +1,31 @@
+package certificate
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+)
+
+// CertificateRepositoryPort interfaz para el repositorio de certificados de firma
+type CertificateRepositoryPort interface {
+	// Activate almacena un certificado como activo, desactiva los anteriores del usuario y actualiza su contraseña privada
+	Activate(ctx context.Context, cert *models.SigningCertificate, passwordPri string) error
+	// GetActiveByNIT obtiene el certificado activo de un NIT
+	GetActiveByNIT(ctx context.Context, nit string) (*models.SigningCertificate, error)
+	// ListByUser obtiene todos los certificados registrados por un usuario
+	ListByUser(ctx context.Context, userID uint) ([]models.SigningCertificate, error)
+	// Deactivate desactiva un certificado de un usuario
+	Deactivate(ctx context.Context, userID, id uint) error
+	// GetExpiring obtiene los certificados activos que vencen antes de la fecha indicada y aún no han sido notificados
+	GetExpiring(ctx context.Context, until time.Time) ([]models.SigningCertificate, error)
+	// MarkExpiryNotified registra el evento de vencimiento y marca el certificado como notificado
+	MarkExpiryNotified(ctx context.Context, id uint, domainEvent *event.DomainEvent) error
+}
+
+// CertificateInspector valida el contenido de un certificado del Ministerio de Hacienda
+type CertificateInspector interface {
+	// Inspect valida el certificado con su contraseña privada y extrae su información
+	Inspect(content []byte, passwordPri string) (*models.CertificateInfo, error)
+}
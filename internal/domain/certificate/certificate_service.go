@@ -0,0 +1,279 @@
+package certificate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate/models"
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// ExpiryNotificationWindow es la anticipación con la que se notifica el vencimiento de un certificado
+const ExpiryNotificationWindow = 30 * 24 * time.Hour
+
+type CertificateService struct {
+	repo          CertificateRepositoryPort
+	authRepo      auth.AuthRepositoryPort
+	inspector     CertificateInspector
+	cryptManager  ports.CryptManager
+	encryptionKey string
+	nativeSigning bool
+	events        events.EventManager
+}
+
+// NewCertificateService crea el servicio de certificados. La llave de encriptación protege el contenido
+// de los certificados almacenados; si no se define no es posible registrar certificados. Solo el firmador nativo usa
+// los certificados registrados, por lo que si nativeSigning no está habilitado se rechaza su registro. Los eventos de
+// vencimiento se entregan a los suscriptores del bus de eventos.
+func NewCertificateService(
+	repo CertificateRepositoryPort,
+	authRepo auth.AuthRepositoryPort,
+	inspector CertificateInspector,
+	cryptManager ports.CryptManager,
+	encryptionKey string,
+	nativeSigning bool,
+	eventManager events.EventManager,
+) CertificateManager {
+	return &CertificateService{
+		repo:          repo,
+		authRepo:      authRepo,
+		inspector:     inspector,
+		cryptManager:  cryptManager,
+		encryptionKey: encryptionKey,
+		nativeSigning: nativeSigning,
+		events:        eventManager,
+	}
+}
+
+// Upload registra el primer certificado activo de un NIT
+func (s *CertificateService) Upload(ctx context.Context, userID uint, nit string, upload *models.CertificateUpload) (*models.SigningCertificate, error) {
+	active, err := s.getActive(ctx, nit)
+	if err != nil {
+		return nil, err
+	}
+
+	if active != nil {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateService", "Upload", "ActiveCertificateExists", nit)
+	}
+
+	return s.store(ctx, userID, nit, upload)
+}
+
+// Rotate reemplaza el certificado activo de un NIT por uno nuevo
+func (s *CertificateService) Rotate(ctx context.Context, userID uint, nit string, upload *models.CertificateUpload) (*models.SigningCertificate, error) {
+	active, err := s.getActive(ctx, nit)
+	if err != nil {
+		return nil, err
+	}
+
+	if active == nil {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateService", "Rotate", "NoActiveCertificate", nit)
+	}
+
+	return s.store(ctx, userID, nit, upload)
+}
+
+// List obtiene los certificados registrados por un usuario
+func (s *CertificateService) List(ctx context.Context, userID uint) ([]models.SigningCertificate, error) {
+	certs, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateService", "List", err, "FailedToGetCertificates")
+	}
+
+	now := utils.TimeNow()
+	for i := range certs {
+		certs[i].DaysToExpire = daysUntil(now, certs[i].ExpiresAt)
+	}
+
+	return certs, nil
+}
+
+// Deactivate desactiva un certificado de un usuario
+func (s *CertificateService) Deactivate(ctx context.Context, userID, id uint) error {
+	if err := s.repo.Deactivate(ctx, userID, id); err != nil {
+		if errors.Is(err, errPackage.ErrCertificateNotFound) {
+			return shared_error.NewFormattedGeneralServiceError("CertificateService", "Deactivate", "NotFound")
+		}
+		return shared_error.NewFormattedGeneralServiceWithError("CertificateService", "Deactivate", err, "FailedToDeactivateCertificate")
+	}
+
+	logs.Info("Signing certificate deactivated", map[string]interface{}{"certificateID": id, "userID": userID})
+	return nil
+}
+
+// GetActiveCertificateData obtiene el contenido desencriptado del certificado activo de un NIT.
+// Retorna ErrCertificateNotFound si el NIT no posee un certificado activo registrado.
+func (s *CertificateService) GetActiveCertificateData(ctx context.Context, nit string) ([]byte, error) {
+	active, err := s.getActive(ctx, nit)
+	if err != nil {
+		return nil, err
+	}
+
+	if active == nil || s.encryptionKey == "" {
+		return nil, errPackage.ErrCertificateNotFound
+	}
+
+	content, err := s.cryptManager.Decrypt(s.encryptionKey, active.EncryptedContent)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateService", "GetActiveCertificateData", err, "InvalidCertificate", nit)
+	}
+
+	return content, nil
+}
+
+// NotifyExpiringCertificates genera un evento de dominio por cada certificado activo que vence dentro de la
+// ventana de notificación. Cada certificado se notifica una sola vez.
+func (s *CertificateService) NotifyExpiringCertificates(ctx context.Context) (int, error) {
+	now := utils.TimeNow()
+
+	// 1. Obtener los certificados próximos a vencer que no han sido notificados
+	certs, err := s.repo.GetExpiring(ctx, now.Add(ExpiryNotificationWindow))
+	if err != nil {
+		return 0, shared_error.NewFormattedGeneralServiceWithError("CertificateService", "NotifyExpiringCertificates", err, "FailedToGetCertificates")
+	}
+
+	notified := 0
+	for _, cert := range certs {
+		// 2. Los eventos se asocian a la casa matriz del usuario
+		branch, err := s.authRepo.GetMatrixBranch(ctx, cert.UserID)
+		if err != nil {
+			logs.Error("Failed to get matrix branch for certificate expiry event", map[string]interface{}{
+				"certificateID": cert.ID,
+				"error":         err.Error(),
+			})
+			continue
+		}
+
+		// 3. Registrar el evento y marcar el certificado como notificado
+		payload, _ := json.Marshal(map[string]interface{}{
+			"certificate_id": cert.ID,
+			"nit":            cert.NIT,
+			"expires_at":     cert.ExpiresAt,
+			"days_to_expire": daysUntil(now, cert.ExpiresAt),
+		})
+
+		domainEvent := &event.DomainEvent{
+			UserID:     cert.UserID,
+			BranchID:   branch.ID,
+			EventType:  event.CertificateExpiring,
+			Payload:    string(payload),
+			OccurredAt: now.Format("2006-01-02 15:04:05"),
+		}
+
+		if err := s.repo.MarkExpiryNotified(ctx, cert.ID, domainEvent); err != nil {
+			logs.Error("Failed to register certificate expiry event", map[string]interface{}{
+				"certificateID": cert.ID,
+				"error":         err.Error(),
+			})
+			continue
+		}
+
+		// 4. Entregar el evento a los suscriptores una vez confirmada la transacción
+		if s.events != nil {
+			s.events.Dispatch(ctx, *domainEvent)
+		}
+
+		notified++
+	}
+
+	return notified, nil
+}
+
+// store valida, encripta y activa un certificado
+func (s *CertificateService) store(ctx context.Context, userID uint, nit string, upload *models.CertificateUpload) (*models.SigningCertificate, error) {
+	// 1. Verificar que el firmador use los certificados registrados y que su almacenamiento esté configurado
+	if !s.nativeSigning {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateService", "Store", "CertificateUploadRequiresNativeSigner")
+	}
+
+	if s.encryptionKey == "" {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateService", "Store", "CertificateStorageNotConfigured")
+	}
+
+	// 2. Validar el certificado con la contraseña privada
+	info, err := s.inspector.Inspect(upload.Content, upload.PasswordPri)
+	if err != nil {
+		return nil, err
+	}
+
+	if normalizeNIT(info.NIT) != normalizeNIT(nit) {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateService", "Store", "CertificateNITMismatch", info.NIT, nit)
+	}
+
+	// 3. Determinar la fecha de vencimiento, priorizando la del propio certificado
+	expiresAt := info.ExpiresAt
+	if expiresAt == nil {
+		expiresAt = upload.ExpiresAt
+	}
+
+	if expiresAt == nil {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateService", "Store", "CertificateExpiryRequired")
+	}
+
+	if !expiresAt.After(utils.TimeNow()) {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateService", "Store", "CertificateExpired", expiresAt.Format(time.DateOnly))
+	}
+
+	// 4. Encriptar el contenido del certificado
+	encrypted, err := s.cryptManager.Encrypt(s.encryptionKey, upload.Content)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateService", "Store", err, "FailedToStoreCertificate")
+	}
+
+	cert := &models.SigningCertificate{
+		UserID:           userID,
+		NIT:              nit,
+		Fingerprint:      info.Fingerprint,
+		ExpiresAt:        *expiresAt,
+		IsActive:         true,
+		CreatedAt:        utils.TimeNow(),
+		EncryptedContent: encrypted,
+	}
+
+	// 5. Activar el certificado y actualizar la contraseña privada del usuario
+	if err := s.repo.Activate(ctx, cert, upload.PasswordPri); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateService", "Store", err, "FailedToStoreCertificate")
+	}
+
+	cert.DaysToExpire = daysUntil(utils.TimeNow(), cert.ExpiresAt)
+
+	logs.Info("Signing certificate activated", map[string]interface{}{
+		"nit":         nit,
+		"fingerprint": cert.Fingerprint,
+		"expiresAt":   cert.ExpiresAt,
+	})
+
+	return cert, nil
+}
+
+// getActive obtiene el certificado activo de un NIT, retornando nil si no existe
+func (s *CertificateService) getActive(ctx context.Context, nit string) (*models.SigningCertificate, error) {
+	active, err := s.repo.GetActiveByNIT(ctx, nit)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrCertificateNotFound) {
+			return nil, nil
+		}
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateService", "GetActive", err, "FailedToGetCertificates")
+	}
+
+	return active, nil
+}
+
+func normalizeNIT(nit string) string {
+	return strings.ReplaceAll(strings.TrimSpace(nit), "-", "")
+}
+
+func daysUntil(now, date time.Time) int {
+	return int(math.Ceil(date.Sub(now).Hours() / 24))
+}
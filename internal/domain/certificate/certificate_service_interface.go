@@ -0,0 +1,23 @@
+package certificate
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate/models"
+)
+
+// CertificateManager interfaz para la gestión de certificados de firma por NIT
+type CertificateManager interface {
+	// Upload registra el primer certificado activo de un NIT
+	Upload(ctx context.Context, userID uint, nit string, upload *models.CertificateUpload) (*models.SigningCertificate, error)
+	// Rotate reemplaza el certificado activo de un NIT por uno nuevo
+	Rotate(ctx context.Context, userID uint, nit string, upload *models.CertificateUpload) (*models.SigningCertificate, error)
+	// List obtiene los certificados registrados por un usuario
+	List(ctx context.Context, userID uint) ([]models.SigningCertificate, error)
+	// Deactivate desactiva un certificado de un usuario
+	Deactivate(ctx context.Context, userID, id uint) error
+	// GetActiveCertificateData obtiene el contenido desencriptado del certificado activo de un NIT
+	GetActiveCertificateData(ctx context.Context, nit string) ([]byte, error)
+	// NotifyExpiringCertificates genera un evento por cada certificado próximo a vencer y retorna cuántos se notificaron
+	NotifyExpiringCertificates(ctx context.Context) (int, error)
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// SigningCertificate representa un certificado de firma emitido por el Ministerio de Hacienda para un NIT.
+// El contenido del certificado (incluida la llave privada) se almacena encriptado y nunca se expone.
+type SigningCertificate struct {
+	ID               uint       `json:"id"`
+	UserID           uint       `json:"-"`
+	NIT              string     `json:"nit"`
+	Fingerprint      string     `json:"fingerprint"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	DaysToExpire     int        `json:"days_to_expire"`
+	IsActive         bool       `json:"is_active"`
+	CreatedAt        time.Time  `json:"created_at"`
+	DeactivatedAt    *time.Time `json:"deactivated_at,omitempty"`
+	ExpiryNotifiedAt *time.Time `json:"-"`
+	EncryptedContent string     `json:"-"`
+}
+
+// CertificateUpload representa los datos necesarios para registrar o renovar un certificado
+type CertificateUpload struct {
+	Content     []byte
+	PasswordPri string
+	ExpiresAt   *time.Time
+}
+
+// CertificateInfo representa la información extraída de un certificado válido
+type CertificateInfo struct {
+	NIT         string
+	Fingerprint string
+	ExpiresAt   *time.Time
+}
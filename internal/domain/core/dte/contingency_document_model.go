@@ -7,16 +7,22 @@ import (
 
 // ContingencyDocument representa un documento en estado de contingencia
 type ContingencyDocument struct {
-	ID              string    `json:"id,omitempty"`
-	DocumentID      string    `json:"document_id"`
-	BranchID        uint      `json:"branch_id"`
-	ContingencyType int8      `json:"contingency_type"`
-	Reason          string    `json:"reason"`
-	BatchID         *string   `json:"batch_id,omitempty"`
-	MHBatchID       *string   `json:"mh_batch_id,omitempty"`
-	Observations    *string   `json:"observations,omitempty"`
-	CreatedAt       time.Time `json:"created_at,omitempty"`
-	UpdatedAt       time.Time `json:"updated_at,omitempty"`
+	ID              string  `json:"id,omitempty"`
+	DocumentID      string  `json:"document_id"`
+	BranchID        uint    `json:"branch_id"`
+	ContingencyType int8    `json:"contingency_type"`
+	Reason          string  `json:"reason"`
+	BatchID         *string `json:"batch_id,omitempty"`
+	MHBatchID       *string `json:"mh_batch_id,omitempty"`
+	Observations    *string `json:"observations,omitempty"`
+	// ContingencyEventID es el evento de contingencia que informó el documento, pendiente de la respuesta de Hacienda
+	// o aceptado
+	ContingencyEventID *string `json:"contingency_event_id,omitempty"`
+	// ContingencyEventStatus es el estado del evento que informó el documento, solo se obtiene con los documentos
+	// pendientes de retransmitir
+	ContingencyEventStatus string    `json:"-"`
+	CreatedAt              time.Time `json:"created_at,omitempty"`
+	UpdatedAt              time.Time `json:"updated_at,omitempty"`
 
 	Document *DTEDetails        `json:"document,omitempty"`
 	Branch   *user.BranchOffice `json:"branch,omitempty"`
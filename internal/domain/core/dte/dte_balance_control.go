@@ -22,3 +22,13 @@ type BalanceControl struct {
 	Branch       *user.BranchOffice   `json:"branch,omitempty"`
 	Transactions []BalanceTransaction `json:"transactions,omitempty"`
 }
+
+// balanceTolerance es la diferencia máxima para considerar agotado un saldo, evita errores de redondeo de centavos
+const balanceTolerance = 0.005
+
+// IsExhausted indica si los montos gravado, exento y no sujeto del documento fueron agotados por sus ajustes
+func (b *BalanceControl) IsExhausted() bool {
+	return b.RemainingTaxedAmount <= balanceTolerance &&
+		b.RemainingExemptAmount <= balanceTolerance &&
+		b.RemainingNotSubjectAmount <= balanceTolerance
+}
@@ -0,0 +1,13 @@
+package dte
+
+// PrintableDTE representa los datos necesarios para generar la versión legible de un DTE
+type PrintableDTE struct {
+	DTEType        string
+	Status         string
+	Transmission   string
+	ReceptionStamp *string
+	QRLink         string
+	Document       map[string]interface{}
+	Logo           []byte
+	Footer         string
+}
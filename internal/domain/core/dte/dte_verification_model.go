@@ -0,0 +1,15 @@
+package dte
+
+// DTEVerification representa el resultado de verificar un DTE firmado
+type DTEVerification struct {
+	Valid          bool     `json:"valid"`
+	SignatureValid bool     `json:"signature_valid"`
+	Algorithm      string   `json:"algorithm"`
+	IssuerNIT      string   `json:"issuer_nit"`
+	DTEType        string   `json:"dte_type"`
+	GenerationCode string   `json:"generation_code"`
+	ControlNumber  string   `json:"control_number"`
+	DocumentFound  bool     `json:"document_found"`
+	PayloadMatches bool     `json:"payload_matches"`
+	Differences    []string `json:"differences,omitempty"`
+}
@@ -3,9 +3,18 @@ package error
 import "errors"
 
 var (
-	ErrBranchMatrixNotFound       = errors.New("branch matrix not found")
-	ErrAtLeastOneBranch           = errors.New("at least one branch is required")
-	ErrDontHaveBranchMatrix       = errors.New("don't have branch matrix, is required that the user have a branch matrix")
-	ErrMoreThanOneBranchMatrix    = errors.New("more than one branch matrix, is required that the user have only one branch matrix")
-	ErrBranchMatrixWithoutAddress = errors.New("for the branch matrix is required that have an address associated")
+	ErrBranchMatrixNotFound           = errors.New("branch matrix not found")
+	ErrAtLeastOneBranch               = errors.New("at least one branch is required")
+	ErrDontHaveBranchMatrix           = errors.New("don't have branch matrix, is required that the user have a branch matrix")
+	ErrMoreThanOneBranchMatrix        = errors.New("more than one branch matrix, is required that the user have only one branch matrix")
+	ErrBranchMatrixWithoutAddress     = errors.New("for the branch matrix is required that have an address associated")
+	ErrCertificateNotFound            = errors.New("signing certificate not found")
+	ErrPDFTemplateNotFound            = errors.New("pdf template not found")
+	ErrIdempotencyKeyNotFound         = errors.New("idempotency key not found")
+	ErrEmissionJobNotFound            = errors.New("emission job not found")
+	ErrWebhookNotFound                = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound        = errors.New("webhook delivery not found")
+	ErrContingencyDocumentNotFound    = errors.New("contingency document not found")
+	ErrContingencyDocumentNotPending  = errors.New("contingency document is no longer pending")
+	ErrRetransmissionScheduleNotFound = errors.New("retransmission schedule not found")
 )
@@ -0,0 +1,34 @@
+package event
+
+// DTEPayload contiene la información de un documento en los eventos de su ciclo de vida
+type DTEPayload struct {
+	GenerationCode  string  `json:"generation_code"`
+	ControlNumber   string  `json:"control_number,omitempty"`
+	DTEType         string  `json:"dte_type,omitempty"`
+	Status          string  `json:"status,omitempty"`
+	ReceptionStamp  *string `json:"reception_stamp,omitempty"`
+	ContingencyType *int8   `json:"contingency_type,omitempty"`
+	Reason          string  `json:"reason,omitempty"`
+}
+
+// BatchPayload contiene el resultado de un lote de contingencia procesado por Hacienda
+type BatchPayload struct {
+	BatchID   string   `json:"batch_id"`
+	MHBatchID string   `json:"mh_batch_id"`
+	Processed []string `json:"processed"`
+	Rejected  []string `json:"rejected"`
+}
+
+// BalancePayload identifica el documento cuyo saldo fue agotado y la nota de crédito que lo agotó
+type BalancePayload struct {
+	OriginalGenerationCode   string `json:"original_generation_code"`
+	AdjustmentGenerationCode string `json:"adjustment_generation_code"`
+}
+
+// UserRegisteredPayload contiene la información de un cliente recién registrado
+type UserRegisteredPayload struct {
+	NIT          string `json:"nit"`
+	BusinessName string `json:"business_name"`
+	Email        string `json:"email"`
+	Branches     int    `json:"branches"`
+}
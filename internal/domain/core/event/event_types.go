@@ -0,0 +1,26 @@
+package event
+
+// Tipos de eventos de dominio
+const (
+	// CertificateExpiring se genera cuando un certificado de firma está próximo a vencer
+	CertificateExpiring = "CERTIFICATE_EXPIRING"
+	// DTEDeliveryRequested se genera cuando se solicita el envío de un DTE por correo a su receptor
+	DTEDeliveryRequested = "DTE_DELIVERY_REQUESTED"
+	// DTEReceived se genera cuando Hacienda recibe un documento, ya sea en línea, asíncrono o en contingencia
+	DTEReceived = "DTE_RECEIVED"
+	// DTERejected se genera cuando Hacienda rechaza un documento almacenado
+	DTERejected = "DTE_REJECTED"
+	// DTEContingency se genera cuando un documento se almacena en contingencia
+	DTEContingency = "DTE_CONTINGENCY"
+	// ContingencyBatchProcessed se genera cuando Hacienda termina de procesar un lote de contingencia
+	ContingencyBatchProcessed = "CONTINGENCY_BATCH_PROCESSED"
+	// DTEInvalidated se genera cuando se invalida un documento
+	DTEInvalidated = "DTE_INVALIDATED"
+	// CreditNoteBalanceExhausted se genera cuando las notas de crédito agotan el saldo de un documento
+	CreditNoteBalanceExhausted = "CREDIT_NOTE_BALANCE_EXHAUSTED"
+	// UserRegistered se genera cuando se registra un nuevo cliente
+	UserRegistered = "USER_REGISTERED"
+
+	// AllEvents permite suscribirse a todos los eventos
+	AllEvents = "*"
+)
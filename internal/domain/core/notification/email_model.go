@@ -0,0 +1,16 @@
+package notification
+
+// Email representa un correo electrónico saliente
+type Email struct {
+	To          []string
+	Subject     string
+	Body        string
+	Attachments []Attachment
+}
+
+// Attachment representa un archivo adjunto de un correo electrónico
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
@@ -0,0 +1,25 @@
+package notification
+
+// Tipos de notificación
+const (
+	// TypeEmail es una notificación enviada por correo electrónico
+	TypeEmail = "EMAIL"
+	// TypeEventEmail es una notificación por correo electrónico de un evento de dominio a los usuarios notificables
+	TypeEventEmail = "EVENT_EMAIL"
+)
+
+// Tipos de entidad de un usuario notificable
+const (
+	// EntityClient identifica a un usuario cliente
+	EntityClient = "CLIENT"
+)
+
+// Estados de entrega de una notificación
+const (
+	// DeliveryPending indica que la notificación no se ha enviado o que se reintentará
+	DeliveryPending = "PENDING"
+	// DeliverySent indica que la notificación fue entregada al servidor de correo
+	DeliverySent = "SENT"
+	// DeliveryFailed indica que se agotaron los intentos de entrega
+	DeliveryFailed = "FAILED"
+)
@@ -4,11 +4,18 @@ import "time"
 
 // UserNotification representa una notificación para un usuario
 type UserNotification struct {
-	ID               uint      `json:"id,omitempty"`
-	UserID           uint      `json:"user_id"`
-	EventID          uint      `json:"event_id"`
-	NotificationType string    `json:"notification_type"`
-	Message          string    `json:"message"`
-	DeliveryStatus   string    `json:"delivery_status"`
-	DeliveryAt       time.Time `json:"delivery_at,omitempty"`
+	ID               uint       `json:"id,omitempty"`
+	UserID           uint       `json:"user_id"`
+	EventID          uint       `json:"event_id"`
+	BranchID         uint       `json:"-"`
+	EventType        string     `json:"-"`
+	NotificationType string     `json:"notification_type"`
+	Message          string     `json:"message"`
+	DeliveryStatus   string     `json:"delivery_status"`
+	DeliveryAt       time.Time  `json:"delivery_at,omitempty"`
+	Recipient        string     `json:"recipient,omitempty"`
+	ReferenceID      string     `json:"reference_id,omitempty"`
+	Attempts         int        `json:"attempts"`
+	LastError        string     `json:"last_error,omitempty"`
+	NextAttemptAt    *time.Time `json:"next_attempt_at,omitempty"`
 }
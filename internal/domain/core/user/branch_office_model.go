@@ -2,6 +2,8 @@ package user
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/base"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
@@ -20,8 +22,39 @@ type BranchOffice struct {
 	POSCode             *string  `json:"pos_code,omitempty"`
 	POSCodeMH           *string  `json:"pos_code_mh,omitempty"`
 	IsActive            bool     `json:"is_active"`
+	AsyncEmission       bool     `json:"async_emission"`
 	Address             *Address `json:"address,omitempty"`
 	User                *User    `json:"user,omitempty"`
+
+	// Permisos de las credenciales de la sucursal: su rol y los scopes adicionales al rol
+	Role   string   `json:"role"`
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Credenciales anteriores a la última rotación, válidas hasta PreviousExpiresAt
+	PreviousAPIKey    *string    `json:"-"`
+	PreviousAPISecret *string    `json:"-"`
+	PreviousExpiresAt *time.Time `json:"-"`
+}
+
+// UsesPreviousCredentials indica si el API key corresponde a las credenciales anteriores a la última rotación y
+// estas siguen en su periodo de gracia
+func (b *BranchOffice) UsesPreviousCredentials(apiKey string, now time.Time) bool {
+	return b.PreviousAPIKey != nil && *b.PreviousAPIKey == apiKey && b.APIKey != apiKey &&
+		b.PreviousExpiresAt != nil && now.Before(*b.PreviousExpiresAt)
+}
+
+// CredentialsSecret obtiene el hash del API secret que corresponde al API key, el actual o el anterior a la
+// rotación mientras siga en su periodo de gracia. Retorna false si el API key no corresponde a la sucursal.
+func (b *BranchOffice) CredentialsSecret(apiKey string, now time.Time) (string, bool) {
+	if b.APIKey == apiKey {
+		return b.APISecret, true
+	}
+
+	if b.UsesPreviousCredentials(apiKey, now) && b.PreviousAPISecret != nil {
+		return *b.PreviousAPISecret, true
+	}
+
+	return "", false
 }
 
 func (b *BranchOffice) Validate() error {
@@ -71,5 +104,15 @@ func (b *BranchOffice) Validate() error {
 		}
 	}
 
+	if b.Role != "" && !IsValidRole(b.Role) {
+		return dte_errors.NewValidationError("InvalidField", "role")
+	}
+
+	for _, scope := range b.Scopes {
+		if !IsValidScope(scope) {
+			return dte_errors.NewValidationError("InvalidField", "scopes")
+		}
+	}
+
 	return nil
 }
@@ -6,4 +6,45 @@ type ListBranchesResponse struct {
 	EstablishmentCode *string `json:"establishment_code,omitempty"`
 	APIKey            string  `json:"api_key"`
 	APISecret         string  `json:"api_secret"`
+	Role              string  `json:"role"`
+}
+
+// BranchOfficeResponse representa una sucursal en las respuestas de administración de sucursales. APISecret solo se
+// incluye al crear la sucursal.
+type BranchOfficeResponse struct {
+	ID                  uint     `json:"id"`
+	EstablishmentType   string   `json:"establishment_type"`
+	EstablishmentCode   *string  `json:"establishment_code,omitempty"`
+	EstablishmentCodeMH *string  `json:"establishment_code_mh,omitempty"`
+	POSCode             *string  `json:"pos_code,omitempty"`
+	POSCodeMH           *string  `json:"pos_code_mh,omitempty"`
+	Email               *string  `json:"email,omitempty"`
+	Phone               *string  `json:"phone,omitempty"`
+	APIKey              string   `json:"api_key"`
+	APISecret           string   `json:"api_secret,omitempty"`
+	IsActive            bool     `json:"is_active"`
+	AsyncEmission       bool     `json:"async_emission"`
+	Role                string   `json:"role"`
+	Scopes              []string `json:"scopes"`
+	Address             *Address `json:"address,omitempty"`
+}
+
+// ToResponse convierte la sucursal a su representación de administración sin su API secret
+func (b *BranchOffice) ToResponse() BranchOfficeResponse {
+	return BranchOfficeResponse{
+		ID:                  b.ID,
+		EstablishmentType:   b.EstablishmentType,
+		EstablishmentCode:   b.EstablishmentCode,
+		EstablishmentCodeMH: b.EstablishmentCodeMH,
+		POSCode:             b.POSCode,
+		POSCodeMH:           b.POSCodeMH,
+		Email:               b.Email,
+		Phone:               b.Phone,
+		APIKey:              b.APIKey,
+		IsActive:            b.IsActive,
+		AsyncEmission:       b.AsyncEmission,
+		Role:                b.Role,
+		Scopes:              b.EffectiveScopes(),
+		Address:             b.Address,
+	}
 }
@@ -0,0 +1,65 @@
+package user
+
+import (
+	"slices"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+)
+
+// Roles de las credenciales de API de las sucursales
+const (
+	RoleAdmin    = "admin"    // Administrador del cliente, tiene todos los permisos
+	RoleOperator = "operator" // Operador de sucursal, emite y consulta documentos
+	RoleAuditor  = "auditor"  // Auditor, consulta documentos y métricas sin modificarlos
+)
+
+// Scopes que autorizan las operaciones de la API
+const (
+	ScopeDTECreate     = "dte:create"     // Emitir y reenviar documentos y gestionar la cola de contingencia
+	ScopeDTEInvalidate = "dte:invalidate" // Invalidar documentos
+	ScopeDTERead       = "dte:read"       // Consultar documentos, eventos, contingencias y discrepancias
+	ScopeMetricsRead   = "metrics:read"   // Consultar métricas y el estado de los jobs
+	ScopeAdminBranches = "admin:branches" // Administrar las sucursales y sus credenciales
+	ScopeAdminSettings = "admin:settings" // Administrar certificados, webhooks, plantillas y programaciones
+)
+
+// roleScopes contiene los scopes que otorga cada rol
+var roleScopes = map[string][]string{
+	RoleAdmin: {
+		ScopeDTECreate, ScopeDTEInvalidate, ScopeDTERead, ScopeMetricsRead, ScopeAdminBranches, ScopeAdminSettings,
+	},
+	RoleOperator: {ScopeDTECreate, ScopeDTERead},
+	RoleAuditor:  {ScopeDTERead, ScopeMetricsRead},
+}
+
+// IsValidRole indica si el rol existe
+func IsValidRole(role string) bool {
+	_, ok := roleScopes[role]
+	return ok
+}
+
+// IsValidScope indica si el scope existe
+func IsValidScope(scope string) bool {
+	return slices.Contains(roleScopes[RoleAdmin], scope)
+}
+
+// DefaultRole obtiene el rol que se asigna a una sucursal nueva: la casa matriz administra el cliente y el resto de
+// sucursales opera
+func DefaultRole(establishmentType string) string {
+	if establishmentType == constants.CasaMatriz {
+		return RoleAdmin
+	}
+	return RoleOperator
+}
+
+// EffectiveScopes obtiene los scopes de las credenciales de la sucursal, los de su rol junto a los adicionales
+func (b *BranchOffice) EffectiveScopes() []string {
+	scopes := slices.Clone(roleScopes[b.Role])
+	for _, scope := range b.Scopes {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes
+}
@@ -154,12 +154,13 @@ func (u *User) ValidateBranchOffices() error {
 	return nil
 }
 
-// SetBranchesKeysAndSecrets asigna las llaves y secretos a las sucursales del usuario
+// SetBranchesKeysAndSecrets asigna las llaves y secretos a las sucursales del usuario junto al rol predeterminado de sus credenciales
 func (u *User) SetBranchesKeysAndSecrets(keys []string, secrets []string) {
 	for i := range u.BranchOffices {
 		u.BranchOffices[i].APIKey = keys[i]
 		u.BranchOffices[i].APISecret = secrets[i]
 		u.BranchOffices[i].IsActive = true
+		u.BranchOffices[i].Role = DefaultRole(u.BranchOffices[i].EstablishmentType)
 	}
 }
 
@@ -178,6 +179,7 @@ func (u *User) ListBranches() []ListBranchesResponse {
 			EstablishmentCode: branch.EstablishmentCode,
 			APIKey:            branch.APIKey,
 			APISecret:         branch.APISecret,
+			Role:              branch.Role,
 		})
 	}
 
@@ -0,0 +1,27 @@
+package accounting_liquidation_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/temporal"
+)
+
+// AccountingLiquidationBody cuerpo del Documento Contable de Liquidación, a diferencia de otros DTE es un único objeto
+type AccountingLiquidationBody struct {
+	PeriodStart                  temporal.EmissionDate // Fecha de inicio del periodo liquidado
+	PeriodEnd                    temporal.EmissionDate // Fecha de fin del periodo liquidado
+	LiquidationCode              string                // Código interno de la liquidación
+	DocumentCount                int                   // Cantidad de documentos liquidados
+	OperationsValue              financial.Amount      // Valor total de las operaciones liquidadas
+	AmountWithoutPerception      financial.Amount      // Monto de las operaciones sin percepción
+	WithoutPerceptionDescription *string               // Descripción de las operaciones sin percepción
+	SubTotal                     financial.Amount      // Subtotal de las operaciones gravadas
+	IVA                          financial.Amount      // IVA 13% del subtotal
+	AmountSubjectToPerception    financial.Amount      // Monto sujeto a percepción de IVA
+	IVAPerceived                 financial.Amount      // IVA percibido 1%
+	Commission                   financial.Amount      // Comisión cobrada por el agente
+	CommissionPercentage         financial.Amount      // Porcentaje de comisión aplicado
+	CommissionIVA                financial.Amount      // IVA 13% de la comisión
+	NetAmountToPay               financial.Amount      // Líquido a pagar
+	TotalInWords                 string                // Líquido a pagar en letras
+	Observations                 *string               // Observaciones
+}
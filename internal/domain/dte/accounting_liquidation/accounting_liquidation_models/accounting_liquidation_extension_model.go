@@ -0,0 +1,10 @@
+package accounting_liquidation_models
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+
+// AccountingLiquidationExtension extensión del Documento Contable de Liquidación, identifica a quien entrega y al empleado responsable
+type AccountingLiquidationExtension struct {
+	DeliveryName     document.DeliveryName     // Nombre de quien entrega
+	DeliveryDocument document.DeliveryDocument // Documento de quien entrega
+	EmployeeCode     *string                   // Código del empleado responsable
+}
@@ -0,0 +1,9 @@
+package accounting_liquidation_models
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+
+type AccountingLiquidationModel struct {
+	*models.DTEDocument
+	Body                 *AccountingLiquidationBody
+	LiquidationExtension *AccountingLiquidationExtension
+}
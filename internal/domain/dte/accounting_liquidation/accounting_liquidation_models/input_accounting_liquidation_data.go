@@ -0,0 +1,9 @@
+package accounting_liquidation_models
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+
+type InputAccountingLiquidationData struct {
+	*models.InputDataCommon
+	Body                 *AccountingLiquidationBody      `json:"body"`                            // Cuerpo del documento contable de liquidación
+	LiquidationExtension *AccountingLiquidationExtension `json:"liquidation_extension,omitempty"` // Extensión del documento contable de liquidación
+}
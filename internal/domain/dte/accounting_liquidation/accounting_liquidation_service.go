@@ -0,0 +1,130 @@
+package accounting_liquidation
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type accountingLiquidationService struct {
+	validator        *validator.AccountingLiquidationRulesValidator
+	seqNumberManager dte_documents.SequentialNumberManager
+	dteManager       dte_documents.DTEManager
+}
+
+// NewAccountingLiquidationService Crea un nuevo servicio de Documento Contable de Liquidación.
+func NewAccountingLiquidationService(seqNumberManager dte_documents.SequentialNumberManager, dteManager dte_documents.DTEManager) ports.DTEService {
+	return &accountingLiquidationService{
+		validator:        validator.NewAccountingLiquidationRulesValidator(nil),
+		seqNumberManager: seqNumberManager,
+		dteManager:       dteManager,
+	}
+}
+
+// Create Crea un nuevo Documento Contable de Liquidación electrónico con base en los datos proporcionados.
+func (s *accountingLiquidationService) Create(ctx context.Context, input interface{}, branchID uint) (interface{}, error) {
+	data := input.(*accounting_liquidation_models.InputAccountingLiquidationData)
+
+	// 1. Crear el documento base
+	if data.Body.TotalInWords == "" {
+		data.Body.TotalInWords = utils.InLetters(data.Body.NetAmountToPay.GetValue())
+	}
+	baseDoc := createBaseDocument(data)
+	accountingLiquidation := &accounting_liquidation_models.AccountingLiquidationModel{
+		DTEDocument:          baseDoc,
+		Body:                 data.Body,
+		LiquidationExtension: data.LiquidationExtension,
+	}
+
+	// 2. Validar el documento con las reglas del documento contable de liquidación
+	if err := s.validate(accountingLiquidation); err != nil {
+		logs.Error("Failed to validate accounting liquidation document", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 3. Generar el número de control y el código UUID
+	if err := s.generateCodeAndIdentifiers(ctx, accountingLiquidation, branchID); err != nil {
+		return nil, err
+	}
+
+	return accountingLiquidation, nil
+}
+
+// validate Valida un Documento Contable de Liquidación electrónico con base en las reglas de negocio.
+func (s *accountingLiquidationService) validate(accountingLiquidation *accounting_liquidation_models.AccountingLiquidationModel) error {
+	s.validator = validator.NewAccountingLiquidationRulesValidator(accountingLiquidation)
+	err := s.validator.Validate()
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"AccountingLiquidationService",
+			"Validate",
+			err,
+			"ValidationFailed",
+		)
+	}
+
+	return nil
+}
+
+// createBaseDocument Crea un documento base para el documento contable de liquidación electrónico.
+// El cuerpo y la extensión son propios de este tipo de documento, por lo que no se asignan items ni extensión genérica.
+func createBaseDocument(data *accounting_liquidation_models.InputAccountingLiquidationData) *models.DTEDocument {
+	var appendixes []interfaces.Appendix
+
+	if data.Appendixes != nil {
+		for _, appendix := range data.Appendixes {
+			appendixes = append(appendixes, &appendix)
+		}
+	}
+
+	return &models.DTEDocument{
+		Identification: data.Identification,
+		Issuer:         data.Issuer,
+		Receiver:       data.Receiver,
+		Appendix:       appendixes,
+	}
+}
+
+func (s *accountingLiquidationService) generateCodeAndIdentifiers(ctx context.Context, accountingLiquidation *accounting_liquidation_models.AccountingLiquidationModel, branchID uint) error {
+	if err := s.generateControlNumber(ctx, accountingLiquidation, branchID); err != nil {
+		return err
+	}
+	return accountingLiquidation.Identification.GenerateCode()
+}
+
+// generateControlNumber Genera un número de control único para el documento contable de liquidación.
+func (s *accountingLiquidationService) generateControlNumber(ctx context.Context, accountingLiquidation *accounting_liquidation_models.AccountingLiquidationModel, branchID uint) error {
+	establishmentCode := accountingLiquidation.Issuer.GetEstablishmentCode()
+	posCode := accountingLiquidation.Issuer.GetPOSCode()
+
+	controlNumber, err := s.seqNumberManager.GetNextControlNumber(
+		ctx,
+		constants.DocContableLiquidacionElectronico,
+		branchID,
+		posCode,
+		establishmentCode,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = accountingLiquidation.Identification.SetControlNumber(controlNumber)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"AccountingLiquidationService",
+			"GenerateControlNumber",
+			err,
+			"FailedToSetControlNumber",
+		)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/validator/strategy"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+)
+
+type AccountingLiquidationRulesValidator struct {
+	document   *accounting_liquidation_models.AccountingLiquidationModel
+	strategies []interfaces.DTEValidationStrategy
+}
+
+// NewAccountingLiquidationRulesValidator Crea un validador de reglas para documentos contables de liquidación electrónicos
+func NewAccountingLiquidationRulesValidator(doc *accounting_liquidation_models.AccountingLiquidationModel) *AccountingLiquidationRulesValidator {
+	validator := &AccountingLiquidationRulesValidator{
+		document: doc,
+		strategies: []interfaces.DTEValidationStrategy{
+			&strategy.AccountingLiquidationPeriodStrategy{Document: doc}, // 1. Validaciones del periodo liquidado
+			&strategy.AccountingLiquidationAmountStrategy{Document: doc}, // 2. Validaciones de montos y comisión
+		},
+	}
+	return validator
+}
+
+// Validate Ejecuta las validaciones del documento contable de liquidación electrónico.
+func (v *AccountingLiquidationRulesValidator) Validate() *dte_errors.DTEError {
+	var validationErrors []*dte_errors.DTEError
+
+	for _, strategyValidator := range v.strategies {
+		if err := strategyValidator.Validate(); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return dte_errors.NewDTEErrorComposite(validationErrors)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/shopspring/decimal"
+)
+
+type AccountingLiquidationAmountStrategy struct {
+	Document *accounting_liquidation_models.AccountingLiquidationModel
+}
+
+// Validate - Valida los montos, la comisión y el líquido a pagar del Documento Contable de Liquidación
+func (s *AccountingLiquidationAmountStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil || s.Document.Body == nil {
+		return nil
+	}
+
+	validations := []func() *dte_errors.DTEError{
+		s.validateIVA,
+		s.validateIVAPerceived,
+		s.validateCommission,
+		s.validateNetAmountToPay,
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateIVA valida que el IVA sea el 13% del subtotal
+func (s *AccountingLiquidationAmountStrategy) validateIVA() *dte_errors.DTEError {
+	body := s.Document.Body
+	expected := body.SubTotal.GetValueAsDecimal().Mul(decimal.NewFromFloat(constants.TaxIvaAmount))
+
+	if !s.compareTotalsWithTolerance(expected, body.IVA.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidIVACalculation", expected.InexactFloat64(), body.IVA.GetValue())
+	}
+
+	return nil
+}
+
+// validateIVAPerceived valida que el monto sujeto a percepción no exceda el subtotal y que el IVA percibido sea el 1% de dicho monto
+func (s *AccountingLiquidationAmountStrategy) validateIVAPerceived() *dte_errors.DTEError {
+	body := s.Document.Body
+	if body.AmountSubjectToPerception.GetValueAsDecimal().GreaterThan(body.SubTotal.GetValueAsDecimal()) {
+		return dte_errors.NewDTEErrorSimple("InvalidAmountSubjectToPerception", body.AmountSubjectToPerception.GetValue(), body.SubTotal.GetValue())
+	}
+
+	expected := body.AmountSubjectToPerception.GetValueAsDecimal().Mul(decimal.NewFromFloat(constants.IVAPerceptionAmount))
+	if !s.compareTotalsWithTolerance(expected, body.IVAPerceived.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidIVAPerception", body.IVAPerceived.GetValue(), expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateCommission valida el porcentaje de comisión, la comisión sobre el subtotal y su IVA
+func (s *AccountingLiquidationAmountStrategy) validateCommission() *dte_errors.DTEError {
+	body := s.Document.Body
+	percentage := body.CommissionPercentage.GetValueAsDecimal()
+	if percentage.GreaterThan(decimal.NewFromInt(100)) {
+		return dte_errors.NewDTEErrorSimple("InvalidCommissionPercentage", body.CommissionPercentage.GetValue())
+	}
+
+	expectedCommission := body.SubTotal.GetValueAsDecimal().Mul(percentage).Div(decimal.NewFromInt(100))
+	if !s.compareTotalsWithTolerance(expectedCommission, body.Commission.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidCommission", body.Commission.GetValue(), expectedCommission.InexactFloat64())
+	}
+
+	expectedIVA := body.Commission.GetValueAsDecimal().Mul(decimal.NewFromFloat(constants.TaxIvaAmount))
+	if !s.compareTotalsWithTolerance(expectedIVA, body.CommissionIVA.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidCommissionIVA", body.CommissionIVA.GetValue(), expectedIVA.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateNetAmountToPay valida que el líquido a pagar sea el subtotal más IVA, menos el IVA percibido, la comisión y su IVA
+func (s *AccountingLiquidationAmountStrategy) validateNetAmountToPay() *dte_errors.DTEError {
+	body := s.Document.Body
+	expected := body.SubTotal.GetValueAsDecimal().
+		Add(body.IVA.GetValueAsDecimal()).
+		Sub(body.IVAPerceived.GetValueAsDecimal()).
+		Sub(body.Commission.GetValueAsDecimal()).
+		Sub(body.CommissionIVA.GetValueAsDecimal())
+
+	if !s.compareTotalsWithTolerance(expected, body.NetAmountToPay.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidNetAmountToPay", body.NetAmountToPay.GetValue(), expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// compareTotalsWithTolerance compara dos totales con una tolerancia especificada
+func (s *AccountingLiquidationAmountStrategy) compareTotalsWithTolerance(expected, actual decimal.Decimal, tolerance float64) bool {
+	diff := expected.Sub(actual).Abs()
+	return diff.LessThanOrEqual(decimal.NewFromFloat(tolerance))
+}
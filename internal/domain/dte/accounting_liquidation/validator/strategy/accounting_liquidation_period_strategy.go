@@ -0,0 +1,69 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+)
+
+type AccountingLiquidationPeriodStrategy struct {
+	Document *accounting_liquidation_models.AccountingLiquidationModel
+}
+
+// Validate - Valida el periodo liquidado y los datos de control del Documento Contable de Liquidación
+func (s *AccountingLiquidationPeriodStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil || s.Document.Body == nil {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "Body")
+	}
+
+	validations := []func() *dte_errors.DTEError{
+		s.validatePeriod,
+		s.validateLiquidationCode,
+		s.validateDocumentCount,
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePeriod valida que el periodo inicie antes de finalizar y que no finalice después de la fecha de emisión
+func (s *AccountingLiquidationPeriodStrategy) validatePeriod() *dte_errors.DTEError {
+	body := s.Document.Body
+	if body.PeriodStart.GetValue().After(body.PeriodEnd.GetValue()) {
+		return dte_errors.NewDTEErrorSimple("InvalidLiquidationPeriod", body.PeriodStart.ToString(), body.PeriodEnd.ToString())
+	}
+
+	emissionDate := s.Document.Identification.GetEmissionDate()
+	if body.PeriodEnd.GetValue().After(emissionDate) {
+		return dte_errors.NewDTEErrorSimple("InvalidLiquidationPeriodEnd", body.PeriodEnd.ToString(), emissionDate.Format("2006-01-02"))
+	}
+
+	return nil
+}
+
+// validateLiquidationCode valida que el código de liquidación esté presente y no exceda 30 caracteres
+func (s *AccountingLiquidationPeriodStrategy) validateLiquidationCode() *dte_errors.DTEError {
+	code := s.Document.Body.LiquidationCode
+	if code == "" {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "Body->LiquidationCode")
+	}
+
+	if len(code) > 30 {
+		return dte_errors.NewDTEErrorSimple("InvalidLength", "Body->LiquidationCode", "1-30", code)
+	}
+
+	return nil
+}
+
+// validateDocumentCount valida que se liquide al menos un documento
+func (s *AccountingLiquidationPeriodStrategy) validateDocumentCount() *dte_errors.DTEError {
+	if s.Document.Body.DocumentCount < 1 {
+		return dte_errors.NewDTEErrorSimple("InvalidDocumentCount", s.Document.Body.DocumentCount)
+	}
+
+	return nil
+}
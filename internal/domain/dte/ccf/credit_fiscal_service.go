@@ -9,6 +9,7 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
 	buisnessValidator "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/temporal"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
@@ -18,18 +19,25 @@ import (
 type creditFiscalService struct {
 	validator        *validator.CCFRulesValidator
 	seqNumberManager dte_documents.SequentialNumberManager
+	dteManager       dte_documents.DTEManager
 }
 
 // NewCCFService Crea un nuevo servicio Comprobante de Crédito Fiscal.
-func NewCCFService(seqNumberManager dte_documents.SequentialNumberManager) ports.DTEService {
+func NewCCFService(seqNumberManager dte_documents.SequentialNumberManager, dteManager dte_documents.DTEManager) ports.DTEService {
 	return &creditFiscalService{
 		validator:        validator.NewCCFRulesValidator(nil),
 		seqNumberManager: seqNumberManager,
+		dteManager:       dteManager,
 	}
 }
 
 func (s *creditFiscalService) Create(ctx context.Context, input interface{}, branchID uint) (interface{}, error) {
 	data := input.(*ccf_models.CCFData)
+	if err := s.validateLinkedDocuments(ctx, data, branchID); err != nil {
+		logs.Error("Failed to validate related remission notes", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
 	baseDoc := createBaseDocument(data)
 
 	creditFiscalDocument := &ccf_models.CreditFiscalDocument{
@@ -55,6 +63,26 @@ func (s *creditFiscalService) Create(ctx context.Context, input interface{}, bra
 	return creditFiscalDocument, nil
 }
 
+// validateLinkedDocuments verifica que los documentos electrónicos referenciados (Nota de Remisión o Liquidaciones) existan y hayan sido recibidos por Hacienda
+func (s *creditFiscalService) validateLinkedDocuments(ctx context.Context, data *ccf_models.CCFData, branchID uint) error {
+	for i, relatedDoc := range data.RelatedDocs {
+		// Los documentos físicos y los tipos no permitidos no se verifican contra la base de datos
+		if !constants.ValidCCFDTETypesRelateDoc[relatedDoc.GetDocumentType()] ||
+			relatedDoc.GetGenerationType() != constants.ElectronicDocument {
+			continue
+		}
+
+		doc, err := s.dteManager.GetReceivedDocument(ctx, branchID, relatedDoc.GetDocumentType(), relatedDoc.GetDocumentNumber())
+		if err != nil {
+			return err
+		}
+
+		data.RelatedDocs[i].EmissionDate = *temporal.NewValidatedEmissionDate(doc.CreatedAt)
+	}
+
+	return nil
+}
+
 func (s *creditFiscalService) validate(ccf *ccf_models.CreditFiscalDocument) error {
 	s.validator = validator.NewCCFRulesValidator(ccf)
 	err := s.validator.Validate()
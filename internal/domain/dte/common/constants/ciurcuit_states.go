@@ -7,3 +7,24 @@ const (
 	StateOpen                  // Representa el estado abierto del circuit breaker
 	StateHalfOpen              // Representa el estado semi-abierto del circuit breaker
 )
+
+// Componentes externos protegidos por un circuit breaker
+const (
+	CircuitHaciendaReception = "hacienda_reception" // Recepción y consulta de documentos de Hacienda
+	CircuitHaciendaAuth      = "hacienda_auth"      // Autenticación con Hacienda
+	CircuitSigner            = "signer"             // Servicio externo de firma
+)
+
+// String devuelve el nombre del estado del circuit breaker
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "CLOSED"
+	case StateOpen:
+		return "OPEN"
+	case StateHalfOpen:
+		return "HALF_OPEN"
+	default:
+		return "UNKNOWN"
+	}
+}
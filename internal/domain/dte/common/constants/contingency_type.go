@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	NoDisponibilidadMH    = iota + 1 // No disponibilidad de sistema del MH
 	FallaConexionSistema             // Falla en conexiones del sistema del emisor
@@ -34,3 +36,17 @@ func GetContingencyReason(contingencyType int8) string {
 	}
 	return ContingencyReasons[5]
 }
+
+// ContingencyTransmissionDeadline es el plazo que otorga el MH para transmitir un documento emitido en contingencia,
+// contado desde su almacenamiento en contingencia
+const ContingencyTransmissionDeadline = 72 * time.Hour
+
+// IsAllowedContingencyType indica si el tipo de contingencia es uno de los permitidos
+func IsAllowedContingencyType(contingencyType int) bool {
+	for _, ct := range AllowedContingencyTypes {
+		if ct == contingencyType {
+			return true
+		}
+	}
+	return false
+}
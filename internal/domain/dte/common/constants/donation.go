@@ -0,0 +1,31 @@
+package constants
+
+const (
+	DonacionEfectivo = iota + 1 // Tipo de donación en efectivo
+	DonacionBien                // Tipo de donación de bienes
+	DonacionServicio            // Tipo de donación de servicios
+)
+
+const (
+	DonanteDomiciliado   = iota + 1 // Donante domiciliado en El Salvador
+	DonanteNoDomiciliado            // Donante no domiciliado en El Salvador
+)
+
+const (
+	CodigoPaisElSalvador = "9300" // Código de El Salvador en el catálogo de países de Hacienda (CAT-020)
+)
+
+var (
+	// AllowedDonationTypes contiene los tipos de donación permitidos en un Comprobante de Donación: Efectivo, Bien y Servicio
+	AllowedDonationTypes = []int{
+		DonacionEfectivo,
+		DonacionBien,
+		DonacionServicio,
+	}
+
+	// AllowedDomiciledCodes contiene los códigos de domicilio permitidos para el donante
+	AllowedDomiciledCodes = []int{
+		DonanteDomiciliado,
+		DonanteNoDomiciliado,
+	}
+)
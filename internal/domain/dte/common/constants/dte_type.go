@@ -23,6 +23,21 @@ const (
 )
 
 var (
+	// DTETypeNames contiene el nombre de cada tipo de documento, utilizado en su versión legible
+	DTETypeNames = map[string]string{
+		FacturaElectronica:                "Factura",
+		CCFElectronico:                    "Comprobante de Crédito Fiscal",
+		NotaRemisionElectronica:           "Nota de Remisión",
+		NotaCreditoElectronica:            "Nota de Crédito",
+		NotaDebitoElectronica:             "Nota de Débito",
+		ComprobanteRetencionElectronico:   "Comprobante de Retención",
+		ComprobanteLiquidacionElectronico: "Comprobante de Liquidación",
+		DocContableLiquidacionElectronico: "Documento Contable de Liquidación",
+		FacturaExportacionElectronica:     "Factura de Exportación",
+		FacturaSujetoExcluidoElectronica:  "Factura de Sujeto Excluido",
+		ComprobanteDonacionElectronico:    "Comprobante de Donación",
+	}
+
 	// ValidDTETypes Es una lista de valores permitidos para el campo DTEType
 	ValidDTETypes = map[string]bool{
 		FacturaElectronica:                true,
@@ -73,6 +88,20 @@ var (
 		DocContableLiquidacionElectronico: true,
 	}
 
+	// ValidRemissionNoteDTETypesRelateDoc Es una lista de valores permitidos para el campo DTEType de un documento relacionado en una Nota de Remisión
+	ValidRemissionNoteDTETypesRelateDoc = map[string]bool{
+		FacturaElectronica: true,
+		CCFElectronico:     true,
+	}
+
+	// ValidLiquidationDTETypesItem Es una lista de valores permitidos para el campo DTEType de un documento liquidado en un Comprobante de Liquidación
+	ValidLiquidationDTETypesItem = map[string]bool{
+		FacturaElectronica:     true,
+		CCFElectronico:         true,
+		NotaCreditoElectronica: true,
+		NotaDebitoElectronica:  true,
+	}
+
 	// ValidDTETypesForContingency Es una lista de valores permitidos que se puede enviar por contingencia
 	ValidDTETypesForContingency = map[string]bool{
 		FacturaElectronica:               true,
@@ -0,0 +1,42 @@
+package constants
+
+const (
+	// Tipos de item de exportación
+	ExportBienes          = iota + 1 // Exportación de bienes
+	ExportServicios                  // Exportación de servicios
+	ExportBienesServicios            // Exportación de bienes y servicios
+
+	// Tipos de persona del receptor extranjero
+	PersonaNatural  = 1 // Persona natural
+	PersonaJuridica = 2 // Persona jurídica
+)
+
+var (
+	// AllowedItemExportTypes contiene los tipos de item de exportación permitidos
+	AllowedItemExportTypes = []int{
+		ExportBienes,
+		ExportServicios,
+		ExportBienesServicios,
+	}
+
+	// AllowedPersonTypes contiene los tipos de persona permitidos para un receptor extranjero
+	AllowedPersonTypes = []int{
+		PersonaNatural,
+		PersonaJuridica,
+	}
+
+	// Incoterms contiene los códigos INCOTERMS permitidos y su descripción (CAT-031)
+	Incoterms = map[string]string{
+		"01": "EXW-En fabrica",
+		"02": "FCA-Libre transportista",
+		"03": "CPT-Transporte pagado hasta",
+		"04": "CIP-Transporte y seguro pagado hasta",
+		"05": "DAP-Entrega en el lugar",
+		"06": "DPU-Entregado en el lugar descargado",
+		"07": "DDP-Entrega con impuestos pagados",
+		"08": "FAS-Libre al costado del buque",
+		"09": "FOB-Libre a bordo",
+		"10": "CFR-Costo y flete",
+		"11": "CIF-Costo seguro y flete",
+	}
+)
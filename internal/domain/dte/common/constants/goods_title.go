@@ -0,0 +1,20 @@
+package constants
+
+const (
+	BienTituloDeposito     = "01" // Depósito
+	BienTituloPropiedad    = "02" // Propiedad
+	BienTituloConsignacion = "03" // Consignación
+	BienTituloTraslado     = "04" // Traslado
+	BienTituloOtros        = "05" // Otros
+)
+
+var (
+	// AllowedGoodsTitles contiene los títulos a que se remiten los bienes permitidos (CAT-025)
+	AllowedGoodsTitles = []string{
+		BienTituloDeposito,
+		BienTituloPropiedad,
+		BienTituloConsignacion,
+		BienTituloTraslado,
+		BienTituloOtros,
+	}
+)
@@ -15,6 +15,8 @@ const (
 	TaxTourismAirportAmount = 7.0
 	TaxFOVIALAmount         = 0.20
 	TaxCOTRANSAmount        = 0.10
+
+	IVAPerceptionAmount = 0.01 // Percepción de IVA 1%
 )
 
 var (
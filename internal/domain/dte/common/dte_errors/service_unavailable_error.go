@@ -0,0 +1,38 @@
+package dte_errors
+
+import "fmt"
+
+// ServiceUnavailableError indica que un servicio externo no está disponible: no respondió, respondió con un error 5xx
+// o su circuit breaker está abierto y la solicitud no se envió. Envuelve el error original.
+type ServiceUnavailableError struct {
+	Component   string
+	CircuitOpen bool
+	Err         error
+}
+
+// NewServiceUnavailableError crea el error de un servicio que falló al atender la solicitud
+func NewServiceUnavailableError(component string, err error) *ServiceUnavailableError {
+	return &ServiceUnavailableError{
+		Component: component,
+		Err:       err,
+	}
+}
+
+// NewCircuitOpenError crea el error de una solicitud que no se envió porque el circuit breaker del servicio está abierto
+func NewCircuitOpenError(component string) *ServiceUnavailableError {
+	return &ServiceUnavailableError{
+		Component:   component,
+		CircuitOpen: true,
+	}
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	if e.CircuitOpen {
+		return fmt.Sprintf("%s unavailable: circuit breaker open, request not sent", e.Component)
+	}
+	return fmt.Sprintf("%s unavailable: %v", e.Component, e.Err)
+}
+
+func (e *ServiceUnavailableError) Unwrap() error {
+	return e.Err
+}
@@ -29,7 +29,7 @@ func (s *ContingencyStrategy) Validate() *dte_errors.DTEError {
 		}
 
 		// Validar que sea uno de los tipos permitidos
-		if !validateContingencyType(*s.Document.GetIdentification().GetContingencyType()) {
+		if !constants.IsAllowedContingencyType(*s.Document.GetIdentification().GetContingencyType()) {
 			return dte_errors.NewDTEErrorSimple("InvalidContingencyType",
 				s.Document.GetIdentification().GetContingencyType())
 		}
@@ -37,13 +37,3 @@ func (s *ContingencyStrategy) Validate() *dte_errors.DTEError {
 
 	return nil
 }
-
-// validateContingencyType Válida que el tipo de contingencia sea uno de los permitidos
-func validateContingencyType(contingencyType int) bool {
-	for _, ct := range constants.AllowedContingencyTypes {
-		if ct == contingencyType {
-			return true
-		}
-	}
-	return false
-}
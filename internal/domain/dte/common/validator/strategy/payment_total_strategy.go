@@ -17,7 +17,9 @@ func (s *PaymentTotalStrategy) Validate() *dte_errors.DTEError {
 		return nil
 	}
 
-	if s.Document.GetIdentification().GetDTEType() == constants.NotaCreditoElectronica {
+	if s.Document.GetIdentification().GetDTEType() == constants.NotaCreditoElectronica ||
+		s.Document.GetIdentification().GetDTEType() == constants.NotaDebitoElectronica ||
+		s.Document.GetIdentification().GetDTEType() == constants.NotaRemisionElectronica {
 		return nil
 	}
 
@@ -0,0 +1,43 @@
+package document
+
+import (
+	"regexp"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+)
+
+var fiscalPrecinctRegex = regexp.MustCompile(`^[0-9]{2}$`)
+
+type FiscalPrecinct struct {
+	Value string `json:"value"`
+}
+
+func NewFiscalPrecinct(value string) (*FiscalPrecinct, error) {
+	fp := &FiscalPrecinct{Value: value}
+	if fp.IsValid() {
+		return fp, nil
+	}
+	return nil, dte_errors.NewValidationError("InvalidFormat", "recintoFiscal", "2 digits", value)
+}
+
+func NewValidatedFiscalPrecinct(value string) *FiscalPrecinct {
+	return &FiscalPrecinct{Value: value}
+}
+
+// IsValid valida que el recinto fiscal sea un código de 2 dígitos según el catálogo de Hacienda
+func (fp *FiscalPrecinct) IsValid() bool {
+	return fiscalPrecinctRegex.MatchString(fp.Value)
+}
+
+func (fp *FiscalPrecinct) GetValue() string {
+	return fp.Value
+}
+
+func (fp *FiscalPrecinct) Equals(other interfaces.ValueObject[string]) bool {
+	return fp.GetValue() == other.GetValue()
+}
+
+func (fp *FiscalPrecinct) ToString() string {
+	return fp.Value
+}
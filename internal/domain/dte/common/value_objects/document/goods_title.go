@@ -0,0 +1,45 @@
+package document
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+)
+
+type GoodsTitle struct {
+	Value string `json:"value"`
+}
+
+func NewGoodsTitle(value string) (*GoodsTitle, error) {
+	goodsTitle := &GoodsTitle{Value: value}
+	if goodsTitle.IsValid() {
+		return goodsTitle, nil
+	}
+	return nil, dte_errors.NewValidationError("InvalidGoodsTitle", value)
+}
+
+func NewValidatedGoodsTitle(value string) *GoodsTitle {
+	return &GoodsTitle{Value: value}
+}
+
+// IsValid valida que el título a que se remiten los bienes sea 01, 02, 03, 04 o 05
+func (gt *GoodsTitle) IsValid() bool {
+	for _, v := range constants.AllowedGoodsTitles {
+		if gt.Value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (gt *GoodsTitle) GetValue() string {
+	return gt.Value
+}
+
+func (gt *GoodsTitle) Equals(other interfaces.ValueObject[string]) bool {
+	return gt.GetValue() == other.GetValue()
+}
+
+func (gt *GoodsTitle) ToString() string {
+	return gt.Value
+}
@@ -0,0 +1,46 @@
+package document
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+)
+
+type Incoterm struct {
+	Value string `json:"value"`
+}
+
+func NewIncoterm(value string) (*Incoterm, error) {
+	incoterm := &Incoterm{Value: value}
+	if incoterm.IsValid() {
+		return incoterm, nil
+	}
+	return nil, dte_errors.NewValidationError("InvalidIncoterm", value)
+}
+
+func NewValidatedIncoterm(value string) *Incoterm {
+	return &Incoterm{Value: value}
+}
+
+// IsValid valida que el código INCOTERMS exista en el catálogo de Hacienda
+func (i *Incoterm) IsValid() bool {
+	_, ok := constants.Incoterms[i.Value]
+	return ok
+}
+
+// GetDescription obtiene la descripción del código INCOTERMS según el catálogo de Hacienda
+func (i *Incoterm) GetDescription() string {
+	return constants.Incoterms[i.Value]
+}
+
+func (i *Incoterm) GetValue() string {
+	return i.Value
+}
+
+func (i *Incoterm) Equals(other interfaces.ValueObject[string]) bool {
+	return i.GetValue() == other.GetValue()
+}
+
+func (i *Incoterm) ToString() string {
+	return i.Value
+}
@@ -0,0 +1,39 @@
+package document
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+)
+
+type Regime struct {
+	Value string `json:"value"`
+}
+
+func NewRegime(value string) (*Regime, error) {
+	regime := &Regime{Value: value}
+	if regime.IsValid() {
+		return regime, nil
+	}
+	return nil, dte_errors.NewValidationError("InvalidLength", "regimen", "1-13", value)
+}
+
+func NewValidatedRegime(value string) *Regime {
+	return &Regime{Value: value}
+}
+
+// IsValid valida que el régimen de exportación tenga entre 1 y 13 caracteres
+func (r *Regime) IsValid() bool {
+	return len(r.Value) >= 1 && len(r.Value) <= 13
+}
+
+func (r *Regime) GetValue() string {
+	return r.Value
+}
+
+func (r *Regime) Equals(other interfaces.ValueObject[string]) bool {
+	return r.GetValue() == other.GetValue()
+}
+
+func (r *Regime) ToString() string {
+	return r.Value
+}
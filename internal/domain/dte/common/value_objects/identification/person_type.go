@@ -0,0 +1,47 @@
+package identification
+
+import (
+	"fmt"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+)
+
+type PersonType struct {
+	Value int `json:"value"`
+}
+
+func NewPersonType(value int) (*PersonType, error) {
+	personType := &PersonType{Value: value}
+	if personType.IsValid() {
+		return personType, nil
+	}
+	return nil, dte_errors.NewValidationError("InvalidPersonType", value)
+}
+
+func NewValidatedPersonType(value int) *PersonType {
+	return &PersonType{Value: value}
+}
+
+// IsValid valida que el tipo de persona sea 1 (Natural) o 2 (Jurídica)
+func (p *PersonType) IsValid() bool {
+	for _, allowed := range constants.AllowedPersonTypes {
+		if p.Value == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *PersonType) GetValue() int {
+	return p.Value
+}
+
+func (p *PersonType) Equals(other interfaces.ValueObject[int]) bool {
+	return p.Value == other.GetValue()
+}
+
+func (p *PersonType) ToString() string {
+	return fmt.Sprintf("%d", p.Value)
+}
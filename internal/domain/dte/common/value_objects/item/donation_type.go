@@ -0,0 +1,47 @@
+package item
+
+import (
+	"fmt"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+)
+
+type DonationType struct {
+	Value int `json:"value"`
+}
+
+func NewDonationType(value int) (*DonationType, error) {
+	donationType := &DonationType{Value: value}
+	if donationType.IsValid() {
+		return donationType, nil
+	}
+	return nil, dte_errors.NewValidationError("InvalidDonationType", value)
+}
+
+func NewValidatedDonationType(value int) *DonationType {
+	return &DonationType{Value: value}
+}
+
+// IsValid valida que el tipo de donación sea 1 (Efectivo), 2 (Bien) o 3 (Servicio)
+func (d *DonationType) IsValid() bool {
+	for _, allowed := range constants.AllowedDonationTypes {
+		if d.Value == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *DonationType) GetValue() int {
+	return d.Value
+}
+
+func (d *DonationType) Equals(other interfaces.ValueObject[int]) bool {
+	return d.Value == other.GetValue()
+}
+
+func (d *DonationType) ToString() string {
+	return fmt.Sprintf("%d", d.Value)
+}
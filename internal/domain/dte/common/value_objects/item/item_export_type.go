@@ -0,0 +1,46 @@
+package item
+
+import (
+	"fmt"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+)
+
+type ItemExportType struct {
+	Value int
+}
+
+func NewItemExportType(value int) (*ItemExportType, error) {
+	itemExportType := &ItemExportType{Value: value}
+	if itemExportType.IsValid() {
+		return itemExportType, nil
+	}
+	return &ItemExportType{}, dte_errors.NewValidationError("InvalidItemExportType", value)
+}
+
+func NewValidatedItemExportType(value int) *ItemExportType {
+	return &ItemExportType{Value: value}
+}
+
+func (i *ItemExportType) GetValue() int {
+	return i.Value
+}
+
+func (i *ItemExportType) IsValid() bool {
+	for _, allowed := range constants.AllowedItemExportTypes {
+		if i.Value == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *ItemExportType) Equals(other interfaces.ValueObject[int]) bool {
+	return i.Value == other.GetValue()
+}
+
+func (i *ItemExportType) ToString() string {
+	return fmt.Sprintf("%d", i.Value)
+}
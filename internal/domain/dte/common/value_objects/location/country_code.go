@@ -0,0 +1,43 @@
+package location
+
+import (
+	"regexp"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+)
+
+var countryCodeRegex = regexp.MustCompile(`^[0-9A-Z]{2,4}$`)
+
+type CountryCode struct {
+	Value string `json:"value"`
+}
+
+func NewCountryCode(value string) (*CountryCode, error) {
+	countryCode := &CountryCode{Value: value}
+	if countryCode.IsValid() {
+		return countryCode, nil
+	}
+	return nil, dte_errors.NewValidationError("InvalidCountryCode", value)
+}
+
+func NewValidatedCountryCode(value string) *CountryCode {
+	return &CountryCode{Value: value}
+}
+
+// IsValid valida que el código de país tenga el formato del catálogo de países de Hacienda (CAT-020)
+func (c *CountryCode) IsValid() bool {
+	return countryCodeRegex.MatchString(c.Value)
+}
+
+func (c *CountryCode) GetValue() string {
+	return c.Value
+}
+
+func (c *CountryCode) Equals(other interfaces.ValueObject[string]) bool {
+	return c.GetValue() == other.GetValue()
+}
+
+func (c *CountryCode) ToString() string {
+	return c.Value
+}
@@ -3,9 +3,15 @@ package contingency
 import (
 	"context"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
 )
 
 // ContingencyEventSender interfaz para enviar eventos de contingencia
 type ContingencyEventSender interface {
-	PrepareAndSendContingencyEvent(ctx context.Context, docs []dte.ContingencyDocument) error
+	// PrepareContingencyEvent prepara y firma el evento de contingencia de los documentos de una sucursal con un mismo
+	// tipo y motivo de contingencia. Retorna el evento en estado PENDING, sin enviarlo.
+	PrepareContingencyEvent(ctx context.Context, docs []dte.ContingencyDocument) (*models.ContingencyEventRecord, error)
+	// SendContingencyEvent envía el evento firmado y registra en él la respuesta de Hacienda, tanto si lo aceptó como si
+	// lo rechazó. Retorna un error si no se obtuvo una respuesta.
+	SendContingencyEvent(ctx context.Context, record *models.ContingencyEventRecord) error
 }
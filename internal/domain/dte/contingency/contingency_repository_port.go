@@ -3,17 +3,35 @@ package contingency
 import (
 	"context"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
-	"time"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
 )
 
 // ContingencyRepositoryPort interfaz para el repositorio de contingencia (ya existe)
 type ContingencyRepositoryPort interface {
 	// Create almacena un documento de contingencia en la base de datos
 	Create(ctx context.Context, doc *dte.ContingencyDocument) error
-	// GetPending obtiene los documentos en estado PENDING para procesar
-	GetPending(ctx context.Context, limit int) ([]dte.ContingencyDocument, error)
+	// GetPending obtiene los documentos en estado PENDING para procesar, excepto los de los NIT indicados, con el estado
+	// del evento que los informó
+	GetPending(ctx context.Context, limit int, excludedNITs []string) ([]dte.ContingencyDocument, error)
+	// GetPendingByNIT obtiene los documentos en estado PENDING de las sucursales de un NIT, con el estado del evento que
+	// los informó
+	GetPendingByNIT(ctx context.Context, nit string, limit int) ([]dte.ContingencyDocument, error)
+	// List obtiene una página de los documentos en contingencia de las sucursales de un usuario y el total que cumplen los filtros
+	List(ctx context.Context, userID uint, filters *models.ContingencyFilters) ([]dte.ContingencyDocument, int64, error)
+	// GetByID obtiene un documento en contingencia de un usuario, retorna ErrContingencyDocumentNotFound si no existe
+	GetByID(ctx context.Context, userID uint, id string) (*dte.ContingencyDocument, error)
+	// UpdateClassification actualiza el tipo y el motivo de contingencia de un documento
+	UpdateClassification(ctx context.Context, id string, contingencyType int8, reason string) error
+	// Cancel retira un documento pendiente de la cola de contingencia marcándolo como rechazado con la observación
+	// indicada, retorna ErrContingencyDocumentNotPending si el documento ya no está pendiente
+	Cancel(ctx context.Context, id string, observation string) error
 	// UpdateBatch actualiza el estado de los documentos de un lote
 	UpdateBatch(ctx context.Context, ids []string, observations []string, stamps map[string]string, batchID string, mhBatchID string, status string) error
-	// GetFirstContingencyTimestamp obtiene la fecha de la primera contingencia de un sistema
-	GetFirstContingencyTimestamp(ctx context.Context, branchID uint) (*time.Time, error)
+	// SaveEvent almacena un evento de contingencia pendiente antes de enviarlo y lo asocia a los documentos que informa
+	SaveEvent(ctx context.Context, event *models.ContingencyEventRecord) error
+	// GetEvent obtiene un evento de contingencia con su documento firmado y los documentos asociados a él
+	GetEvent(ctx context.Context, id string) (*models.ContingencyEventRecord, error)
+	// UpdateEvent almacena la respuesta de Hacienda a un evento, si no lo aceptó retira la asociación de sus documentos
+	// para que se informen en un nuevo evento
+	UpdateEvent(ctx context.Context, event *models.ContingencyEventRecord) error
 }
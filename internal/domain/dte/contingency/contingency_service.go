@@ -3,21 +3,28 @@ package contingency
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"strings"
+	"sync"
+	"time"
 
-	"github.com/MarlonG1/api-facturacion-sv/config"
 	appPorts "github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
 	authModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
 	batch "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter"
 	transmitterModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
@@ -36,8 +43,27 @@ type ContingencyService struct {
 	contingencyEvents ContingencyEventSender
 	timeProvider      ports.TimeProvider
 	config            *transmitterModels.TransmissionConfig
+	events            events.EventPublisher
+	retransmitting    sync.Mutex
 }
 
+// ErrRetransmissionInProgress indica que la retransmisión se omitió porque hay otra en curso
+var ErrRetransmissionInProgress = fmt.Errorf("%w: contingency retransmission already in progress", jobs.ErrJobSkipped)
+
+const (
+	// ManualRetransmissionTimeout es el tiempo máximo de una retransmisión solicitada manualmente
+	ManualRetransmissionTimeout = 10 * time.Minute
+	// DefaultQueuePageSize es la cantidad de documentos por página cuando no se indica
+	DefaultQueuePageSize = 20
+	// MaxQueuePageSize es la cantidad máxima de documentos por página
+	MaxQueuePageSize = 100
+	// MinContingencyReasonLength y MaxContingencyReasonLength delimitan la longitud del motivo de contingencia
+	MinContingencyReasonLength = 5
+	MaxContingencyReasonLength = 150
+	// CancelledObservation es la observación registrada en los documentos cancelados manualmente
+	CancelledObservation = "Cancelado manualmente"
+)
+
 func NewContingencyManager(
 	authManager auth.AuthManager,
 	dteManager dte_documents.DTEManager,
@@ -50,6 +76,7 @@ func NewContingencyManager(
 	contingencyEvents ContingencyEventSender,
 	timeProvider ports.TimeProvider,
 	config *transmitterModels.TransmissionConfig,
+	eventPublisher events.EventPublisher,
 ) ContingencyManager {
 	return &ContingencyService{
 		authManager:       authManager,
@@ -63,6 +90,7 @@ func NewContingencyManager(
 		contingencyEvents: contingencyEvents,
 		config:            config,
 		timeProvider:      timeProvider,
+		events:            eventPublisher,
 	}
 }
 
@@ -115,12 +143,86 @@ func (s *ContingencyService) StoreDocumentInContingency(ctx context.Context, doc
 		"contingencyType": contingencyType,
 	})
 
+	// 6. Publicar el evento de contingencia
+	s.publishContingency(ctx, claims.BranchID, contingencyDoc, dteInfo.Identification.ControlNumber, dteType)
+
+	return nil
+}
+
+// MoveToContingency marca como contingencia un documento almacenado previamente como pendiente, como ocurre en la
+// emisión asíncrona, y lo registra para que sea retransmitido por el job de contingencia
+func (s *ContingencyService) MoveToContingency(ctx context.Context, branchID uint, generationCode string, contingencyType int8, reason string) error {
+	// 1. Actualizar la transmisión del documento
+	err := s.dteManager.UpdateDTE(ctx, branchID, dte.DTEDetails{
+		ID:           generationCode,
+		Transmission: constants.TransmissionContingency,
+		Status:       constants.DocumentPending,
+	})
+	if err != nil {
+		logs.Error("Failed to update DTE transmission", map[string]interface{}{
+			"error": err.Error(),
+			"id":    generationCode,
+		})
+		return shared_error.NewGeneralServiceError("ContingencyService", "MoveToContingency", "failed to update DTE transmission", err)
+	}
+
+	// 2. Almacenar el documento en contingencia
+	contingencyDoc := &dte.ContingencyDocument{
+		DocumentID:      generationCode,
+		BranchID:        branchID,
+		ContingencyType: contingencyType,
+		Reason:          reason,
+	}
+
+	if err = s.repo.Create(ctx, contingencyDoc); err != nil {
+		logs.Error("Failed to store contingency document", map[string]interface{}{
+			"error": err.Error(),
+			"id":    generationCode,
+		})
+		return shared_error.NewGeneralServiceError("ContingencyService", "MoveToContingency", "failed to store contingency document", err)
+	}
+
+	logs.Info("Document moved to contingency", map[string]interface{}{
+		"id":              generationCode,
+		"contingencyType": contingencyType,
+	})
+
+	// 3. Publicar el evento de contingencia
+	s.publishContingency(ctx, branchID, contingencyDoc, "", "")
+
 	return nil
 }
 
-// RetransmitPendingDocuments retransmite documentos pendientes en contingencia
-func (s *ContingencyService) RetransmitPendingDocuments(ctx context.Context) error {
-	pendingDocs, err := s.repo.GetPending(ctx, config.Server.MaxBatchSize)
+// publishContingency publica el evento de un documento almacenado en contingencia
+func (s *ContingencyService) publishContingency(ctx context.Context, branchID uint, doc *dte.ContingencyDocument, controlNumber, dteType string) {
+	if s.events == nil {
+		return
+	}
+
+	contingencyType := doc.ContingencyType
+	s.events.Publish(ctx, branchID, event.DTEContingency, event.DTEPayload{
+		GenerationCode:  doc.DocumentID,
+		ControlNumber:   controlNumber,
+		DTEType:         dteType,
+		Status:          constants.DocumentPending,
+		ContingencyType: &contingencyType,
+		Reason:          doc.Reason,
+	})
+}
+
+// RetransmitPendingDocuments retransmite los documentos pendientes en contingencia de todos los sistemas, excepto los
+// de los NIT indicados, que se retransmiten con su propia programación
+func (s *ContingencyService) RetransmitPendingDocuments(ctx context.Context, batchSize int, excludedNITs []string) error {
+	// 1. Evitar retransmitir en paralelo con otra retransmisión
+	if !s.retransmitting.TryLock() {
+		logs.Warn("Contingency retransmission already in progress, skipping execution")
+		return ErrRetransmissionInProgress
+	}
+	defer s.retransmitting.Unlock()
+
+	// 2. Obtener los documentos pendientes
+	batchSize = s.resolveBatchSize(batchSize)
+	pendingDocs, err := s.repo.GetPending(ctx, batchSize, excludedNITs)
 	if err != nil {
 		return shared_error.NewGeneralServiceError("ContingencyService", "RetransmitPendingDocuments", "failed to get pending documents", err)
 	}
@@ -130,37 +232,433 @@ func (s *ContingencyService) RetransmitPendingDocuments(ctx context.Context) err
 		return nil
 	}
 
-	// Agrupar por sistema y tipo de DTE
-	docsBySystemAndType := s.groupBySystemAndType(pendingDocs)
+	s.retransmit(ctx, pendingDocs, batchSize)
+	return nil
+}
+
+// RetransmitTenantDocuments retransmite los documentos pendientes de un NIT esperando a que termine, se utiliza en
+// los jobs de los usuarios con programación propia
+func (s *ContingencyService) RetransmitTenantDocuments(ctx context.Context, nit string, batchSize int) error {
+	// 1. Evitar retransmitir en paralelo con otra retransmisión
+	if !s.retransmitting.TryLock() {
+		logs.Warn("Contingency retransmission already in progress, skipping execution", map[string]interface{}{"nit": nit})
+		return ErrRetransmissionInProgress
+	}
+	defer s.retransmitting.Unlock()
+
+	// 2. Obtener los documentos pendientes del NIT
+	batchSize = s.resolveBatchSize(batchSize)
+	pendingDocs, err := s.repo.GetPendingByNIT(ctx, nit, batchSize)
+	if err != nil {
+		return shared_error.NewGeneralServiceError("ContingencyService", "RetransmitTenantDocuments", "failed to get pending documents", err)
+	}
+
+	if len(pendingDocs) == 0 {
+		return nil
+	}
+
+	s.retransmit(ctx, pendingDocs, batchSize)
+	return nil
+}
+
+// RetransmitDocumentsByNIT obtiene los documentos pendientes de un NIT e inicia su retransmisión en segundo plano,
+// retornando la cantidad de documentos a retransmitir. Solo se permite una retransmisión a la vez, ya sea manual o
+// de los jobs de contingencia, para no transmitir dos veces el mismo documento.
+func (s *ContingencyService) RetransmitDocumentsByNIT(ctx context.Context, nit string, batchSize int) (*models.RetransmissionResult, error) {
+	// 1. Evitar retransmitir en paralelo con otra retransmisión
+	if !s.retransmitting.TryLock() {
+		return nil, shared_error.NewFormattedGeneralServiceError("ContingencyService", "RetransmitDocumentsByNIT", "ContingencyRetransmissionInProgress")
+	}
+
+	// 2. Obtener los documentos pendientes del NIT
+	batchSize = s.resolveBatchSize(batchSize)
+	pendingDocs, err := s.repo.GetPendingByNIT(ctx, nit, batchSize)
+	if err != nil {
+		s.retransmitting.Unlock()
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ContingencyService", "RetransmitDocumentsByNIT", err, "FailedToGetContingencyDocuments")
+	}
+
+	result := &models.RetransmissionResult{NIT: nit, Documents: len(pendingDocs)}
+	if len(pendingDocs) == 0 {
+		s.retransmitting.Unlock()
+		return result, nil
+	}
+
+	// 3. Retransmitir en segundo plano, desvinculado de la cancelación de la solicitud
+	go func() {
+		defer s.retransmitting.Unlock()
+
+		retransmitCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ManualRetransmissionTimeout)
+		defer cancel()
+
+		logs.Info("Starting manual contingency retransmission", map[string]interface{}{
+			"nit":       nit,
+			"documents": len(pendingDocs),
+		})
+		s.retransmit(retransmitCtx, pendingDocs, batchSize)
+	}()
+
+	return result, nil
+}
+
+// resolveBatchSize obtiene el tamaño de lote a utilizar, el configurado para la transmisión si no se indica uno
+func (s *ContingencyService) resolveBatchSize(batchSize int) int {
+	if batchSize < 1 {
+		return s.config.GetBatchSize()
+	}
+	return batchSize
+}
+
+// retransmit informa a Hacienda los documentos pendientes con un evento de contingencia por sucursal y transmite por
+// lotes solo los documentos cuyo evento fue aceptado. Los documentos de un evento aceptado se transmiten sin volver a
+// informarlos, y los de un evento que quedó pendiente porque no se registró la respuesta de Hacienda se transmiten
+// solo si al reenviar el evento Hacienda lo acepta.
+func (s *ContingencyService) retransmit(ctx context.Context, pendingDocs []dte.ContingencyDocument, batchSize int) {
+	// 1. Separar los documentos según el estado del evento que los informó
+	var reported, awaiting, unreported []dte.ContingencyDocument
+	for _, doc := range pendingDocs {
+		switch {
+		case doc.ContingencyEventID == nil:
+			unreported = append(unreported, doc)
+		case doc.ContingencyEventStatus == models.ContingencyEventAccepted:
+			reported = append(reported, doc)
+		case doc.ContingencyEventStatus == models.ContingencyEventPending:
+			awaiting = append(awaiting, doc)
+		default:
+			unreported = append(unreported, doc)
+		}
+	}
+
+	// 2. Reenviar los eventos pendientes antes de transmitir sus documentos
+	for _, docs := range groupDocuments(awaiting, pendingEventKey) {
+		if s.resendPendingEvent(ctx, *docs[0].ContingencyEventID, docs) {
+			reported = append(reported, docs...)
+		}
+	}
+
+	// 3. Enviar un evento por sucursal con un mismo tipo y motivo de contingencia
+	for _, docs := range groupDocuments(unreported, eventGroupKey) {
+		if s.reportContingency(ctx, docs) {
+			reported = append(reported, docs...)
+		}
+	}
 
-	for systemNIT, typeGroups := range docsBySystemAndType {
-		// Primero enviar el evento de contingencia para todos los documentos del sistema
-		if err := s.contingencyEvents.PrepareAndSendContingencyEvent(ctx, pendingDocs); err != nil {
-			logs.Error("Failed to send contingency event", map[string]interface{}{
+	// 4. Transmitir por lotes los documentos informados de cada sucursal y tipo de DTE
+	for _, docs := range groupDocuments(reported, batchGroupKey) {
+		systemNIT, dteType := docs[0].Branch.User.NIT, docs[0].Document.DTEType
+		if err := s.processSystemDocumentsByType(ctx, systemNIT, dteType, docs, batchSize); err != nil {
+			logs.Error("Failed to process system documents", map[string]interface{}{
 				"error":     err.Error(),
 				"systemNIT": systemNIT,
+				"branchID":  docs[0].BranchID,
+				"dteType":   dteType,
 			})
-			continue
 		}
+	}
+}
 
-		// Luego procesar cada grupo de documentos por tipo
-		for dteType, docs := range typeGroups {
-			if err := s.processSystemDocumentsByType(ctx, systemNIT, dteType, docs); err != nil {
-				logs.Error("Failed to process system documents", map[string]interface{}{
-					"error":     err.Error(),
-					"systemNIT": systemNIT,
-					"dteType":   dteType,
-				})
-				continue
-			}
+// reportContingency envía el evento de contingencia de los documentos de una sucursal y almacena la respuesta de
+// Hacienda, indicando si los documentos pueden transmitirse por lotes. El evento se almacena asociado a sus documentos
+// antes de enviarlo, para que un fallo al registrar la respuesta no provoque que se informen en otro evento.
+func (s *ContingencyService) reportContingency(ctx context.Context, docs []dte.ContingencyDocument) bool {
+	// 1. Preparar y firmar el evento
+	record, err := s.contingencyEvents.PrepareContingencyEvent(ctx, docs)
+	if err != nil {
+		logs.Error("Failed to prepare contingency event", map[string]interface{}{
+			"error":     err.Error(),
+			"branchID":  docs[0].BranchID,
+			"documents": len(docs),
+		})
+		return false
+	}
+
+	// 2. Almacenar el evento pendiente asociado a sus documentos
+	if err = s.repo.SaveEvent(ctx, record); err != nil {
+		logs.Error("Failed to store contingency event", map[string]interface{}{
+			"error":    err.Error(),
+			"eventID":  record.ID,
+			"branchID": record.BranchID,
+		})
+		return false
+	}
+
+	// 3. Enviar el evento y almacenar la respuesta
+	return s.sendEvent(ctx, record, len(docs))
+}
+
+// resendPendingEvent reenvía el evento firmado que quedó pendiente de la respuesta de Hacienda, por un fallo al
+// registrarla o porque el proceso se interrumpió, indicando si sus documentos pueden transmitirse por lotes
+func (s *ContingencyService) resendPendingEvent(ctx context.Context, eventID string, docs []dte.ContingencyDocument) bool {
+	// 1. Obtener el evento almacenado
+	record, err := s.repo.GetEvent(ctx, eventID)
+	if err != nil {
+		logs.Error("Failed to get pending contingency event", map[string]interface{}{
+			"error":     err.Error(),
+			"eventID":   eventID,
+			"branchID":  docs[0].BranchID,
+			"documents": len(docs),
+		})
+		return false
+	}
+
+	// 2. Si otra ejecución ya registró la respuesta, no se reenvía
+	if record.Status != models.ContingencyEventPending {
+		return record.IsAccepted()
+	}
+
+	logs.Info("Resending pending contingency event", map[string]interface{}{
+		"eventID":   record.ID,
+		"branchID":  record.BranchID,
+		"documents": len(record.DocumentIDs),
+	})
+
+	// 3. Reenviar el evento y almacenar la respuesta
+	return s.sendEvent(ctx, record, len(docs))
+}
+
+// sendEvent envía un evento almacenado como pendiente y registra la respuesta de Hacienda, indicando si fue aceptado.
+// Sus documentos solo se transmiten por lotes si la respuesta se registró, de lo contrario el evento sigue pendiente y
+// se reenvía en la siguiente retransmisión.
+func (s *ContingencyService) sendEvent(ctx context.Context, record *models.ContingencyEventRecord, documents int) bool {
+	// 1. Enviar el evento, sin respuesta de Hacienda se considera rechazado para informar los documentos de nuevo
+	if err := s.contingencyEvents.SendContingencyEvent(ctx, record); err != nil {
+		logs.Error("Failed to send contingency event", map[string]interface{}{
+			"error":     err.Error(),
+			"eventID":   record.ID,
+			"branchID":  record.BranchID,
+			"documents": documents,
+		})
+		record.Status = models.ContingencyEventRejected
+		record.Message = err.Error()
+	}
+
+	// 2. Almacenar la respuesta de Hacienda, si falla el evento sigue pendiente y sus documentos asociados a él
+	if err := s.repo.UpdateEvent(ctx, record); err != nil {
+		logs.Error("Failed to store contingency event response", map[string]interface{}{
+			"error":    err.Error(),
+			"eventID":  record.ID,
+			"accepted": record.IsAccepted(),
+		})
+		return false
+	}
+
+	if !record.IsAccepted() {
+		logs.Warn("Contingency event rejected, documents remain pending", map[string]interface{}{
+			"eventID":      record.ID,
+			"branchID":     record.BranchID,
+			"message":      record.Message,
+			"observations": record.Observations,
+		})
+		return false
+	}
+
+	logs.Info("Contingency event accepted", map[string]interface{}{
+		"eventID":   record.ID,
+		"branchID":  record.BranchID,
+		"documents": documents,
+	})
+
+	return true
+}
+
+// ListDocuments obtiene una página de los documentos en contingencia de un usuario, de forma predeterminada solo los
+// pendientes de transmisión, indicando el tiempo restante antes de vencer el plazo de transmisión del MH
+func (s *ContingencyService) ListDocuments(ctx context.Context, userID uint, filters *models.ContingencyFilters) (*models.ContingencyQueuePage, error) {
+	// 1. Normalizar la paginación
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PageSize < 1 {
+		filters.PageSize = DefaultQueuePageSize
+	}
+	filters.PageSize = min(filters.PageSize, MaxQueuePageSize)
+
+	// 2. Consultar los documentos
+	docs, total, err := s.repo.List(ctx, userID, filters)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ContingencyService", "ListDocuments", err, "FailedToGetContingencyDocuments")
+	}
+
+	totalPages := int(total) / filters.PageSize
+	if int(total)%filters.PageSize > 0 {
+		totalPages++
+	}
+
+	queued := make([]models.QueuedDocument, len(docs))
+	for i := range docs {
+		queued[i] = s.toQueuedDocument(&docs[i])
+	}
+
+	return &models.ContingencyQueuePage{
+		Documents: queued,
+		Pagination: models.ContingencyPagination{
+			Page:       filters.Page,
+			PageSize:   filters.PageSize,
+			TotalItems: total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// GetDocument obtiene un documento en contingencia de un usuario con su plazo de transmisión
+func (s *ContingencyService) GetDocument(ctx context.Context, userID uint, id string) (*models.QueuedDocument, error) {
+	doc, err := s.getUserDocument(ctx, userID, id, "GetDocument")
+	if err != nil {
+		return nil, err
+	}
+
+	queued := s.toQueuedDocument(doc)
+	return &queued, nil
+}
+
+// ReclassifyDocument cambia el tipo y el motivo de contingencia de un documento pendiente. Si no se indica el motivo
+// se utiliza el motivo predeterminado del tipo, excepto para el tipo 5 (Otro motivo) en el que es obligatorio.
+func (s *ContingencyService) ReclassifyDocument(ctx context.Context, userID uint, id string, contingencyType int8, reason string) (*models.QueuedDocument, error) {
+	// 1. Validar la nueva clasificación
+	if !constants.IsAllowedContingencyType(int(contingencyType)) {
+		return nil, shared_error.NewFormattedGeneralServiceError("ContingencyService", "ReclassifyDocument", "InvalidContingencyClassification", contingencyType)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" && contingencyType != constants.OtroMotivo {
+		reason = constants.GetContingencyReason(contingencyType)
+	}
+	if len(reason) < MinContingencyReasonLength || len(reason) > MaxContingencyReasonLength {
+		return nil, shared_error.NewFormattedGeneralServiceError("ContingencyService", "ReclassifyDocument", "InvalidContingencyClassificationReason",
+			MinContingencyReasonLength, MaxContingencyReasonLength)
+	}
+
+	// 2. Obtener el documento y verificar que siga pendiente
+	doc, err := s.getPendingDocument(ctx, userID, id, "ReclassifyDocument")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2.1 Un documento asociado a un evento de contingencia conserva la clasificación con la que se informó
+	if doc.ContingencyEventID != nil {
+		return nil, shared_error.NewFormattedGeneralServiceError("ContingencyService", "ReclassifyDocument", "ContingencyDocumentAlreadyReported", id)
+	}
+
+	// 3. Actualizar la clasificación
+	if err = s.repo.UpdateClassification(ctx, doc.ID, contingencyType, reason); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ContingencyService", "ReclassifyDocument", err, "FailedToUpdateContingencyDocument", id)
+	}
+
+	logs.Info("Contingency document reclassified", map[string]interface{}{
+		"id":              doc.ID,
+		"previousType":    doc.ContingencyType,
+		"contingencyType": contingencyType,
+	})
+
+	doc.ContingencyType = contingencyType
+	doc.Reason = reason
+	queued := s.toQueuedDocument(doc)
+	return &queued, nil
+}
+
+// CancelDocument retira un documento pendiente de la cola de contingencia marcándolo como rechazado, de forma que el
+// job de contingencia no vuelva a intentar su transmisión
+func (s *ContingencyService) CancelDocument(ctx context.Context, userID uint, id string, reason string) error {
+	// 1. Obtener el documento y verificar que siga pendiente
+	doc, err := s.getPendingDocument(ctx, userID, id, "CancelDocument")
+	if err != nil {
+		return err
+	}
+
+	// 2. Cancelar el documento registrando el motivo como observación
+	observation := CancelledObservation
+	if reason = strings.TrimSpace(reason); reason != "" {
+		observation = fmt.Sprintf("%s: %s", CancelledObservation, reason)
+	}
+
+	if err = s.repo.Cancel(ctx, doc.ID, observation); err != nil {
+		if errors.Is(err, errPackage.ErrContingencyDocumentNotPending) {
+			return shared_error.NewFormattedGeneralServiceError("ContingencyService", "CancelDocument", "ContingencyDocumentNotPending", id)
 		}
+		return shared_error.NewFormattedGeneralServiceWithError("ContingencyService", "CancelDocument", err, "FailedToUpdateContingencyDocument", id)
+	}
+
+	logs.Info("Contingency document cancelled", map[string]interface{}{
+		"id":         doc.ID,
+		"documentID": doc.DocumentID,
+	})
+
+	// 3. Publicar el rechazo del documento
+	if s.events != nil {
+		s.events.Publish(ctx, doc.BranchID, event.DTERejected, event.DTEPayload{
+			GenerationCode: doc.DocumentID,
+			ControlNumber:  doc.Document.ControlNumber,
+			DTEType:        doc.Document.DTEType,
+			Status:         constants.DocumentRejected,
+			Reason:         observation,
+		})
 	}
 
 	return nil
 }
 
+// getUserDocument obtiene un documento en contingencia de las sucursales del usuario
+func (s *ContingencyService) getUserDocument(ctx context.Context, userID uint, id, operation string) (*dte.ContingencyDocument, error) {
+	doc, err := s.repo.GetByID(ctx, userID, id)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrContingencyDocumentNotFound) {
+			return nil, shared_error.NewFormattedGeneralServiceError("ContingencyService", operation, "ContingencyDocumentNotFound", id)
+		}
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ContingencyService", operation, err, "FailedToGetContingencyDocuments")
+	}
+
+	return doc, nil
+}
+
+// getPendingDocument obtiene un documento en contingencia del usuario que siga pendiente de transmisión
+func (s *ContingencyService) getPendingDocument(ctx context.Context, userID uint, id, operation string) (*dte.ContingencyDocument, error) {
+	doc, err := s.getUserDocument(ctx, userID, id, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	if doc.Document.Status != constants.DocumentPending {
+		return nil, shared_error.NewFormattedGeneralServiceError("ContingencyService", operation, "ContingencyDocumentNotPending", id)
+	}
+
+	return doc, nil
+}
+
+// toQueuedDocument calcula el plazo de transmisión de un documento en contingencia. El tiempo restante y el
+// vencimiento solo aplican a los documentos que siguen pendientes.
+func (s *ContingencyService) toQueuedDocument(doc *dte.ContingencyDocument) models.QueuedDocument {
+	deadline := doc.CreatedAt.Add(constants.ContingencyTransmissionDeadline)
+	queued := models.QueuedDocument{
+		ID:                 doc.ID,
+		BranchID:           doc.BranchID,
+		GenerationCode:     doc.DocumentID,
+		ContingencyType:    doc.ContingencyType,
+		Reason:             doc.Reason,
+		ContingencyEventID: doc.ContingencyEventID,
+		BatchID:            doc.BatchID,
+		MHBatchID:          doc.MHBatchID,
+		Observations:       doc.Observations,
+		CreatedAt:          doc.CreatedAt,
+		Deadline:           deadline,
+	}
+
+	if doc.Document != nil {
+		queued.ControlNumber = doc.Document.ControlNumber
+		queued.DTEType = doc.Document.DTEType
+		queued.Status = doc.Document.Status
+	}
+
+	if queued.Status == constants.DocumentPending {
+		remaining := deadline.Sub(s.timeProvider.Now())
+		queued.RemainingSeconds = max(int64(remaining.Seconds()), 0)
+		queued.Overdue = remaining <= 0
+	}
+
+	return queued
+}
+
 // processSystemDocumentsByType procesa documentos de un tipo específico para un sistema
-func (s *ContingencyService) processSystemDocumentsByType(ctx context.Context, systemNIT string, dteType string, docs []dte.ContingencyDocument) error {
+func (s *ContingencyService) processSystemDocumentsByType(ctx context.Context, systemNIT string, dteType string, docs []dte.ContingencyDocument, batchSize int) error {
 	if len(docs) == 0 {
 		logs.Warn("No documents to process")
 		return nil
@@ -183,8 +681,8 @@ func (s *ContingencyService) processSystemDocumentsByType(ctx context.Context, s
 	}
 
 	// 3. Procesar documentos en lotes de máximo 100
-	for i := 0; i < len(docs); i += s.config.GetBatchSize() {
-		end := i + s.config.GetBatchSize()
+	for i := 0; i < len(docs); i += batchSize {
+		end := i + batchSize
 		if end > len(docs) {
 			end = len(docs)
 		}
@@ -245,16 +743,37 @@ func (s *ContingencyService) processSystemDocumentsByType(ctx context.Context, s
 	return nil
 }
 
-// groupBySystemAndType agrupa documentos por sistema y tipo
-func (s *ContingencyService) groupBySystemAndType(docs []dte.ContingencyDocument) map[string]map[string][]dte.ContingencyDocument {
-	result := make(map[string]map[string][]dte.ContingencyDocument)
-	for _, doc := range docs {
-		if result[doc.Branch.User.NIT] == nil {
-			result[doc.Branch.User.NIT] = make(map[string][]dte.ContingencyDocument)
+// groupDocuments agrupa los documentos según la llave indicada conservando el orden en que aparece cada grupo
+func groupDocuments(docs []dte.ContingencyDocument, key func(doc *dte.ContingencyDocument) string) [][]dte.ContingencyDocument {
+	var groups [][]dte.ContingencyDocument
+	positions := make(map[string]int)
+	for i := range docs {
+		k := key(&docs[i])
+		position, ok := positions[k]
+		if !ok {
+			position = len(groups)
+			positions[k] = position
+			groups = append(groups, nil)
 		}
-		result[doc.Branch.User.NIT][doc.Document.DTEType] = append(result[doc.Branch.User.NIT][doc.Document.DTEType], doc)
+		groups[position] = append(groups[position], docs[i])
 	}
-	return result
+	return groups
+}
+
+// eventGroupKey agrupa los documentos que se informan en un mismo evento de contingencia: los de una sucursal con el
+// mismo tipo y motivo de contingencia
+func eventGroupKey(doc *dte.ContingencyDocument) string {
+	return fmt.Sprintf("%d|%d|%s", doc.BranchID, doc.ContingencyType, doc.Reason)
+}
+
+// pendingEventKey agrupa los documentos asociados a un mismo evento pendiente de la respuesta de Hacienda
+func pendingEventKey(doc *dte.ContingencyDocument) string {
+	return *doc.ContingencyEventID
+}
+
+// batchGroupKey agrupa los documentos que se transmiten en los mismos lotes: los de una sucursal con el mismo tipo de DTE
+func batchGroupKey(doc *dte.ContingencyDocument) string {
+	return fmt.Sprintf("%d|%s", doc.BranchID, doc.Document.DTEType)
 }
 
 // generateMatchingToken genera un token para el cliente
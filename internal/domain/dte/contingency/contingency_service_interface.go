@@ -1,11 +1,29 @@
 package contingency
 
-import "context"
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+)
 
 // ContingencyManager interfaz para manejo de documentos en contingencia
 type ContingencyManager interface {
 	// StoreDocumentInContingency almacena un documento en contingencia
 	StoreDocumentInContingency(ctx context.Context, document interface{}, dteType string, contingencyType int8, reason string) error
-	// RetransmitPendingDocuments retransmite los documentos pendientes
-	RetransmitPendingDocuments(ctx context.Context) error
+	// MoveToContingency envía a contingencia un documento ya almacenado cuya transmisión falló
+	MoveToContingency(ctx context.Context, branchID uint, generationCode string, contingencyType int8, reason string) error
+	// RetransmitPendingDocuments retransmite los documentos pendientes, excepto los de los NIT indicados
+	RetransmitPendingDocuments(ctx context.Context, batchSize int, excludedNITs []string) error
+	// RetransmitTenantDocuments retransmite los documentos pendientes de un NIT esperando a que termine
+	RetransmitTenantDocuments(ctx context.Context, nit string, batchSize int) error
+	// RetransmitDocumentsByNIT inicia en segundo plano la retransmisión de los documentos pendientes de un NIT
+	RetransmitDocumentsByNIT(ctx context.Context, nit string, batchSize int) (*models.RetransmissionResult, error)
+	// ListDocuments obtiene una página de los documentos en contingencia de un usuario con su plazo de transmisión
+	ListDocuments(ctx context.Context, userID uint, filters *models.ContingencyFilters) (*models.ContingencyQueuePage, error)
+	// GetDocument obtiene un documento en contingencia de un usuario con su plazo de transmisión
+	GetDocument(ctx context.Context, userID uint, id string) (*models.QueuedDocument, error)
+	// ReclassifyDocument cambia el tipo y el motivo de contingencia de un documento pendiente
+	ReclassifyDocument(ctx context.Context, userID uint, id string, contingencyType int8, reason string) (*models.QueuedDocument, error)
+	// CancelDocument retira un documento pendiente de la cola de contingencia marcándolo como rechazado
+	CancelDocument(ctx context.Context, userID uint, id string, reason string) error
 }
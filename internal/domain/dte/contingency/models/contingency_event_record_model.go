@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+const (
+	// ContingencyEventPending indica que el evento se almacenó antes de enviarlo y aún no se registra la respuesta de
+	// Hacienda
+	ContingencyEventPending = "PENDING"
+	// ContingencyEventAccepted indica que Hacienda recibió el evento de contingencia
+	ContingencyEventAccepted = "ACCEPTED"
+	// ContingencyEventRejected indica que Hacienda rechazó el evento de contingencia
+	ContingencyEventRejected = "REJECTED"
+)
+
+// ContingencyEventRecord contiene un evento de contingencia enviado a Hacienda y su respuesta. Cada evento informa los
+// documentos de una sola sucursal con un mismo tipo y motivo de contingencia, y sus documentos solo se transmiten por
+// lotes una vez que el evento es aceptado.
+type ContingencyEventRecord struct {
+	ID              string    `json:"id"`
+	BranchID        uint      `json:"branch_id"`
+	NIT             string    `json:"nit"`
+	ContingencyType int8      `json:"contingency_type"`
+	Reason          string    `json:"reason"`
+	DocumentIDs     []string  `json:"document_ids"`
+	SignedDocument  string    `json:"-"`
+	Status          string    `json:"status"`
+	ReceptionStamp  *string   `json:"reception_stamp,omitempty"`
+	Message         string    `json:"message,omitempty"`
+	Observations    []string  `json:"observations,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// IsAccepted indica si Hacienda recibió el evento
+func (e *ContingencyEventRecord) IsAccepted() bool {
+	return e.Status == ContingencyEventAccepted
+}
+
+// ContingencyEventResponse representa la respuesta de Hacienda a un evento de contingencia
+type ContingencyEventResponse struct {
+	Status         string   `json:"estado"`
+	DateTime       string   `json:"fechaHora"`
+	Message        string   `json:"mensaje"`
+	ReceptionStamp *string  `json:"selloRecibido"`
+	Observations   []string `json:"observaciones"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+// ContingencyFilters contiene los filtros para consultar los documentos en contingencia de un usuario
+type ContingencyFilters struct {
+	BranchID *uint
+	Status   string
+
+	// Paginación
+	Page     int
+	PageSize int
+}
+
+// QueuedDocument representa un documento en contingencia junto al plazo que tiene para transmitirse al MH
+type QueuedDocument struct {
+	ID                 string    `json:"id"`
+	BranchID           uint      `json:"branch_id"`
+	GenerationCode     string    `json:"generation_code"`
+	ControlNumber      string    `json:"control_number"`
+	DTEType            string    `json:"dte_type"`
+	Status             string    `json:"status"`
+	ContingencyType    int8      `json:"contingency_type"`
+	Reason             string    `json:"reason"`
+	ContingencyEventID *string   `json:"contingency_event_id,omitempty"`
+	BatchID            *string   `json:"batch_id,omitempty"`
+	MHBatchID          *string   `json:"mh_batch_id,omitempty"`
+	Observations       *string   `json:"observations,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+	Deadline           time.Time `json:"deadline"`
+	RemainingSeconds   int64     `json:"remaining_seconds"`
+	Overdue            bool      `json:"overdue"`
+}
+
+// ContingencyQueuePage contiene una página de documentos en contingencia y el total que cumplen los filtros
+type ContingencyQueuePage struct {
+	Documents  []QueuedDocument      `json:"documents"`
+	Pagination ContingencyPagination `json:"pagination"`
+}
+
+// ContingencyPagination contiene la información de paginación de una consulta de documentos en contingencia
+type ContingencyPagination struct {
+	Page       int   `json:"page"`
+	PageSize   int   `json:"page_size"`
+	TotalItems int64 `json:"total_items"`
+	TotalPages int   `json:"total_pages"`
+}
+
+// RetransmissionResult contiene el resultado de solicitar la retransmisión manual de los documentos de un NIT
+type RetransmissionResult struct {
+	NIT       string `json:"nit"`
+	Documents int    `json:"documents"`
+}
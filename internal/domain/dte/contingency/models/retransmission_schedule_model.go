@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RetransmissionSchedule contiene la programación del job de retransmisión de documentos en contingencia. La
+// expresión cron y las ventanas horarias se evalúan en la zona horaria de la aplicación.
+type RetransmissionSchedule struct {
+	UserID              uint       `json:"-"`
+	NIT                 string     `json:"nit,omitempty"`
+	Cron                string     `json:"cron"`
+	Windows             string     `json:"windows"`
+	BatchSize           int        `json:"batch_size"`
+	MaxExecutionMinutes int        `json:"max_execution_minutes"`
+	IsDefault           bool       `json:"is_default"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+// MaxExecutionTime obtiene el tiempo máximo de cada ejecución de la retransmisión
+func (s *RetransmissionSchedule) MaxExecutionTime() time.Duration {
+	return time.Duration(s.MaxExecutionMinutes) * time.Minute
+}
+
+// RetransmissionScheduleInput contiene los cambios de la programación de un usuario, los campos nulos conservan el
+// valor actual
+type RetransmissionScheduleInput struct {
+	Cron                *string
+	Windows             *string
+	BatchSize           *int
+	MaxExecutionMinutes *int
+}
+
+// TimeWindow representa una ventana horaria diaria, expresada como minutos desde la medianoche. Si el fin es anterior
+// al inicio la ventana cruza la medianoche, y si son iguales abarca el día completo.
+type TimeWindow struct {
+	Start int
+	End   int
+}
+
+// Contains indica si la hora del día del tiempo indicado se encuentra dentro de la ventana
+func (w TimeWindow) Contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+
+	switch {
+	case w.Start == w.End:
+		return true
+	case w.Start < w.End:
+		return minute >= w.Start && minute < w.End
+	default:
+		return minute >= w.Start || minute < w.End
+	}
+}
+
+// String obtiene la ventana con el formato HH:MM-HH:MM
+func (w TimeWindow) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", w.Start/60, w.Start%60, w.End/60, w.End%60)
+}
+
+// ParseTimeWindows convierte una lista de ventanas HH:MM-HH:MM separadas por comas
+func ParseTimeWindows(value string) ([]TimeWindow, error) {
+	var windows []TimeWindow
+	for _, raw := range strings.Split(value, ",") {
+		bounds := strings.Split(strings.TrimSpace(raw), "-")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid time window %q", raw)
+		}
+
+		start, err := parseClock(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClock(bounds[1])
+		if err != nil {
+			return nil, err
+		}
+
+		windows = append(windows, TimeWindow{Start: start, End: end})
+	}
+
+	return windows, nil
+}
+
+// InWindows indica si el tiempo indicado se encuentra dentro de alguna de las ventanas, sin ventanas no hay restricción
+func InWindows(windows []TimeWindow, t time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+
+	for _, window := range windows {
+		if window.Contains(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseClock convierte una hora HH:MM en minutos desde la medianoche
+func parseClock(value string) (int, error) {
+	clock, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil || len(strings.TrimSpace(value)) != 5 {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+
+	return clock.Hour()*60 + clock.Minute(), nil
+}
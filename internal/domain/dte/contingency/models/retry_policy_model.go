@@ -1,10 +1,51 @@
 package models
 
-import "time"
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
 
+// RetryPolicy define los reintentos de una operación con espera exponencial
 type RetryPolicy struct {
 	MaxAttempts     int
 	InitialInterval time.Duration
 	MaxInterval     time.Duration
 	BackoffFactor   float64
+	// Jitter es la variación aleatoria de cada espera, como fracción de la espera (0.2 = ±20%)
+	Jitter float64
+}
+
+// Backoff calcula la espera antes del reintento indicado, contando desde 1. La espera crece desde InitialInterval
+// multiplicándose por BackoffFactor hasta MaxInterval, y varía aleatoriamente según Jitter para que las réplicas no
+// reintenten al mismo tiempo.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	if retry < 1 {
+		retry = 1
+	}
+
+	backoff := float64(p.InitialInterval) * math.Pow(math.Max(p.BackoffFactor, 1), float64(retry-1))
+	if p.MaxInterval > 0 && backoff > float64(p.MaxInterval) {
+		backoff = float64(p.MaxInterval)
+	}
+
+	if p.Jitter > 0 {
+		backoff += backoff * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(backoff)
+}
+
+// Wait espera el tiempo de Backoff del reintento indicado, retorna el error del contexto si se cancela antes
+func (p RetryPolicy) Wait(ctx context.Context, retry int) error {
+	timer := time.NewTimer(p.Backoff(retry))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
@@ -0,0 +1,19 @@
+package contingency
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+)
+
+// RetransmissionScheduleRepositoryPort interfaz para el repositorio de programaciones de retransmisión por usuario
+type RetransmissionScheduleRepositoryPort interface {
+	// Get obtiene la programación de un usuario, retorna ErrRetransmissionScheduleNotFound si no tiene una propia
+	Get(ctx context.Context, userID uint) (*models.RetransmissionSchedule, error)
+	// List obtiene las programaciones de todos los usuarios que tienen una propia
+	List(ctx context.Context) ([]models.RetransmissionSchedule, error)
+	// Save crea o reemplaza la programación de un usuario
+	Save(ctx context.Context, schedule *models.RetransmissionSchedule) error
+	// Delete elimina la programación de un usuario para que vuelva a utilizar la predeterminada
+	Delete(ctx context.Context, userID uint) error
+}
@@ -0,0 +1,163 @@
+package contingency
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// MaxRetransmissionExecutionMinutes es el tiempo máximo que puede configurarse para una ejecución de la retransmisión
+const MaxRetransmissionExecutionMinutes = 120
+
+type RetransmissionScheduleService struct {
+	repo         RetransmissionScheduleRepositoryPort
+	defaults     models.RetransmissionSchedule
+	maxBatchSize int
+	scheduler    RetransmissionScheduler
+}
+
+// NewRetransmissionScheduleService crea el servicio de programación con la programación predeterminada del entorno y
+// el tamaño máximo de lote que acepta Hacienda
+func NewRetransmissionScheduleService(repo RetransmissionScheduleRepositoryPort, defaults models.RetransmissionSchedule, maxBatchSize int) RetransmissionScheduleManager {
+	defaults.IsDefault = true
+	return &RetransmissionScheduleService{
+		repo:         repo,
+		defaults:     defaults,
+		maxBatchSize: maxBatchSize,
+	}
+}
+
+// AttachScheduler configura el programador en el que se aplican los cambios de programación
+func (s *RetransmissionScheduleService) AttachScheduler(scheduler RetransmissionScheduler) {
+	s.scheduler = scheduler
+}
+
+// Default obtiene una copia de la programación predeterminada
+func (s *RetransmissionScheduleService) Default() *models.RetransmissionSchedule {
+	schedule := s.defaults
+	return &schedule
+}
+
+// Get obtiene la programación propia del usuario o, si no tiene una, la predeterminada
+func (s *RetransmissionScheduleService) Get(ctx context.Context, userID uint, nit string) (*models.RetransmissionSchedule, error) {
+	schedule, err := s.repo.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrRetransmissionScheduleNotFound) {
+			schedule = s.Default()
+			schedule.UserID, schedule.NIT = userID, nit
+			return schedule, nil
+		}
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RetransmissionScheduleService", "Get", err, "FailedToGetRetransmissionSchedule")
+	}
+
+	return schedule, nil
+}
+
+// ListCustom obtiene las programaciones propias de los usuarios
+func (s *RetransmissionScheduleService) ListCustom(ctx context.Context) ([]models.RetransmissionSchedule, error) {
+	schedules, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RetransmissionScheduleService", "ListCustom", err, "FailedToGetRetransmissionSchedule")
+	}
+
+	return schedules, nil
+}
+
+// Save combina los cambios con la programación vigente del usuario, la valida, la guarda como programación propia y
+// reprograma su job de retransmisión
+func (s *RetransmissionScheduleService) Save(ctx context.Context, userID uint, nit string, input *models.RetransmissionScheduleInput) (*models.RetransmissionSchedule, error) {
+	// 1. Obtener la programación vigente
+	schedule, err := s.Get(ctx, userID, nit)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Aplicar los cambios
+	if input.Cron != nil {
+		schedule.Cron = strings.TrimSpace(*input.Cron)
+	}
+	if input.Windows != nil {
+		schedule.Windows = strings.ReplaceAll(*input.Windows, " ", "")
+	}
+	if input.BatchSize != nil {
+		schedule.BatchSize = *input.BatchSize
+	}
+	if input.MaxExecutionMinutes != nil {
+		schedule.MaxExecutionMinutes = *input.MaxExecutionMinutes
+	}
+
+	// 3. Validar la programación resultante
+	if err = s.validate(schedule); err != nil {
+		return nil, err
+	}
+
+	// 4. Guardar la programación propia del usuario
+	now := utils.TimeNow()
+	schedule.IsDefault = false
+	schedule.UpdatedAt = &now
+	if err = s.repo.Save(ctx, schedule); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RetransmissionScheduleService", "Save", err, "FailedToSaveRetransmissionSchedule")
+	}
+
+	// 5. Reprogramar el job del usuario
+	if s.scheduler != nil {
+		if err = s.scheduler.Apply(schedule); err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("RetransmissionScheduleService", "Save", err, "FailedToSaveRetransmissionSchedule")
+		}
+	}
+
+	logs.Info("Retransmission schedule updated", map[string]interface{}{
+		"nit":     nit,
+		"cron":    schedule.Cron,
+		"windows": schedule.Windows,
+	})
+
+	return schedule, nil
+}
+
+// Reset elimina la programación propia del usuario, sus documentos vuelven a retransmitirse con la predeterminada
+func (s *RetransmissionScheduleService) Reset(ctx context.Context, userID uint, nit string) (*models.RetransmissionSchedule, error) {
+	if err := s.repo.Delete(ctx, userID); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RetransmissionScheduleService", "Reset", err, "FailedToSaveRetransmissionSchedule")
+	}
+
+	if s.scheduler != nil {
+		s.scheduler.Remove(nit)
+	}
+
+	schedule := s.Default()
+	schedule.UserID, schedule.NIT = userID, nit
+	return schedule, nil
+}
+
+// validate verifica la expresión cron, las ventanas horarias, el tamaño de lote y el tiempo máximo de ejecución
+func (s *RetransmissionScheduleService) validate(schedule *models.RetransmissionSchedule) error {
+	if schedule.Cron == "" {
+		return shared_error.NewFormattedGeneralServiceError("RetransmissionScheduleService", "Save", "InvalidRetransmissionCron", schedule.Cron)
+	}
+	if s.scheduler != nil {
+		if err := s.scheduler.ValidateCron(schedule.Cron); err != nil {
+			return shared_error.NewFormattedGeneralServiceError("RetransmissionScheduleService", "Save", "InvalidRetransmissionCron", schedule.Cron)
+		}
+	}
+
+	if _, err := models.ParseTimeWindows(schedule.Windows); err != nil {
+		return shared_error.NewFormattedGeneralServiceError("RetransmissionScheduleService", "Save", "InvalidRetransmissionWindows", schedule.Windows)
+	}
+
+	if schedule.BatchSize < 1 || schedule.BatchSize > s.maxBatchSize {
+		return shared_error.NewFormattedGeneralServiceError("RetransmissionScheduleService", "Save", "InvalidRetransmissionBatchSize", s.maxBatchSize)
+	}
+
+	if schedule.MaxExecutionMinutes < 1 || schedule.MaxExecutionMinutes > MaxRetransmissionExecutionMinutes {
+		return shared_error.NewFormattedGeneralServiceError("RetransmissionScheduleService", "Save", "InvalidRetransmissionMaxExecution", MaxRetransmissionExecutionMinutes)
+	}
+
+	return nil
+}
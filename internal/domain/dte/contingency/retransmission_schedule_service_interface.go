@@ -0,0 +1,23 @@
+package contingency
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+)
+
+// RetransmissionScheduleManager interfaz para la gestión de la programación de la retransmisión de contingencia
+type RetransmissionScheduleManager interface {
+	// Default obtiene la programación predeterminada configurada en el entorno
+	Default() *models.RetransmissionSchedule
+	// Get obtiene la programación vigente de un usuario, la propia o la predeterminada
+	Get(ctx context.Context, userID uint, nit string) (*models.RetransmissionSchedule, error)
+	// ListCustom obtiene las programaciones propias de los usuarios
+	ListCustom(ctx context.Context) ([]models.RetransmissionSchedule, error)
+	// Save valida y guarda la programación propia de un usuario y la aplica en el programador
+	Save(ctx context.Context, userID uint, nit string, input *models.RetransmissionScheduleInput) (*models.RetransmissionSchedule, error)
+	// Reset elimina la programación propia de un usuario y retorna la predeterminada
+	Reset(ctx context.Context, userID uint, nit string) (*models.RetransmissionSchedule, error)
+	// AttachScheduler configura el programador en el que se aplican los cambios de programación
+	AttachScheduler(scheduler RetransmissionScheduler)
+}
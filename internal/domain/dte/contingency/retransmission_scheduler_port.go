@@ -0,0 +1,23 @@
+package contingency
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+
+// RetransmissionJobName es el nombre del job de retransmisión predeterminado, los jobs propios de cada usuario agregan
+// su NIT como sufijo
+const RetransmissionJobName = "contingency_retransmission"
+
+// RetransmissionScheduler interfaz para el programador de los jobs de retransmisión, permite aplicar los cambios de
+// programación de un usuario sin reiniciar la aplicación
+type RetransmissionScheduler interface {
+	// ValidateCron verifica que la expresión cron pueda programarse
+	ValidateCron(expression string) error
+	// Apply programa o reprograma el job de retransmisión propio de un NIT
+	Apply(schedule *models.RetransmissionSchedule) error
+	// Remove elimina el job propio de un NIT, sus documentos vuelven a retransmitirse con la programación predeterminada
+	Remove(nit string)
+}
+
+// TenantJobName obtiene el nombre del job de retransmisión propio de un NIT
+func TenantJobName(nit string) string {
+	return RetransmissionJobName + ":" + nit
+}
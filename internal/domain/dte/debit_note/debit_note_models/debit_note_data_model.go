@@ -0,0 +1,9 @@
+package debit_note_models
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+
+type DebitNoteInput struct {
+	*models.InputDataCommon
+	Items        []DebitNoteItem
+	DebitSummary *DebitNoteSummary
+}
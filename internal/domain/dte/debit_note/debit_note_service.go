@@ -0,0 +1,229 @@
+package debit_note
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	buisnessValidator "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/temporal"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/debit_note/debit_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/debit_note/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type debitNoteService struct {
+	validator        *validator.DebitNoteRulesValidator
+	seqNumberManager dte_documents.SequentialNumberManager
+	dteManager       dte_documents.DTEManager
+}
+
+// NewDebitNoteService Crea un nuevo servicio de Nota de Débito.
+func NewDebitNoteService(seqNumberManager dte_documents.SequentialNumberManager, dteManager dte_documents.DTEManager) ports.DTEService {
+	return &debitNoteService{
+		validator:        validator.NewDebitNoteRulesValidator(nil),
+		seqNumberManager: seqNumberManager,
+		dteManager:       dteManager,
+	}
+}
+
+// Create Crea una nueva Nota de Débito electrónica con base en los datos proporcionados.
+func (s *debitNoteService) Create(ctx context.Context, input interface{}, branchID uint) (interface{}, error) {
+	data := input.(*debit_note_models.DebitNoteInput)
+	// 1. Validar la existencia de documentos relacionados
+	if err := s.validateRelatedDocs(ctx, data, branchID); err != nil {
+		logs.Error("Failed to validate related documents", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 2. Crear el documento base
+	baseDoc := createBaseDocument(data)
+	debitNote := &debit_note_models.DebitNoteModel{
+		DTEDocument:  baseDoc,
+		DebitItems:   data.Items,
+		DebitSummary: *data.DebitSummary,
+	}
+
+	// 3. Validar el documento base
+	if err := s.validate(debitNote); err != nil {
+		logs.Error("Failed to validate debit note document basic validation", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 4. Validar contra reglas principales de negocio
+	if err := buisnessValidator.ValidateDTEDocument(debitNote); err != nil {
+		logs.Error("Failed to validate debit note document generic validations", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 5. Generar el número de control y el código UUID
+	if err := s.generateCodeAndIdentifiers(ctx, debitNote, branchID); err != nil {
+		return nil, err
+	}
+
+	return debitNote, nil
+}
+
+// validateRelatedDocs verifica que los documentos relacionados existan en la base de datos
+func (s *debitNoteService) validateRelatedDocs(ctx context.Context, data *debit_note_models.DebitNoteInput, branchID uint) error {
+	if data.RelatedDocs == nil || len(data.RelatedDocs) == 0 {
+		return shared_error.NewFormattedGeneralServiceError(
+			"DebitNoteService",
+			"validateRelatedDocs",
+			"NoRelatedDocs",
+		)
+	}
+
+	// Verificar que cada documento relacionado exista en la base de datos
+	for i, relatedDoc := range data.RelatedDocs {
+		// 1. Verificar si el documento existe y obtenerlo
+		doc, err := s.dteManager.GetByGenerationCode(ctx, branchID, relatedDoc.GetDocumentNumber())
+		if err != nil {
+			return err
+		}
+
+		status, err := s.dteManager.VerifyStatus(ctx, branchID, relatedDoc.GetDocumentNumber())
+		if err != nil {
+			return err
+		}
+
+		if status != constants.DocumentReceived {
+			return shared_error.NewFormattedGeneralServiceError(
+				"DebitNoteService",
+				"validateRelatedDocs",
+				"DocumentNotReceived",
+				relatedDoc.GetDocumentNumber(),
+				status,
+			)
+		}
+
+		// 2. Extraer el NIT del receptor del documento relacionado
+		data.RelatedDocs[i].EmissionDate = *temporal.NewValidatedEmissionDate(doc.CreatedAt)
+		extractor, err := utils.ExtractDTEReceiverFromString(doc.Details.JSONData)
+		if err != nil {
+			return err
+		}
+
+		// 3. Verificar que el NIT del receptor del documento relacionado coincida con el NIT del receptor de la Nota de Débito
+		if data.Receiver.NIT.GetValue() != extractor.Receiver.NIT {
+			return shared_error.NewFormattedGeneralServiceError(
+				"DebitNoteService",
+				"validateRelatedDocs",
+				"NotMatchingReceiverNITDebitNote",
+			)
+		}
+	}
+
+	return nil
+}
+
+// Validate Valida una Nota de Débito electrónica con base en las reglas de negocio.
+func (s *debitNoteService) validate(debitNote *debit_note_models.DebitNoteModel) error {
+	s.validator = validator.NewDebitNoteRulesValidator(debitNote)
+	err := s.validator.Validate()
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"DebitNoteService",
+			"Validate",
+			err,
+			"ValidationFailed",
+		)
+	}
+	return nil
+}
+
+// generateControlNumber Genera un número de control único para la Nota de Débito.
+func (s *debitNoteService) generateControlNumber(ctx context.Context, debitNote *debit_note_models.DebitNoteModel, branchID uint) error {
+	establishmentCode := debitNote.Issuer.GetEstablishmentCode()
+	posCode := debitNote.Issuer.GetPOSCode()
+
+	controlNumber, err := s.seqNumberManager.GetNextControlNumber(
+		ctx,
+		constants.NotaDebitoElectronica,
+		branchID,
+		posCode,
+		establishmentCode,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = debitNote.Identification.SetControlNumber(controlNumber)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"DebitNoteService",
+			"GenerateControlNumber",
+			err,
+			"FailedToSetControlNumber",
+		)
+	}
+	return nil
+}
+
+// generateCodeAndIdentifiers Genera el código UUID y número de control de la Nota de Débito.
+func (s *debitNoteService) generateCodeAndIdentifiers(ctx context.Context, debitNote *debit_note_models.DebitNoteModel, branchID uint) error {
+	err := debitNote.Identification.GenerateCode()
+	if err != nil {
+		return err
+	}
+
+	return s.generateControlNumber(ctx, debitNote, branchID)
+}
+
+// createBaseDocument Crea un documento base para la Nota de Débito electrónica.
+func createBaseDocument(data *debit_note_models.DebitNoteInput) *models.DTEDocument {
+	var extInterface interfaces.Extension
+	var thirdPartySale interfaces.ThirdPartySale
+	var appendixes []interfaces.Appendix
+	var otherDocuments []interfaces.OtherDocuments
+	var relatedDocuments []interfaces.RelatedDocument
+
+	baseItems := make([]interfaces.Item, len(data.Items))
+	for i, item := range data.Items {
+		baseItems[i] = &item
+	}
+
+	if data.Appendixes != nil {
+		for _, appendix := range data.Appendixes {
+			appendixes = append(appendixes, &appendix)
+		}
+	}
+
+	if data.Extension != nil {
+		extInterface = data.Extension
+	}
+
+	if data.RelatedDocs != nil {
+		for _, relatedDoc := range data.RelatedDocs {
+			relatedDocuments = append(relatedDocuments, &relatedDoc)
+		}
+	}
+
+	if data.OtherDocs != nil {
+		for _, otherDoc := range data.OtherDocs {
+			otherDocuments = append(otherDocuments, &otherDoc)
+		}
+	}
+
+	if data.ThirdPartySale != nil {
+		thirdPartySale = data.ThirdPartySale
+	}
+
+	return &models.DTEDocument{
+		Identification:   data.Identification,
+		Issuer:           data.Issuer,
+		Receiver:         data.Receiver,
+		Items:            baseItems,
+		RelatedDocuments: relatedDocuments,
+		OtherDocuments:   otherDocuments,
+		Summary:          data.DebitSummary.Summary,
+		ThirdPartySale:   thirdPartySale,
+		Extension:        extInterface,
+		Appendix:         appendixes,
+	}
+}
@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/debit_note/debit_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/debit_note/validator/strategy"
+)
+
+type DebitNoteRulesValidator struct {
+	document   *debit_note_models.DebitNoteModel
+	strategies []interfaces.DTEValidationStrategy
+}
+
+func NewDebitNoteRulesValidator(doc *debit_note_models.DebitNoteModel) *DebitNoteRulesValidator {
+	validator := &DebitNoteRulesValidator{
+		document: doc,
+		strategies: []interfaces.DTEValidationStrategy{
+			&strategy.DebitNoteItemStrategy{Document: doc},       // Validaciones de ítems
+			&strategy.DebitNoteTaxStrategy{Document: doc},        // Validaciones de impuestos
+			&strategy.DebitNoteRelatedDocStrategy{Document: doc}, // Validaciones de documentos relacionados
+		},
+	}
+	return validator
+}
+
+// Validate Ejecuta las validaciones de la nota de crédito electrónica.
+func (v *DebitNoteRulesValidator) Validate() *dte_errors.DTEError {
+	var validationErrors []*dte_errors.DTEError
+
+	for _, strategyValidator := range v.strategies {
+		if err := strategyValidator.Validate(); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return dte_errors.NewDTEErrorComposite(validationErrors)
+	}
+
+	return nil
+}
@@ -0,0 +1,366 @@
+package strategy
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/debit_note/debit_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type DebitNoteTaxStrategy struct {
+	Document *debit_note_models.DebitNoteModel
+}
+
+// Validate - Valida los campos específicos de una Nota de Débito
+func (s *DebitNoteTaxStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil {
+		return nil
+	}
+
+	// 1. Validar totales base
+	if err := s.validateBaseTotals(); err != nil {
+		logs.Error("Error validating base totals")
+		return err
+	}
+
+	// 2. Validar IVA
+	if err := s.validateIVA(); err != nil {
+		logs.Error("Error validating IVA")
+		return err
+	}
+
+	// 3. Validar percepción
+	if err := s.validatePerception(); err != nil {
+		logs.Error("Error validating perception")
+		return err
+	}
+
+	// 4. Validar montos monetarios
+	if err := s.validateMonetaryAmounts(); err != nil {
+		logs.Error("Error validating monetary amounts")
+		return err
+	}
+
+	// 5. Validar montos totales
+	if err := s.validateTotalAmounts(); err != nil {
+		logs.Error("Error validating total amounts")
+		return err
+	}
+	return nil
+}
+
+func (s *DebitNoteTaxStrategy) validateBaseTotals() *dte_errors.DTEError {
+	// 1. Calcular totales desde items
+	var totalTaxed, totalNonSubject, totalExempt decimal.Decimal
+
+	for _, item := range s.Document.DebitItems {
+		totalTaxed = totalTaxed.Add(decimal.NewFromFloat(item.TaxedSale.GetValue()))
+		totalNonSubject = totalNonSubject.Add(decimal.NewFromFloat(item.NonSubjectSale.GetValue()))
+		totalExempt = totalExempt.Add(decimal.NewFromFloat(item.ExemptSale.GetValue()))
+	}
+
+	// 2. Validar que los totales coincidan con el resumen
+	summaryTaxed := decimal.NewFromFloat(s.Document.DebitSummary.TotalTaxed.GetValue())
+	summaryNonSubject := decimal.NewFromFloat(s.Document.DebitSummary.TotalNonSubject.GetValue())
+	summaryExempt := decimal.NewFromFloat(s.Document.DebitSummary.TotalExempt.GetValue())
+
+	// Verificar total gravado
+	// Usar una pequeña tolerancia para comparaciones con decimales
+	diff := totalTaxed.Sub(summaryTaxed).Abs()
+	if diff.GreaterThan(decimal.NewFromFloat(0.01)) {
+		logs.Error("Invalid taxed total", map[string]interface{}{
+			"calculated": totalTaxed,
+			"declared":   summaryTaxed,
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidTotalTaxed",
+			summaryTaxed.InexactFloat64(),
+			totalTaxed.InexactFloat64())
+	}
+
+	//Verificar que los descuentos no sobrepasen el subtotal
+	if decimal.NewFromFloat(s.Document.DebitSummary.SubTotal.GetValue()).LessThan(decimal.NewFromFloat(s.Document.DebitSummary.TaxedDiscount.GetValue())) {
+		logs.Error("Invalid taxed discount", map[string]interface{}{
+			"taxedDiscount": s.Document.DebitSummary.TaxedDiscount.GetValue(),
+			"subTotal":      s.Document.DebitSummary.SubTotal.GetValue(),
+		})
+		return dte_errors.NewDTEErrorSimple("DiscountExceedsSubtotal",
+			"TaxedDiscount",
+			s.Document.DebitSummary.TaxedDiscount.GetValue(),
+			s.Document.DebitSummary.SubTotal.GetValue())
+	}
+
+	if decimal.NewFromFloat(s.Document.DebitSummary.SubTotal.GetValue()).LessThan(decimal.NewFromFloat(s.Document.DebitSummary.ExemptDiscount.GetValue())) {
+		logs.Error("Invalid exempt discount", map[string]interface{}{
+			"exemptDiscount": s.Document.DebitSummary.ExemptDiscount.GetValue(),
+			"subTotal":       s.Document.DebitSummary.SubTotal.GetValue(),
+		})
+		return dte_errors.NewDTEErrorSimple("DiscountExceedsSubtotal",
+			"ExemptDiscount",
+			s.Document.DebitSummary.ExemptDiscount.GetValue(),
+			s.Document.DebitSummary.SubTotal.GetValue())
+	}
+
+	if decimal.NewFromFloat(s.Document.DebitSummary.SubTotal.GetValue()).LessThan(decimal.NewFromFloat(s.Document.DebitSummary.NonSubjectDiscount.GetValue())) {
+		logs.Error("Invalid non subject discount", map[string]interface{}{
+			"nonSubjectDiscount": s.Document.DebitSummary.NonSubjectDiscount.GetValue(),
+			"subTotal":           s.Document.DebitSummary.SubTotal.GetValue(),
+		})
+		return dte_errors.NewDTEErrorSimple("DiscountExceedsSubtotal",
+			"NonSubjectDiscount",
+			s.Document.DebitSummary.NonSubjectDiscount.GetValue(),
+			s.Document.DebitSummary.SubTotal.GetValue())
+	}
+
+	// Verificar total no sujeto
+	if !totalNonSubject.Equal(summaryNonSubject) {
+		logs.Error("Invalid non-subject total", map[string]interface{}{
+			"calculated": totalNonSubject,
+			"declared":   summaryNonSubject,
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidTotalNonSubject",
+			totalNonSubject.InexactFloat64(),
+			summaryNonSubject.InexactFloat64())
+	}
+
+	// Verificar total exento
+	if !totalExempt.Equal(summaryExempt) {
+		logs.Error("Invalid exempt total", map[string]interface{}{
+			"calculated": totalExempt,
+			"declared":   summaryExempt,
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidTotalExempt",
+			totalExempt.InexactFloat64(),
+			summaryExempt.InexactFloat64())
+	}
+
+	// 3. Validar que subtotal de ventas sea la suma de todos los tipos
+	expectedSubTotalSales := totalTaxed.Add(totalNonSubject).Add(totalExempt)
+	actualSubTotalSales := decimal.NewFromFloat(s.Document.DebitSummary.SubTotalSales.GetValue())
+
+	// Usar una pequeña tolerancia para comparaciones con decimales
+	diff = expectedSubTotalSales.Sub(actualSubTotalSales).Abs()
+	if diff.GreaterThan(decimal.NewFromFloat(0.01)) {
+		logs.Error("Invalid subtotal sales", map[string]interface{}{
+			"calculated": expectedSubTotalSales,
+			"declared":   actualSubTotalSales,
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidSubTotalSales",
+			expectedSubTotalSales.InexactFloat64(),
+			actualSubTotalSales.InexactFloat64())
+	}
+
+	return nil
+}
+
+func (s *DebitNoteTaxStrategy) validateIVA() *dte_errors.DTEError {
+	baseTaxed := decimal.NewFromFloat(s.Document.DebitSummary.TotalTaxed.GetValue())
+
+	// Si no hay monto gravado, no se requieren impuestos
+	if !baseTaxed.GreaterThan(decimal.Zero) {
+		if len(s.Document.DebitSummary.TotalTaxes) > 0 {
+			logs.Error("Taxes present with zero taxed amount")
+			return dte_errors.NewDTEErrorSimple("InvalidTaxes")
+		}
+		return nil
+	}
+
+	baseTaxed = baseTaxed.Sub(decimal.NewFromFloat(s.Document.DebitSummary.TaxedDiscount.GetValue()))
+
+	// Verificar que tenga al menos un impuesto válido
+	if len(s.Document.DebitSummary.TotalTaxes) == 0 {
+		logs.Error("No taxes present with non-zero taxed amount")
+		return dte_errors.NewDTEErrorSimple("MissingTaxes")
+	}
+
+	// Validar el cálculo de cada impuesto
+	for _, tax := range s.Document.DebitSummary.TotalTaxes {
+		var expectedTax decimal.Decimal
+
+		switch tax.GetCode() {
+		case constants.TaxIVA:
+			expectedTax = baseTaxed.Mul(decimal.NewFromFloat(constants.TaxIvaAmount))
+		case constants.TaxIVAExport:
+			expectedTax = baseTaxed.Mul(decimal.NewFromFloat(constants.TaxIVAExportAmount))
+		case constants.TaxTourism:
+			expectedTax = baseTaxed.Mul(decimal.NewFromFloat(constants.TaxTourismAmount))
+		case constants.TaxTourismAirport:
+			expectedTax = decimal.NewFromFloat(constants.TaxTourismAirportAmount)
+		case constants.TaxFOVIAL:
+			expectedTax = baseTaxed.Mul(decimal.NewFromFloat(constants.TaxFOVIALAmount))
+		case constants.TaxCOTRANS:
+			expectedTax = decimal.NewFromFloat(constants.TaxCOTRANSAmount)
+		case constants.TaxSpecialOther:
+			continue
+		}
+
+		actualTax := decimal.NewFromFloat(tax.GetValue())
+		// Usar una pequeña tolerancia para comparaciones con decimales
+		diff := expectedTax.Sub(actualTax).Abs()
+		if diff.GreaterThan(decimal.NewFromFloat(0.01)) {
+			logs.Error("Invalid tax calculation", map[string]interface{}{
+				"taxCode":  tax.GetCode(),
+				"expected": expectedTax,
+				"actual":   actualTax,
+			})
+			return dte_errors.NewDTEErrorSimple("InvalidTaxCalculation",
+				tax.GetCode(),
+				expectedTax.InexactFloat64(),
+				actualTax.InexactFloat64())
+		}
+	}
+
+	return nil
+}
+
+func (s *DebitNoteTaxStrategy) validatePerception() *dte_errors.DTEError {
+	if s.Document.DebitSummary.IVAPerception.GetValue() != 0 {
+		baseTaxed := decimal.NewFromFloat(s.Document.DebitSummary.TotalTaxed.GetValue())
+		expectedPerception := baseTaxed.Mul(decimal.NewFromFloat(0.01))
+		actualPerception := decimal.NewFromFloat(s.Document.DebitSummary.IVAPerception.GetValue())
+
+		// Usar una pequeña tolerancia para comparaciones con decimales
+		diff := expectedPerception.Sub(actualPerception).Abs()
+		if diff.GreaterThan(decimal.NewFromFloat(0.01)) {
+			return dte_errors.NewDTEErrorSimple("InvalidPerceptionAmount",
+				actualPerception.StringFixed(2),
+				expectedPerception.StringFixed(2))
+		}
+	}
+
+	return nil
+}
+
+func (s *DebitNoteTaxStrategy) validateTotalAmounts() *dte_errors.DTEError {
+	// Obtener total operación
+	totalOperation := decimal.NewFromFloat(s.Document.DebitSummary.TotalOperation.GetValue())
+
+	// Obtener montos que afectan el total a pagar
+	taxedAmount := decimal.NewFromFloat(s.Document.DebitSummary.TotalTaxed.GetValue())
+
+	expectedSubTotal := decimal.NewFromFloat(s.Document.DebitSummary.SubTotalSales.GetValue()).
+		Sub(decimal.NewFromFloat(s.Document.DebitSummary.TaxedDiscount.GetValue())).
+		Sub(decimal.NewFromFloat(s.Document.DebitSummary.ExemptDiscount.GetValue())).
+		Sub(decimal.NewFromFloat(s.Document.DebitSummary.NonSubjectDiscount.GetValue()))
+
+	actualSubTotal := decimal.NewFromFloat(s.Document.DebitSummary.SubTotal.GetValue())
+	// Usar una pequeña tolerancia para comparaciones con decimales
+	diff := expectedSubTotal.Sub(actualSubTotal).Abs()
+	if diff.GreaterThan(decimal.NewFromFloat(0.01)) {
+		logs.Error("Invalid subtotal calculation with discounts", map[string]interface{}{
+			"expected":           expectedSubTotal,
+			"actual":             actualSubTotal,
+			"taxedDiscount":      s.Document.DebitSummary.TaxedDiscount.GetValue(),
+			"exemptDiscount":     s.Document.DebitSummary.ExemptDiscount.GetValue(),
+			"nonSubjectDiscount": s.Document.DebitSummary.NonSubjectDiscount.GetValue(),
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidSubTotalCalculation",
+			expectedSubTotal.InexactFloat64(),
+			actualSubTotal.InexactFloat64())
+	}
+
+	// Calcular IVA con descuento
+	if taxedAmount.GreaterThan(decimal.Zero) {
+		taxedWithDiscount := taxedAmount.
+			Sub(decimal.NewFromFloat(s.Document.DebitSummary.TaxedDiscount.GetValue()))
+		expectedIVA := taxedWithDiscount.Mul(decimal.NewFromFloat(0.13))
+
+		for _, tax := range s.Document.DebitSummary.TotalTaxes {
+			if tax.GetCode() == constants.TaxIVA {
+				actualIVA := decimal.NewFromFloat(tax.GetValue())
+				// Usar una pequeña tolerancia para comparaciones con decimales
+				diff := expectedIVA.Sub(actualIVA).Abs()
+				if diff.GreaterThan(decimal.NewFromFloat(0.01)) {
+					logs.Error("Invalid IVA calculation with discount", map[string]interface{}{
+						"expected":      expectedIVA,
+						"actual":        actualIVA,
+						"taxedAmount":   taxedAmount,
+						"taxedDiscount": s.Document.DebitSummary.TaxedDiscount.GetValue(),
+					})
+					return dte_errors.NewDTEErrorSimple("InvalidIVACalculation",
+						expectedIVA.InexactFloat64(),
+						actualIVA.InexactFloat64())
+				}
+				break
+			}
+		}
+	}
+
+	// Inicializar el total a pagar con el total operación
+	expectedTotalOperation := actualSubTotal
+
+	if taxedAmount.GreaterThan(decimal.Zero) {
+		// Agregar percepción
+		perception := decimal.NewFromFloat(s.Document.DebitSummary.IVAPerception.GetValue())
+		expectedTotalOperation = expectedTotalOperation.Add(perception)
+
+		// Restar retención IVA
+		ivaRetention := decimal.NewFromFloat(s.Document.DebitSummary.IVARetention.GetValue())
+		expectedTotalOperation = expectedTotalOperation.Sub(ivaRetention)
+
+		// Restar retención de renta
+		incomeRetention := decimal.NewFromFloat(s.Document.DebitSummary.IncomeRetention.GetValue())
+		expectedTotalOperation = expectedTotalOperation.Sub(incomeRetention)
+	}
+
+	for _, taxes := range s.Document.DebitSummary.GetTotalTaxes() {
+		expectedTotalOperation = expectedTotalOperation.Add(decimal.NewFromFloat(taxes.GetTotalAmount()))
+	}
+
+	// Usar una pequeña tolerancia para comparaciones con decimales
+	diff = expectedTotalOperation.Sub(totalOperation).Abs()
+	if diff.GreaterThan(decimal.NewFromFloat(0.01)) {
+		logs.Error("Invalid total operation", map[string]interface{}{
+			"calculated":      expectedTotalOperation,
+			"declared":        totalOperation,
+			"difference":      diff,
+			"operation":       totalOperation,
+			"perception":      s.Document.DebitSummary.IVAPerception.GetValue(),
+			"ivaRetention":    s.Document.DebitSummary.IVARetention.GetValue(),
+			"incomeRetention": s.Document.DebitSummary.IncomeRetention.GetValue(),
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidTotalOperation",
+			totalOperation.InexactFloat64(),
+			expectedTotalOperation.InexactFloat64())
+	}
+
+	return nil
+}
+
+func (s *DebitNoteTaxStrategy) validateMonetaryAmounts() *dte_errors.DTEError {
+	// Validar IVA Perception
+	if err := ValidateMonetaryAmount(s.Document.DebitSummary.IVAPerception.GetValue(), "iva_perception"); err != nil {
+		return err
+	}
+
+	// Validar Total Operation
+	if err := ValidateMonetaryAmount(s.Document.DebitSummary.TotalOperation.GetValue(), "total_operation"); err != nil {
+		return err
+	}
+
+	// Validar Payment Amounts
+	for _, payment := range s.Document.DebitSummary.GetPaymentTypes() {
+		if err := ValidateMonetaryAmount(payment.GetAmount(), "payment_amount"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ValidateMonetaryAmount(amount float64, fieldName string) *dte_errors.DTEError {
+	decValue := decimal.NewFromFloat(amount)
+	multiplier := decimal.NewFromInt(100)
+	scaled := decValue.Mul(multiplier)
+
+	// Usar una pequeña tolerancia para comparaciones con decimales
+	diff := scaled.Sub(decimal.NewFromInt(scaled.IntPart())).Abs()
+	if diff.GreaterThan(decimal.NewFromFloat(0.01)) {
+		return dte_errors.NewDTEErrorSimple("InvalidMonetaryAmount",
+			fieldName,
+			amount)
+	}
+
+	return nil
+}
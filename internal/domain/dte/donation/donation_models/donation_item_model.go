@@ -0,0 +1,18 @@
+package donation_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/item"
+)
+
+type DonationItem struct {
+	Number       item.ItemNumber   // Número del ítem
+	Type         item.DonationType // Tipo de donación (Efectivo, Bien o Servicio)
+	Quantity     item.Quantity     // Cantidad donada
+	Code         *item.ItemCode    // Código del bien o servicio (opcional)
+	UnitMeasure  item.UnitMeasure  // Unidad de medida
+	Description  string            // Descripción de lo donado
+	Depreciation financial.Amount  // Depreciación del bien donado
+	UnitValue    financial.Amount  // Valor unitario
+	Value        financial.Amount  // Valor donado (cantidad * valor unitario - depreciación)
+}
@@ -0,0 +1,38 @@
+package donation_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/shopspring/decimal"
+)
+
+type DonationModel struct {
+	*models.DTEDocument
+	Donor           *Donor
+	DonationItems   []DonationItem
+	DonationSummary *DonationSummary
+}
+
+// GetTotalValueByItems Suma el valor donado de todos los items del documento
+func (d *DonationModel) GetTotalValueByItems() decimal.Decimal {
+	var totalValue decimal.Decimal
+
+	for _, item := range d.DonationItems {
+		totalValue = totalValue.Add(item.Value.GetValueAsDecimal())
+	}
+
+	return totalValue
+}
+
+// GetCashValueByItems Suma el valor donado de los items en efectivo del documento
+func (d *DonationModel) GetCashValueByItems() decimal.Decimal {
+	var cashValue decimal.Decimal
+
+	for _, item := range d.DonationItems {
+		if item.Type.GetValue() == constants.DonacionEfectivo {
+			cashValue = cashValue.Add(item.Value.GetValueAsDecimal())
+		}
+	}
+
+	return cashValue
+}
@@ -0,0 +1,13 @@
+package donation_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+)
+
+// DonationSummary resumen del Comprobante de Donación, las donaciones no causan tributos
+type DonationSummary struct {
+	TotalValue   financial.Amount         // Valor total donado
+	TotalInWords string                   // Valor total en letras
+	PaymentTypes []interfaces.PaymentType // Formas de pago de las donaciones en efectivo
+}
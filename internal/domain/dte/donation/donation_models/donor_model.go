@@ -0,0 +1,25 @@
+package donation_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/base"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/identification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/location"
+)
+
+// Donor representa al donante del Comprobante de Donación, reemplaza la sección del receptor.
+// El emisor del comprobante actúa como donatario.
+type Donor struct {
+	DocumentType        *document.DTEType              // Tipo de documento de identificación
+	DocumentNumber      *identification.DocumentNumber // Número de documento de identificación
+	NRC                 *identification.NRC            // NRC (opcional)
+	Name                string                         // Nombre, denominación o razón social
+	ActivityCode        *identification.ActivityCode   // Código de actividad económica (opcional)
+	ActivityDescription *string                        // Descripción de la actividad económica (opcional)
+	Address             *models.Address                // Dirección (requerida para donantes domiciliados)
+	Phone               *base.Phone                    // Teléfono (opcional)
+	Email               *base.Email                    // Correo electrónico (opcional)
+	DomiciledCode       int                            // Código de domicilio (1 -> Domiciliado, 2 -> No domiciliado)
+	CountryCode         *location.CountryCode          // Código de país del donante
+}
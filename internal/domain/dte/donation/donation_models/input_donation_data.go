@@ -0,0 +1,10 @@
+package donation_models
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+
+type InputDonationData struct {
+	*models.InputDataCommon
+	Donor           *Donor           `json:"donor"`                      // Donante que realiza la donación
+	DonationItems   []DonationItem   `json:"donation_items"`             // Lista de items donados
+	DonationSummary *DonationSummary `json:"donation_summary,omitempty"` // Resumen del comprobante de donación
+}
@@ -0,0 +1,141 @@
+package donation
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type donationService struct {
+	validator        *validator.DonationRulesValidator
+	seqNumberManager dte_documents.SequentialNumberManager
+	dteManager       dte_documents.DTEManager
+}
+
+// NewDonationService Crea un nuevo servicio de Comprobante de Donación.
+func NewDonationService(seqNumberManager dte_documents.SequentialNumberManager, dteManager dte_documents.DTEManager) ports.DTEService {
+	return &donationService{
+		validator:        validator.NewDonationRulesValidator(nil),
+		seqNumberManager: seqNumberManager,
+		dteManager:       dteManager,
+	}
+}
+
+// Create Crea un nuevo Comprobante de Donación electrónico con base en los datos proporcionados.
+func (s *donationService) Create(ctx context.Context, input interface{}, branchID uint) (interface{}, error) {
+	data := input.(*donation_models.InputDonationData)
+
+	// 1. Crear el documento base
+	if data.DonationSummary.TotalInWords == "" {
+		data.DonationSummary.TotalInWords = utils.InLetters(data.DonationSummary.TotalValue.GetValue())
+	}
+	baseDoc := createBaseDocument(data)
+	donation := &donation_models.DonationModel{
+		DTEDocument:     baseDoc,
+		Donor:           data.Donor,
+		DonationItems:   data.DonationItems,
+		DonationSummary: data.DonationSummary,
+	}
+
+	// 2. Validar el documento con las reglas del comprobante de donación
+	if err := s.validate(donation); err != nil {
+		logs.Error("Failed to validate donation document", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 3. Generar el número de control y el código UUID
+	if err := s.generateCodeAndIdentifiers(ctx, donation, branchID); err != nil {
+		return nil, err
+	}
+
+	return donation, nil
+}
+
+// validate Valida un Comprobante de Donación electrónico con base en las reglas de negocio.
+func (s *donationService) validate(donation *donation_models.DonationModel) error {
+	s.validator = validator.NewDonationRulesValidator(donation)
+	err := s.validator.Validate()
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"DonationService",
+			"Validate",
+			err,
+			"ValidationFailed",
+		)
+	}
+
+	return nil
+}
+
+// createBaseDocument Crea un documento base para el comprobante de donación electrónico.
+// El receptor se deja vacío, ya que este tipo de documento utiliza la sección "donante" y el emisor actúa como donatario.
+func createBaseDocument(data *donation_models.InputDonationData) *models.DTEDocument {
+	var appendixes []interfaces.Appendix
+	var otherDocs []interfaces.OtherDocuments
+
+	if data.Appendixes != nil {
+		for _, appendix := range data.Appendixes {
+			appendixes = append(appendixes, &appendix)
+		}
+	}
+
+	if data.OtherDocs != nil {
+		for _, doc := range data.OtherDocs {
+			otherDocs = append(otherDocs, &doc)
+		}
+	}
+
+	return &models.DTEDocument{
+		Identification: data.Identification,
+		Issuer:         data.Issuer,
+		Receiver: &models.Receiver{
+			Address: &models.Address{},
+		},
+		Appendix:       appendixes,
+		OtherDocuments: otherDocs,
+	}
+}
+
+func (s *donationService) generateCodeAndIdentifiers(ctx context.Context, donation *donation_models.DonationModel, branchID uint) error {
+	if err := s.generateControlNumber(ctx, donation, branchID); err != nil {
+		return err
+	}
+	return donation.Identification.GenerateCode()
+}
+
+// generateControlNumber Genera un número de control único para el comprobante de donación.
+func (s *donationService) generateControlNumber(ctx context.Context, donation *donation_models.DonationModel, branchID uint) error {
+	establishmentCode := donation.Issuer.GetEstablishmentCode()
+	posCode := donation.Issuer.GetPOSCode()
+
+	controlNumber, err := s.seqNumberManager.GetNextControlNumber(
+		ctx,
+		constants.ComprobanteDonacionElectronico,
+		branchID,
+		posCode,
+		establishmentCode,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = donation.Identification.SetControlNumber(controlNumber)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"DonationService",
+			"GenerateControlNumber",
+			err,
+			"FailedToSetControlNumber",
+		)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/validator/strategy"
+)
+
+type DonationRulesValidator struct {
+	document   *donation_models.DonationModel
+	strategies []interfaces.DTEValidationStrategy
+}
+
+// NewDonationRulesValidator Crea un validador de reglas para comprobantes de donación electrónicos
+func NewDonationRulesValidator(doc *donation_models.DonationModel) *DonationRulesValidator {
+	validator := &DonationRulesValidator{
+		document: doc,
+		strategies: []interfaces.DTEValidationStrategy{
+			&strategy.DonationDonorStrategy{Document: doc}, // 1. Validaciones del donante y documentos asociados
+			&strategy.DonationItemStrategy{Document: doc},  // 2. Validaciones de items
+			&strategy.DonationTotalStrategy{Document: doc}, // 3. Validaciones de totales y formas de pago
+		},
+	}
+	return validator
+}
+
+// Validate Ejecuta las validaciones del comprobante de donación electrónico.
+func (v *DonationRulesValidator) Validate() *dte_errors.DTEError {
+	var validationErrors []*dte_errors.DTEError
+
+	for _, strategyValidator := range v.strategies {
+		if err := strategyValidator.Validate(); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return dte_errors.NewDTEErrorComposite(validationErrors)
+	}
+
+	return nil
+}
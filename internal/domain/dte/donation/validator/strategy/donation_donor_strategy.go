@@ -0,0 +1,84 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+)
+
+type DonationDonorStrategy struct {
+	Document *donation_models.DonationModel
+}
+
+func (s *DonationDonorStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil {
+		return nil
+	}
+
+	validations := []func() *dte_errors.DTEError{
+		s.validateDonorRequired,
+		s.validateDomicile,
+		s.validateOtherDocuments,
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateDonorRequired valida que el comprobante incluya al donante
+func (s *DonationDonorStrategy) validateDonorRequired() *dte_errors.DTEError {
+	if s.Document.Donor == nil {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "Donor")
+	}
+
+	if s.Document.Donor.CountryCode == nil {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "Donor->CountryCode")
+	}
+
+	return nil
+}
+
+// validateDomicile valida que el donante domiciliado tenga dirección en El Salvador
+// y que el donante no domiciliado pertenezca a otro país
+func (s *DonationDonorStrategy) validateDomicile() *dte_errors.DTEError {
+	donor := s.Document.Donor
+	country := donor.CountryCode.GetValue()
+
+	switch donor.DomiciledCode {
+	case constants.DonanteDomiciliado:
+		if country != constants.CodigoPaisElSalvador {
+			return dte_errors.NewDTEErrorSimple("InvalidDonorCountry", donor.DomiciledCode, country)
+		}
+		if donor.Address == nil {
+			return dte_errors.NewDTEErrorSimple("RequiredField", "Donor->Address")
+		}
+	case constants.DonanteNoDomiciliado:
+		if country == constants.CodigoPaisElSalvador {
+			return dte_errors.NewDTEErrorSimple("InvalidDonorCountry", donor.DomiciledCode, country)
+		}
+	}
+
+	return nil
+}
+
+// validateOtherDocuments valida que se declaren entre 1 y 10 documentos asociados
+// y que ninguno corresponda a información médica
+func (s *DonationDonorStrategy) validateOtherDocuments() *dte_errors.DTEError {
+	count := len(s.Document.OtherDocuments)
+	if count < 1 || count > 10 {
+		return dte_errors.NewDTEErrorSimple("InvalidOtherDocsCount", count)
+	}
+
+	for _, doc := range s.Document.OtherDocuments {
+		if doc.GetAssociatedDocument() == constants.DocumentoMedico {
+			return dte_errors.NewDTEErrorSimple("InvalidAssociatedDocumentForDonation", doc.GetAssociatedDocument())
+		}
+	}
+
+	return nil
+}
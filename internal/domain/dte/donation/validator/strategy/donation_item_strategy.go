@@ -0,0 +1,83 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/shopspring/decimal"
+)
+
+type DonationItemStrategy struct {
+	Document *donation_models.DonationModel
+}
+
+func (s *DonationItemStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil {
+		return nil
+	}
+
+	validations := []func() *dte_errors.DTEError{
+		s.validateItemsCount,
+		s.validateDepreciation,
+		s.validateItemValues,
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateItemsCount valida que existan items y que no se exceda el límite permitido
+func (s *DonationItemStrategy) validateItemsCount() *dte_errors.DTEError {
+	if len(s.Document.DonationItems) == 0 {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "DonationItems")
+	}
+
+	if len(s.Document.DonationItems) > 2000 {
+		return dte_errors.NewDTEErrorSimple("ExceededItemsLimit", len(s.Document.DonationItems))
+	}
+
+	return nil
+}
+
+// validateDepreciation valida que solo las donaciones de bienes declaren depreciación
+func (s *DonationItemStrategy) validateDepreciation() *dte_errors.DTEError {
+	for _, item := range s.Document.DonationItems {
+		if item.Type.GetValue() != constants.DonacionBien && !item.Depreciation.GetValueAsDecimal().IsZero() {
+			return dte_errors.NewDTEErrorSimple("InvalidDonationDepreciation",
+				item.Number.GetValue(),
+				item.Type.GetValue())
+		}
+	}
+
+	return nil
+}
+
+// validateItemValues valida que el valor donado de cada item sea cantidad * valor unitario - depreciación
+func (s *DonationItemStrategy) validateItemValues() *dte_errors.DTEError {
+	for _, item := range s.Document.DonationItems {
+		expectedValue := item.UnitValue.GetValueAsDecimal().
+			Mul(decimal.NewFromFloat(item.Quantity.GetValue())).
+			Sub(item.Depreciation.GetValueAsDecimal())
+		actualValue := item.Value.GetValueAsDecimal()
+
+		if expectedValue.Sub(actualValue).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+			logs.Info("Evaluating donation item value", map[string]interface{}{
+				"item_number": item.Number.GetValue(),
+				"expected":    expectedValue.InexactFloat64(),
+				"actual":      actualValue.InexactFloat64(),
+			})
+			return dte_errors.NewDTEErrorSimple("InvalidDonationItemValue",
+				item.Number.GetValue(),
+				actualValue.InexactFloat64(),
+				expectedValue.InexactFloat64())
+		}
+	}
+
+	return nil
+}
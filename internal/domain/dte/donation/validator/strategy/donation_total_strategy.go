@@ -0,0 +1,76 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/shopspring/decimal"
+)
+
+type DonationTotalStrategy struct {
+	Document *donation_models.DonationModel
+}
+
+func (s *DonationTotalStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil || s.Document.DonationSummary == nil {
+		return nil
+	}
+
+	validations := []func() *dte_errors.DTEError{
+		s.validateTotalValue,
+		s.validatePayments,
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTotalValue valida que el valor total concuerde con la suma de los valores donados en los items
+func (s *DonationTotalStrategy) validateTotalValue() *dte_errors.DTEError {
+	expected := s.Document.GetTotalValueByItems()
+	actual := s.Document.DonationSummary.TotalValue.GetValueAsDecimal()
+
+	if !s.compareTotalsWithTolerance(expected, actual, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidDonationTotalValue",
+			actual.InexactFloat64(),
+			expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validatePayments valida que las formas de pago solo se declaren para donaciones en efectivo
+// y que su suma concuerde con el valor donado en efectivo
+func (s *DonationTotalStrategy) validatePayments() *dte_errors.DTEError {
+	if len(s.Document.DonationSummary.PaymentTypes) == 0 {
+		return nil
+	}
+
+	cashValue := s.Document.GetCashValueByItems()
+	if cashValue.IsZero() {
+		return dte_errors.NewDTEErrorSimple("PaymentsWithoutCashDonation")
+	}
+
+	var totalPayments decimal.Decimal
+	for _, payment := range s.Document.DonationSummary.PaymentTypes {
+		totalPayments = totalPayments.Add(decimal.NewFromFloat(payment.GetAmount()))
+	}
+
+	if !s.compareTotalsWithTolerance(cashValue, totalPayments, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidPaymentTotal",
+			totalPayments.InexactFloat64(),
+			cashValue.InexactFloat64())
+	}
+
+	return nil
+}
+
+// compareTotalsWithTolerance compara dos totales con una tolerancia especificada
+func (s *DonationTotalStrategy) compareTotalsWithTolerance(expected, actual decimal.Decimal, tolerance float64) bool {
+	diff := expected.Sub(actual).Abs()
+	return diff.LessThanOrEqual(decimal.NewFromFloat(tolerance))
+}
@@ -24,8 +24,8 @@ func NewDTEService(repo DTERepositoryPort) DTEManager {
 }
 
 func (m *DTEService) Create(ctx context.Context, document interface{}, transmission, status string, receptionStamp *string) error {
-	// 1. Establecer el sello de recepción en el apéndice del DTE
-	if transmission != constants.TransmissionContingency {
+	// 1. Establecer el sello de recepción en el apéndice del DTE, los documentos pendientes de transmitir aún no lo tienen
+	if transmission != constants.TransmissionContingency && receptionStamp != nil {
 		if err := m.setReceptionStampIntoAppendix(document, receptionStamp); err != nil {
 			return shared_error.NewFormattedGeneralServiceWithError("DTEService", "CreateDTE", err, "FailedToSetReceptionStamp")
 		}
@@ -110,6 +110,15 @@ func (m *DTEService) ValidateForCreditNote(ctx context.Context, branchID uint, o
 	return nil
 }
 
+func (m *DTEService) GetBalanceControl(ctx context.Context, branchID uint, originalDTE string) (*dte.BalanceControl, error) {
+	balanceControl, err := m.repo.GetDTEBalanceControl(ctx, branchID, originalDTE)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DTEService", "GetBalanceControl", err, "FailedToGetBalanceControl")
+	}
+
+	return balanceControl, nil
+}
+
 func (m *DTEService) UpdateDTE(ctx context.Context, branchID uint, document dte.DTEDetails) error {
 	// 1. Actualizar el DTE en la base de datos
 	if err := m.repo.Update(ctx, branchID, document); err != nil {
@@ -119,6 +128,41 @@ func (m *DTEService) UpdateDTE(ctx context.Context, branchID uint, document dte.
 	return nil
 }
 
+// MarkReceived marca como recibido un documento almacenado como pendiente y agrega el sello de recepción a su apéndice,
+// igual que al almacenar un documento recibido en línea
+func (m *DTEService) MarkReceived(ctx context.Context, branchID uint, document *dte.DTEDocument, receptionStamp *string) error {
+	details := dte.DTEDetails{
+		ID:             document.Details.ID,
+		Status:         constants.DocumentReceived,
+		ReceptionStamp: receptionStamp,
+	}
+
+	// 1. Establecer el sello de recepción en el apéndice del JSON almacenado
+	if receptionStamp != nil {
+		var docMap map[string]interface{}
+		if err := json.Unmarshal([]byte(document.Details.JSONData), &docMap); err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("DTEService", "MarkReceived", err, "FailedToSetReceptionStamp")
+		}
+
+		if err := m.setReceptionStampIntoAppendix(docMap, receptionStamp); err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("DTEService", "MarkReceived", err, "FailedToSetReceptionStamp")
+		}
+
+		jsonData, err := json.Marshal(docMap)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("DTEService", "MarkReceived", err, "FailedToSetReceptionStamp")
+		}
+		details.JSONData = string(jsonData)
+	}
+
+	// 2. Actualizar el DTE en la base de datos
+	if err := m.repo.Update(ctx, branchID, details); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("DTEService", "MarkReceived", err, "FailedToUpdateDTE")
+	}
+
+	return nil
+}
+
 func (m *DTEService) VerifyStatus(ctx context.Context, branchID uint, id string) (string, error) {
 	// 1. Verificar el estado del DTE en la base de datos
 	status, err := m.repo.VerifyStatus(ctx, branchID, id)
@@ -139,6 +183,26 @@ func (m *DTEService) GetByGenerationCode(ctx context.Context, branchID uint, gen
 	return dteDocument, nil
 }
 
+func (m *DTEService) GetReceivedDocument(ctx context.Context, branchID uint, dteType, generationCode string) (*dte.DTEDocument, error) {
+	// 1. Obtener el DTE referenciado por su código de generación
+	dteDocument, err := m.repo.GetByGenerationCode(ctx, branchID, generationCode)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DTEService", "GetReceivedDocument", err, "FailedToGetDTE", generationCode)
+	}
+
+	// 2. Verificar que el DTE referenciado sea del tipo declarado
+	if dteDocument.Details.DTEType != dteType {
+		return nil, shared_error.NewFormattedGeneralServiceError("DTEService", "GetReceivedDocument", "RelatedDocumentTypeMismatch", generationCode, dteType, dteDocument.Details.DTEType)
+	}
+
+	// 3. Verificar que el DTE referenciado haya sido recibido por Hacienda
+	if dteDocument.Details.Status != constants.DocumentReceived {
+		return nil, shared_error.NewFormattedGeneralServiceError("DTEService", "GetReceivedDocument", "RelatedDocumentNotReceived", generationCode, dteDocument.Details.Status)
+	}
+
+	return dteDocument, nil
+}
+
 func (m *DTEService) GetByGenerationCodeConsult(ctx context.Context, branchID uint, generationCode string) (*dte.DTEResponse, error) {
 	// 1. Obtener el DTE por su código de generación
 	dteDocument, err := m.repo.GetByGenerationCode(ctx, branchID, generationCode)
@@ -212,7 +276,19 @@ func (m *DTEService) setReceptionStampIntoAppendix(document interface{}, recepti
 		Valor:    *receptionStamp,
 	}
 
-	// 3. Agregar el sello de recepción al apéndice
+	// 3. Agregar el sello de recepción al apéndice. Los documentos recuperados por la conciliación se almacenan a partir
+	// de su JSON y pueden conservar el sello si ya se había agregado
+	if docMap, ok := document.(map[string]interface{}); ok {
+		appendices, _ := docMap["apendice"].([]interface{})
+		for _, existing := range appendices {
+			if entry, ok := existing.(map[string]interface{}); ok && entry["valor"] == appendix.Valor {
+				return nil
+			}
+		}
+		docMap["apendice"] = append(appendices, *appendix)
+		return nil
+	}
+
 	switch dteType {
 	case constants.FacturaElectronica:
 		document.(*structs.InvoiceDTEResponse).Apendice =
@@ -223,6 +299,27 @@ func (m *DTEService) setReceptionStampIntoAppendix(document interface{}, recepti
 	case constants.NotaCreditoElectronica:
 		document.(*structs.CreditNoteDTEResponse).Apendice =
 			append(document.(*structs.CreditNoteDTEResponse).Apendice, *appendix)
+	case constants.NotaDebitoElectronica:
+		document.(*structs.DebitNoteDTEResponse).Apendice =
+			append(document.(*structs.DebitNoteDTEResponse).Apendice, *appendix)
+	case constants.FacturaSujetoExcluidoElectronica:
+		document.(*structs.FSEDTEResponse).Apendice =
+			append(document.(*structs.FSEDTEResponse).Apendice, *appendix)
+	case constants.FacturaExportacionElectronica:
+		document.(*structs.ExportInvoiceDTEResponse).Apendice =
+			append(document.(*structs.ExportInvoiceDTEResponse).Apendice, *appendix)
+	case constants.NotaRemisionElectronica:
+		document.(*structs.RemissionNoteDTEResponse).Apendice =
+			append(document.(*structs.RemissionNoteDTEResponse).Apendice, *appendix)
+	case constants.ComprobanteLiquidacionElectronico:
+		document.(*structs.LiquidationDTEResponse).Apendice =
+			append(document.(*structs.LiquidationDTEResponse).Apendice, *appendix)
+	case constants.DocContableLiquidacionElectronico:
+		document.(*structs.AccountingLiquidationDTEResponse).Apendice =
+			append(document.(*structs.AccountingLiquidationDTEResponse).Apendice, *appendix)
+	case constants.ComprobanteDonacionElectronico:
+		document.(*structs.DonationDTEResponse).Apendice =
+			append(document.(*structs.DonationDTEResponse).Apendice, *appendix)
 	case constants.ComprobanteRetencionElectronico:
 		document.(*structs.RetentionDTEResponse).Apendice =
 			append(document.(*structs.RetentionDTEResponse).Apendice, *appendix)
@@ -12,6 +12,8 @@ type DTEManager interface {
 	Create(context.Context, interface{}, string, string, *string) error
 	// UpdateDTE actualiza el estado de un DTE en la base de datos.
 	UpdateDTE(ctx context.Context, branchID uint, document dte.DTEDetails) error
+	// MarkReceived marca como recibido un DTE pendiente y agrega el sello de recepción a su apéndice.
+	MarkReceived(ctx context.Context, branchID uint, document *dte.DTEDocument, receptionStamp *string) error
 	// VerifyStatus verifica el estado de un DTE en la base de datos.
 	VerifyStatus(ctx context.Context, branchID uint, id string) (string, error)
 	// GetByGenerationCode obtiene un DTE por su código de generación para procesos internos.
@@ -20,8 +22,12 @@ type DTEManager interface {
 	GenerateBalanceTransaction(ctx context.Context, branchID uint, transactionType, id, originalDTE string, document interface{}) error
 	// GenerateBalanceTransactionWithAmounts genera una transacción de balance con montos específicos.
 	GenerateBalanceTransactionWithAmounts(ctx context.Context, branchID uint, transactionType, originalDTE, adjustmentDTE string, taxedSale, exemptSale, notSubjectSale float64) error
+	// GetBalanceControl obtiene el control de saldo de un DTE.
+	GetBalanceControl(ctx context.Context, branchID uint, originalDTE string) (*dte.BalanceControl, error)
 	// ValidateForCreditNote valida un DTE para la creación de una Nota de Crédito.
 	ValidateForCreditNote(ctx context.Context, branchID uint, originalDTE string, document interface{}) error
+	// GetReceivedDocument obtiene un DTE referenciado por otro documento, verificando que exista, sea del tipo esperado y haya sido recibido.
+	GetReceivedDocument(ctx context.Context, branchID uint, dteType, generationCode string) (*dte.DTEDocument, error)
 	// GetByGenerationCodeConsult obtiene un DTE por su código de generación para consultas.
 	GetByGenerationCodeConsult(ctx context.Context, branchID uint, generationCode string) (*dte.DTEResponse, error)
 	// GetAllDTEs obtiene todos los DTEs en la base de datos con filtros y paginación.
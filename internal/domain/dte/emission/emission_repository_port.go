@@ -0,0 +1,22 @@
+package emission
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission/models"
+)
+
+// EmissionRepositoryPort interfaz para la cola de transmisión de los documentos emitidos de forma asíncrona
+type EmissionRepositoryPort interface {
+	// Create registra un job en la cola
+	Create(ctx context.Context, job *models.EmissionJob) error
+	// GetReady obtiene los jobs en cola cuyo próximo intento ya se cumplió y los jobs en proceso cuya reserva expiró
+	GetReady(ctx context.Context, now time.Time, limit int) ([]models.EmissionJob, error)
+	// Claim reserva un job listo hasta la fecha indicada e incrementa sus intentos, retorna false si otro worker lo reservó
+	Claim(ctx context.Context, id uint, now, until time.Time) (bool, error)
+	// Update actualiza el estado, los intentos, el último error y el próximo intento de un job
+	Update(ctx context.Context, job *models.EmissionJob) error
+	// GetByDocumentID obtiene el job de un documento, retorna ErrEmissionJobNotFound si no existe
+	GetByDocumentID(ctx context.Context, branchID uint, documentID string) (*models.EmissionJob, error)
+}
@@ -0,0 +1,181 @@
+package emission
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+const (
+	// RetryBaseDelay es la espera antes del primer reintento, se duplica en cada intento fallido
+	RetryBaseDelay = 30 * time.Second
+	// RetryMaxDelay es la espera máxima entre reintentos
+	RetryMaxDelay = 10 * time.Minute
+	// ClaimDuration es el tiempo que un job queda reservado por un worker, debe superar la duración de los
+	// reintentos de transmisión para que otro worker no lo retome mientras sigue en proceso
+	ClaimDuration = 5 * time.Minute
+	// CredentialsWaitDelay es la espera antes de retomar un job cuya sucursal no tiene credenciales de Hacienda
+	// almacenadas, que se restablecen en su próximo inicio de sesión
+	CredentialsWaitDelay = 5 * time.Minute
+	// claimCandidates es la cantidad de jobs listos que se consultan para reservar uno
+	claimCandidates = 10
+	// maxErrorLength es la longitud máxima del último error almacenado
+	maxErrorLength = 500
+)
+
+type EmissionQueueService struct {
+	repo        EmissionRepositoryPort
+	maxAttempts int
+}
+
+// NewEmissionQueueService crea el servicio de la cola de transmisión. El worker se autentica ante Hacienda con las
+// credenciales de la sucursal, por lo que el job no almacena el token de la solicitud.
+func NewEmissionQueueService(repo EmissionRepositoryPort, maxAttempts int) EmissionQueueManager {
+	return &EmissionQueueService{
+		repo:        repo,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Enqueue registra el job para que sea transmitido de inmediato por el siguiente worker disponible
+func (s *EmissionQueueService) Enqueue(ctx context.Context, branchID uint, documentID, dteType string) error {
+	now := utils.TimeNow()
+	job := &models.EmissionJob{
+		BranchID:      branchID,
+		DocumentID:    documentID,
+		DTEType:       dteType,
+		Status:        models.JobQueued,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := s.repo.Create(ctx, job); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("EmissionQueueService", "Enqueue", err, "FailedToEnqueueEmission", documentID)
+	}
+
+	return nil
+}
+
+// ClaimNext reserva el primer job listo que no haya sido reservado por otro worker
+func (s *EmissionQueueService) ClaimNext(ctx context.Context) (*models.EmissionJob, error) {
+	now := utils.TimeNow()
+	until := now.Add(ClaimDuration)
+
+	// 1. Obtener los jobs listos para procesarse
+	ready, err := s.repo.GetReady(ctx, now, claimCandidates)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("EmissionQueueService", "ClaimNext", err, "FailedToGetEmissionJobs")
+	}
+
+	// 2. Reservar el primero disponible
+	for i := range ready {
+		job := &ready[i]
+
+		claimed, err := s.repo.Claim(ctx, job.ID, now, until)
+		if err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("EmissionQueueService", "ClaimNext", err, "FailedToUpdateEmissionJob", job.DocumentID)
+		}
+		if !claimed {
+			continue
+		}
+
+		job.Status = models.JobProcessing
+		job.Attempts++
+		job.LockedUntil = &until
+
+		return job, nil
+	}
+
+	return nil, nil
+}
+
+// Finish registra el estado final del job
+func (s *EmissionQueueService) Finish(ctx context.Context, job *models.EmissionJob, status string) error {
+	job.Status = status
+	job.LastError = ""
+	job.NextAttemptAt = nil
+	job.LockedUntil = nil
+
+	if err := s.repo.Update(ctx, job); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("EmissionQueueService", "Finish", err, "FailedToUpdateEmissionJob", job.DocumentID)
+	}
+
+	return nil
+}
+
+// Fail registra el intento fallido. Mientras el error sea reintentable y no se agoten los intentos, el job vuelve
+// a la cola con una espera exponencial.
+func (s *EmissionQueueService) Fail(ctx context.Context, job *models.EmissionJob, cause error, retryable bool) error {
+	job.LastError = truncate(cause.Error(), maxErrorLength)
+	job.LockedUntil = nil
+
+	if retryable && job.Attempts < s.maxAttempts {
+		next := utils.TimeNow().Add(NextRetryDelay(job.Attempts))
+		job.Status = models.JobQueued
+		job.NextAttemptAt = &next
+	} else {
+		job.Status = models.JobFailed
+		job.NextAttemptAt = nil
+	}
+
+	if err := s.repo.Update(ctx, job); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("EmissionQueueService", "Fail", err, "FailedToUpdateEmissionJob", job.DocumentID)
+	}
+
+	return nil
+}
+
+// Reschedule devuelve el job a la cola sin consumir el intento reservado, para que una espera que no depende del
+// documento no agote sus intentos
+func (s *EmissionQueueService) Reschedule(ctx context.Context, job *models.EmissionJob, cause error, delay time.Duration) error {
+	next := utils.TimeNow().Add(delay)
+	job.Status = models.JobQueued
+	job.Attempts = max(job.Attempts-1, 0)
+	job.LastError = truncate(cause.Error(), maxErrorLength)
+	job.NextAttemptAt = &next
+	job.LockedUntil = nil
+
+	if err := s.repo.Update(ctx, job); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("EmissionQueueService", "Reschedule", err, "FailedToUpdateEmissionJob", job.DocumentID)
+	}
+
+	return nil
+}
+
+// GetJob obtiene el job de un documento
+func (s *EmissionQueueService) GetJob(ctx context.Context, branchID uint, documentID string) (*models.EmissionJob, error) {
+	job, err := s.repo.GetByDocumentID(ctx, branchID, documentID)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrEmissionJobNotFound) {
+			return nil, nil
+		}
+		return nil, shared_error.NewFormattedGeneralServiceWithError("EmissionQueueService", "GetJob", err, "FailedToGetEmissionJobs")
+	}
+
+	return job, nil
+}
+
+// NextRetryDelay calcula la espera antes del siguiente intento según los intentos realizados: 30s, 1m, 2m, 4m...
+// hasta un máximo de RetryMaxDelay
+func NextRetryDelay(attempts int) time.Duration {
+	delay := RetryBaseDelay
+	for i := 1; i < attempts && delay < RetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, RetryMaxDelay)
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+	return string(runes[:length])
+}
@@ -0,0 +1,24 @@
+package emission
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission/models"
+)
+
+// EmissionQueueManager define las operaciones de la cola de transmisión asíncrona de DTE
+type EmissionQueueManager interface {
+	// Enqueue registra la transmisión pendiente de un documento ya almacenado
+	Enqueue(ctx context.Context, branchID uint, documentID, dteType string) error
+	// ClaimNext reserva el siguiente job listo para transmitirse, retorna nil si no hay jobs pendientes
+	ClaimNext(ctx context.Context) (*models.EmissionJob, error)
+	// Finish registra el estado final de un job
+	Finish(ctx context.Context, job *models.EmissionJob, status string) error
+	// Fail registra el intento fallido de un job y lo reprograma mientras sea reintentable y no se agoten los intentos
+	Fail(ctx context.Context, job *models.EmissionJob, cause error, retryable bool) error
+	// Reschedule devuelve un job a la cola para retomarlo después de delay sin contar el intento actual
+	Reschedule(ctx context.Context, job *models.EmissionJob, cause error, delay time.Duration) error
+	// GetJob obtiene el job de un documento o nil si el documento no se emitió de forma asíncrona
+	GetJob(ctx context.Context, branchID uint, documentID string) (*models.EmissionJob, error)
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+const (
+	// JobQueued indica que el documento espera ser transmitido por un worker
+	JobQueued = "QUEUED"
+	// JobProcessing indica que un worker está transmitiendo el documento
+	JobProcessing = "PROCESSING"
+	// JobCompleted indica que el documento fue recibido o rechazado por Hacienda
+	JobCompleted = "COMPLETED"
+	// JobContingency indica que el documento pasó a contingencia y será retransmitido por el job de contingencia
+	JobContingency = "CONTINGENCY"
+	// JobFailed indica que se agotaron los intentos y el documento permanece pendiente
+	JobFailed = "FAILED"
+)
+
+// EmissionJob representa la transmisión pendiente de un documento emitido de forma asíncrona
+type EmissionJob struct {
+	ID            uint       `json:"-"`
+	BranchID      uint       `json:"-"`
+	DocumentID    string     `json:"document_id"`
+	DTEType       string     `json:"dte_type"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	LockedUntil   *time.Time `json:"-"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// IsFinished indica si el job ya no será procesado por los workers
+func (j *EmissionJob) IsFinished() bool {
+	return j.Status == JobCompleted || j.Status == JobContingency || j.Status == JobFailed
+}
@@ -0,0 +1,21 @@
+package models
+
+// AsyncEmissionResult representa la respuesta de una emisión asíncrona aceptada
+type AsyncEmissionResult struct {
+	GenerationCode string `json:"generation_code"`
+	ControlNumber  string `json:"control_number"`
+	DTEType        string `json:"dte_type"`
+	Status         string `json:"status"`
+	StatusURL      string `json:"status_url"`
+}
+
+// EmissionStatus representa el estado de un documento y de su transmisión asíncrona, si la tiene
+type EmissionStatus struct {
+	GenerationCode string       `json:"generation_code"`
+	ControlNumber  string       `json:"control_number"`
+	DTEType        string       `json:"dte_type"`
+	Status         string       `json:"status"`
+	Transmission   string       `json:"transmission"`
+	ReceptionStamp *string      `json:"reception_stamp,omitempty"`
+	Job            *EmissionJob `json:"job,omitempty"`
+}
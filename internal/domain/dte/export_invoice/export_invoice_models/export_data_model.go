@@ -0,0 +1,13 @@
+package export_invoice_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/item"
+)
+
+// ExportData contiene los datos de exportación que Hacienda solicita en la sección del emisor
+type ExportData struct {
+	ItemExportType *item.ItemExportType     // Tipo de item exportado (1 -> Bienes, 2 -> Servicios, 3 -> Ambos)
+	FiscalPrecinct *document.FiscalPrecinct // Recinto fiscal (requerido si se exportan bienes)
+	Regime         *document.Regime         // Régimen de exportación (requerido si se exportan bienes)
+}
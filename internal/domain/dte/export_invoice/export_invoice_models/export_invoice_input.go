@@ -0,0 +1,11 @@
+package export_invoice_models
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+
+type ExportInvoiceInput struct {
+	*models.InputDataCommon
+	ForeignReceiver *ForeignReceiver `json:"foreign_receiver"`         // Receptor extranjero de la exportación
+	ExportData      *ExportData      `json:"export_data"`              // Datos de exportación del emisor
+	ExportItems     []ExportItem     `json:"export_items"`             // Lista de items de la exportación
+	ExportSummary   *ExportSummary   `json:"export_summary,omitempty"` // Resumen de la exportación
+}
@@ -0,0 +1,25 @@
+package export_invoice_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/shopspring/decimal"
+)
+
+type ExportInvoiceModel struct {
+	*models.DTEDocument
+	ForeignReceiver *ForeignReceiver
+	ExportData      *ExportData
+	ExportItems     []ExportItem
+	ExportSummary   *ExportSummary
+}
+
+// GetTotalsByItems Suma las ventas gravadas, los montos no gravados y los descuentos de todos los items
+func (e *ExportInvoiceModel) GetTotalsByItems() (taxed, nonTaxed, discount decimal.Decimal) {
+	for _, item := range e.ExportItems {
+		taxed = taxed.Add(item.TaxedSale.GetValueAsDecimal())
+		nonTaxed = nonTaxed.Add(item.NonTaxed.GetValueAsDecimal())
+		discount = discount.Add(decimal.NewFromFloat(item.GetDiscount()))
+	}
+
+	return taxed, nonTaxed, discount
+}
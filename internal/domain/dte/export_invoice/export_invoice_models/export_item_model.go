@@ -0,0 +1,12 @@
+package export_invoice_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+)
+
+type ExportItem struct {
+	*models.Item                  // Hereda item base
+	TaxedSale    financial.Amount // Venta gravada con tasa 0% (cantidad * precio unitario - descuento)
+	NonTaxed     financial.Amount // Cargos o abonos que no afectan la base imponible
+}
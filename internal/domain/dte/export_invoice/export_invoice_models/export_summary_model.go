@@ -0,0 +1,25 @@
+package export_invoice_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+)
+
+type ExportSummary struct {
+	TotalTaxed              financial.Amount           // Total de ventas gravadas
+	Discount                financial.Amount           // Descuento global sobre las ventas gravadas
+	DiscountPercentage      financial.Discount         // Porcentaje del descuento global
+	TotalDiscount           financial.Amount           // Total de descuentos (items + global)
+	Insurance               financial.Amount           // Monto del seguro
+	Freight                 financial.Amount           // Monto del flete
+	TotalOperation          financial.Amount           // Monto total de la operación
+	TotalNonTaxed           financial.Amount           // Total de cargos o abonos no gravados
+	TotalToPay              financial.Amount           // Total a pagar
+	TotalInWords            string                     // Total a pagar en letras
+	OperationCondition      financial.PaymentCondition // Condición de la operación
+	PaymentTypes            []interfaces.PaymentType   // Formas de pago
+	Incoterm                *document.Incoterm         // Código INCOTERMS (opcional)
+	ElectronicPaymentNumber *string                    // Número de pago electrónico (opcional)
+	Observations            *string                    // Observaciones (opcional)
+}
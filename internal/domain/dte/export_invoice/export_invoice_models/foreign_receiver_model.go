@@ -0,0 +1,23 @@
+package export_invoice_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/base"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/identification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/location"
+)
+
+// ForeignReceiver representa al receptor extranjero de una Factura de Exportación
+type ForeignReceiver struct {
+	Name                string                         // Nombre, denominación o razón social
+	DocumentType        *document.DTEType              // Tipo de documento de identificación (catálogo internacional)
+	DocumentNumber      *identification.DocumentNumber // Número de documento de identificación
+	CommercialName      *string                        // Nombre comercial (opcional)
+	CountryCode         *location.CountryCode          // Código de país de destino
+	CountryName         string                         // Nombre del país de destino
+	Complement          string                         // Dirección en el extranjero
+	PersonType          *identification.PersonType     // Tipo de persona (1 -> Natural, 2 -> Jurídica)
+	ActivityDescription *string                        // Descripción de la actividad económica (opcional)
+	Phone               *base.Phone                    // Teléfono (opcional)
+	Email               *base.Email                    // Correo electrónico (opcional)
+}
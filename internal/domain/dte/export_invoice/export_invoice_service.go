@@ -0,0 +1,143 @@
+package export_invoice
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type exportInvoiceService struct {
+	validator        *validator.ExportInvoiceRulesValidator
+	dteManager       dte_documents.DTEManager
+	seqNumberManager dte_documents.SequentialNumberManager
+}
+
+// NewExportInvoiceService crea una nueva instancia del servicio de Factura de Exportación
+func NewExportInvoiceService(seqNumberManager dte_documents.SequentialNumberManager, dteManager dte_documents.DTEManager) ports.DTEService {
+	return &exportInvoiceService{
+		validator:        validator.NewExportInvoiceRulesValidator(nil),
+		seqNumberManager: seqNumberManager,
+		dteManager:       dteManager,
+	}
+}
+
+func (s *exportInvoiceService) Create(ctx context.Context, input interface{}, branchID uint) (interface{}, error) {
+	data := input.(*export_invoice_models.ExportInvoiceInput)
+
+	// 1. Crear el documento base para la factura de exportación
+	if data.ExportSummary.TotalInWords == "" {
+		data.ExportSummary.TotalInWords = utils.InLetters(data.ExportSummary.TotalToPay.GetValue())
+	}
+	baseDoc := createBaseDocument(data)
+	exportInvoice := &export_invoice_models.ExportInvoiceModel{
+		DTEDocument:     baseDoc,
+		ForeignReceiver: data.ForeignReceiver,
+		ExportData:      data.ExportData,
+		ExportItems:     data.ExportItems,
+		ExportSummary:   data.ExportSummary,
+	}
+
+	// 2. Validar el documento de factura de exportación generado
+	err := s.validate(exportInvoice)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Generar el codigo de generacion y el numero de control
+	if err := s.generateCodeAndIdentifiers(ctx, exportInvoice, branchID); err != nil {
+		return nil, err
+	}
+
+	return exportInvoice, nil
+}
+
+func (s *exportInvoiceService) validate(exportInvoice *export_invoice_models.ExportInvoiceModel) error {
+	s.validator = validator.NewExportInvoiceRulesValidator(exportInvoice)
+	err := s.validator.Validate()
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"ExportInvoiceService",
+			"Validate",
+			err,
+			"ValidationFailed",
+		)
+	}
+
+	return nil
+}
+
+// createBaseDocument Crea un documento base para la factura de exportación electrónica.
+// El receptor se deja vacío, ya que el receptor extranjero se maneja en su propio modelo.
+func createBaseDocument(data *export_invoice_models.ExportInvoiceInput) *models.DTEDocument {
+	var appendixes []interfaces.Appendix
+	var thirdPartySale interfaces.ThirdPartySale
+
+	items := make([]interfaces.Item, len(data.ExportItems))
+	for i, item := range data.ExportItems {
+		items[i] = item.Item
+	}
+
+	if data.Appendixes != nil {
+		for _, appendix := range data.Appendixes {
+			appendixes = append(appendixes, &appendix)
+		}
+	}
+
+	if data.ThirdPartySale != nil {
+		thirdPartySale = data.ThirdPartySale
+	}
+
+	return &models.DTEDocument{
+		Identification: data.Identification,
+		Issuer:         data.Issuer,
+		Items:          items,
+		Receiver: &models.Receiver{
+			Address: &models.Address{},
+		},
+		ThirdPartySale: thirdPartySale,
+		Appendix:       appendixes,
+	}
+}
+
+func (s *exportInvoiceService) generateCodeAndIdentifiers(ctx context.Context, exportInvoice *export_invoice_models.ExportInvoiceModel, branchID uint) error {
+	if err := s.generateControlNumber(ctx, exportInvoice, branchID); err != nil {
+		return err
+	}
+	return exportInvoice.Identification.GenerateCode()
+}
+
+// generateControlNumber Genera un número de control único para la factura de exportación.
+func (s *exportInvoiceService) generateControlNumber(ctx context.Context, exportInvoice *export_invoice_models.ExportInvoiceModel, branchID uint) error {
+	establishmentCode := exportInvoice.Issuer.GetEstablishmentCode()
+	posCode := exportInvoice.Issuer.GetPOSCode()
+
+	controlNumber, err := s.seqNumberManager.GetNextControlNumber(
+		ctx,
+		constants.FacturaExportacionElectronica,
+		branchID,
+		posCode,
+		establishmentCode,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = exportInvoice.Identification.SetControlNumber(controlNumber)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"ExportInvoiceService",
+			"GenerateControlNumber",
+			err,
+			"FailedToSetControlNumber",
+		)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/validator/strategy"
+)
+
+type ExportInvoiceRulesValidator struct {
+	document   *export_invoice_models.ExportInvoiceModel
+	strategies []interfaces.DTEValidationStrategy
+}
+
+// NewExportInvoiceRulesValidator Crea un validador de reglas para facturas de exportación electrónicas
+func NewExportInvoiceRulesValidator(doc *export_invoice_models.ExportInvoiceModel) *ExportInvoiceRulesValidator {
+	validator := &ExportInvoiceRulesValidator{
+		document: doc,
+		strategies: []interfaces.DTEValidationStrategy{
+			&strategy.ExportDataStrategy{Document: doc},  // 1. Validaciones de datos de exportación
+			&strategy.ExportItemStrategy{Document: doc},  // 2. Validaciones de items
+			&strategy.ExportTaxStrategy{Document: doc},   // 3. Validaciones de impuestos (IVA 0%)
+			&strategy.ExportTotalStrategy{Document: doc}, // 4. Validaciones de totales
+		},
+	}
+	return validator
+}
+
+// Validate Ejecuta las validaciones de la factura de exportación electrónica.
+func (v *ExportInvoiceRulesValidator) Validate() *dte_errors.DTEError {
+	var validationErrors []*dte_errors.DTEError
+
+	for _, strategyValidator := range v.strategies {
+		if err := strategyValidator.Validate(); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return dte_errors.NewDTEErrorComposite(validationErrors)
+	}
+
+	return nil
+}
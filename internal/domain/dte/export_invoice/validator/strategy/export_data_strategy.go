@@ -0,0 +1,42 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+)
+
+type ExportDataStrategy struct {
+	Document *export_invoice_models.ExportInvoiceModel
+}
+
+// Validate valida los datos de exportación del emisor según el tipo de item exportado
+func (s *ExportDataStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil {
+		return nil
+	}
+
+	if s.Document.ExportData == nil || s.Document.ExportData.ItemExportType == nil {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "ExportData->ItemExportType")
+	}
+
+	data := s.Document.ExportData
+	// 1. Exportación de servicios: no aplica recinto fiscal ni régimen
+	if data.ItemExportType.GetValue() == constants.ExportServicios {
+		if data.FiscalPrecinct != nil || data.Regime != nil {
+			return dte_errors.NewDTEErrorSimple("InvalidExportDataForServices")
+		}
+		return nil
+	}
+
+	// 2. Exportación de bienes (o bienes y servicios): recinto fiscal y régimen son obligatorios
+	if data.FiscalPrecinct == nil {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "ExportData->FiscalPrecinct")
+	}
+
+	if data.Regime == nil {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "ExportData->Regime")
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/shopspring/decimal"
+)
+
+type ExportItemStrategy struct {
+	Document *export_invoice_models.ExportInvoiceModel
+}
+
+func (s *ExportItemStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil {
+		return nil
+	}
+
+	if len(s.Document.ExportItems) == 0 {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "ExportItems")
+	}
+
+	if len(s.Document.ExportItems) > 2000 {
+		return dte_errors.NewDTEErrorSimple("ExceededItemsLimit", len(s.Document.ExportItems))
+	}
+
+	for _, item := range s.Document.ExportItems {
+		if err := s.validateTaxedSale(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTaxedSale valida que la venta gravada del item sea cantidad * precio unitario - descuento
+func (s *ExportItemStrategy) validateTaxedSale(item export_invoice_models.ExportItem) *dte_errors.DTEError {
+	expectedTaxed := decimal.NewFromFloat(item.GetUnitPrice()).
+		Mul(decimal.NewFromFloat(item.GetQuantity())).
+		Sub(decimal.NewFromFloat(item.GetDiscount()))
+	actualTaxed := item.TaxedSale.GetValueAsDecimal()
+
+	if expectedTaxed.Sub(actualTaxed).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+		logs.Error("Invalid export item taxed sale", map[string]interface{}{
+			"itemNumber": item.GetNumber(),
+			"expected":   expectedTaxed.InexactFloat64(),
+			"actual":     actualTaxed.InexactFloat64(),
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidTaxedAmount",
+			actualTaxed.InexactFloat64(),
+			expectedTaxed.InexactFloat64())
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+)
+
+type ExportTaxStrategy struct {
+	Document *export_invoice_models.ExportInvoiceModel
+}
+
+// Validate valida que las exportaciones solo apliquen IVA a tasa 0% (C3)
+func (s *ExportTaxStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil {
+		return nil
+	}
+
+	for _, item := range s.Document.ExportItems {
+		// 1. Solo se permite el tributo de IVA exportaciones
+		for _, tax := range item.GetTaxes() {
+			if tax != constants.TaxIVAExport {
+				return dte_errors.NewDTEErrorSimple("InvalidTaxForExport", item.GetNumber(), tax)
+			}
+		}
+
+		// 2. Toda venta gravada debe declarar el tributo de IVA exportaciones
+		if item.TaxedSale.GetValue() > 0 && len(item.GetTaxes()) == 0 {
+			return dte_errors.NewDTEErrorSimple("MissingExportTax", item.GetNumber())
+		}
+	}
+
+	return nil
+}
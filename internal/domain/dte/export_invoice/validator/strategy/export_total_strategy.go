@@ -0,0 +1,117 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/shopspring/decimal"
+)
+
+type ExportTotalStrategy struct {
+	Document *export_invoice_models.ExportInvoiceModel
+}
+
+func (s *ExportTotalStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil || s.Document.ExportSummary == nil {
+		return nil
+	}
+
+	validations := []func() *dte_errors.DTEError{
+		s.validateItemTotals,
+		s.validateTotalOperation,
+		s.validateTotalToPay,
+		s.validatePayments,
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateItemTotals valida que los totales del resumen concuerden con la suma de los items
+func (s *ExportTotalStrategy) validateItemTotals() *dte_errors.DTEError {
+	summary := s.Document.ExportSummary
+	taxed, nonTaxed, itemsDiscount := s.Document.GetTotalsByItems()
+
+	if !s.compareTotalsWithTolerance(taxed, summary.TotalTaxed.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalTaxed",
+			summary.TotalTaxed.GetValue(),
+			taxed.InexactFloat64())
+	}
+
+	if !s.compareTotalsWithTolerance(nonTaxed, summary.TotalNonTaxed.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalNonTaxed",
+			nonTaxed.InexactFloat64(),
+			summary.TotalNonTaxed.GetValue())
+	}
+
+	expectedDiscount := itemsDiscount.Add(summary.Discount.GetValueAsDecimal())
+	if !s.compareTotalsWithTolerance(expectedDiscount, summary.TotalDiscount.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalDiscount",
+			summary.TotalDiscount.GetValue(),
+			expectedDiscount.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateTotalOperation valida que el monto total de la operación sea total gravado - descuento + seguro + flete
+func (s *ExportTotalStrategy) validateTotalOperation() *dte_errors.DTEError {
+	summary := s.Document.ExportSummary
+	expected := summary.TotalTaxed.GetValueAsDecimal().
+		Sub(summary.Discount.GetValueAsDecimal()).
+		Add(summary.Insurance.GetValueAsDecimal()).
+		Add(summary.Freight.GetValueAsDecimal())
+
+	if !s.compareTotalsWithTolerance(expected, summary.TotalOperation.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalOperation",
+			summary.TotalOperation.GetValue(),
+			expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateTotalToPay valida que el total a pagar sea el monto total de la operación más el total no gravado
+func (s *ExportTotalStrategy) validateTotalToPay() *dte_errors.DTEError {
+	summary := s.Document.ExportSummary
+	expected := summary.TotalOperation.GetValueAsDecimal().Add(summary.TotalNonTaxed.GetValueAsDecimal())
+
+	if !s.compareTotalsWithTolerance(expected, summary.TotalToPay.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalToPayCalculation",
+			expected.InexactFloat64(),
+			summary.TotalToPay.GetValue())
+	}
+
+	return nil
+}
+
+// validatePayments valida que la suma de las formas de pago concuerde con el total a pagar
+func (s *ExportTotalStrategy) validatePayments() *dte_errors.DTEError {
+	if len(s.Document.ExportSummary.PaymentTypes) == 0 {
+		return nil
+	}
+
+	var totalPayments decimal.Decimal
+	for _, payment := range s.Document.ExportSummary.PaymentTypes {
+		totalPayments = totalPayments.Add(decimal.NewFromFloat(payment.GetAmount()))
+	}
+
+	totalToPay := s.Document.ExportSummary.TotalToPay.GetValueAsDecimal()
+	if !s.compareTotalsWithTolerance(totalToPay, totalPayments, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidPaymentTotal",
+			totalPayments.InexactFloat64(),
+			totalToPay.InexactFloat64())
+	}
+
+	return nil
+}
+
+// compareTotalsWithTolerance compara dos totales con una tolerancia especificada
+func (s *ExportTotalStrategy) compareTotalsWithTolerance(expected, actual decimal.Decimal, tolerance float64) bool {
+	diff := expected.Sub(actual).Abs()
+	return diff.LessThanOrEqual(decimal.NewFromFloat(tolerance))
+}
@@ -0,0 +1,20 @@
+package fse_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/base"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/identification"
+)
+
+// ExcludedSubject representa al sujeto excluido de la Factura Sujeto Excluido, reemplaza la sección del receptor
+type ExcludedSubject struct {
+	DocumentType        *document.DTEType              // Tipo de documento de identificación
+	DocumentNumber      *identification.DocumentNumber // Número de documento de identificación
+	Name                string                         // Nombre, denominación o razón social
+	ActivityCode        *identification.ActivityCode   // Código de actividad económica (opcional)
+	ActivityDescription *string                        // Descripción de la actividad económica (opcional)
+	Address             *models.Address                // Dirección
+	Phone               *base.Phone                    // Teléfono (opcional)
+	Email               *base.Email                    // Correo electrónico (opcional)
+}
@@ -0,0 +1,11 @@
+package fse_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+)
+
+type FSEItem struct {
+	*models.Item                  // Hereda item base
+	Purchase     financial.Amount // Monto de la compra (cantidad * precio unitario - descuento)
+}
@@ -0,0 +1,24 @@
+package fse_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/shopspring/decimal"
+)
+
+type FSEModel struct {
+	*models.DTEDocument
+	ExcludedSubject *ExcludedSubject
+	FSEItems        []FSEItem
+	FSESummary      *FSESummary
+}
+
+// GetTotalPurchaseByItems Suma el monto de compra de todos los items del documento
+func (f *FSEModel) GetTotalPurchaseByItems() decimal.Decimal {
+	var totalPurchase decimal.Decimal
+
+	for _, item := range f.FSEItems {
+		totalPurchase = totalPurchase.Add(item.Purchase.GetValueAsDecimal())
+	}
+
+	return totalPurchase
+}
@@ -0,0 +1,20 @@
+package fse_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+)
+
+type FSESummary struct {
+	TotalPurchase      financial.Amount           // Total de compras
+	Discount           financial.Amount           // Descuento global sobre la compra
+	TotalDiscount      financial.Amount           // Total de descuentos (items + global)
+	SubTotal           financial.Amount           // Subtotal (total de compras - descuento)
+	IVARetention       financial.Amount           // Retención IVA 1%
+	IncomeRetention    financial.Amount           // Retención de Renta
+	TotalToPay         financial.Amount           // Total a pagar (subtotal - retenciones)
+	TotalInWords       string                     // Total a pagar en letras
+	OperationCondition financial.PaymentCondition // Condición de la operación
+	PaymentTypes       []interfaces.PaymentType   // Formas de pago
+	Observations       *string                    // Observaciones
+}
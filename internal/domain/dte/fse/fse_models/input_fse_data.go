@@ -0,0 +1,10 @@
+package fse_models
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+
+type InputFSEData struct {
+	*models.InputDataCommon
+	ExcludedSubject *ExcludedSubject `json:"excluded_subject"`      // Sujeto excluido al que se le realiza la compra
+	FSEItems        []FSEItem        `json:"fse_items"`             // Lista de items de la factura sujeto excluido
+	FSESummary      *FSESummary      `json:"fse_summary,omitempty"` // Resumen de la factura sujeto excluido
+}
@@ -0,0 +1,136 @@
+package fse
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type fseService struct {
+	validator        *validator.FSERulesValidator
+	dteManager       dte_documents.DTEManager
+	seqNumberManager dte_documents.SequentialNumberManager
+}
+
+// NewFSEService crea una nueva instancia del servicio de Factura Sujeto Excluido
+func NewFSEService(seqNumberManager dte_documents.SequentialNumberManager, dteManager dte_documents.DTEManager) ports.DTEService {
+	return &fseService{
+		validator:        validator.NewFSERulesValidator(nil),
+		seqNumberManager: seqNumberManager,
+		dteManager:       dteManager,
+	}
+}
+
+func (s *fseService) Create(ctx context.Context, input interface{}, branchID uint) (interface{}, error) {
+	data := input.(*fse_models.InputFSEData)
+
+	// 1. Crear el documento base para la factura sujeto excluido
+	if data.FSESummary.TotalInWords == "" {
+		data.FSESummary.TotalInWords = utils.InLetters(data.FSESummary.TotalToPay.GetValue())
+	}
+	baseDoc := createBaseDocument(data)
+	fse := &fse_models.FSEModel{
+		DTEDocument:     baseDoc,
+		ExcludedSubject: data.ExcludedSubject,
+		FSEItems:        data.FSEItems,
+		FSESummary:      data.FSESummary,
+	}
+
+	// 2. Validar el documento de factura sujeto excluido generado
+	err := s.validate(fse)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Generar el codigo de generacion y el numero de control
+	if err := s.generateCodeAndIdentifiers(ctx, fse, branchID); err != nil {
+		return nil, err
+	}
+
+	return fse, nil
+}
+
+func (s *fseService) validate(fse *fse_models.FSEModel) error {
+	s.validator = validator.NewFSERulesValidator(fse)
+	err := s.validator.Validate()
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"FSEService",
+			"Validate",
+			err,
+			"ValidationFailed",
+		)
+	}
+
+	return nil
+}
+
+// createBaseDocument Crea un documento base para la factura sujeto excluido electrónica.
+// El receptor se deja vacío, ya que este tipo de documento utiliza la sección "sujetoExcluido".
+func createBaseDocument(data *fse_models.InputFSEData) *models.DTEDocument {
+	var appendixes []interfaces.Appendix
+
+	items := make([]interfaces.Item, len(data.FSEItems))
+	for i, item := range data.FSEItems {
+		items[i] = item.Item
+	}
+
+	if data.Appendixes != nil {
+		for _, appendix := range data.Appendixes {
+			appendixes = append(appendixes, &appendix)
+		}
+	}
+
+	return &models.DTEDocument{
+		Identification: data.Identification,
+		Issuer:         data.Issuer,
+		Items:          items,
+		Receiver: &models.Receiver{
+			Address: &models.Address{},
+		},
+		Appendix: appendixes,
+	}
+}
+
+func (s *fseService) generateCodeAndIdentifiers(ctx context.Context, fse *fse_models.FSEModel, branchID uint) error {
+	if err := s.generateControlNumber(ctx, fse, branchID); err != nil {
+		return err
+	}
+	return fse.Identification.GenerateCode()
+}
+
+// generateControlNumber Genera un número de control único para la factura sujeto excluido.
+func (s *fseService) generateControlNumber(ctx context.Context, fse *fse_models.FSEModel, branchID uint) error {
+	establishmentCode := fse.Issuer.GetEstablishmentCode()
+	posCode := fse.Issuer.GetPOSCode()
+
+	controlNumber, err := s.seqNumberManager.GetNextControlNumber(
+		ctx,
+		constants.FacturaSujetoExcluidoElectronica,
+		branchID,
+		posCode,
+		establishmentCode,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = fse.Identification.SetControlNumber(controlNumber)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"FSEService",
+			"GenerateControlNumber",
+			err,
+			"FailedToSetControlNumber",
+		)
+	}
+	return nil
+}
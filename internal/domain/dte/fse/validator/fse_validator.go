@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/validator/strategy"
+)
+
+type FSERulesValidator struct {
+	document   *fse_models.FSEModel
+	strategies []interfaces.DTEValidationStrategy
+}
+
+// NewFSERulesValidator Crea un validador de reglas para facturas sujeto excluido electrónicas
+func NewFSERulesValidator(doc *fse_models.FSEModel) *FSERulesValidator {
+	validator := &FSERulesValidator{
+		document: doc,
+		strategies: []interfaces.DTEValidationStrategy{
+			&strategy.FSEItemStrategy{Document: doc},  // 1. Validaciones de items
+			&strategy.FSETotalStrategy{Document: doc}, // 2. Validaciones de totales y retenciones
+		},
+	}
+	return validator
+}
+
+// Validate Ejecuta las validaciones de la factura sujeto excluido electrónica.
+func (v *FSERulesValidator) Validate() *dte_errors.DTEError {
+	var validationErrors []*dte_errors.DTEError
+
+	for _, strategyValidator := range v.strategies {
+		if err := strategyValidator.Validate(); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return dte_errors.NewDTEErrorComposite(validationErrors)
+	}
+
+	return nil
+}
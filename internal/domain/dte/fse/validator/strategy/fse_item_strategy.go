@@ -0,0 +1,80 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/shopspring/decimal"
+)
+
+type FSEItemStrategy struct {
+	Document *fse_models.FSEModel
+}
+
+func (s *FSEItemStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil {
+		return nil
+	}
+
+	validations := []func() *dte_errors.DTEError{
+		s.validateItemsCount,
+		s.validateItemsWithoutTaxes,
+		s.validatePurchaseAmounts,
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateItemsCount valida que existan items y que no se exceda el límite permitido
+func (s *FSEItemStrategy) validateItemsCount() *dte_errors.DTEError {
+	if len(s.Document.FSEItems) == 0 {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "FSEItems")
+	}
+
+	if len(s.Document.FSEItems) > 2000 {
+		return dte_errors.NewDTEErrorSimple("ExceededItemsLimit", len(s.Document.FSEItems))
+	}
+
+	return nil
+}
+
+// validateItemsWithoutTaxes valida que los items no incluyan tributos, el sujeto excluido no es contribuyente de IVA
+func (s *FSEItemStrategy) validateItemsWithoutTaxes() *dte_errors.DTEError {
+	for _, item := range s.Document.FSEItems {
+		if len(item.GetTaxes()) > 0 {
+			return dte_errors.NewDTEErrorSimple("InvalidTaxesForFSE", item.GetNumber())
+		}
+	}
+
+	return nil
+}
+
+// validatePurchaseAmounts valida que el monto de compra de cada item sea cantidad * precio unitario - descuento
+func (s *FSEItemStrategy) validatePurchaseAmounts() *dte_errors.DTEError {
+	for _, item := range s.Document.FSEItems {
+		expectedPurchase := decimal.NewFromFloat(item.GetUnitPrice()).
+			Mul(decimal.NewFromFloat(item.GetQuantity())).
+			Sub(decimal.NewFromFloat(item.GetDiscount()))
+		actualPurchase := item.Purchase.GetValueAsDecimal()
+
+		if expectedPurchase.Sub(actualPurchase).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+			logs.Info("Evaluating FSE item purchase", map[string]interface{}{
+				"item_number": item.GetNumber(),
+				"expected":    expectedPurchase.InexactFloat64(),
+				"actual":      actualPurchase.InexactFloat64(),
+			})
+			return dte_errors.NewDTEErrorSimple("InvalidFSEItemPurchase",
+				item.GetNumber(),
+				actualPurchase.InexactFloat64(),
+				expectedPurchase.InexactFloat64())
+		}
+	}
+
+	return nil
+}
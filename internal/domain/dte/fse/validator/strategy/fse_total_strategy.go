@@ -0,0 +1,138 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/shopspring/decimal"
+)
+
+type FSETotalStrategy struct {
+	Document *fse_models.FSEModel
+}
+
+func (s *FSETotalStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil || s.Document.FSESummary == nil {
+		return nil
+	}
+
+	validations := []func() *dte_errors.DTEError{
+		s.validateTotalPurchase,
+		s.validateTotalDiscount,
+		s.validateSubTotal,
+		s.validateRetentions,
+		s.validateTotalToPay,
+		s.validatePayments,
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTotalPurchase valida que el total de compras concuerde con la suma de compras de los items
+func (s *FSETotalStrategy) validateTotalPurchase() *dte_errors.DTEError {
+	expected := s.Document.GetTotalPurchaseByItems()
+	actual := s.Document.FSESummary.TotalPurchase.GetValueAsDecimal()
+
+	if !s.compareTotalsWithTolerance(expected, actual, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidFSETotalPurchase",
+			actual.InexactFloat64(),
+			expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateTotalDiscount valida que el total de descuentos sea la suma de los descuentos por item mas el descuento global
+func (s *FSETotalStrategy) validateTotalDiscount() *dte_errors.DTEError {
+	expected := s.Document.FSESummary.Discount.GetValueAsDecimal()
+	for _, item := range s.Document.FSEItems {
+		expected = expected.Add(decimal.NewFromFloat(item.GetDiscount()))
+	}
+	actual := s.Document.FSESummary.TotalDiscount.GetValueAsDecimal()
+
+	if !s.compareTotalsWithTolerance(expected, actual, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalDiscount",
+			actual.InexactFloat64(),
+			expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateSubTotal valida que el subtotal sea el total de compras menos el descuento global
+func (s *FSETotalStrategy) validateSubTotal() *dte_errors.DTEError {
+	summary := s.Document.FSESummary
+	expected := summary.TotalPurchase.GetValueAsDecimal().Sub(summary.Discount.GetValueAsDecimal())
+	actual := summary.SubTotal.GetValueAsDecimal()
+
+	if !s.compareTotalsWithTolerance(expected, actual, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidFSESubTotal",
+			actual.InexactFloat64(),
+			expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateRetentions valida que las retenciones de IVA y renta no excedan el subtotal de la compra
+func (s *FSETotalStrategy) validateRetentions() *dte_errors.DTEError {
+	summary := s.Document.FSESummary
+	retentions := summary.IVARetention.GetValueAsDecimal().Add(summary.IncomeRetention.GetValueAsDecimal())
+
+	if retentions.GreaterThan(summary.SubTotal.GetValueAsDecimal()) {
+		return dte_errors.NewDTEErrorSimple("ExcessiveFSERetention",
+			retentions.InexactFloat64(),
+			summary.SubTotal.GetValue())
+	}
+
+	return nil
+}
+
+// validateTotalToPay valida que el total a pagar sea el subtotal menos las retenciones de IVA y renta
+func (s *FSETotalStrategy) validateTotalToPay() *dte_errors.DTEError {
+	summary := s.Document.FSESummary
+	expected := summary.SubTotal.GetValueAsDecimal().
+		Sub(summary.IVARetention.GetValueAsDecimal()).
+		Sub(summary.IncomeRetention.GetValueAsDecimal())
+	actual := summary.TotalToPay.GetValueAsDecimal()
+
+	if !s.compareTotalsWithTolerance(expected, actual, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalToPayCalculation",
+			expected.InexactFloat64(),
+			actual.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validatePayments valida que la suma de las formas de pago concuerde con el total a pagar
+func (s *FSETotalStrategy) validatePayments() *dte_errors.DTEError {
+	if len(s.Document.FSESummary.PaymentTypes) == 0 {
+		return nil
+	}
+
+	var totalPayments decimal.Decimal
+	for _, payment := range s.Document.FSESummary.PaymentTypes {
+		totalPayments = totalPayments.Add(decimal.NewFromFloat(payment.GetAmount()))
+	}
+
+	totalToPay := s.Document.FSESummary.TotalToPay.GetValueAsDecimal()
+	if !s.compareTotalsWithTolerance(totalToPay, totalPayments, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidPaymentTotal",
+			totalPayments.InexactFloat64(),
+			totalToPay.InexactFloat64())
+	}
+
+	return nil
+}
+
+// compareTotalsWithTolerance compara dos totales con una tolerancia especificada
+func (s *FSETotalStrategy) compareTotalsWithTolerance(expected, actual decimal.Decimal, tolerance float64) bool {
+	diff := expected.Sub(actual).Abs()
+	return diff.LessThanOrEqual(decimal.NewFromFloat(tolerance))
+}
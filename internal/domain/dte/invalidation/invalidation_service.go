@@ -104,32 +104,54 @@ func (s *invalidationService) handleControlBalance(ctx context.Context, branchID
 		}(rd)
 	}
 
-	// 3. Generar las transacciones de recuperación de saldo para cada item relacionado
+	// 3. Sumar los montos de los items de cada documento relacionado emitido electrónicamente, el documento de ajuste
+	// registra una sola transacción de recuperación por documento relacionado
+	type recoveredAmounts struct {
+		taxed, exempt, notSubject float64
+	}
+	var relatedOrder []string
+	amountsByDoc := make(map[string]*recoveredAmounts)
 	for _, item := range extractor.Items {
 		relatedDoc, ok := relatedDocsMap[item.RelatedDoc]
-		if ok && relatedDoc.GenerationType == constants.ElectronicDocument {
-			logs.Info("Generating balance control transaction", map[string]interface{}{
-				"branchID":         branchID,
-				"originalCode":     originalCode,
-				"relatedDocument":  relatedDoc.DocumentNumber,
-				"taxedAmount":      item.TaxedAmount,
-				"exemptAmount":     item.ExemptAmount,
-				"notSubjectAmount": item.NotSubjectAmount,
-			})
+		if !ok || relatedDoc.GenerationType != constants.ElectronicDocument {
+			continue
+		}
+
+		amounts, exists := amountsByDoc[relatedDoc.DocumentNumber]
+		if !exists {
+			amounts = &recoveredAmounts{}
+			amountsByDoc[relatedDoc.DocumentNumber] = amounts
+			relatedOrder = append(relatedOrder, relatedDoc.DocumentNumber)
+		}
+		amounts.taxed += item.TaxedAmount
+		amounts.exempt += item.ExemptAmount
+		amounts.notSubject += item.NotSubjectAmount
+	}
 
-			if err := s.dteManager.GenerateBalanceTransactionWithAmounts(ctx,
-				branchID,
-				constants.DocumentInvalid,
-				relatedDoc.DocumentNumber,
-				originalCode,
-				item.TaxedAmount,
-				item.ExemptAmount,
-				item.NotSubjectAmount); err != nil {
-				logs.Error("Error generating balance transaction", map[string]interface{}{
-					"error": err.Error(),
-				})
-				return shared_error.NewFormattedGeneralServiceError("InvalidationService", "InvalidateDocument", "FailedToRecoverInvalidatedAmounts")
-			}
+	// 4. Generar la transacción de recuperación de saldo de cada documento relacionado
+	for _, documentNumber := range relatedOrder {
+		amounts := amountsByDoc[documentNumber]
+		logs.Info("Generating balance control transaction", map[string]interface{}{
+			"branchID":         branchID,
+			"originalCode":     originalCode,
+			"relatedDocument":  documentNumber,
+			"taxedAmount":      amounts.taxed,
+			"exemptAmount":     amounts.exempt,
+			"notSubjectAmount": amounts.notSubject,
+		})
+
+		if err := s.dteManager.GenerateBalanceTransactionWithAmounts(ctx,
+			branchID,
+			constants.DocumentInvalid,
+			documentNumber,
+			originalCode,
+			amounts.taxed,
+			amounts.exempt,
+			amounts.notSubject); err != nil {
+			logs.Error("Error generating balance transaction", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return shared_error.NewFormattedGeneralServiceError("InvalidationService", "InvalidateDocument", "FailedToRecoverInvalidatedAmounts")
 		}
 	}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
 	buisnessValidator "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/temporal"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/invoice/invoice_models"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/invoice/validator"
@@ -17,19 +18,25 @@ import (
 type invoiceService struct {
 	validator        *validator.InvoiceRulesValidator
 	seqNumberManager dte_documents.SequentialNumberManager
+	dteManager       dte_documents.DTEManager
 }
 
 // NewInvoiceService Crea un nuevo servicio de facturas electrónicas.
-func NewInvoiceService(seqNumberManager dte_documents.SequentialNumberManager) ports.DTEService {
+func NewInvoiceService(seqNumberManager dte_documents.SequentialNumberManager, dteManager dte_documents.DTEManager) ports.DTEService {
 	return &invoiceService{
 		validator:        validator.NewInvoiceRulesValidator(nil),
 		seqNumberManager: seqNumberManager,
+		dteManager:       dteManager,
 	}
 }
 
 // Create Crea una nueva invoice electrónica con base en los datos proporcionados.
 func (s *invoiceService) Create(ctx context.Context, input interface{}, branchID uint) (interface{}, error) {
 	data := input.(*invoice_models.InvoiceData)
+	if err := s.validateLinkedDocuments(ctx, data, branchID); err != nil {
+		return nil, err
+	}
+
 	baseDoc := createBaseDocument(data)
 
 	invoice := &invoice_models.ElectronicInvoice{
@@ -53,6 +60,26 @@ func (s *invoiceService) Create(ctx context.Context, input interface{}, branchID
 	return invoice, nil
 }
 
+// validateLinkedDocuments verifica que los documentos electrónicos referenciados (Nota de Remisión o Liquidaciones) existan y hayan sido recibidos por Hacienda
+func (s *invoiceService) validateLinkedDocuments(ctx context.Context, data *invoice_models.InvoiceData, branchID uint) error {
+	for i, relatedDoc := range data.RelatedDocs {
+		// Los documentos físicos y los tipos no permitidos no se verifican contra la base de datos
+		if !constants.ValidInvoiceDTETypesRelateDoc[relatedDoc.GetDocumentType()] ||
+			relatedDoc.GetGenerationType() != constants.ElectronicDocument {
+			continue
+		}
+
+		doc, err := s.dteManager.GetReceivedDocument(ctx, branchID, relatedDoc.GetDocumentType(), relatedDoc.GetDocumentNumber())
+		if err != nil {
+			return err
+		}
+
+		data.RelatedDocs[i].EmissionDate = *temporal.NewValidatedEmissionDate(doc.CreatedAt)
+	}
+
+	return nil
+}
+
 // Validate Valida una invoice electrónica con base en las reglas de negocio.
 func (s *invoiceService) validate(invoice *invoice_models.ElectronicInvoice) error {
 	s.validator = validator.NewInvoiceRulesValidator(invoice)
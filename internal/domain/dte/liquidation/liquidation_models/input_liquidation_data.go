@@ -0,0 +1,9 @@
+package liquidation_models
+
+import "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+
+type InputLiquidationData struct {
+	*models.InputDataCommon
+	LiquidationItems   []LiquidationItem   `json:"liquidation_items"`             // Lista de documentos liquidados
+	LiquidationSummary *LiquidationSummary `json:"liquidation_summary,omitempty"` // Resumen del comprobante de liquidación
+}
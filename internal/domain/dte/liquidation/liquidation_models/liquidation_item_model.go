@@ -0,0 +1,23 @@
+package liquidation_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/item"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/temporal"
+)
+
+type LiquidationItem struct {
+	Number         item.ItemNumber         // Número del ítem
+	DTEType        document.DTEType        // Tipo de DTE del documento liquidado
+	GenerationType document.OperationType  // Tipo de generación del documento liquidado (Fisico o Electronico)
+	DocumentNumber document.DocumentNumber // Número del documento liquidado
+	GenerationDate temporal.EmissionDate   // Fecha de generación del documento liquidado
+	NonSubjectSale financial.Amount        // Venta no sujeta
+	ExemptSale     financial.Amount        // Venta exenta
+	TaxedSale      financial.Amount        // Venta gravada
+	ExportSale     financial.Amount        // Venta de exportación
+	Taxes          []string                // Códigos de tributos aplicados a la venta gravada
+	IVAItem        financial.Amount        // IVA del documento liquidado
+	Observation    *string                 // Observaciones del ítem
+}
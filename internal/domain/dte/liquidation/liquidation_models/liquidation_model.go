@@ -0,0 +1,37 @@
+package liquidation_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/shopspring/decimal"
+)
+
+type LiquidationModel struct {
+	*models.DTEDocument
+	LiquidationItems   []LiquidationItem
+	LiquidationSummary *LiquidationSummary
+}
+
+// GetSalesByItems Suma los montos de venta no sujeta, exenta, gravada y de exportación de todos los items
+func (l *LiquidationModel) GetSalesByItems() (decimal.Decimal, decimal.Decimal, decimal.Decimal, decimal.Decimal) {
+	var nonSubject, exempt, taxed, export decimal.Decimal
+
+	for _, item := range l.LiquidationItems {
+		nonSubject = nonSubject.Add(item.NonSubjectSale.GetValueAsDecimal())
+		exempt = exempt.Add(item.ExemptSale.GetValueAsDecimal())
+		taxed = taxed.Add(item.TaxedSale.GetValueAsDecimal())
+		export = export.Add(item.ExportSale.GetValueAsDecimal())
+	}
+
+	return nonSubject, exempt, taxed, export
+}
+
+// GetIVAByItems Suma el IVA de todos los documentos liquidados
+func (l *LiquidationModel) GetIVAByItems() decimal.Decimal {
+	var totalIVA decimal.Decimal
+
+	for _, item := range l.LiquidationItems {
+		totalIVA = totalIVA.Add(item.IVAItem.GetValueAsDecimal())
+	}
+
+	return totalIVA
+}
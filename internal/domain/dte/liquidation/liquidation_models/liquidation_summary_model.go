@@ -0,0 +1,20 @@
+package liquidation_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+)
+
+type LiquidationSummary struct {
+	TotalNonSubject    financial.Amount           // Total de ventas no sujetas
+	TotalExempt        financial.Amount           // Total de ventas exentas
+	TotalTaxed         financial.Amount           // Total de ventas gravadas
+	TotalExport        financial.Amount           // Total de exportaciones
+	SubTotalSales      financial.Amount           // Suma de todos los tipos de venta
+	Taxes              []interfaces.Tax           // Resumen de tributos
+	TotalOperation     financial.Amount           // Monto total de la operación (subtotal + tributos)
+	IVAPerception      financial.Amount           // IVA percibido 1%
+	Total              financial.Amount           // Total (monto de la operación + IVA percibido)
+	TotalInWords       string                     // Total en letras
+	OperationCondition financial.PaymentCondition // Condición de la operación
+}
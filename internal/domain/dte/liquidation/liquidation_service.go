@@ -0,0 +1,163 @@
+package liquidation
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/temporal"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type liquidationService struct {
+	validator        *validator.LiquidationRulesValidator
+	seqNumberManager dte_documents.SequentialNumberManager
+	dteManager       dte_documents.DTEManager
+}
+
+// NewLiquidationService Crea un nuevo servicio de Comprobante de Liquidación.
+func NewLiquidationService(seqNumberManager dte_documents.SequentialNumberManager, dteManager dte_documents.DTEManager) ports.DTEService {
+	return &liquidationService{
+		validator:        validator.NewLiquidationRulesValidator(nil),
+		seqNumberManager: seqNumberManager,
+		dteManager:       dteManager,
+	}
+}
+
+// Create Crea un nuevo Comprobante de Liquidación electrónico con base en los datos proporcionados.
+func (s *liquidationService) Create(ctx context.Context, input interface{}, branchID uint) (interface{}, error) {
+	data := input.(*liquidation_models.InputLiquidationData)
+
+	// 1. Validar la existencia de los documentos electrónicos liquidados
+	if err := s.validateLiquidatedDocs(ctx, data, branchID); err != nil {
+		logs.Error("Failed to validate liquidated documents", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 2. Crear el documento base
+	if data.LiquidationSummary.TotalInWords == "" {
+		data.LiquidationSummary.TotalInWords = utils.InLetters(data.LiquidationSummary.Total.GetValue())
+	}
+	baseDoc := createBaseDocument(data)
+	liquidation := &liquidation_models.LiquidationModel{
+		DTEDocument:        baseDoc,
+		LiquidationItems:   data.LiquidationItems,
+		LiquidationSummary: data.LiquidationSummary,
+	}
+
+	// 3. Validar el documento con las reglas del comprobante de liquidación
+	if err := s.validate(liquidation); err != nil {
+		logs.Error("Failed to validate liquidation document", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 4. Generar el número de control y el código UUID
+	if err := s.generateCodeAndIdentifiers(ctx, liquidation, branchID); err != nil {
+		return nil, err
+	}
+
+	return liquidation, nil
+}
+
+// validateLiquidatedDocs verifica que los documentos electrónicos liquidados existan y hayan sido recibidos por Hacienda,
+// tomando la fecha de generación desde el documento almacenado
+func (s *liquidationService) validateLiquidatedDocs(ctx context.Context, data *liquidation_models.InputLiquidationData, branchID uint) error {
+	for i, liquidatedDoc := range data.LiquidationItems {
+		// Los documentos físicos no se encuentran en la base de datos
+		if liquidatedDoc.GenerationType.GetValue() != constants.ElectronicDocument {
+			continue
+		}
+
+		doc, err := s.dteManager.GetReceivedDocument(ctx, branchID, liquidatedDoc.DTEType.GetValue(), liquidatedDoc.DocumentNumber.GetValue())
+		if err != nil {
+			return err
+		}
+
+		data.LiquidationItems[i].GenerationDate = *temporal.NewValidatedEmissionDate(doc.CreatedAt)
+	}
+
+	return nil
+}
+
+// validate Valida un Comprobante de Liquidación electrónico con base en las reglas de negocio.
+func (s *liquidationService) validate(liquidation *liquidation_models.LiquidationModel) error {
+	s.validator = validator.NewLiquidationRulesValidator(liquidation)
+	err := s.validator.Validate()
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"LiquidationService",
+			"Validate",
+			err,
+			"ValidationFailed",
+		)
+	}
+
+	return nil
+}
+
+// createBaseDocument Crea un documento base para el comprobante de liquidación electrónico.
+// Los documentos liquidados se manejan en el cuerpo propio del comprobante, por lo que no se asignan items genéricos.
+func createBaseDocument(data *liquidation_models.InputLiquidationData) *models.DTEDocument {
+	var extInterface interfaces.Extension
+	var appendixes []interfaces.Appendix
+
+	if data.Appendixes != nil {
+		for _, appendix := range data.Appendixes {
+			appendixes = append(appendixes, &appendix)
+		}
+	}
+
+	if data.Extension != nil {
+		extInterface = data.Extension
+	}
+
+	return &models.DTEDocument{
+		Identification: data.Identification,
+		Issuer:         data.Issuer,
+		Receiver:       data.Receiver,
+		Extension:      extInterface,
+		Appendix:       appendixes,
+	}
+}
+
+func (s *liquidationService) generateCodeAndIdentifiers(ctx context.Context, liquidation *liquidation_models.LiquidationModel, branchID uint) error {
+	if err := s.generateControlNumber(ctx, liquidation, branchID); err != nil {
+		return err
+	}
+	return liquidation.Identification.GenerateCode()
+}
+
+// generateControlNumber Genera un número de control único para el comprobante de liquidación.
+func (s *liquidationService) generateControlNumber(ctx context.Context, liquidation *liquidation_models.LiquidationModel, branchID uint) error {
+	establishmentCode := liquidation.Issuer.GetEstablishmentCode()
+	posCode := liquidation.Issuer.GetPOSCode()
+
+	controlNumber, err := s.seqNumberManager.GetNextControlNumber(
+		ctx,
+		constants.ComprobanteLiquidacionElectronico,
+		branchID,
+		posCode,
+		establishmentCode,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = liquidation.Identification.SetControlNumber(controlNumber)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"LiquidationService",
+			"GenerateControlNumber",
+			err,
+			"FailedToSetControlNumber",
+		)
+	}
+	return nil
+}
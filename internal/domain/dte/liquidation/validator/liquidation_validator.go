@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/validator/strategy"
+)
+
+type LiquidationRulesValidator struct {
+	document   *liquidation_models.LiquidationModel
+	strategies []interfaces.DTEValidationStrategy
+}
+
+// NewLiquidationRulesValidator Crea un validador de reglas para comprobantes de liquidación electrónicos
+func NewLiquidationRulesValidator(doc *liquidation_models.LiquidationModel) *LiquidationRulesValidator {
+	validator := &LiquidationRulesValidator{
+		document: doc,
+		strategies: []interfaces.DTEValidationStrategy{
+			&strategy.LiquidationItemStrategy{Document: doc},  // 1. Validaciones de documentos liquidados
+			&strategy.LiquidationTotalStrategy{Document: doc}, // 2. Validaciones de totales
+		},
+	}
+	return validator
+}
+
+// Validate Ejecuta las validaciones del comprobante de liquidación electrónico.
+func (v *LiquidationRulesValidator) Validate() *dte_errors.DTEError {
+	var validationErrors []*dte_errors.DTEError
+
+	for _, strategyValidator := range v.strategies {
+		if err := strategyValidator.Validate(); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return dte_errors.NewDTEErrorComposite(validationErrors)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/shopspring/decimal"
+)
+
+type LiquidationItemStrategy struct {
+	Document *liquidation_models.LiquidationModel
+}
+
+// Validate - Valida los documentos liquidados de un Comprobante de Liquidación
+func (s *LiquidationItemStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil || len(s.Document.LiquidationItems) == 0 {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "LiquidationItems")
+	}
+
+	// Validar número máximo de documentos liquidados
+	if len(s.Document.LiquidationItems) > 500 {
+		return dte_errors.NewDTEErrorSimple("ExceededLiquidationItemsLimit", len(s.Document.LiquidationItems))
+	}
+
+	validations := []func(item *liquidation_models.LiquidationItem) *dte_errors.DTEError{
+		s.validateDTEType,
+		s.validateGenerationDate,
+		s.validateTaxes,
+		s.validateIVA,
+	}
+
+	for _, item := range s.Document.LiquidationItems {
+		for _, validate := range validations {
+			if err := validate(&item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateDTEType valida que el tipo de documento liquidado sea permitido
+func (s *LiquidationItemStrategy) validateDTEType(item *liquidation_models.LiquidationItem) *dte_errors.DTEError {
+	if !constants.ValidLiquidationDTETypesItem[item.DTEType.GetValue()] {
+		return dte_errors.NewDTEErrorSimple("InvalidLiquidationItemDTEType",
+			item.Number.GetValue(),
+			item.DTEType.GetValue(),
+			constants.ShowValidRelatedDocTypes(constants.ValidLiquidationDTETypesItem))
+	}
+
+	return nil
+}
+
+// validateGenerationDate valida que el documento liquidado no tenga una fecha posterior a la emisión del comprobante
+func (s *LiquidationItemStrategy) validateGenerationDate(item *liquidation_models.LiquidationItem) *dte_errors.DTEError {
+	emissionDate := s.Document.Identification.GetEmissionDate()
+	if item.GenerationDate.GetValue().After(emissionDate) {
+		logs.Info("Liquidated document date is after the emission date", map[string]interface{}{
+			"item_number":     item.Number.GetValue(),
+			"generation_date": item.GenerationDate.GetValue(),
+			"emission_date":   emissionDate,
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidLiquidationItemDate",
+			item.Number.GetValue(),
+			item.DocumentNumber.GetValue())
+	}
+
+	return nil
+}
+
+// validateTaxes valida que los documentos con venta gravada declaren el IVA y que los demás no declaren tributos
+func (s *LiquidationItemStrategy) validateTaxes(item *liquidation_models.LiquidationItem) *dte_errors.DTEError {
+	if item.TaxedSale.GetValue() > 0 {
+		for _, tax := range item.Taxes {
+			if tax == constants.TaxIVA {
+				return nil
+			}
+		}
+		return dte_errors.NewDTEErrorSimple("MissingTaxesItem", item.Number.GetValue())
+	}
+
+	if len(item.Taxes) > 0 {
+		return dte_errors.NewDTEErrorSimple("InvalidTaxesWithNonTaxed", item.Number.GetValue())
+	}
+
+	return nil
+}
+
+// validateIVA valida que el IVA del documento liquidado sea el 13% de la venta gravada
+func (s *LiquidationItemStrategy) validateIVA(item *liquidation_models.LiquidationItem) *dte_errors.DTEError {
+	expected := item.TaxedSale.GetValueAsDecimal().Mul(decimal.NewFromFloat(constants.TaxIvaAmount))
+	actual := item.IVAItem.GetValueAsDecimal()
+
+	if !s.compareTotalsWithTolerance(expected, actual, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidLiquidationItemIVA",
+			item.Number.GetValue(),
+			actual.InexactFloat64(),
+			expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// compareTotalsWithTolerance compara dos totales con una tolerancia especificada
+func (s *LiquidationItemStrategy) compareTotalsWithTolerance(expected, actual decimal.Decimal, tolerance float64) bool {
+	diff := expected.Sub(actual).Abs()
+	return diff.LessThanOrEqual(decimal.NewFromFloat(tolerance))
+}
@@ -0,0 +1,145 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/shopspring/decimal"
+)
+
+type LiquidationTotalStrategy struct {
+	Document *liquidation_models.LiquidationModel
+}
+
+func (s *LiquidationTotalStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil || s.Document.LiquidationSummary == nil {
+		return nil
+	}
+
+	validations := []func() *dte_errors.DTEError{
+		s.validateSalesTotals,
+		s.validateSubTotalSales,
+		s.validateTaxes,
+		s.validateTotalOperation,
+		s.validateIVAPerception,
+		s.validateTotal,
+	}
+
+	for _, validate := range validations {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateSalesTotals valida que los totales por tipo de venta concuerden con la suma de los documentos liquidados
+func (s *LiquidationTotalStrategy) validateSalesTotals() *dte_errors.DTEError {
+	summary := s.Document.LiquidationSummary
+	nonSubject, exempt, taxed, export := s.Document.GetSalesByItems()
+
+	if !s.compareTotalsWithTolerance(nonSubject, summary.TotalNonSubject.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalNonSubject", summary.TotalNonSubject.GetValue(), nonSubject.InexactFloat64())
+	}
+
+	if !s.compareTotalsWithTolerance(exempt, summary.TotalExempt.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalExempt", summary.TotalExempt.GetValue(), exempt.InexactFloat64())
+	}
+
+	if !s.compareTotalsWithTolerance(taxed, summary.TotalTaxed.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalTaxed", summary.TotalTaxed.GetValue(), taxed.InexactFloat64())
+	}
+
+	if !s.compareTotalsWithTolerance(export, summary.TotalExport.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalExport", summary.TotalExport.GetValue(), export.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateSubTotalSales valida que el subtotal de ventas sea la suma de todos los tipos de venta
+func (s *LiquidationTotalStrategy) validateSubTotalSales() *dte_errors.DTEError {
+	summary := s.Document.LiquidationSummary
+	expected := summary.TotalNonSubject.GetValueAsDecimal().
+		Add(summary.TotalExempt.GetValueAsDecimal()).
+		Add(summary.TotalTaxed.GetValueAsDecimal()).
+		Add(summary.TotalExport.GetValueAsDecimal())
+
+	if !s.compareTotalsWithTolerance(expected, summary.SubTotalSales.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidSubTotalSales", summary.SubTotalSales.GetValue(), expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateTaxes valida que el IVA del resumen corresponda a la suma del IVA de los documentos liquidados
+func (s *LiquidationTotalStrategy) validateTaxes() *dte_errors.DTEError {
+	summary := s.Document.LiquidationSummary
+	expectedIVA := s.Document.GetIVAByItems()
+
+	var actualIVA decimal.Decimal
+	for _, tax := range summary.Taxes {
+		if tax.GetCode() == constants.TaxIVA {
+			actualIVA = actualIVA.Add(decimal.NewFromFloat(tax.GetValue()))
+		}
+	}
+
+	if expectedIVA.GreaterThan(decimal.Zero) && len(summary.Taxes) == 0 {
+		return dte_errors.NewDTEErrorSimple("MissingTaxes")
+	}
+
+	if !s.compareTotalsWithTolerance(expectedIVA, actualIVA, 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidIVACalculation", expectedIVA.InexactFloat64(), actualIVA.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateTotalOperation valida que el monto total de la operación sea el subtotal de ventas más los tributos
+func (s *LiquidationTotalStrategy) validateTotalOperation() *dte_errors.DTEError {
+	summary := s.Document.LiquidationSummary
+	expected := summary.SubTotalSales.GetValueAsDecimal()
+	for _, tax := range summary.Taxes {
+		expected = expected.Add(decimal.NewFromFloat(tax.GetValue()))
+	}
+
+	if !s.compareTotalsWithTolerance(expected, summary.TotalOperation.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalOperation", summary.TotalOperation.GetValue(), expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateIVAPerception valida que el IVA percibido, si se aplica, sea el 1% del total gravado
+func (s *LiquidationTotalStrategy) validateIVAPerception() *dte_errors.DTEError {
+	summary := s.Document.LiquidationSummary
+	if summary.IVAPerception.GetValue() == 0 {
+		return nil
+	}
+
+	expected := summary.TotalTaxed.GetValueAsDecimal().Mul(decimal.NewFromFloat(constants.IVAPerceptionAmount))
+	if !s.compareTotalsWithTolerance(expected, summary.IVAPerception.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidIVAPerception", summary.IVAPerception.GetValue(), expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// validateTotal valida que el total sea el monto total de la operación más el IVA percibido
+func (s *LiquidationTotalStrategy) validateTotal() *dte_errors.DTEError {
+	summary := s.Document.LiquidationSummary
+	expected := summary.TotalOperation.GetValueAsDecimal().Add(summary.IVAPerception.GetValueAsDecimal())
+
+	if !s.compareTotalsWithTolerance(expected, summary.Total.GetValueAsDecimal(), 0.01) {
+		return dte_errors.NewDTEErrorSimple("InvalidLiquidationTotal", summary.Total.GetValue(), expected.InexactFloat64())
+	}
+
+	return nil
+}
+
+// compareTotalsWithTolerance compara dos totales con una tolerancia especificada
+func (s *LiquidationTotalStrategy) compareTotalsWithTolerance(expected, actual decimal.Decimal, tolerance float64) bool {
+	diff := expected.Sub(actual).Abs()
+	return diff.LessThanOrEqual(decimal.NewFromFloat(tolerance))
+}
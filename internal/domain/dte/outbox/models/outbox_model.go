@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+const (
+	// EntryPending indica que se registró la intención de transmitir el documento y la transmisión está en curso, o se
+	// interrumpió antes de conocer su resultado
+	EntryPending = "PENDING"
+	// EntryTransmitted indica que Hacienda recibió el documento y falta almacenarlo
+	EntryTransmitted = "TRANSMITTED"
+	// EntrySaved indica que el documento se almacenó y faltan sus operaciones adicionales
+	EntrySaved = "SAVED"
+	// EntryCompleted indica que el documento se almacenó y se ejecutaron sus operaciones adicionales
+	EntryCompleted = "COMPLETED"
+	// EntryNotTransmitted indica que Hacienda no recibió el documento, por lo que no hay nada que completar
+	EntryNotTransmitted = "NOT_TRANSMITTED"
+	// EntryFailed indica que se agotaron los intentos de completar el documento recibido por Hacienda
+	EntryFailed = "FAILED"
+)
+
+// OutboxEntry representa un documento cuya transmisión a Hacienda debe completarse localmente: el modelo de Hacienda
+// se registra antes de transmitirlo y el resultado de la transmisión se registra antes de almacenarlo
+type OutboxEntry struct {
+	ID             uint
+	BranchID       uint
+	GenerationCode string
+	ControlNumber  string
+	DTEType        string
+	Status         string
+	Document       string
+	ReceptionStamp *string
+	MHResponse     string
+	Attempts       int
+	LastError      string
+	NextAttemptAt  *time.Time
+	LockedUntil    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// IsFinished indica si el documento ya no será completado por el job del outbox
+func (e *OutboxEntry) IsFinished() bool {
+	return e.Status == EntryCompleted || e.Status == EntryNotTransmitted || e.Status == EntryFailed
+}
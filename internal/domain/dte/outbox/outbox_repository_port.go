@@ -0,0 +1,24 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox/models"
+)
+
+// OutboxRepositoryPort interfaz para el outbox de los documentos transmitidos a Hacienda
+type OutboxRepositoryPort interface {
+	// Create registra la intención de transmitir un documento
+	Create(ctx context.Context, entry *models.OutboxEntry) error
+	// Update actualiza en una sola operación el estado, el resultado de la transmisión, los intentos, el último error,
+	// el próximo intento y la reserva de un documento
+	Update(ctx context.Context, entry *models.OutboxEntry) error
+	// GetDue obtiene los documentos recibidos por Hacienda pendientes de completarse cuyo próximo intento ya se cumplió
+	GetDue(ctx context.Context, now time.Time, limit int) ([]models.OutboxEntry, error)
+	// Claim reserva el documento hasta until solo si sigue pendiente de completarse, su próximo intento ya se cumplió y
+	// no está reservado, de modo que un único proceso lo complete
+	Claim(ctx context.Context, id uint, now, until time.Time) (bool, error)
+	// GetInterrupted obtiene los documentos cuya transmisión se registró en el rango indicado sin conocerse su resultado
+	GetInterrupted(ctx context.Context, since, before time.Time, limit int) ([]models.OutboxEntry, error)
+}
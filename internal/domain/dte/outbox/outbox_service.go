@@ -0,0 +1,207 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox/models"
+	transmitterModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+const (
+	// MaxAttempts es la cantidad máxima de intentos para completar un documento recibido por Hacienda
+	MaxAttempts = 24
+	// RetryBaseDelay es la espera antes del primer reintento, se duplica en cada intento fallido
+	RetryBaseDelay = time.Minute
+	// RetryMaxDelay es la espera máxima entre reintentos
+	RetryMaxDelay = 30 * time.Minute
+	// ClaimDuration es el tiempo durante el que un documento queda reservado para completarse, debe superar la duración
+	// de almacenarlo y ejecutar sus operaciones adicionales
+	ClaimDuration = 5 * time.Minute
+	// InterruptedAfter es el tiempo tras el cual una transmisión registrada sin resultado se considera interrumpida, debe
+	// superar la duración de los reintentos de transmisión
+	InterruptedAfter = 30 * time.Minute
+	// InterruptedLookback es el periodo en el que se revisan las transmisiones interrumpidas
+	InterruptedLookback = constants.ContingencyTransmissionDeadline
+	// maxErrorLength es la longitud máxima del último error almacenado
+	maxErrorLength = 500
+)
+
+type OutboxService struct {
+	repo OutboxRepositoryPort
+}
+
+func NewOutboxService(repo OutboxRepositoryPort) OutboxManager {
+	return &OutboxService{
+		repo: repo,
+	}
+}
+
+// Register registra el modelo de Hacienda del documento como pendiente de transmisión
+func (s *OutboxService) Register(ctx context.Context, branchID uint, document interface{}) (*models.OutboxEntry, error) {
+	// 1. Serializar el documento y extraer su identificación
+	jsonData, err := json.Marshal(document)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("OutboxService", "Register", err, "FailedToRegisterOutboxEntry", "")
+	}
+
+	identification, err := utils.ExtractAuxiliarIdentification(document)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("OutboxService", "Register", err, "FailedToRegisterOutboxEntry", "")
+	}
+
+	// 2. Registrar la intención de transmisión
+	now := utils.TimeNow()
+	entry := &models.OutboxEntry{
+		BranchID:       branchID,
+		GenerationCode: identification.Identification.GenerationCode,
+		ControlNumber:  identification.Identification.ControlNumber,
+		DTEType:        identification.Identification.DTEType,
+		Status:         models.EntryPending,
+		Document:       string(jsonData),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err = s.repo.Create(ctx, entry); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("OutboxService", "Register", err, "FailedToRegisterOutboxEntry", entry.GenerationCode)
+	}
+
+	return entry, nil
+}
+
+// RecordTransmission registra el sello y la respuesta de Hacienda, el documento queda listo para almacenarse. La
+// solicitud que lo transmitió lo completa con la reserva de ClaimDuration; el job solo lo retoma si el intento falla o
+// la reserva expira.
+func (s *OutboxService) RecordTransmission(ctx context.Context, entry *models.OutboxEntry, result *transmitterModels.TransmitResult) error {
+	mhResponse, err := json.Marshal(result)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("OutboxService", "RecordTransmission", err, "FailedToUpdateOutboxEntry", entry.GenerationCode)
+	}
+
+	now := utils.TimeNow()
+	entry.Status = models.EntryTransmitted
+	entry.ReceptionStamp = result.ReceptionStamp
+	entry.MHResponse = string(mhResponse)
+	next, lockedUntil := now.Add(RetryBaseDelay), now.Add(ClaimDuration)
+	entry.NextAttemptAt = &next
+	entry.LockedUntil = &lockedUntil
+
+	return s.update(ctx, entry, "RecordTransmission")
+}
+
+// RecordNotTransmitted registra que Hacienda no recibió el documento junto a la causa
+func (s *OutboxService) RecordNotTransmitted(ctx context.Context, entry *models.OutboxEntry, cause error) error {
+	entry.Status = models.EntryNotTransmitted
+	entry.LastError = truncate(cause.Error(), maxErrorLength)
+	entry.NextAttemptAt = nil
+
+	return s.update(ctx, entry, "RecordNotTransmitted")
+}
+
+// Advance registra el siguiente estado del documento y limpia el error del intento anterior
+func (s *OutboxService) Advance(ctx context.Context, entry *models.OutboxEntry, status string) error {
+	entry.Status = status
+	entry.LastError = ""
+	if entry.IsFinished() {
+		entry.NextAttemptAt = nil
+		entry.LockedUntil = nil
+	}
+
+	return s.update(ctx, entry, "Advance")
+}
+
+// Retry registra el intento fallido. Mientras no se agoten los intentos el documento se reprograma con una espera
+// exponencial, conservando su estado para continuar desde el paso que falló.
+func (s *OutboxService) Retry(ctx context.Context, entry *models.OutboxEntry, cause error) error {
+	entry.Attempts++
+	entry.LastError = truncate(cause.Error(), maxErrorLength)
+	entry.LockedUntil = nil
+
+	if entry.Attempts < MaxAttempts {
+		next := utils.TimeNow().Add(NextRetryDelay(entry.Attempts))
+		entry.NextAttemptAt = &next
+	} else {
+		entry.Status = models.EntryFailed
+		entry.NextAttemptAt = nil
+		logs.Error("Outbox entry exhausted its attempts and requires manual review", map[string]interface{}{
+			"generationCode": entry.GenerationCode,
+			"attempts":       entry.Attempts,
+			"error":          entry.LastError,
+		})
+	}
+
+	return s.update(ctx, entry, "Retry")
+}
+
+// GetDue obtiene los documentos recibidos por Hacienda cuyo próximo intento ya se cumplió
+func (s *OutboxService) GetDue(ctx context.Context, limit int) ([]models.OutboxEntry, error) {
+	entries, err := s.repo.GetDue(ctx, utils.TimeNow(), limit)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("OutboxService", "GetDue", err, "FailedToGetOutboxEntries")
+	}
+
+	return entries, nil
+}
+
+// Claim reserva el documento durante ClaimDuration para completarlo
+func (s *OutboxService) Claim(ctx context.Context, entry *models.OutboxEntry) (bool, error) {
+	now := utils.TimeNow()
+	lockedUntil := now.Add(ClaimDuration)
+
+	claimed, err := s.repo.Claim(ctx, entry.ID, now, lockedUntil)
+	if err != nil {
+		return false, shared_error.NewFormattedGeneralServiceWithError("OutboxService", "Claim", err, "FailedToUpdateOutboxEntry", entry.GenerationCode)
+	}
+
+	if claimed {
+		entry.LockedUntil = &lockedUntil
+	}
+
+	return claimed, nil
+}
+
+// GetInterrupted obtiene los documentos que siguen pendientes de transmisión tras InterruptedAfter, dentro del plazo
+// de InterruptedLookback
+func (s *OutboxService) GetInterrupted(ctx context.Context, limit int) ([]models.OutboxEntry, error) {
+	now := utils.TimeNow()
+	entries, err := s.repo.GetInterrupted(ctx, now.Add(-InterruptedLookback), now.Add(-InterruptedAfter), limit)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("OutboxService", "GetInterrupted", err, "FailedToGetOutboxEntries")
+	}
+
+	return entries, nil
+}
+
+func (s *OutboxService) update(ctx context.Context, entry *models.OutboxEntry, operation string) error {
+	entry.UpdatedAt = utils.TimeNow()
+	if err := s.repo.Update(ctx, entry); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("OutboxService", operation, err, "FailedToUpdateOutboxEntry", entry.GenerationCode)
+	}
+
+	return nil
+}
+
+// NextRetryDelay calcula la espera antes del siguiente intento según los intentos realizados: 1m, 2m, 4m... hasta un
+// máximo de RetryMaxDelay
+func NextRetryDelay(attempts int) time.Duration {
+	delay := RetryBaseDelay
+	for i := 1; i < attempts && delay < RetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, RetryMaxDelay)
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+	return string(runes[:length])
+}
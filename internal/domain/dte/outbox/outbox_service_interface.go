@@ -0,0 +1,28 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox/models"
+	transmitterModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+)
+
+// OutboxManager define las operaciones del outbox de los documentos transmitidos a Hacienda
+type OutboxManager interface {
+	// Register registra la intención de transmitir el documento antes de enviarlo a Hacienda
+	Register(ctx context.Context, branchID uint, document interface{}) (*models.OutboxEntry, error)
+	// RecordTransmission registra que Hacienda recibió el documento junto a su sello y respuesta
+	RecordTransmission(ctx context.Context, entry *models.OutboxEntry, result *transmitterModels.TransmitResult) error
+	// RecordNotTransmitted registra que Hacienda no recibió el documento
+	RecordNotTransmitted(ctx context.Context, entry *models.OutboxEntry, cause error) error
+	// Advance registra el siguiente estado de un documento recibido por Hacienda
+	Advance(ctx context.Context, entry *models.OutboxEntry, status string) error
+	// Retry registra el intento fallido de completar un documento y lo reprograma mientras no se agoten los intentos
+	Retry(ctx context.Context, entry *models.OutboxEntry, cause error) error
+	// GetDue obtiene los documentos recibidos por Hacienda listos para completarse
+	GetDue(ctx context.Context, limit int) ([]models.OutboxEntry, error)
+	// Claim reserva un documento obtenido con GetDue para completarlo, retorna false si otro proceso ya lo reservó
+	Claim(ctx context.Context, entry *models.OutboxEntry) (bool, error)
+	// GetInterrupted obtiene los documentos cuya transmisión se interrumpió sin conocerse su resultado
+	GetInterrupted(ctx context.Context, limit int) ([]models.OutboxEntry, error)
+}
@@ -0,0 +1,95 @@
+package models
+
+import "time"
+
+// Origen de los documentos que revisa la conciliación
+const (
+	// SourcePendingDocument es un documento almacenado que sigue pendiente de transmisión
+	SourcePendingDocument = "PENDING_DOCUMENT"
+	// SourceFailedSequence es un documento registrado en los números de secuencia fallidos
+	SourceFailedSequence = "FAILED_SEQUENCE"
+	// SourceOutboxIntent es un documento registrado en el outbox cuya transmisión se interrumpió sin conocerse su resultado
+	SourceOutboxIntent = "OUTBOX_INTENT"
+)
+
+// Acciones con las que la conciliación repara una discrepancia
+const (
+	// ActionMarkedReceived indica que el documento pendiente se marcó como recibido con el sello de Hacienda
+	ActionMarkedReceived = "MARKED_RECEIVED"
+	// ActionMarkedRejected indica que el documento pendiente se marcó como rechazado
+	ActionMarkedRejected = "MARKED_REJECTED"
+	// ActionRecovered indica que el documento recibido por Hacienda no existía y se almacenó como recibido
+	ActionRecovered = "RECOVERED"
+	// ActionResumed indica que el documento del outbox recibido por Hacienda se retomó para almacenarse desde el outbox
+	ActionResumed = "RESUMED"
+)
+
+// PendingDocument representa un documento almacenado que sigue pendiente de transmisión
+type PendingDocument struct {
+	GenerationCode string
+	BranchID       uint
+	DTEType        string
+	ControlNumber  string
+	Transmission   string
+	JSONData       string
+	CreatedAt      time.Time
+}
+
+// FailedSequence representa un documento registrado en los números de secuencia fallidos que aún no se concilia
+type FailedSequence struct {
+	ID                  uint
+	BranchID            uint
+	DTEType             string
+	SequenceNumber      uint
+	OriginalRequestData string
+	CreatedAt           time.Time
+}
+
+// Discrepancy representa una diferencia entre el estado local de un documento y su estado en Hacienda, junto a la
+// acción con la que se reparó
+type Discrepancy struct {
+	ID             uint      `json:"id"`
+	UserID         uint      `json:"-"`
+	BranchID       uint      `json:"branch_id"`
+	GenerationCode string    `json:"generation_code"`
+	ControlNumber  string    `json:"control_number"`
+	DTEType        string    `json:"dte_type"`
+	Source         string    `json:"source"`
+	LocalStatus    string    `json:"local_status"`
+	MHStatus       string    `json:"mh_status"`
+	ReceptionStamp *string   `json:"reception_stamp,omitempty"`
+	Action         string    `json:"action"`
+	Detail         string    `json:"detail,omitempty"`
+	DetectedAt     time.Time `json:"detected_at"`
+}
+
+// ReconciliationResult contiene el resumen de una ejecución de la conciliación
+type ReconciliationResult struct {
+	Checked       int `json:"checked"`
+	Discrepancies int `json:"discrepancies"`
+}
+
+// DiscrepancyFilters contiene los filtros para consultar las discrepancias de un usuario
+type DiscrepancyFilters struct {
+	BranchID *uint
+	Source   string
+	Action   string
+
+	// Paginación
+	Page     int
+	PageSize int
+}
+
+// DiscrepancyPage contiene una página de discrepancias y el total que cumplen los filtros
+type DiscrepancyPage struct {
+	Discrepancies []Discrepancy         `json:"discrepancies"`
+	Pagination    DiscrepancyPagination `json:"pagination"`
+}
+
+// DiscrepancyPagination contiene la información de paginación de una consulta de discrepancias
+type DiscrepancyPagination struct {
+	Page       int   `json:"page"`
+	PageSize   int   `json:"page_size"`
+	TotalItems int64 `json:"total_items"`
+	TotalPages int   `json:"total_pages"`
+}
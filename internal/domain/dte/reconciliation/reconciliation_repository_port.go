@@ -0,0 +1,24 @@
+package reconciliation
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation/models"
+)
+
+// ReconciliationRepositoryPort define las consultas y registros de la conciliación con Hacienda
+type ReconciliationRepositoryPort interface {
+	// GetPendingDocuments obtiene los documentos pendientes creados antes de la fecha indicada, del más antiguo al más reciente
+	GetPendingDocuments(ctx context.Context, createdBefore time.Time, limit int) ([]models.PendingDocument, error)
+	// GetUnreconciledSequences obtiene los números de secuencia fallidos registrados desde la fecha indicada que aún no se concilian
+	GetUnreconciledSequences(ctx context.Context, since time.Time, limit int) ([]models.FailedSequence, error)
+	// MarkSequenceReconciled marca un número de secuencia fallido como conciliado
+	MarkSequenceReconciled(ctx context.Context, id uint) error
+	// DocumentExists indica si el documento con el código de generación indicado está almacenado
+	DocumentExists(ctx context.Context, generationCode string) (bool, error)
+	// SaveDiscrepancy registra una discrepancia encontrada por la conciliación
+	SaveDiscrepancy(ctx context.Context, discrepancy *models.Discrepancy) error
+	// ListDiscrepancies obtiene las discrepancias de un usuario que cumplen los filtros, de la más reciente a la más antigua
+	ListDiscrepancies(ctx context.Context, userID uint, filters *models.DiscrepancyFilters) ([]models.Discrepancy, int64, error)
+}
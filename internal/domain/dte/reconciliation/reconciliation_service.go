@@ -0,0 +1,534 @@
+package reconciliation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	appPorts "github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	authModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox"
+	outboxModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+const (
+	// DefaultPageSize es la cantidad de discrepancias por página cuando no se indica
+	DefaultPageSize = 20
+	// MaxPageSize es la cantidad máxima de discrepancias por página
+	MaxPageSize = 100
+	// BatchSize es la cantidad máxima de documentos de cada origen que se revisan en una ejecución
+	BatchSize = 100
+	// PendingDocumentMinAge es la antigüedad mínima de un documento pendiente para consultarlo en Hacienda, los más
+	// recientes aún pueden estar transmitiéndose desde la cola de emisión
+	PendingDocumentMinAge = 30 * time.Minute
+	// FailedSequenceLookback es el periodo en el que se revisan los números de secuencia fallidos
+	FailedSequenceLookback = constants.ContingencyTransmissionDeadline
+
+	// MHProcessedStatus y MHRejectedStatus son los estados con los que Hacienda responde la consulta de un documento
+	MHProcessedStatus = "PROCESADO"
+	MHRejectedStatus  = "RECHAZADO"
+
+	// LocalStatusMissing es el estado local de un documento que Hacienda recibió pero no está almacenado
+	LocalStatusMissing = "MISSING"
+)
+
+type ReconciliationService struct {
+	repo         ReconciliationRepositoryPort
+	authManager  auth.AuthManager
+	dteManager   dte_documents.DTEManager
+	transmitter  appPorts.DTETransmitter
+	cache        ports.CacheManager
+	tokenService ports.TokenManager
+	events       events.EventPublisher
+	outbox       outbox.OutboxManager
+}
+
+// branchSession contiene el contexto con el que se consultan en Hacienda los documentos de una sucursal
+type branchSession struct {
+	ctx    context.Context
+	userID uint
+	nit    string
+}
+
+func NewReconciliationService(
+	repo ReconciliationRepositoryPort,
+	authManager auth.AuthManager,
+	dteManager dte_documents.DTEManager,
+	transmitter appPorts.DTETransmitter,
+	cache ports.CacheManager,
+	tokenService ports.TokenManager,
+	eventPublisher events.EventPublisher,
+	outboxManager outbox.OutboxManager,
+) ReconciliationManager {
+	return &ReconciliationService{
+		repo:         repo,
+		authManager:  authManager,
+		dteManager:   dteManager,
+		transmitter:  transmitter,
+		cache:        cache,
+		tokenService: tokenService,
+		events:       eventPublisher,
+		outbox:       outboxManager,
+	}
+}
+
+// Reconcile consulta en Hacienda los documentos pendientes, los números de secuencia fallidos y las transmisiones
+// interrumpidas del outbox. Los documentos que Hacienda procesó o rechazó se actualizan localmente, los que recibió sin
+// que se almacenaran se recuperan a partir de la solicitud original y los del outbox se retoman para que el outbox los
+// complete. Cada reparación se registra como una discrepancia.
+func (s *ReconciliationService) Reconcile(ctx context.Context) (*models.ReconciliationResult, error) {
+	result := &models.ReconciliationResult{}
+	sessions := make(map[uint]*branchSession)
+	now := utils.TimeNow()
+
+	// 1. Revisar los documentos que siguen pendientes
+	pending, err := s.repo.GetPendingDocuments(ctx, now.Add(-PendingDocumentMinAge), BatchSize)
+	if err != nil {
+		return nil, shared_error.NewGeneralServiceError("ReconciliationService", "Reconcile", "failed to get pending documents", err)
+	}
+
+	for i := range pending {
+		if err = ctx.Err(); err != nil {
+			return result, err
+		}
+
+		session := s.session(ctx, sessions, pending[i].BranchID)
+		if session == nil {
+			continue
+		}
+
+		result.Checked++
+		if s.reconcilePending(session, &pending[i]) {
+			result.Discrepancies++
+		}
+	}
+
+	// 2. Revisar los documentos registrados en los números de secuencia fallidos
+	sequences, err := s.repo.GetUnreconciledSequences(ctx, now.Add(-FailedSequenceLookback), BatchSize)
+	if err != nil {
+		return result, shared_error.NewGeneralServiceError("ReconciliationService", "Reconcile", "failed to get failed sequences", err)
+	}
+
+	for i := range sequences {
+		if err = ctx.Err(); err != nil {
+			return result, err
+		}
+
+		checked, discrepancy := s.reconcileSequence(ctx, sessions, &sequences[i])
+		if checked {
+			result.Checked++
+		}
+		if discrepancy {
+			result.Discrepancies++
+		}
+	}
+
+	// 3. Revisar las transmisiones interrumpidas del outbox
+	if s.outbox == nil {
+		return result, nil
+	}
+
+	interrupted, err := s.outbox.GetInterrupted(ctx, BatchSize)
+	if err != nil {
+		return result, err
+	}
+
+	for i := range interrupted {
+		if err = ctx.Err(); err != nil {
+			return result, err
+		}
+
+		session := s.session(ctx, sessions, interrupted[i].BranchID)
+		if session == nil {
+			continue
+		}
+
+		result.Checked++
+		if s.reconcileInterrupted(session, &interrupted[i]) {
+			result.Discrepancies++
+		}
+	}
+
+	return result, nil
+}
+
+// ListDiscrepancies obtiene una página de las discrepancias de las sucursales del usuario
+func (s *ReconciliationService) ListDiscrepancies(ctx context.Context, userID uint, filters *models.DiscrepancyFilters) (*models.DiscrepancyPage, error) {
+	// 1. Normalizar la paginación
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PageSize < 1 {
+		filters.PageSize = DefaultPageSize
+	}
+	filters.PageSize = min(filters.PageSize, MaxPageSize)
+
+	// 2. Consultar las discrepancias
+	discrepancies, total, err := s.repo.ListDiscrepancies(ctx, userID, filters)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ReconciliationService", "ListDiscrepancies", err, "FailedToGetReconciliationDiscrepancies")
+	}
+
+	totalPages := int(total) / filters.PageSize
+	if int(total)%filters.PageSize > 0 {
+		totalPages++
+	}
+
+	return &models.DiscrepancyPage{
+		Discrepancies: discrepancies,
+		Pagination: models.DiscrepancyPagination{
+			Page:       filters.Page,
+			PageSize:   filters.PageSize,
+			TotalItems: total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// reconcilePending consulta un documento pendiente en Hacienda y actualiza su estado si Hacienda ya lo procesó o lo
+// rechazó, indicando si se encontró una discrepancia
+func (s *ReconciliationService) reconcilePending(session *branchSession, doc *models.PendingDocument) bool {
+	// 1. Consultar el estado del documento en Hacienda
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(doc.JSONData), &document); err != nil {
+		logs.Error("Failed to parse pending document", map[string]interface{}{
+			"generationCode": doc.GenerationCode,
+			"error":          err.Error(),
+		})
+		return false
+	}
+
+	status, err := s.transmitter.CheckDocumentStatus(session.ctx, document, session.nit)
+	if err != nil {
+		// Hacienda no conoce el documento o no respondió, el documento sigue pendiente
+		logs.Debug("Pending document not found in Hacienda", map[string]interface{}{
+			"generationCode": doc.GenerationCode,
+			"error":          err.Error(),
+		})
+		return false
+	}
+
+	discrepancy := &models.Discrepancy{
+		UserID:         session.userID,
+		BranchID:       doc.BranchID,
+		GenerationCode: doc.GenerationCode,
+		ControlNumber:  doc.ControlNumber,
+		DTEType:        doc.DTEType,
+		Source:         models.SourcePendingDocument,
+		LocalStatus:    constants.DocumentPending,
+		MHStatus:       status.Status,
+		ReceptionStamp: status.ReceptionStamp,
+	}
+	payload := event.DTEPayload{
+		GenerationCode: doc.GenerationCode,
+		ControlNumber:  doc.ControlNumber,
+		DTEType:        doc.DTEType,
+	}
+
+	// 2. Actualizar el estado local según la respuesta de Hacienda
+	var eventType string
+	update := dte.DTEDetails{ID: doc.GenerationCode}
+	switch status.Status {
+	case MHProcessedStatus:
+		update.Status, update.ReceptionStamp = constants.DocumentReceived, status.ReceptionStamp
+		discrepancy.Action, eventType = models.ActionMarkedReceived, event.DTEReceived
+		payload.ReceptionStamp = status.ReceptionStamp
+	case MHRejectedStatus:
+		update.Status = constants.DocumentRejected
+		discrepancy.Action, eventType = models.ActionMarkedRejected, event.DTERejected
+		discrepancy.Detail, payload.Reason = status.MessageDesc, status.MessageDesc
+	default:
+		return false
+	}
+
+	if err = s.dteManager.UpdateDTE(session.ctx, doc.BranchID, update); err != nil {
+		logs.Error("Failed to repair pending document", map[string]interface{}{
+			"generationCode": doc.GenerationCode,
+			"mhStatus":       status.Status,
+			"error":          err.Error(),
+		})
+		return false
+	}
+
+	// 3. Registrar la discrepancia y publicar el evento del nuevo estado
+	payload.Status = update.Status
+	s.saveDiscrepancy(session.ctx, discrepancy)
+	if s.events != nil {
+		s.events.Publish(session.ctx, doc.BranchID, eventType, payload)
+	}
+
+	return true
+}
+
+// reconcileSequence recupera un documento registrado en los números de secuencia fallidos que Hacienda recibió pero no
+// está almacenado, indicando si se consultó en Hacienda y si se encontró una discrepancia. Los números de secuencia
+// sin respuesta de Hacienda se revisan de nuevo en la siguiente ejecución.
+func (s *ReconciliationService) reconcileSequence(ctx context.Context, sessions map[uint]*branchSession, sequence *models.FailedSequence) (bool, bool) {
+	// 1. Obtener la identificación del documento, las invalidaciones no se concilian
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(sequence.OriginalRequestData), &document); err != nil {
+		logs.Error("Failed to parse failed sequence document", map[string]interface{}{
+			"id":    sequence.ID,
+			"error": err.Error(),
+		})
+		s.markReconciled(ctx, sequence.ID)
+		return false, false
+	}
+
+	identification, err := utils.ExtractAuxiliarIdentification(document)
+	generationCode := identification.Identification.GenerationCode
+	if err != nil || identification.Identification.DTEType == "" || generationCode == "" {
+		s.markReconciled(ctx, sequence.ID)
+		return false, false
+	}
+
+	// 2. Verificar si el documento está almacenado
+	exists, err := s.repo.DocumentExists(ctx, generationCode)
+	if err != nil {
+		logs.Error("Failed to verify failed sequence document", map[string]interface{}{
+			"generationCode": generationCode,
+			"error":          err.Error(),
+		})
+		return false, false
+	}
+	if exists {
+		s.markReconciled(ctx, sequence.ID)
+		return false, false
+	}
+
+	// 3. Consultar el documento en Hacienda
+	session := s.session(ctx, sessions, sequence.BranchID)
+	if session == nil {
+		return false, false
+	}
+
+	status, err := s.transmitter.CheckDocumentStatus(session.ctx, document, session.nit)
+	if err != nil {
+		logs.Debug("Failed sequence document not found in Hacienda", map[string]interface{}{
+			"generationCode": generationCode,
+			"error":          err.Error(),
+		})
+		return true, false
+	}
+
+	// 4. Si Hacienda tampoco lo procesó ambos estados coinciden
+	if status.Status != MHProcessedStatus {
+		s.markReconciled(ctx, sequence.ID)
+		return true, false
+	}
+
+	// 5. Almacenar el documento recibido por Hacienda
+	if err = s.dteManager.Create(session.ctx, document, constants.TransmissionNormal, constants.DocumentReceived, status.ReceptionStamp); err != nil {
+		logs.Error("Failed to recover document received by Hacienda", map[string]interface{}{
+			"generationCode": generationCode,
+			"error":          err.Error(),
+		})
+		return true, false
+	}
+	s.markReconciled(ctx, sequence.ID)
+
+	// 6. Registrar la discrepancia y publicar el evento de recepción
+	s.saveDiscrepancy(session.ctx, &models.Discrepancy{
+		UserID:         session.userID,
+		BranchID:       sequence.BranchID,
+		GenerationCode: generationCode,
+		ControlNumber:  identification.Identification.ControlNumber,
+		DTEType:        identification.Identification.DTEType,
+		Source:         models.SourceFailedSequence,
+		LocalStatus:    LocalStatusMissing,
+		MHStatus:       status.Status,
+		ReceptionStamp: status.ReceptionStamp,
+		Action:         models.ActionRecovered,
+	})
+	if s.events != nil {
+		s.events.Publish(session.ctx, sequence.BranchID, event.DTEReceived, event.DTEPayload{
+			GenerationCode: generationCode,
+			ControlNumber:  identification.Identification.ControlNumber,
+			DTEType:        identification.Identification.DTEType,
+			Status:         constants.DocumentReceived,
+			ReceptionStamp: status.ReceptionStamp,
+		})
+	}
+
+	return true, true
+}
+
+// reconcileInterrupted consulta en Hacienda un documento del outbox cuya transmisión se interrumpió. Si Hacienda lo
+// procesó se registra su resultado para que el job del outbox lo almacene y ejecute sus operaciones adicionales; si lo
+// rechazó se registra como no transmitido. Sin respuesta de Hacienda se revisa de nuevo en la siguiente ejecución.
+func (s *ReconciliationService) reconcileInterrupted(session *branchSession, entry *outboxModels.OutboxEntry) bool {
+	// 1. Consultar el estado del documento en Hacienda
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(entry.Document), &document); err != nil {
+		logs.Error("Failed to parse outbox document", map[string]interface{}{
+			"generationCode": entry.GenerationCode,
+			"error":          err.Error(),
+		})
+		return false
+	}
+
+	status, err := s.transmitter.CheckDocumentStatus(session.ctx, document, session.nit)
+	if err != nil {
+		logs.Debug("Outbox document not found in Hacienda", map[string]interface{}{
+			"generationCode": entry.GenerationCode,
+			"error":          err.Error(),
+		})
+		return false
+	}
+
+	// 2. Registrar el resultado de la transmisión en el outbox
+	switch status.Status {
+	case MHProcessedStatus:
+		err = s.outbox.RecordTransmission(session.ctx, entry, status)
+	case MHRejectedStatus:
+		err = s.outbox.RecordNotTransmitted(session.ctx, entry, fmt.Errorf("document rejected by Hacienda: %s", status.MessageDesc))
+		if err == nil {
+			return false
+		}
+	default:
+		return false
+	}
+	if err != nil {
+		logs.Error("Failed to resume interrupted outbox document", map[string]interface{}{
+			"generationCode": entry.GenerationCode,
+			"mhStatus":       status.Status,
+			"error":          err.Error(),
+		})
+		return false
+	}
+
+	// 3. Registrar la discrepancia, el evento de recepción se publica cuando el outbox complete el documento
+	s.saveDiscrepancy(session.ctx, &models.Discrepancy{
+		UserID:         session.userID,
+		BranchID:       entry.BranchID,
+		GenerationCode: entry.GenerationCode,
+		ControlNumber:  entry.ControlNumber,
+		DTEType:        entry.DTEType,
+		Source:         models.SourceOutboxIntent,
+		LocalStatus:    LocalStatusMissing,
+		MHStatus:       status.Status,
+		ReceptionStamp: status.ReceptionStamp,
+		Action:         models.ActionResumed,
+	})
+
+	return true
+}
+
+// session obtiene el contexto de la sucursal para consultar sus documentos en Hacienda. Si no puede generarse, los
+// documentos de la sucursal no se concilian en esta ejecución.
+func (s *ReconciliationService) session(ctx context.Context, sessions map[uint]*branchSession, branchID uint) *branchSession {
+	if session, ok := sessions[branchID]; ok {
+		return session
+	}
+	sessions[branchID] = nil
+
+	branch, err := s.authManager.GetBranchByBranchID(ctx, branchID)
+	if err != nil {
+		logs.Warn("Failed to get branch, its documents are not reconciled", map[string]interface{}{
+			"branchID": branchID,
+			"error":    err.Error(),
+		})
+		return nil
+	}
+
+	token, err := s.generateMatchingToken(branch)
+	if err != nil {
+		logs.Warn("Failed to generate branch token, its documents are not reconciled", map[string]interface{}{
+			"branchID": branchID,
+			"error":    err.Error(),
+		})
+		return nil
+	}
+
+	claims := &authModels.AuthClaims{
+		ClientID: branch.User.ID,
+		BranchID: branch.ID,
+		AuthType: branch.User.AuthType,
+		NIT:      branch.User.NIT,
+	}
+	sessionCtx := context.WithValue(ctx, "claims", claims)
+	sessionCtx = context.WithValue(sessionCtx, "token", token)
+
+	sessions[branchID] = &branchSession{
+		ctx:    sessionCtx,
+		userID: branch.User.ID,
+		nit:    branch.User.NIT,
+	}
+	return sessions[branchID]
+}
+
+// saveDiscrepancy registra la discrepancia, un error al registrarla no revierte la reparación
+func (s *ReconciliationService) saveDiscrepancy(ctx context.Context, discrepancy *models.Discrepancy) {
+	discrepancy.DetectedAt = utils.TimeNow()
+	if err := s.repo.SaveDiscrepancy(ctx, discrepancy); err != nil {
+		logs.Error("Failed to save reconciliation discrepancy", map[string]interface{}{
+			"generationCode": discrepancy.GenerationCode,
+			"action":         discrepancy.Action,
+			"error":          err.Error(),
+		})
+		return
+	}
+
+	logs.Info("Reconciliation discrepancy repaired", map[string]interface{}{
+		"generationCode": discrepancy.GenerationCode,
+		"source":         discrepancy.Source,
+		"localStatus":    discrepancy.LocalStatus,
+		"mhStatus":       discrepancy.MHStatus,
+		"action":         discrepancy.Action,
+	})
+}
+
+// markReconciled marca el número de secuencia fallido como conciliado
+func (s *ReconciliationService) markReconciled(ctx context.Context, id uint) {
+	if err := s.repo.MarkSequenceReconciled(ctx, id); err != nil {
+		logs.Error("Failed to mark failed sequence as reconciled", map[string]interface{}{
+			"id":    id,
+			"error": err.Error(),
+		})
+	}
+}
+
+// generateMatchingToken genera un token para el cliente con las marcas de tiempo de su última autenticación, con el
+// que se obtienen sus credenciales de Hacienda en caché
+func (s *ReconciliationService) generateMatchingToken(client *user.BranchOffice) (string, error) {
+	key := fmt.Sprintf("token:timestamps:%d", client.User.ID)
+	var timestamps struct {
+		IssuedAt  int64 `json:"IssuedAt"`
+		ExpiresAt int64 `json:"ExpiresAt"`
+	}
+
+	jsonTimestamps, err := s.cache.Get(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err = json.Unmarshal([]byte(jsonTimestamps), &timestamps); err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":        client.User.ID,
+		"branch_sub": client.ID,
+		"auth_type":  client.User.AuthType,
+		"nit":        client.User.NIT,
+		"exp":        timestamps.ExpiresAt,
+		"iat":        timestamps.IssuedAt,
+	})
+
+	return token.SignedString([]byte(s.tokenService.GetSecretKey()))
+}
@@ -0,0 +1,16 @@
+package reconciliation
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation/models"
+)
+
+// ReconciliationManager compara el estado local de los documentos con su estado en Hacienda
+type ReconciliationManager interface {
+	// Reconcile revisa en Hacienda los documentos pendientes y los números de secuencia fallidos, reparando el estado
+	// local y registrando las discrepancias encontradas
+	Reconcile(ctx context.Context) (*models.ReconciliationResult, error)
+	// ListDiscrepancies obtiene una página de las discrepancias de las sucursales del usuario
+	ListDiscrepancies(ctx context.Context, userID uint, filters *models.DiscrepancyFilters) (*models.DiscrepancyPage, error)
+}
@@ -0,0 +1,13 @@
+package remission_note_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+)
+
+type RemissionNoteItem struct {
+	*models.Item
+	NonSubjectSale financial.Amount
+	ExemptSale     financial.Amount
+	TaxedSale      financial.Amount
+}
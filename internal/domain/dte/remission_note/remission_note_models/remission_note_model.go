@@ -0,0 +1,13 @@
+package remission_note_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+)
+
+type RemissionNoteModel struct {
+	*models.DTEDocument
+	RemissionItems   []RemissionNoteItem
+	RemissionSummary RemissionNoteSummary
+	GoodsTitle       *document.GoodsTitle // Título a que se remiten los bienes
+}
@@ -0,0 +1,12 @@
+package remission_note_models
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+)
+
+// RemissionNoteSummary resumen de la Nota de Remisión, no incluye sección de pagos ni retenciones
+type RemissionNoteSummary struct {
+	*models.Summary                  // Hereda summary base
+	TaxedDiscount   financial.Amount // Descuento gravado
+}
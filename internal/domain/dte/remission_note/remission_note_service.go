@@ -0,0 +1,204 @@
+package remission_note
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	buisnessValidator "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/temporal"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/validator"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type remissionNoteService struct {
+	validator        *validator.RemissionNoteRulesValidator
+	seqNumberManager dte_documents.SequentialNumberManager
+	dteManager       dte_documents.DTEManager
+}
+
+// NewRemissionNoteService Crea un nuevo servicio de Nota de Remisión.
+func NewRemissionNoteService(seqNumberManager dte_documents.SequentialNumberManager, dteManager dte_documents.DTEManager) ports.DTEService {
+	return &remissionNoteService{
+		validator:        validator.NewRemissionNoteRulesValidator(nil),
+		seqNumberManager: seqNumberManager,
+		dteManager:       dteManager,
+	}
+}
+
+// Create Crea una nueva Nota de Remisión electrónica con base en los datos proporcionados.
+func (s *remissionNoteService) Create(ctx context.Context, input interface{}, branchID uint) (interface{}, error) {
+	data := input.(*remission_note_models.RemissionNoteInput)
+	// 1. Validar la existencia de documentos relacionados electrónicos, si se proporcionaron
+	if err := s.validateRelatedDocs(ctx, data, branchID); err != nil {
+		logs.Error("Failed to validate related documents", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 2. Crear el documento base
+	baseDoc := createBaseDocument(data)
+	remissionNote := &remission_note_models.RemissionNoteModel{
+		DTEDocument:      baseDoc,
+		RemissionItems:   data.Items,
+		RemissionSummary: *data.RemissionSummary,
+		GoodsTitle:       data.GoodsTitle,
+	}
+
+	// 3. Validar el documento base
+	if err := s.validate(remissionNote); err != nil {
+		logs.Error("Failed to validate remission note document basic validation", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 4. Validar contra reglas principales de negocio
+	if err := buisnessValidator.ValidateDTEDocument(remissionNote); err != nil {
+		logs.Error("Failed to validate remission note document generic validations", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	// 5. Generar el número de control y el código UUID
+	if err := s.generateCodeAndIdentifiers(ctx, remissionNote, branchID); err != nil {
+		return nil, err
+	}
+
+	return remissionNote, nil
+}
+
+// validateRelatedDocs verifica que los documentos relacionados electrónicos existan y hayan sido recibidos por Hacienda
+func (s *remissionNoteService) validateRelatedDocs(ctx context.Context, data *remission_note_models.RemissionNoteInput, branchID uint) error {
+	for i, relatedDoc := range data.RelatedDocs {
+		// Los documentos físicos no se encuentran en la base de datos
+		if relatedDoc.GetGenerationType() != constants.ElectronicDocument {
+			continue
+		}
+
+		// 1. Verificar si el documento existe y obtenerlo
+		doc, err := s.dteManager.GetByGenerationCode(ctx, branchID, relatedDoc.GetDocumentNumber())
+		if err != nil {
+			return err
+		}
+
+		// 2. Verificar que el documento haya sido recibido por Hacienda
+		status, err := s.dteManager.VerifyStatus(ctx, branchID, relatedDoc.GetDocumentNumber())
+		if err != nil {
+			return err
+		}
+
+		if status != constants.DocumentReceived {
+			return shared_error.NewFormattedGeneralServiceError(
+				"RemissionNoteService",
+				"validateRelatedDocs",
+				"RelatedDocumentNotReceived",
+				relatedDoc.GetDocumentNumber(),
+				status,
+			)
+		}
+
+		data.RelatedDocs[i].EmissionDate = *temporal.NewValidatedEmissionDate(doc.CreatedAt)
+	}
+
+	return nil
+}
+
+// validate Valida una Nota de Remisión electrónica con base en las reglas de negocio.
+func (s *remissionNoteService) validate(remissionNote *remission_note_models.RemissionNoteModel) error {
+	s.validator = validator.NewRemissionNoteRulesValidator(remissionNote)
+	err := s.validator.Validate()
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"RemissionNoteService",
+			"Validate",
+			err,
+			"ValidationFailed",
+		)
+	}
+	return nil
+}
+
+// generateControlNumber Genera un número de control único para la Nota de Remisión.
+func (s *remissionNoteService) generateControlNumber(ctx context.Context, remissionNote *remission_note_models.RemissionNoteModel, branchID uint) error {
+	establishmentCode := remissionNote.Issuer.GetEstablishmentCode()
+	posCode := remissionNote.Issuer.GetPOSCode()
+
+	controlNumber, err := s.seqNumberManager.GetNextControlNumber(
+		ctx,
+		constants.NotaRemisionElectronica,
+		branchID,
+		posCode,
+		establishmentCode,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = remissionNote.Identification.SetControlNumber(controlNumber)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError(
+			"RemissionNoteService",
+			"GenerateControlNumber",
+			err,
+			"FailedToSetControlNumber",
+		)
+	}
+	return nil
+}
+
+// generateCodeAndIdentifiers Genera el código UUID y número de control de la Nota de Remisión.
+func (s *remissionNoteService) generateCodeAndIdentifiers(ctx context.Context, remissionNote *remission_note_models.RemissionNoteModel, branchID uint) error {
+	err := remissionNote.Identification.GenerateCode()
+	if err != nil {
+		return err
+	}
+
+	return s.generateControlNumber(ctx, remissionNote, branchID)
+}
+
+// createBaseDocument Crea un documento base para la Nota de Remisión electrónica.
+func createBaseDocument(data *remission_note_models.RemissionNoteInput) *models.DTEDocument {
+	var extInterface interfaces.Extension
+	var thirdPartySale interfaces.ThirdPartySale
+	var appendixes []interfaces.Appendix
+	var relatedDocuments []interfaces.RelatedDocument
+
+	baseItems := make([]interfaces.Item, len(data.Items))
+	for i, item := range data.Items {
+		baseItems[i] = &item
+	}
+
+	if data.Appendixes != nil {
+		for _, appendix := range data.Appendixes {
+			appendixes = append(appendixes, &appendix)
+		}
+	}
+
+	if data.Extension != nil {
+		extInterface = data.Extension
+	}
+
+	if data.RelatedDocs != nil {
+		for _, relatedDoc := range data.RelatedDocs {
+			relatedDocuments = append(relatedDocuments, &relatedDoc)
+		}
+	}
+
+	if data.ThirdPartySale != nil {
+		thirdPartySale = data.ThirdPartySale
+	}
+
+	return &models.DTEDocument{
+		Identification:   data.Identification,
+		Issuer:           data.Issuer,
+		Receiver:         data.Receiver,
+		Items:            baseItems,
+		RelatedDocuments: relatedDocuments,
+		Summary:          data.RemissionSummary.Summary,
+		ThirdPartySale:   thirdPartySale,
+		Extension:        extInterface,
+		Appendix:         appendixes,
+	}
+}
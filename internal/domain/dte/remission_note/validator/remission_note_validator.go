@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/validator/strategy"
+)
+
+type RemissionNoteRulesValidator struct {
+	document   *remission_note_models.RemissionNoteModel
+	strategies []interfaces.DTEValidationStrategy
+}
+
+func NewRemissionNoteRulesValidator(doc *remission_note_models.RemissionNoteModel) *RemissionNoteRulesValidator {
+	validator := &RemissionNoteRulesValidator{
+		document: doc,
+		strategies: []interfaces.DTEValidationStrategy{
+			&strategy.RemissionNoteItemStrategy{Document: doc},       // Validaciones de ítems
+			&strategy.RemissionNoteTaxStrategy{Document: doc},        // Validaciones de impuestos y totales
+			&strategy.RemissionNoteRelatedDocStrategy{Document: doc}, // Validaciones de documentos relacionados
+		},
+	}
+	return validator
+}
+
+// Validate Ejecuta las validaciones de la nota de remisión electrónica.
+func (v *RemissionNoteRulesValidator) Validate() *dte_errors.DTEError {
+	var validationErrors []*dte_errors.DTEError
+
+	for _, strategyValidator := range v.strategies {
+		if err := strategyValidator.Validate(); err != nil {
+			validationErrors = append(validationErrors, err)
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return dte_errors.NewDTEErrorComposite(validationErrors)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type RemissionNoteItemStrategy struct {
+	Document *remission_note_models.RemissionNoteModel
+}
+
+// Validate - Valida los ítems de una Nota de Remisión
+func (s *RemissionNoteItemStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil || len(s.Document.RemissionItems) == 0 {
+		return dte_errors.NewDTEErrorSimple("RequiredField", "RemissionItems")
+	}
+
+	// Validar número máximo de ítems
+	if len(s.Document.RemissionItems) > 2000 {
+		return dte_errors.NewDTEErrorSimple("ExceededItemsLimit", len(s.Document.RemissionItems))
+	}
+
+	for _, item := range s.Document.RemissionItems {
+		// Validar tipos de venta y sus restricciones
+		if err := s.validateItemSaleTypes(&item); err != nil {
+			return err
+		}
+
+		// Validar reglas específicas de cada ítem
+		if err := s.validateItem(&item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *RemissionNoteItemStrategy) validateItem(item *remission_note_models.RemissionNoteItem) *dte_errors.DTEError {
+	// La Nota de Remisión ampara el traslado de bienes, no se permiten ítems de tipo impuesto
+	if item.GetType() == constants.Impuesto {
+		logs.Error("Tax items are not allowed in remission notes", map[string]interface{}{
+			"itemNumber": item.GetNumber(),
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidItemTypeForRemissionNote", item.GetNumber())
+	}
+
+	if item.TaxedSale.GetValue() > 0 && item.GetUnitPrice() == 0 {
+		logs.Error("Unit price cannot be zero when taxed sale is present", map[string]interface{}{
+			"itemNumber": item.GetNumber(),
+			"taxedSale":  item.TaxedSale.GetValue(),
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidUnitPriceZero",
+			item.GetNumber(), item.GetUnitPrice(), item.TaxedSale.GetValue())
+	}
+
+	// Validación de impuestos: al menos uno debe estar presente si hay venta gravada
+	if item.TaxedSale.GetValue() > 0 {
+		if item.GetTaxes() == nil || len(item.GetTaxes()) == 0 {
+			logs.Error("At least one tax is required for remission note items", map[string]interface{}{
+				"itemNumber": item.GetNumber(),
+			})
+			return dte_errors.NewDTEErrorSimple("MissingItemTaxes", item.GetNumber())
+		}
+	}
+
+	for _, tax := range item.GetTaxes() {
+		if !constants.MapAllowedTaxTypes[tax] {
+			logs.Error("Invalid tax type", map[string]interface{}{
+				"itemNumber": item.GetNumber(),
+				"tax":        tax,
+			})
+			return dte_errors.NewDTEErrorSimple("InvalidTaxType", tax)
+		}
+	}
+
+	return nil
+}
+
+func (s *RemissionNoteItemStrategy) validateItemSaleTypes(item *remission_note_models.RemissionNoteItem) *dte_errors.DTEError {
+	// Validar que no haya ventas mixtas
+	salesTypes := 0
+	if item.TaxedSale.GetValue() > 0 {
+		salesTypes++
+	}
+	if item.ExemptSale.GetValue() > 0 {
+		salesTypes++
+	}
+	if item.NonSubjectSale.GetValue() > 0 {
+		salesTypes++
+	}
+
+	if salesTypes > 1 {
+		logs.Error("Mixed sales types in single item", map[string]interface{}{
+			"itemNumber":     item.GetNumber(),
+			"taxedSale":      item.TaxedSale.GetValue(),
+			"exemptSale":     item.ExemptSale.GetValue(),
+			"nonSubjectSale": item.NonSubjectSale.GetValue(),
+		})
+		return dte_errors.NewDTEErrorSimple("MixedSalesTypesNotAllowed", item.GetNumber())
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package strategy
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type RemissionNoteRelatedDocStrategy struct {
+	Document *remission_note_models.RemissionNoteModel
+}
+
+// Validate - Valida los documentos relacionados de una Nota de Remisión, los cuales son opcionales
+func (s *RemissionNoteRelatedDocStrategy) Validate() *dte_errors.DTEError {
+	if s.Document.GetRelatedDocuments() == nil || len(s.Document.GetRelatedDocuments()) == 0 {
+		// Sin documentos relacionados, ningún ítem puede referenciar uno
+		for _, item := range s.Document.RemissionItems {
+			if item.GetRelatedDoc() != nil {
+				return dte_errors.NewDTEErrorSimple("InvalidItemRelatedDoc", item.GetNumber(), *item.GetRelatedDoc())
+			}
+		}
+		return nil
+	}
+
+	// No debe exceder el máximo de documentos relacionados
+	if len(s.Document.GetRelatedDocuments()) > 50 {
+		return dte_errors.NewDTEErrorSimple("ExceededRelatedDocsLimit",
+			len(s.Document.GetRelatedDocuments()))
+	}
+
+	// Validar tipos de documentos relacionados permitidos para Nota de Remisión
+	for _, doc := range s.Document.GetRelatedDocuments() {
+		if !constants.ValidRemissionNoteDTETypesRelateDoc[doc.GetDocumentType()] {
+			return dte_errors.NewDTEErrorSimple("InvalidRelatedDocDTEType", doc.GetDocumentType(),
+				constants.ShowValidRelatedDocTypes(constants.ValidRemissionNoteDTETypesRelateDoc))
+		}
+	}
+
+	// Validar consistencia de referencias en ítems, la referencia por ítem es opcional
+	for _, item := range s.Document.RemissionItems {
+		if item.GetRelatedDoc() == nil {
+			continue
+		}
+
+		found := false
+		itemRelatedDoc := *item.GetRelatedDoc()
+
+		for _, relDoc := range s.Document.GetRelatedDocuments() {
+			if relDoc.GetDocumentNumber() == itemRelatedDoc {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			logs.Error("Item related document not found in document related docs", map[string]interface{}{
+				"itemNumber": item.GetNumber(),
+				"relatedDoc": itemRelatedDoc,
+			})
+			return dte_errors.NewDTEErrorSimple("InvalidItemRelatedDoc",
+				item.GetNumber(),
+				itemRelatedDoc)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package strategy
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type RemissionNoteTaxStrategy struct {
+	Document *remission_note_models.RemissionNoteModel
+}
+
+// Validate - Valida los impuestos y totales de una Nota de Remisión
+func (s *RemissionNoteTaxStrategy) Validate() *dte_errors.DTEError {
+	if s.Document == nil || s.Document.RemissionSummary.Summary == nil {
+		return nil
+	}
+
+	// 1. Validar totales base
+	if err := s.validateBaseTotals(); err != nil {
+		logs.Error("Error validating base totals")
+		return err
+	}
+
+	// 2. Validar IVA
+	if err := s.validateIVA(); err != nil {
+		logs.Error("Error validating IVA")
+		return err
+	}
+
+	// 3. Validar montos totales
+	if err := s.validateTotalAmounts(); err != nil {
+		logs.Error("Error validating total amounts")
+		return err
+	}
+
+	return nil
+}
+
+func (s *RemissionNoteTaxStrategy) validateBaseTotals() *dte_errors.DTEError {
+	summary := s.Document.RemissionSummary
+
+	// 1. Calcular totales desde items
+	var totalTaxed, totalNonSubject, totalExempt decimal.Decimal
+	for _, item := range s.Document.RemissionItems {
+		totalTaxed = totalTaxed.Add(decimal.NewFromFloat(item.TaxedSale.GetValue()))
+		totalNonSubject = totalNonSubject.Add(decimal.NewFromFloat(item.NonSubjectSale.GetValue()))
+		totalExempt = totalExempt.Add(decimal.NewFromFloat(item.ExemptSale.GetValue()))
+	}
+
+	// 2. Validar que los totales coincidan con el resumen
+	summaryTaxed := decimal.NewFromFloat(summary.TotalTaxed.GetValue())
+	if totalTaxed.Sub(summaryTaxed).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+		logs.Error("Invalid taxed total", map[string]interface{}{
+			"calculated": totalTaxed,
+			"declared":   summaryTaxed,
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidTotalTaxed",
+			summaryTaxed.InexactFloat64(),
+			totalTaxed.InexactFloat64())
+	}
+
+	summaryNonSubject := decimal.NewFromFloat(summary.TotalNonSubject.GetValue())
+	if totalNonSubject.Sub(summaryNonSubject).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalNonSubject",
+			summaryNonSubject.InexactFloat64(),
+			totalNonSubject.InexactFloat64())
+	}
+
+	summaryExempt := decimal.NewFromFloat(summary.TotalExempt.GetValue())
+	if totalExempt.Sub(summaryExempt).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+		return dte_errors.NewDTEErrorSimple("InvalidTotalExempt",
+			summaryExempt.InexactFloat64(),
+			totalExempt.InexactFloat64())
+	}
+
+	// 3. Validar que subtotal de ventas sea la suma de todos los tipos
+	expectedSubTotalSales := totalTaxed.Add(totalNonSubject).Add(totalExempt)
+	actualSubTotalSales := decimal.NewFromFloat(summary.SubTotalSales.GetValue())
+	if expectedSubTotalSales.Sub(actualSubTotalSales).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+		logs.Error("Invalid subtotal sales", map[string]interface{}{
+			"calculated": expectedSubTotalSales,
+			"declared":   actualSubTotalSales,
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidSubTotalSales",
+			expectedSubTotalSales.InexactFloat64(),
+			actualSubTotalSales.InexactFloat64())
+	}
+
+	return nil
+}
+
+func (s *RemissionNoteTaxStrategy) validateIVA() *dte_errors.DTEError {
+	summary := s.Document.RemissionSummary
+	baseTaxed := decimal.NewFromFloat(summary.TotalTaxed.GetValue())
+
+	// Si no hay monto gravado, no se requieren impuestos
+	if !baseTaxed.GreaterThan(decimal.Zero) {
+		if len(summary.TotalTaxes) > 0 {
+			logs.Error("Taxes present with zero taxed amount")
+			return dte_errors.NewDTEErrorSimple("InvalidTaxes")
+		}
+		return nil
+	}
+
+	if len(summary.TotalTaxes) == 0 {
+		logs.Error("No taxes present with non-zero taxed amount")
+		return dte_errors.NewDTEErrorSimple("MissingTaxes")
+	}
+
+	baseTaxed = baseTaxed.Sub(decimal.NewFromFloat(summary.TaxedDiscount.GetValue()))
+	for _, tax := range summary.TotalTaxes {
+		if tax.GetCode() != constants.TaxIVA {
+			continue
+		}
+
+		expectedIVA := baseTaxed.Mul(decimal.NewFromFloat(constants.TaxIvaAmount))
+		actualIVA := decimal.NewFromFloat(tax.GetValue())
+		if expectedIVA.Sub(actualIVA).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+			logs.Error("Invalid IVA calculation with discount", map[string]interface{}{
+				"expected":      expectedIVA,
+				"actual":        actualIVA,
+				"taxedDiscount": summary.TaxedDiscount.GetValue(),
+			})
+			return dte_errors.NewDTEErrorSimple("InvalidIVACalculation",
+				expectedIVA.InexactFloat64(),
+				actualIVA.InexactFloat64())
+		}
+	}
+
+	return nil
+}
+
+func (s *RemissionNoteTaxStrategy) validateTotalAmounts() *dte_errors.DTEError {
+	summary := s.Document.RemissionSummary
+
+	// 1. El subtotal es el subtotal de ventas menos los descuentos
+	expectedSubTotal := decimal.NewFromFloat(summary.SubTotalSales.GetValue()).
+		Sub(decimal.NewFromFloat(summary.TaxedDiscount.GetValue())).
+		Sub(decimal.NewFromFloat(summary.ExemptDiscount.GetValue())).
+		Sub(decimal.NewFromFloat(summary.NonSubjectDiscount.GetValue()))
+
+	actualSubTotal := decimal.NewFromFloat(summary.SubTotal.GetValue())
+	if expectedSubTotal.Sub(actualSubTotal).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+		return dte_errors.NewDTEErrorSimple("InvalidSubTotalCalculation",
+			expectedSubTotal.InexactFloat64(),
+			actualSubTotal.InexactFloat64())
+	}
+
+	// 2. El monto total de la operación es el subtotal más los tributos, sin retenciones ni percepciones
+	expectedTotalOperation := actualSubTotal
+	for _, tax := range summary.GetTotalTaxes() {
+		expectedTotalOperation = expectedTotalOperation.Add(decimal.NewFromFloat(tax.GetTotalAmount()))
+	}
+
+	totalOperation := decimal.NewFromFloat(summary.TotalOperation.GetValue())
+	if expectedTotalOperation.Sub(totalOperation).Abs().GreaterThan(decimal.NewFromFloat(0.01)) {
+		logs.Error("Invalid total operation", map[string]interface{}{
+			"calculated": expectedTotalOperation,
+			"declared":   totalOperation,
+		})
+		return dte_errors.NewDTEErrorSimple("InvalidTotalOperation",
+			totalOperation.InexactFloat64(),
+			expectedTotalOperation.InexactFloat64())
+	}
+
+	return nil
+}
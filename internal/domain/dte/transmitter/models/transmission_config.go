@@ -6,13 +6,18 @@ import (
 	"time"
 )
 
-// TransmissionConfig configuración específica para el servicio de contingencia
+// TransmissionConfig configuración de las transmisiones a Hacienda, compartida por la transmisión individual y la de
+// lotes de contingencia
 type TransmissionConfig struct {
-	Ambient       string
-	BatchSize     int
-	RetryInterval time.Duration
-	MaxInterval   time.Duration
-	BackoffFactor float64
+	Ambient          string
+	BatchSize        int
+	MaxAttempts      int
+	RetryInterval    time.Duration
+	MaxInterval      time.Duration
+	BackoffFactor    float64
+	Jitter           float64
+	CircuitThreshold int32
+	CircuitResetTime time.Duration
 }
 
 // GetAmbient obtiene el ambiente configurado
@@ -40,22 +45,28 @@ func (c *TransmissionConfig) GetBackoffFactor() float64 {
 	return c.BackoffFactor
 }
 
-// GetRetryPolicy construye una política de reintentos
+// GetRetryPolicy construye la política de reintentos, siempre con al menos un intento
 func (c *TransmissionConfig) GetRetryPolicy() models.RetryPolicy {
 	return models.RetryPolicy{
-		MaxAttempts:     3, // Valor por defecto
+		MaxAttempts:     max(c.MaxAttempts, 1),
 		InitialInterval: c.RetryInterval,
 		MaxInterval:     c.MaxInterval,
 		BackoffFactor:   c.BackoffFactor,
+		Jitter:          c.Jitter,
 	}
 }
 
-func NewTransmissionConfig(retryInterval, maxInterval time.Duration, backoffFactor float64) *TransmissionConfig {
+// NewTransmissionConfig crea la configuración de transmisión a partir de las variables de entorno
+func NewTransmissionConfig() *TransmissionConfig {
 	return &TransmissionConfig{
-		Ambient:       config.Server.AmbientCode,
-		BatchSize:     config.Server.MaxBatchSize,
-		RetryInterval: retryInterval,
-		MaxInterval:   maxInterval,
-		BackoffFactor: backoffFactor,
+		Ambient:          config.Server.AmbientCode,
+		BatchSize:        config.Server.MaxBatchSize,
+		MaxAttempts:      config.Transmission.MaxAttempts,
+		RetryInterval:    time.Duration(config.Transmission.RetryIntervalSeconds) * time.Second,
+		MaxInterval:      time.Duration(config.Transmission.MaxIntervalSeconds) * time.Second,
+		BackoffFactor:    config.Transmission.BackoffFactor,
+		Jitter:           config.Transmission.Jitter,
+		CircuitThreshold: int32(config.Transmission.CircuitThreshold),
+		CircuitResetTime: time.Duration(config.Transmission.CircuitResetSeconds) * time.Second,
 	}
 }
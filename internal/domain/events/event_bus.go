@@ -0,0 +1,123 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+const (
+	// DefaultPageSize es la cantidad de eventos por página cuando no se indica
+	DefaultPageSize = 20
+	// MaxPageSize es la cantidad máxima de eventos por página
+	MaxPageSize = 100
+)
+
+type EventBus struct {
+	repo     EventRepositoryPort
+	mu       sync.RWMutex
+	handlers map[string][]EventHandler
+}
+
+// NewEventBus crea el bus de eventos de dominio. Los eventos se registran antes de entregarse a sus suscriptores.
+func NewEventBus(repo EventRepositoryPort) EventManager {
+	return &EventBus{
+		repo:     repo,
+		handlers: make(map[string][]EventHandler),
+	}
+}
+
+// Subscribe registra un handler para un tipo de evento o para todos los eventos con event.AllEvents
+func (b *EventBus) Subscribe(eventType string, handler EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish registra el evento en domain_events y lo entrega a sus suscriptores. Un evento que no pudo registrarse
+// no se entrega para que los suscriptores siempre puedan referenciarlo.
+func (b *EventBus) Publish(ctx context.Context, branchID uint, eventType string, payload interface{}) {
+	// 1. Codificar el contenido del evento
+	content, err := json.Marshal(payload)
+	if err != nil {
+		logs.Error("Failed to encode domain event", map[string]interface{}{
+			"branchID":  branchID,
+			"eventType": eventType,
+			"error":     err.Error(),
+		})
+		return
+	}
+
+	// 2. Registrar el evento, el usuario se resuelve a partir de la sucursal
+	domainEvent := &event.DomainEvent{
+		BranchID:   branchID,
+		EventType:  eventType,
+		Payload:    string(content),
+		OccurredAt: utils.TimeNow().Format("2006-01-02 15:04:05"),
+	}
+
+	if err = b.repo.Create(ctx, domainEvent); err != nil {
+		logs.Error("Failed to register domain event", map[string]interface{}{
+			"branchID":  branchID,
+			"eventType": eventType,
+			"error":     err.Error(),
+		})
+		return
+	}
+
+	// 3. Entregar el evento a sus suscriptores
+	b.Dispatch(ctx, *domainEvent)
+}
+
+// Dispatch entrega el evento a los handlers de su tipo y a los suscritos a todos los eventos
+func (b *EventBus) Dispatch(ctx context.Context, domainEvent event.DomainEvent) {
+	b.mu.RLock()
+	handlers := make([]EventHandler, 0, len(b.handlers[domainEvent.EventType])+len(b.handlers[event.AllEvents]))
+	handlers = append(handlers, b.handlers[domainEvent.EventType]...)
+	handlers = append(handlers, b.handlers[event.AllEvents]...)
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(ctx, domainEvent)
+	}
+}
+
+// List obtiene una página de los eventos del usuario que cumplen los filtros
+func (b *EventBus) List(ctx context.Context, userID uint, filters *models.EventFilters) (*models.EventPage, error) {
+	// 1. Normalizar la paginación
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PageSize < 1 {
+		filters.PageSize = DefaultPageSize
+	}
+	filters.PageSize = min(filters.PageSize, MaxPageSize)
+
+	// 2. Consultar los eventos
+	domainEvents, total, err := b.repo.List(ctx, userID, filters)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("EventBus", "List", err, "FailedToGetEvents")
+	}
+
+	totalPages := int(total) / filters.PageSize
+	if int(total)%filters.PageSize > 0 {
+		totalPages++
+	}
+
+	return &models.EventPage{
+		Events: domainEvents,
+		Pagination: models.EventPagination{
+			Page:       filters.Page,
+			PageSize:   filters.PageSize,
+			TotalItems: total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
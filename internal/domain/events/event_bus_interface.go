@@ -0,0 +1,30 @@
+package events
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events/models"
+)
+
+// EventHandler procesa un evento de dominio ya registrado. Los errores deben registrarse en el propio handler.
+type EventHandler func(ctx context.Context, domainEvent event.DomainEvent)
+
+// EventPublisher publica los eventos de dominio de una sucursal
+type EventPublisher interface {
+	// Publish registra el evento y lo entrega a sus suscriptores. Los errores se registran sin afectar la operación
+	// que originó el evento.
+	Publish(ctx context.Context, branchID uint, eventType string, payload interface{})
+}
+
+// EventManager define el bus de eventos de dominio
+type EventManager interface {
+	EventPublisher
+	// Subscribe registra un handler para un tipo de evento o para todos los eventos con event.AllEvents
+	Subscribe(eventType string, handler EventHandler)
+	// Dispatch entrega a los suscriptores un evento registrado por otra operación, por ejemplo dentro de su propia
+	// transacción
+	Dispatch(ctx context.Context, domainEvent event.DomainEvent)
+	// List obtiene los eventos de un usuario que cumplen los filtros
+	List(ctx context.Context, userID uint, filters *models.EventFilters) (*models.EventPage, error)
+}
@@ -0,0 +1,16 @@
+package events
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events/models"
+)
+
+// EventRepositoryPort interfaz para el repositorio de eventos de dominio
+type EventRepositoryPort interface {
+	// Create registra el evento de dominio. Si no se indica el usuario se asocia al dueño de la sucursal del evento.
+	Create(ctx context.Context, domainEvent *event.DomainEvent) error
+	// List obtiene los eventos de un usuario que cumplen los filtros y el total de eventos encontrados
+	List(ctx context.Context, userID uint, filters *models.EventFilters) ([]event.DomainEvent, int64, error)
+}
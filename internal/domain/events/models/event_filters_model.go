@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+)
+
+// EventFilters contiene los filtros para consultar los eventos de dominio de un usuario
+type EventFilters struct {
+	BranchID  *uint
+	EventType string
+	StartDate *time.Time
+	EndDate   *time.Time
+
+	// Paginación
+	Page     int
+	PageSize int
+}
+
+// EventPage contiene una página de eventos de dominio y el total de eventos que cumplen los filtros
+type EventPage struct {
+	Events     []event.DomainEvent `json:"events"`
+	Pagination EventPagination     `json:"pagination"`
+}
+
+// EventPagination contiene la información de paginación de una consulta de eventos
+type EventPagination struct {
+	Page       int   `json:"page"`
+	PageSize   int   `json:"page_size"`
+	TotalItems int64 `json:"total_items"`
+	TotalPages int   `json:"total_pages"`
+}
@@ -1,12 +1,20 @@
 package models
 
 type HealthStatus struct {
-	Status     string            `json:"status"`
-	Components map[string]Health `json:"components"`
-	Timestamp  string            `json:"timestamp"`
+	Status     string                   `json:"status"`
+	Components map[string]Health        `json:"components"`
+	Circuits   map[string]CircuitStatus `json:"circuits,omitempty"`
+	Timestamp  string                   `json:"timestamp"`
 }
 
 type Health struct {
 	Status  string `json:"status"`
 	Details string `json:"details,omitempty"`
 }
+
+// CircuitStatus es el estado del circuit breaker de un componente externo. Mientras está abierto (OPEN) las
+// solicitudes al componente fallan sin enviarse y los documentos pasan directamente a contingencia.
+type CircuitStatus struct {
+	State    string `json:"state"`
+	Failures int32  `json:"failures"`
+}
@@ -0,0 +1,20 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency/models"
+)
+
+// IdempotencyRepositoryPort interfaz para el almacenamiento de las llaves de idempotencia de una sucursal
+type IdempotencyRepositoryPort interface {
+	// Reserve almacena el registro solo si la llave no existe, retorna false si ya estaba registrada
+	Reserve(ctx context.Context, branchID uint, key string, record *models.IdempotencyRecord, ttl time.Duration) (bool, error)
+	// Get obtiene el registro de una llave, retorna ErrIdempotencyKeyNotFound si no existe o expiró
+	Get(ctx context.Context, branchID uint, key string) (*models.IdempotencyRecord, error)
+	// Save reemplaza el registro de una llave
+	Save(ctx context.Context, branchID uint, key string, record *models.IdempotencyRecord, ttl time.Duration) error
+	// Delete elimina el registro de una llave
+	Delete(ctx context.Context, branchID uint, key string) error
+}
@@ -0,0 +1,134 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+const (
+	// KeyTTL es el tiempo durante el cual se reproduce la respuesta de una llave
+	KeyTTL = 24 * time.Hour
+	// InProgressTTL es el tiempo máximo que una llave permanece reservada mientras se procesa la solicitud
+	InProgressTTL = 5 * time.Minute
+	// MaxKeyLength es la longitud máxima de un Idempotency-Key
+	MaxKeyLength = 255
+)
+
+type IdempotencyService struct {
+	repo IdempotencyRepositoryPort
+}
+
+func NewIdempotencyService(repo IdempotencyRepositoryPort) IdempotencyManager {
+	return &IdempotencyService{
+		repo: repo,
+	}
+}
+
+// Begin reserva la llave o retorna la respuesta almacenada de la solicitud original
+func (s *IdempotencyService) Begin(ctx context.Context, branchID uint, key, requestHash string) (*models.IdempotencyRecord, error) {
+	// 1. Validar la llave
+	if !isValidKey(key) {
+		return nil, shared_error.NewFormattedGeneralServiceError("IdempotencyService", "Begin", "InvalidIdempotencyKey", MaxKeyLength)
+	}
+
+	// 2. Reservar la llave, si la llave expira entre la reserva y la consulta se intenta reservar de nuevo
+	for attempt := 0; attempt < 2; attempt++ {
+		reserved, err := s.repo.Reserve(ctx, branchID, key, &models.IdempotencyRecord{
+			RequestHash: requestHash,
+			Status:      models.StatusInProgress,
+			CreatedAt:   utils.TimeNow(),
+		}, InProgressTTL)
+		if err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("IdempotencyService", "Begin", err, "FailedToProcessIdempotencyKey")
+		}
+		if reserved {
+			return nil, nil
+		}
+
+		// 3. Obtener el registro existente
+		record, err := s.repo.Get(ctx, branchID, key)
+		if errors.Is(err, errPackage.ErrIdempotencyKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("IdempotencyService", "Begin", err, "FailedToProcessIdempotencyKey")
+		}
+
+		// 4. Verificar que la solicitud repetida sea la misma que la original
+		if record.RequestHash != requestHash {
+			return nil, shared_error.NewFormattedGeneralServiceError("IdempotencyService", "Begin", "IdempotencyKeyMismatch")
+		}
+
+		if !record.IsCompleted() {
+			return nil, shared_error.NewFormattedGeneralServiceError("IdempotencyService", "Begin", "IdempotencyKeyInProgress")
+		}
+
+		return record, nil
+	}
+
+	return nil, shared_error.NewFormattedGeneralServiceError("IdempotencyService", "Begin", "FailedToProcessIdempotencyKey")
+}
+
+// Complete almacena la respuesta final de la solicitud para reproducirla en los reintentos
+func (s *IdempotencyService) Complete(ctx context.Context, branchID uint, key, requestHash string, statusCode int, body []byte) error {
+	err := s.repo.Save(ctx, branchID, key, &models.IdempotencyRecord{
+		RequestHash: requestHash,
+		Status:      models.StatusCompleted,
+		StatusCode:  statusCode,
+		Body:        body,
+		CreatedAt:   utils.TimeNow(),
+	}, KeyTTL)
+	if err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("IdempotencyService", "Complete", err, "FailedToProcessIdempotencyKey")
+	}
+
+	return nil
+}
+
+// Release elimina la reserva de la llave
+func (s *IdempotencyService) Release(ctx context.Context, branchID uint, key string) error {
+	if err := s.repo.Delete(ctx, branchID, key); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("IdempotencyService", "Release", err, "FailedToProcessIdempotencyKey")
+	}
+
+	return nil
+}
+
+// HashRequest genera el hash de una solicitud a partir de su método, ruta y contenido. El contenido JSON se
+// compacta para que las diferencias de formato no se consideren un contenido distinto.
+func HashRequest(method, path string, body []byte) string {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, body); err == nil {
+		body = compacted.Bytes()
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(method + " " + path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// isValidKey verifica que la llave no esté vacía, no exceda la longitud máxima y solo contenga caracteres ASCII visibles
+func isValidKey(key string) bool {
+	if key == "" || len(key) > MaxKeyLength {
+		return false
+	}
+
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x21 || key[i] > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,18 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency/models"
+)
+
+// IdempotencyManager define las operaciones para reproducir las solicitudes repetidas con el mismo Idempotency-Key
+type IdempotencyManager interface {
+	// Begin reserva la llave para una nueva solicitud y retorna nil, o retorna el registro completado que debe
+	// reproducirse. Falla si la llave se usó con otro contenido o si la solicitud original sigue en proceso.
+	Begin(ctx context.Context, branchID uint, key, requestHash string) (*models.IdempotencyRecord, error)
+	// Complete almacena la respuesta final de la solicitud asociada a la llave
+	Complete(ctx context.Context, branchID uint, key, requestHash string, statusCode int, body []byte) error
+	// Release libera la llave para que la solicitud pueda reintentarse
+	Release(ctx context.Context, branchID uint, key string) error
+}
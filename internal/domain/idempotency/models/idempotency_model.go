@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+const (
+	// StatusInProgress indica que la solicitud original aún se está procesando
+	StatusInProgress = "IN_PROGRESS"
+	// StatusCompleted indica que la respuesta de la solicitud original fue almacenada
+	StatusCompleted = "COMPLETED"
+)
+
+// IdempotencyRecord representa el resultado almacenado de una solicitud enviada con un Idempotency-Key
+type IdempotencyRecord struct {
+	RequestHash string    `json:"request_hash"`
+	Status      string    `json:"status"`
+	StatusCode  int       `json:"status_code,omitempty"`
+	Body        []byte    `json:"body,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// IsCompleted indica si la respuesta de la solicitud original puede reproducirse
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.Status == StatusCompleted
+}
@@ -0,0 +1,33 @@
+package idempotency
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// transmissionTrackerKey es la llave del contexto con la que se comparte el TransmissionTracker de la solicitud
+type transmissionTrackerKey struct{}
+
+// TransmissionTracker registra si una solicitud llegó a transmitir un documento a Hacienda. Una solicitud que falla
+// después de transmitir pudo haber emitido el documento, por lo que su llave no debe liberarse.
+type TransmissionTracker struct {
+	transmitted atomic.Bool
+}
+
+// WithTransmissionTracker agrega un TransmissionTracker nuevo al contexto
+func WithTransmissionTracker(ctx context.Context) (context.Context, *TransmissionTracker) {
+	tracker := &TransmissionTracker{}
+	return context.WithValue(ctx, transmissionTrackerKey{}, tracker), tracker
+}
+
+// MarkTransmitted registra en el TransmissionTracker del contexto, si existe, que se envió el documento a Hacienda
+func MarkTransmitted(ctx context.Context) {
+	if tracker, ok := ctx.Value(transmissionTrackerKey{}).(*TransmissionTracker); ok {
+		tracker.transmitted.Store(true)
+	}
+}
+
+// Transmitted indica si la solicitud envió algún documento a Hacienda
+func (t *TransmissionTracker) Transmitted() bool {
+	return t.transmitted.Load()
+}
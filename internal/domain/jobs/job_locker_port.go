@@ -0,0 +1,22 @@
+package jobs
+
+import (
+	"context"
+	"time"
+)
+
+// JobLocker interfaz para el bloqueo distribuido de los jobs, garantiza que entre todas las réplicas de la aplicación
+// solo una ejecute cada job a la vez. El bloqueo es un arrendamiento que expira si la réplica que lo tiene deja de
+// renovarlo, por ejemplo porque se detuvo a mitad de una ejecución.
+type JobLocker interface {
+	// Instance obtiene el identificador de esta réplica, utilizado como dueño de los bloqueos que adquiere
+	Instance() string
+	// Acquire adquiere el bloqueo de un job por el tiempo indicado, retorna false si otra réplica lo tiene
+	Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error)
+	// Renew extiende el bloqueo de un job, retorna false si esta réplica ya no lo tiene
+	Renew(ctx context.Context, name string, ttl time.Duration) (bool, error)
+	// Release libera el bloqueo de un job solo si esta réplica lo tiene
+	Release(ctx context.Context, name string) error
+	// Owner obtiene la réplica que tiene el bloqueo de un job y cuándo expira, el dueño es vacío si nadie lo tiene
+	Owner(ctx context.Context, name string) (string, time.Duration, error)
+}
@@ -0,0 +1,26 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs/models"
+)
+
+// ErrJobSkipped indica que un job no se ejecutó, por ejemplo porque la ejecución anterior no ha terminado o porque
+// está fuera de su ventana horaria. Los jobs lo retornan envuelto con el motivo.
+var ErrJobSkipped = errors.New("job skipped")
+
+// JobMonitor registra la programación de los jobs y el resultado de sus ejecuciones
+type JobMonitor interface {
+	// Register registra un job con la descripción de su programación y la función que calcula su próxima ejecución
+	Register(name, schedule string, nextRun func() time.Time)
+	// Unregister elimina un job que dejó de estar programado
+	Unregister(name string)
+	// Track envuelve la ejecución de un job para adquirir su bloqueo distribuido y registrar su inicio, duración y
+	// resultado. El contexto de la ejecución se cancela si la réplica pierde el bloqueo.
+	Track(name string, run func(ctx context.Context) error) func()
+	// List obtiene el estado de los jobs registrados ordenados por nombre y la réplica que tiene el bloqueo de cada uno
+	List(ctx context.Context) *models.JobsStatus
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+const (
+	JobOutcomeSuccess = "SUCCESS"
+	JobOutcomeFailed  = "FAILED"
+	JobOutcomeSkipped = "SKIPPED"
+)
+
+// JobStatus contiene la programación de un job y el resultado de su última ejecución en esta instancia
+type JobStatus struct {
+	Name         string     `json:"name"`
+	Schedule     string     `json:"schedule"`
+	Running      bool       `json:"running"`
+	NextRun      *time.Time `json:"next_run,omitempty"`
+	LastRun      *time.Time `json:"last_run,omitempty"`
+	LastDuration string     `json:"last_duration,omitempty"`
+	LastOutcome  string     `json:"last_outcome,omitempty"`
+	LastError    string     `json:"last_error,omitempty"`
+	Runs         int        `json:"runs"`
+	Failures     int        `json:"failures"`
+	LockOwner    string     `json:"lock_owner,omitempty"`
+	LockTTL      string     `json:"lock_ttl,omitempty"`
+}
+
+// JobsStatus contiene el estado de los jobs junto a la réplica que atiende la consulta, para compararla con el dueño
+// del bloqueo de cada job
+type JobsStatus struct {
+	Instance string      `json:"instance"`
+	Jobs     []JobStatus `json:"jobs"`
+}
@@ -0,0 +1,213 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/notification/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+const (
+	// RetryBaseDelay es la espera antes del primer reintento, se duplica en cada intento fallido
+	RetryBaseDelay = time.Minute
+	// RetryMaxDelay es la espera máxima entre reintentos
+	RetryMaxDelay = time.Hour
+	// ClaimDuration es el tiempo que una notificación queda reservada mientras se intenta su envío
+	ClaimDuration = 5 * time.Minute
+	// PendingBatchSize es la cantidad máxima de notificaciones que se reintentan por ejecución
+	PendingBatchSize = 50
+	// maxErrorLength es la longitud máxima del último error almacenado
+	maxErrorLength = 500
+)
+
+type DeliveryService struct {
+	repo        NotificationRepositoryPort
+	maxAttempts int
+}
+
+func NewDeliveryService(repo NotificationRepositoryPort, maxAttempts int) DeliveryManager {
+	return &DeliveryService{
+		repo:        repo,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// RegisterEmail registra el evento que origina el envío y la notificación pendiente para su destinatario
+func (s *DeliveryService) RegisterEmail(ctx context.Context, delivery *models.EmailDelivery) (*notification.UserNotification, error) {
+	now := utils.TimeNow()
+
+	// 1. Construir el evento de dominio
+	payload, err := json.Marshal(delivery.Payload)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DeliveryService", "RegisterEmail", err, "FailedToRegisterDelivery", delivery.ReferenceID)
+	}
+
+	domainEvent := &event.DomainEvent{
+		UserID:     delivery.UserID,
+		BranchID:   delivery.BranchID,
+		EventType:  delivery.EventType,
+		Payload:    string(payload),
+		OccurredAt: now.Format("2006-01-02 15:04:05"),
+	}
+
+	// 2. Registrar la notificación como pendiente para que pueda enviarse de inmediato
+	userNotification := &notification.UserNotification{
+		UserID:           delivery.UserID,
+		BranchID:         delivery.BranchID,
+		NotificationType: notification.TypeEmail,
+		Message:          delivery.Subject,
+		DeliveryStatus:   notification.DeliveryPending,
+		DeliveryAt:       now,
+		Recipient:        delivery.Recipient,
+		ReferenceID:      delivery.ReferenceID,
+		NextAttemptAt:    &now,
+	}
+
+	if err = s.repo.CreateWithEvent(ctx, domainEvent, userNotification); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DeliveryService", "RegisterEmail", err, "FailedToRegisterDelivery", delivery.ReferenceID)
+	}
+
+	return userNotification, nil
+}
+
+// RegisterEventEmails registra una notificación pendiente por cada usuario notificable del dueño del evento. Si no
+// hay usuarios notificables no se registra ninguna notificación.
+func (s *DeliveryService) RegisterEventEmails(ctx context.Context, domainEvent *event.DomainEvent, message string) ([]*notification.UserNotification, error) {
+	// 1. Obtener los destinatarios del evento
+	users, err := s.repo.GetNotifiableUsers(ctx, domainEvent.UserID)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DeliveryService", "RegisterEventEmails", err, "FailedToRegisterEventNotifications", domainEvent.ID)
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	// 2. Registrar las notificaciones como pendientes para que puedan enviarse de inmediato
+	now := utils.TimeNow()
+	userNotifications := make([]*notification.UserNotification, 0, len(users))
+	for _, user := range users {
+		userNotifications = append(userNotifications, &notification.UserNotification{
+			UserID:           domainEvent.UserID,
+			EventID:          domainEvent.ID,
+			BranchID:         domainEvent.BranchID,
+			EventType:        domainEvent.EventType,
+			NotificationType: notification.TypeEventEmail,
+			Message:          message,
+			DeliveryStatus:   notification.DeliveryPending,
+			DeliveryAt:       now,
+			Recipient:        user.Email,
+			NextAttemptAt:    &now,
+		})
+	}
+
+	if err = s.repo.CreateForEvent(ctx, userNotifications); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DeliveryService", "RegisterEventEmails", err, "FailedToRegisterEventNotifications", domainEvent.ID)
+	}
+
+	return userNotifications, nil
+}
+
+// Claim reserva la notificación para evitar que el envío inmediato y el job de reintentos la procesen al mismo tiempo
+func (s *DeliveryService) Claim(ctx context.Context, userNotification *notification.UserNotification) (bool, error) {
+	now := utils.TimeNow()
+	until := now.Add(ClaimDuration)
+
+	claimed, err := s.repo.Claim(ctx, userNotification.ID, now, until)
+	if err != nil {
+		return false, shared_error.NewFormattedGeneralServiceWithError("DeliveryService", "Claim", err, "FailedToUpdateDelivery", userNotification.ID)
+	}
+
+	if claimed {
+		userNotification.NextAttemptAt = &until
+	}
+
+	return claimed, nil
+}
+
+// MarkSent registra el envío exitoso de la notificación
+func (s *DeliveryService) MarkSent(ctx context.Context, userNotification *notification.UserNotification) error {
+	userNotification.Attempts++
+	userNotification.DeliveryStatus = notification.DeliverySent
+	userNotification.DeliveryAt = utils.TimeNow()
+	userNotification.LastError = ""
+	userNotification.NextAttemptAt = nil
+
+	if err := s.repo.UpdateDelivery(ctx, userNotification); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("DeliveryService", "MarkSent", err, "FailedToUpdateDelivery", userNotification.ID)
+	}
+
+	return nil
+}
+
+// MarkFailed registra el intento fallido. Mientras no se agoten los intentos la notificación sigue pendiente
+// y su próximo intento se programa con una espera exponencial.
+func (s *DeliveryService) MarkFailed(ctx context.Context, userNotification *notification.UserNotification, cause error) error {
+	now := utils.TimeNow()
+
+	userNotification.Attempts++
+	userNotification.DeliveryAt = now
+	userNotification.LastError = truncate(cause.Error(), maxErrorLength)
+
+	if userNotification.Attempts >= s.maxAttempts {
+		userNotification.DeliveryStatus = notification.DeliveryFailed
+		userNotification.NextAttemptAt = nil
+	} else {
+		next := now.Add(NextRetryDelay(userNotification.Attempts))
+		userNotification.DeliveryStatus = notification.DeliveryPending
+		userNotification.NextAttemptAt = &next
+	}
+
+	if err := s.repo.UpdateDelivery(ctx, userNotification); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("DeliveryService", "MarkFailed", err, "FailedToUpdateDelivery", userNotification.ID)
+	}
+
+	return nil
+}
+
+// GetPendingEmails obtiene las notificaciones por correo cuyo próximo intento ya se cumplió
+func (s *DeliveryService) GetPendingEmails(ctx context.Context) ([]notification.UserNotification, error) {
+	pending, err := s.repo.GetPending(ctx, notification.TypeEmail, utils.TimeNow(), PendingBatchSize)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DeliveryService", "GetPendingEmails", err, "FailedToGetPendingDeliveries")
+	}
+
+	return pending, nil
+}
+
+// GetPendingEventEmails obtiene las notificaciones por correo de eventos de dominio cuyo próximo intento ya se cumplió
+func (s *DeliveryService) GetPendingEventEmails(ctx context.Context) ([]notification.UserNotification, error) {
+	pending, err := s.repo.GetPending(ctx, notification.TypeEventEmail, utils.TimeNow(), PendingBatchSize)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DeliveryService", "GetPendingEventEmails", err, "FailedToGetPendingDeliveries")
+	}
+
+	return pending, nil
+}
+
+// NextRetryDelay calcula la espera antes del siguiente intento según los intentos fallidos: 1, 2, 4, 8... minutos
+// hasta un máximo de RetryMaxDelay
+func NextRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		return RetryBaseDelay
+	}
+
+	delay := RetryBaseDelay
+	for i := 1; i < attempts && delay < RetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, RetryMaxDelay)
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+	return string(runes[:length])
+}
@@ -0,0 +1,27 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/notification/models"
+)
+
+// DeliveryManager interfaz para la gestión del estado de entrega de las notificaciones por correo
+type DeliveryManager interface {
+	// RegisterEmail registra una notificación pendiente de envío junto al evento que la origina
+	RegisterEmail(ctx context.Context, delivery *models.EmailDelivery) (*notification.UserNotification, error)
+	// RegisterEventEmails registra las notificaciones pendientes de un evento de dominio para los usuarios notificables
+	RegisterEventEmails(ctx context.Context, domainEvent *event.DomainEvent, message string) ([]*notification.UserNotification, error)
+	// Claim reserva una notificación para intentar su envío, retorna false si no está disponible
+	Claim(ctx context.Context, userNotification *notification.UserNotification) (bool, error)
+	// MarkSent registra que la notificación fue entregada
+	MarkSent(ctx context.Context, userNotification *notification.UserNotification) error
+	// MarkFailed registra un intento fallido y programa el siguiente reintento o marca la notificación como fallida
+	MarkFailed(ctx context.Context, userNotification *notification.UserNotification, cause error) error
+	// GetPendingEmails obtiene las notificaciones por correo que deben reintentarse
+	GetPendingEmails(ctx context.Context) ([]notification.UserNotification, error)
+	// GetPendingEventEmails obtiene las notificaciones por correo de eventos de dominio que deben reintentarse
+	GetPendingEventEmails(ctx context.Context) ([]notification.UserNotification, error)
+}
@@ -0,0 +1,12 @@
+package models
+
+// EmailDelivery representa la solicitud de envío de un documento por correo electrónico a un destinatario
+type EmailDelivery struct {
+	UserID      uint
+	BranchID    uint
+	ReferenceID string
+	Recipient   string
+	Subject     string
+	EventType   string
+	Payload     map[string]interface{}
+}
@@ -0,0 +1,25 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+)
+
+// NotificationRepositoryPort interfaz para el repositorio de notificaciones de usuario
+type NotificationRepositoryPort interface {
+	// CreateWithEvent registra el evento de dominio y su notificación en una misma transacción
+	CreateWithEvent(ctx context.Context, domainEvent *event.DomainEvent, userNotification *notification.UserNotification) error
+	// CreateForEvent registra las notificaciones de un evento de dominio ya registrado en una misma transacción
+	CreateForEvent(ctx context.Context, userNotifications []*notification.UserNotification) error
+	// GetNotifiableUsers obtiene los usuarios notificables registrados por un usuario
+	GetNotifiableUsers(ctx context.Context, userID uint) ([]notification.NotifiableUser, error)
+	// Claim reserva una notificación pendiente hasta la fecha indicada, retorna false si otro proceso ya la reservó
+	Claim(ctx context.Context, id uint, now, until time.Time) (bool, error)
+	// UpdateDelivery actualiza el estado de entrega, los intentos y el próximo intento de una notificación
+	UpdateDelivery(ctx context.Context, userNotification *notification.UserNotification) error
+	// GetPending obtiene las notificaciones pendientes de un tipo cuyo próximo intento ya se cumplió
+	GetPending(ctx context.Context, notificationType string, now time.Time, limit int) ([]notification.UserNotification, error)
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// PDFTemplate representa la personalización de la versión legible de los DTE de un usuario:
+// el logo que se muestra en el encabezado y la plantilla del pie de página
+type PDFTemplate struct {
+	UserID       uint      `json:"-"`
+	Logo         []byte    `json:"-"`
+	LogoMimeType string    `json:"logo_mime_type,omitempty"`
+	HasLogo      bool      `json:"has_logo"`
+	Footer       string    `json:"footer"`
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+}
+
+// FooterData representa los datos disponibles en la plantilla del pie de página, por ejemplo
+// "{{.IssuerName}} - Documento {{.ControlNumber}}"
+type FooterData struct {
+	IssuerName     string
+	IssuerNIT      string
+	DTEType        string
+	DTETypeName    string
+	ControlNumber  string
+	GenerationCode string
+	EmissionDate   string
+}
@@ -0,0 +1,15 @@
+package pdf_template
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template/models"
+)
+
+// PDFTemplateRepositoryPort interfaz para el repositorio de plantillas de la versión legible de los DTE
+type PDFTemplateRepositoryPort interface {
+	// GetByUserID obtiene la plantilla de un usuario, retorna ErrPDFTemplateNotFound si no ha sido configurada
+	GetByUserID(ctx context.Context, userID uint) (*models.PDFTemplate, error)
+	// Save crea o actualiza la plantilla de un usuario
+	Save(ctx context.Context, template *models.PDFTemplate) error
+}
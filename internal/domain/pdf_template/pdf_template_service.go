@@ -0,0 +1,149 @@
+package pdf_template
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+const (
+	// MaxLogoSize es el tamaño máximo del logo en bytes
+	MaxLogoSize = 512 * 1024
+	// MaxLogoDimension es el ancho o alto máximo del logo en pixeles
+	MaxLogoDimension = 2000
+	// MaxFooterLength es la cantidad máxima de caracteres de la plantilla del pie de página
+	MaxFooterLength = 500
+)
+
+var allowedLogoFormats = map[string]string{
+	"png":  "image/png",
+	"jpeg": "image/jpeg",
+}
+
+type PDFTemplateService struct {
+	repo PDFTemplateRepositoryPort
+}
+
+func NewPDFTemplateService(repo PDFTemplateRepositoryPort) PDFTemplateManager {
+	return &PDFTemplateService{
+		repo: repo,
+	}
+}
+
+// GetTemplate obtiene la plantilla de un usuario o una plantilla vacía si no ha sido configurada
+func (s *PDFTemplateService) GetTemplate(ctx context.Context, userID uint) (*models.PDFTemplate, error) {
+	tpl, err := s.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrPDFTemplateNotFound) {
+			return &models.PDFTemplate{UserID: userID}, nil
+		}
+		return nil, shared_error.NewFormattedGeneralServiceWithError("PDFTemplateService", "GetTemplate", err, "FailedToGetPDFTemplate")
+	}
+
+	tpl.HasLogo = len(tpl.Logo) > 0
+	return tpl, nil
+}
+
+// SaveTemplate valida el logo y la plantilla del pie de página antes de almacenarlos
+func (s *PDFTemplateService) SaveTemplate(ctx context.Context, tpl *models.PDFTemplate) (*models.PDFTemplate, error) {
+	// 1. Validar el logo, si se proporciona
+	if len(tpl.Logo) > 0 {
+		mimeType, err := validateLogo(tpl.Logo)
+		if err != nil {
+			return nil, err
+		}
+		tpl.LogoMimeType = mimeType
+	} else {
+		tpl.LogoMimeType = ""
+	}
+
+	// 2. Validar que la plantilla del pie de página pueda generarse
+	tpl.Footer = strings.TrimSpace(tpl.Footer)
+	if err := validateFooter(tpl.Footer); err != nil {
+		return nil, err
+	}
+
+	// 3. Almacenar la plantilla
+	tpl.UpdatedAt = utils.TimeNow()
+	if err := s.repo.Save(ctx, tpl); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("PDFTemplateService", "SaveTemplate", err, "FailedToSavePDFTemplate")
+	}
+
+	tpl.HasLogo = len(tpl.Logo) > 0
+	return tpl, nil
+}
+
+// RenderFooter genera el texto del pie de página. Si la plantilla no puede generarse se omite el pie de página,
+// ya que no debe impedir la generación del documento.
+func (s *PDFTemplateService) RenderFooter(tpl *models.PDFTemplate, data models.FooterData) string {
+	if tpl == nil || tpl.Footer == "" {
+		return ""
+	}
+
+	footer, err := executeFooter(tpl.Footer, data)
+	if err != nil {
+		logs.Warn("Failed to render PDF footer template", map[string]interface{}{
+			"userID": tpl.UserID,
+			"error":  err.Error(),
+		})
+		return ""
+	}
+
+	return footer
+}
+
+func validateLogo(logo []byte) (string, error) {
+	if len(logo) > MaxLogoSize {
+		return "", shared_error.NewFormattedGeneralServiceError("PDFTemplateService", "SaveTemplate", "PDFLogoTooLarge", MaxLogoSize/1024)
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(logo))
+	if err != nil {
+		return "", shared_error.NewFormattedGeneralServiceWithError("PDFTemplateService", "SaveTemplate", err, "InvalidPDFLogo")
+	}
+
+	mimeType, ok := allowedLogoFormats[format]
+	if !ok || config.Width > MaxLogoDimension || config.Height > MaxLogoDimension {
+		return "", shared_error.NewFormattedGeneralServiceError("PDFTemplateService", "SaveTemplate", "InvalidPDFLogo")
+	}
+
+	return mimeType, nil
+}
+
+func validateFooter(footer string) error {
+	if utf8.RuneCountInString(footer) > MaxFooterLength {
+		return shared_error.NewFormattedGeneralServiceError("PDFTemplateService", "SaveTemplate", "PDFFooterTooLong", MaxFooterLength)
+	}
+
+	if _, err := executeFooter(footer, models.FooterData{}); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("PDFTemplateService", "SaveTemplate", err, "InvalidPDFFooter")
+	}
+
+	return nil
+}
+
+func executeFooter(footer string, data models.FooterData) (string, error) {
+	tpl, err := template.New("footer").Option("missingkey=error").Parse(footer)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,17 @@
+package pdf_template
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template/models"
+)
+
+// PDFTemplateManager define las operaciones sobre las plantillas de la versión legible de los DTE
+type PDFTemplateManager interface {
+	// GetTemplate obtiene la plantilla de un usuario o la plantilla por defecto si no ha sido configurada
+	GetTemplate(ctx context.Context, userID uint) (*models.PDFTemplate, error)
+	// SaveTemplate valida y almacena la plantilla de un usuario
+	SaveTemplate(ctx context.Context, template *models.PDFTemplate) (*models.PDFTemplate, error)
+	// RenderFooter genera el texto del pie de página a partir de la plantilla y los datos del documento
+	RenderFooter(template *models.PDFTemplate, data models.FooterData) string
+}
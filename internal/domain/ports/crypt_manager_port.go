@@ -12,6 +12,12 @@ type CryptManager interface {
 	EncryptStruct(token string, data models.HaciendaCredentials) (string, error)
 	// DecryptStruct desencripta un string y lo convierte en una estructura de HaciendaCredentials
 	DecryptStruct(token string, data string) (models.HaciendaCredentials, error)
+	// HashSecret obtiene el hash con el que se almacena un API Secret
+	HashSecret(secret string) string
 	// GenerateBulkAPIKeys genera una cantidad de API Keys aleatorios
 	GenerateBulkAPIKeys(amount int) ([]string, []string, error)
+	// Encrypt encripta un contenido arbitrario con una llave derivada del token y lo convierte en un string
+	Encrypt(token string, data []byte) (string, error)
+	// Decrypt desencripta un string generado por Encrypt
+	Decrypt(token string, data string) ([]byte, error)
 }
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+)
+
+// MailSender determina el comportamiento de un servicio de envío de correos electrónicos
+type MailSender interface {
+	// Send envía un correo electrónico con sus archivos adjuntos
+	Send(ctx context.Context, email *notification.Email) error
+}
@@ -11,6 +11,7 @@ type CacheManager interface {
 	Set(key string, claims []byte, ttl time.Duration) error                                       // Set guarda un token en el cache
 	SetCredentials(token string, cipherInfo *models.HaciendaCredentials, ttl time.Duration) error // SetCredentials guarda las credenciales en el cache
 	GetCredentials(token string) (*models.HaciendaCredentials, error)                             // GetCredentials obtiene las credenciales del cache
+	DeleteCredentials(token string) error                                                         // DeleteCredentials elimina las credenciales del cache
 	Get(key string) (string, error)                                                               // Get obtiene un token del cache
 	Delete(token string) error                                                                    // Delete elimina un token del cache
 	GetRedisClient() *redis.Client                                                                // GetRedisClient retorna el cliente de Redis
@@ -31,6 +32,7 @@ type TokenManager interface {
 	GenerateToken(claims *models.AuthClaims, tokenLifetime time.Duration) (string, error)                                     // GenerateToken genera un nuevo token JWT con los claims proporcionados
 	ValidateToken(token string) (*models.AuthClaims, error)                                                                   // ValidateToken valida un token y retorna sus claims
 	RevokeToken(token string) error                                                                                           // RevokeToken revoca un token específico
+	RevokeIssuedTokens(branchID uint) (int, error)                                                                            // RevokeIssuedTokens revoca los tokens emitidos hasta ahora para una sucursal
 	SaveTimestampsForContingency(issuedAt, expiresAt time.Time, tokenLifetime time.Duration, claims *models.AuthClaims) error // SaveTimestampsForContingency guarda los timestamps de un token en contingencia
 	GetSecretKey() string                                                                                                     // GetSecretKey retorna la clave secreta para firmar los tokens
 }
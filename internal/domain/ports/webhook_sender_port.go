@@ -0,0 +1,10 @@
+package ports
+
+import "context"
+
+// WebhookSender determina el comportamiento de un cliente que envía webhooks a los endpoints de los usuarios
+type WebhookSender interface {
+	// Send envía el cuerpo con los encabezados indicados y retorna el código de estado de la respuesta. Retorna
+	// error si la solicitud falla o si el estado no es 2xx.
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
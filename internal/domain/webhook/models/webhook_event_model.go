@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+const (
+	// EventDTEReceived se emite cuando Hacienda recibe un documento, ya sea en línea, asíncrono o en contingencia
+	EventDTEReceived = "dte.received"
+	// EventDTERejected se emite cuando Hacienda rechaza un documento almacenado
+	EventDTERejected = "dte.rejected"
+	// EventDTEContingency se emite cuando un documento se almacena en contingencia
+	EventDTEContingency = "dte.contingency"
+	// EventContingencyBatchProcessed se emite cuando Hacienda termina de procesar un lote de contingencia
+	EventContingencyBatchProcessed = "contingency.batch_processed"
+	// EventDTEInvalidated se emite cuando se invalida un documento
+	EventDTEInvalidated = "dte.invalidated"
+	// EventCreditNoteBalanceExhausted se emite cuando las notas de crédito agotan el saldo de un documento
+	EventCreditNoteBalanceExhausted = "credit_note.balance_exhausted"
+
+	// AllEvents permite suscribirse a todos los eventos
+	AllEvents = "*"
+)
+
+// SupportedEvents contiene los eventos a los que es posible suscribirse
+var SupportedEvents = []string{
+	EventDTEReceived,
+	EventDTERejected,
+	EventDTEContingency,
+	EventContingencyBatchProcessed,
+	EventDTEInvalidated,
+	EventCreditNoteBalanceExhausted,
+}
+
+// IsSupportedEvent indica si el evento existe o es el comodín de todos los eventos
+func IsSupportedEvent(eventType string) bool {
+	if eventType == AllEvents {
+		return true
+	}
+
+	for _, supported := range SupportedEvents {
+		if supported == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEvent es el cuerpo enviado en cada webhook
+type WebhookEvent struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	BranchID   uint        `json:"branch_id"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
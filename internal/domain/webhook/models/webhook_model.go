@@ -0,0 +1,77 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// DeliveryPending indica que el webhook aún no se entrega y será reintentado
+	DeliveryPending = "PENDING"
+	// DeliveryDelivered indica que el endpoint respondió con un estado 2xx
+	DeliveryDelivered = "DELIVERED"
+	// DeliveryFailed indica que se agotaron los intentos de entrega
+	DeliveryFailed = "FAILED"
+)
+
+// WebhookSubscription representa un endpoint de un usuario que recibe los eventos a los que se suscribió.
+// Secret se almacena encriptado y solo se expone al crear la suscripción.
+type WebhookSubscription struct {
+	ID        uint      `json:"id"`
+	UserID    uint      `json:"-"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret,omitempty"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Matches indica si la suscripción está activa y suscrita al evento
+func (s *WebhookSubscription) Matches(eventType string) bool {
+	if !s.IsActive {
+		return false
+	}
+
+	for _, event := range s.Events {
+		if event == AllEvents || event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// SubscriptionInput contiene los datos para crear o modificar una suscripción. En una modificación, los campos
+// vacíos conservan su valor actual.
+type SubscriptionInput struct {
+	URL      string
+	Events   []string
+	Secret   string
+	IsActive *bool
+}
+
+// WebhookDelivery representa el registro de entrega de un evento a una suscripción
+type WebhookDelivery struct {
+	ID             uint                 `json:"id"`
+	SubscriptionID uint                 `json:"subscription_id"`
+	EventID        string               `json:"event_id"`
+	EventType      string               `json:"event_type"`
+	Payload        json.RawMessage      `json:"payload"`
+	Status         string               `json:"status"`
+	Attempts       int                  `json:"attempts"`
+	ResponseStatus *int                 `json:"response_status,omitempty"`
+	LastError      string               `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time           `json:"next_attempt_at,omitempty"`
+	DeliveredAt    *time.Time           `json:"delivered_at,omitempty"`
+	ReplayOf       *uint                `json:"replay_of,omitempty"`
+	CreatedAt      time.Time            `json:"created_at"`
+	Subscription   *WebhookSubscription `json:"-"`
+}
+
+// DeliveryFilters contiene los filtros del registro de entregas
+type DeliveryFilters struct {
+	SubscriptionID *uint
+	Status         string
+	EventType      string
+	Limit          int
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// reservedNetworks son los rangos no enrutables en internet que net.IP no clasifica: la red "esta red", el espacio
+// compartido de CGNAT y la red de pruebas de rendimiento
+var reservedNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("198.18.0.0/15"),
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// IsPublicIP indica si la dirección puede ser destino de un webhook. Se excluyen las direcciones de loopback,
+// privadas (RFC 1918 y fc00::/7), de enlace local (169.254.0.0/16 y fe80::/10), no especificadas, multicast y
+// reservadas, para que una suscripción no alcance servicios internos de la infraestructura.
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+
+	for _, network := range reservedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPublicDestination indica si la URL usa https y su host no es local ni una dirección IP que no sea pública. Los
+// nombres de dominio se verifican de nuevo al conectar, con la dirección a la que resuelven.
+func isPublicDestination(parsed *url.URL) bool {
+	if parsed.Scheme != "https" {
+		return false
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return IsPublicIP(ip)
+	}
+
+	return true
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook/models"
+)
+
+// domainEventTypes relaciona los eventos de dominio con el evento de webhook al que pueden suscribirse los usuarios
+var domainEventTypes = map[string]string{
+	event.DTEReceived:                models.EventDTEReceived,
+	event.DTERejected:                models.EventDTERejected,
+	event.DTEContingency:             models.EventDTEContingency,
+	event.ContingencyBatchProcessed:  models.EventContingencyBatchProcessed,
+	event.DTEInvalidated:             models.EventDTEInvalidated,
+	event.CreditNoteBalanceExhausted: models.EventCreditNoteBalanceExhausted,
+}
+
+// EventHandler crea el suscriptor del bus de eventos que publica hacia los webhooks los eventos de dominio con un
+// evento de webhook equivalente. El contenido del evento de dominio se envía como los datos del webhook.
+func EventHandler(publisher WebhookPublisher) events.EventHandler {
+	return func(ctx context.Context, domainEvent event.DomainEvent) {
+		eventType, ok := domainEventTypes[domainEvent.EventType]
+		if !ok {
+			return
+		}
+
+		publisher.Publish(ctx, domainEvent.BranchID, eventType, json.RawMessage(domainEvent.Payload))
+	}
+}
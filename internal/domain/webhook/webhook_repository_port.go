@@ -0,0 +1,37 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook/models"
+)
+
+// WebhookRepositoryPort interfaz para el repositorio de suscripciones y entregas de webhooks
+type WebhookRepositoryPort interface {
+	// CreateSubscription registra una suscripción
+	CreateSubscription(ctx context.Context, subscription *models.WebhookSubscription) error
+	// ListSubscriptions obtiene las suscripciones de un usuario
+	ListSubscriptions(ctx context.Context, userID uint) ([]models.WebhookSubscription, error)
+	// GetSubscription obtiene una suscripción de un usuario, retorna ErrWebhookNotFound si no existe
+	GetSubscription(ctx context.Context, userID, id uint) (*models.WebhookSubscription, error)
+	// UpdateSubscription actualiza la URL, los eventos, el secreto y el estado de una suscripción
+	UpdateSubscription(ctx context.Context, subscription *models.WebhookSubscription) error
+	// DeleteSubscription elimina una suscripción de un usuario conservando su registro de entregas
+	DeleteSubscription(ctx context.Context, userID, id uint) error
+	// GetActiveSubscriptionsByBranch obtiene las suscripciones activas del usuario dueño de la sucursal
+	GetActiveSubscriptionsByBranch(ctx context.Context, branchID uint) ([]models.WebhookSubscription, error)
+
+	// CreateDeliveries registra las entregas pendientes de un evento
+	CreateDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error
+	// GetPendingDeliveries obtiene las entregas pendientes cuyo próximo intento ya se cumplió, incluyendo su suscripción
+	GetPendingDeliveries(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error)
+	// ClaimDelivery reserva una entrega pendiente hasta la fecha indicada, retorna false si ya fue reservada
+	ClaimDelivery(ctx context.Context, id uint, now, until time.Time) (bool, error)
+	// UpdateDelivery actualiza el resultado de una entrega
+	UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	// ListDeliveries obtiene el registro de entregas de las suscripciones de un usuario, de la más reciente a la más antigua
+	ListDeliveries(ctx context.Context, userID uint, filters *models.DeliveryFilters) ([]models.WebhookDelivery, error)
+	// GetDelivery obtiene una entrega de un usuario con su suscripción, retorna ErrWebhookDeliveryNotFound si no existe
+	GetDelivery(ctx context.Context, userID, id uint) (*models.WebhookDelivery, error)
+}
@@ -0,0 +1,505 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+const (
+	// RetryBaseDelay es la espera antes del primer reintento, se duplica en cada intento fallido
+	RetryBaseDelay = time.Minute
+	// RetryMaxDelay es la espera máxima entre reintentos
+	RetryMaxDelay = time.Hour
+	// ClaimDuration es el tiempo que una entrega queda reservada mientras se intenta su envío
+	ClaimDuration = 2 * time.Minute
+	// PendingBatchSize es la cantidad máxima de entregas que se reintentan por ejecución
+	PendingBatchSize = 100
+	// MinSecretLength es la longitud mínima de un secreto de firma proporcionado por el usuario
+	MinSecretLength = 16
+	// maxErrorLength es la longitud máxima del último error almacenado
+	maxErrorLength = 500
+
+	// Encabezados enviados en cada webhook
+	HeaderWebhookID = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+type WebhookService struct {
+	repo                 WebhookRepositoryPort
+	sender               ports.WebhookSender
+	cryptManager         ports.CryptManager
+	encryptionKey        string
+	maxAttempts          int
+	allowPrivateNetworks bool
+}
+
+// NewWebhookService crea el servicio de webhooks. Los secretos de firma se almacenan cifrados con encryptionKey. Solo
+// si allowPrivateNetworks está habilitado se aceptan URLs http o que apunten a hosts locales o redes privadas.
+func NewWebhookService(repo WebhookRepositoryPort, sender ports.WebhookSender, cryptManager ports.CryptManager, encryptionKey string, maxAttempts int, allowPrivateNetworks bool) WebhookManager {
+	return &WebhookService{
+		repo:                 repo,
+		sender:               sender,
+		cryptManager:         cryptManager,
+		encryptionKey:        encryptionKey,
+		maxAttempts:          maxAttempts,
+		allowPrivateNetworks: allowPrivateNetworks,
+	}
+}
+
+// CreateSubscription valida y registra la suscripción. Si no se proporciona un secreto se genera uno; el secreto en
+// texto plano solo se retorna en esta operación.
+func (s *WebhookService) CreateSubscription(ctx context.Context, userID uint, input *models.SubscriptionInput) (*models.WebhookSubscription, error) {
+	// 1. Validar la URL y los eventos
+	if err := s.validateURL(input.URL); err != nil {
+		return nil, err
+	}
+	events, err := normalizeEvents(input.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Obtener o generar el secreto de firma
+	secret := input.Secret
+	if secret == "" {
+		if secret, err = s.cryptManager.GenerateAPIKey(); err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "CreateSubscription", err, "FailedToSaveWebhook")
+		}
+	} else if err = validateSecret(secret); err != nil {
+		return nil, err
+	}
+
+	encryptedSecret, err := s.cryptManager.Encrypt(s.encryptionKey, []byte(secret))
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "CreateSubscription", err, "FailedToSaveWebhook")
+	}
+
+	// 3. Registrar la suscripción
+	isActive := true
+	if input.IsActive != nil {
+		isActive = *input.IsActive
+	}
+
+	now := utils.TimeNow()
+	subscription := &models.WebhookSubscription{
+		UserID:    userID,
+		URL:       input.URL,
+		Events:    events,
+		Secret:    encryptedSecret,
+		IsActive:  isActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err = s.repo.CreateSubscription(ctx, subscription); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "CreateSubscription", err, "FailedToSaveWebhook")
+	}
+
+	subscription.Secret = secret
+	return subscription, nil
+}
+
+// ListSubscriptions obtiene las suscripciones del usuario sin sus secretos
+func (s *WebhookService) ListSubscriptions(ctx context.Context, userID uint) ([]models.WebhookSubscription, error) {
+	subscriptions, err := s.repo.ListSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "ListSubscriptions", err, "FailedToGetWebhooks")
+	}
+
+	for i := range subscriptions {
+		subscriptions[i].Secret = ""
+	}
+
+	return subscriptions, nil
+}
+
+// UpdateSubscription modifica la URL, los eventos, el secreto o el estado de la suscripción. El secreto en texto
+// plano solo se retorna cuando se reemplaza.
+func (s *WebhookService) UpdateSubscription(ctx context.Context, userID, id uint, input *models.SubscriptionInput) (*models.WebhookSubscription, error) {
+	// 1. Obtener la suscripción
+	subscription, err := s.getSubscription(ctx, "UpdateSubscription", userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Aplicar los cambios validados
+	if input.URL != "" {
+		if err = s.validateURL(input.URL); err != nil {
+			return nil, err
+		}
+		subscription.URL = input.URL
+	}
+
+	if input.Events != nil {
+		if subscription.Events, err = normalizeEvents(input.Events); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.IsActive != nil {
+		subscription.IsActive = *input.IsActive
+	}
+
+	if input.Secret != "" {
+		if err = validateSecret(input.Secret); err != nil {
+			return nil, err
+		}
+		if subscription.Secret, err = s.cryptManager.Encrypt(s.encryptionKey, []byte(input.Secret)); err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "UpdateSubscription", err, "FailedToSaveWebhook")
+		}
+	}
+
+	// 3. Guardar la suscripción
+	subscription.UpdatedAt = utils.TimeNow()
+	if err = s.repo.UpdateSubscription(ctx, subscription); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "UpdateSubscription", err, "FailedToSaveWebhook")
+	}
+
+	subscription.Secret = input.Secret
+	return subscription, nil
+}
+
+// DeleteSubscription elimina la suscripción, las entregas pendientes dejan de enviarse
+func (s *WebhookService) DeleteSubscription(ctx context.Context, userID, id uint) error {
+	if _, err := s.getSubscription(ctx, "DeleteSubscription", userID, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteSubscription(ctx, userID, id); err != nil {
+		return shared_error.NewFormattedGeneralServiceWithError("WebhookService", "DeleteSubscription", err, "FailedToSaveWebhook")
+	}
+
+	return nil
+}
+
+// Publish registra una entrega por cada suscripción interesada en el evento y las envía en segundo plano. Las
+// entregas que fallen quedan pendientes para el job de reintentos.
+func (s *WebhookService) Publish(ctx context.Context, branchID uint, eventType string, data interface{}) {
+	// 1. Obtener las suscripciones interesadas en el evento
+	subscriptions, err := s.repo.GetActiveSubscriptionsByBranch(ctx, branchID)
+	if err != nil {
+		logs.Error("Failed to get webhook subscriptions", map[string]interface{}{
+			"branchID":  branchID,
+			"eventType": eventType,
+			"error":     err.Error(),
+		})
+		return
+	}
+
+	var matching []*models.WebhookSubscription
+	for i := range subscriptions {
+		if subscriptions[i].Matches(eventType) {
+			matching = append(matching, &subscriptions[i])
+		}
+	}
+	if len(matching) == 0 {
+		return
+	}
+
+	// 2. Construir el evento, todas las entregas comparten el mismo identificador y contenido
+	now := utils.TimeNow()
+	event := models.WebhookEvent{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		BranchID:   branchID,
+		OccurredAt: now,
+		Data:       data,
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logs.Error("Failed to encode webhook event", map[string]interface{}{
+			"eventType": eventType,
+			"error":     err.Error(),
+		})
+		return
+	}
+
+	// 3. Registrar las entregas como pendientes
+	deliveries := make([]*models.WebhookDelivery, 0, len(matching))
+	for _, subscription := range matching {
+		deliveries = append(deliveries, &models.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			Payload:        payload,
+			Status:         models.DeliveryPending,
+			NextAttemptAt:  &now,
+			CreatedAt:      now,
+			Subscription:   subscription,
+		})
+	}
+
+	if err = s.repo.CreateDeliveries(ctx, deliveries); err != nil {
+		logs.Error("Failed to register webhook deliveries", map[string]interface{}{
+			"eventID":   event.ID,
+			"eventType": eventType,
+			"error":     err.Error(),
+		})
+		return
+	}
+
+	// 4. Enviar las entregas sin bloquear la operación que originó el evento
+	go func(ctx context.Context) {
+		for _, delivery := range deliveries {
+			s.claimAndDeliver(ctx, delivery)
+		}
+	}(context.WithoutCancel(ctx))
+}
+
+// ListDeliveries obtiene el registro de entregas del usuario
+func (s *WebhookService) ListDeliveries(ctx context.Context, userID uint, filters *models.DeliveryFilters) ([]models.WebhookDelivery, error) {
+	deliveries, err := s.repo.ListDeliveries(ctx, userID, filters)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "ListDeliveries", err, "FailedToGetWebhookDeliveries")
+	}
+
+	return deliveries, nil
+}
+
+// Replay registra una nueva entrega del mismo evento hacia la suscripción original y la envía de inmediato. La
+// entrega conserva el identificador del evento para que el receptor pueda detectar duplicados.
+func (s *WebhookService) Replay(ctx context.Context, userID, deliveryID uint) (*models.WebhookDelivery, error) {
+	// 1. Obtener la entrega original y su suscripción
+	original, err := s.repo.GetDelivery(ctx, userID, deliveryID)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrWebhookDeliveryNotFound) {
+			return nil, shared_error.NewFormattedGeneralServiceError("WebhookService", "Replay", "WebhookDeliveryNotFound", deliveryID)
+		}
+		return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "Replay", err, "FailedToGetWebhookDeliveries")
+	}
+
+	if original.Subscription == nil || !original.Subscription.IsActive {
+		return nil, shared_error.NewFormattedGeneralServiceError("WebhookService", "Replay", "WebhookInactive", original.SubscriptionID)
+	}
+
+	// 2. Registrar la nueva entrega
+	now := utils.TimeNow()
+	replay := &models.WebhookDelivery{
+		SubscriptionID: original.SubscriptionID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         models.DeliveryPending,
+		NextAttemptAt:  &now,
+		ReplayOf:       &original.ID,
+		CreatedAt:      now,
+		Subscription:   original.Subscription,
+	}
+
+	if err = s.repo.CreateDeliveries(ctx, []*models.WebhookDelivery{replay}); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "Replay", err, "FailedToSaveWebhookDelivery", deliveryID)
+	}
+
+	// 3. Enviar la entrega, si falla queda pendiente para el job de reintentos
+	s.claimAndDeliver(ctx, replay)
+
+	return replay, nil
+}
+
+// DeliverPending reintenta las entregas pendientes cuyo próximo intento ya se cumplió
+func (s *WebhookService) DeliverPending(ctx context.Context) (int, error) {
+	pending, err := s.repo.GetPendingDeliveries(ctx, utils.TimeNow(), PendingBatchSize)
+	if err != nil {
+		return 0, shared_error.NewFormattedGeneralServiceWithError("WebhookService", "DeliverPending", err, "FailedToGetWebhookDeliveries")
+	}
+
+	delivered := 0
+	for i := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if s.claimAndDeliver(ctx, &pending[i]) {
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+// claimAndDeliver reserva la entrega para evitar que el envío inmediato y el job de reintentos la procesen al mismo
+// tiempo, la envía y registra el resultado. Retorna true si la entrega fue exitosa.
+func (s *WebhookService) claimAndDeliver(ctx context.Context, delivery *models.WebhookDelivery) bool {
+	// 1. Reservar la entrega
+	now := utils.TimeNow()
+	claimed, err := s.repo.ClaimDelivery(ctx, delivery.ID, now, now.Add(ClaimDuration))
+	if err != nil {
+		logs.Error("Failed to claim webhook delivery", map[string]interface{}{
+			"deliveryID": delivery.ID,
+			"error":      err.Error(),
+		})
+		return false
+	}
+	if !claimed {
+		return false
+	}
+
+	// 2. Enviar el webhook y registrar el resultado
+	delivery.Attempts++
+	status, err := s.send(ctx, delivery)
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+
+	if err == nil {
+		deliveredAt := utils.TimeNow()
+		delivery.Status = models.DeliveryDelivered
+		delivery.DeliveredAt = &deliveredAt
+		delivery.NextAttemptAt = nil
+		delivery.LastError = ""
+	} else {
+		delivery.LastError = truncate(err.Error(), maxErrorLength)
+		if delivery.Attempts < s.maxAttempts {
+			next := utils.TimeNow().Add(NextRetryDelay(delivery.Attempts))
+			delivery.NextAttemptAt = &next
+		} else {
+			delivery.Status = models.DeliveryFailed
+			delivery.NextAttemptAt = nil
+		}
+
+		logs.Warn("Webhook delivery failed", map[string]interface{}{
+			"deliveryID": delivery.ID,
+			"eventType":  delivery.EventType,
+			"attempts":   delivery.Attempts,
+			"error":      delivery.LastError,
+		})
+	}
+
+	if err = s.repo.UpdateDelivery(ctx, delivery); err != nil {
+		logs.Error("Failed to update webhook delivery", map[string]interface{}{
+			"deliveryID": delivery.ID,
+			"error":      err.Error(),
+		})
+	}
+
+	return delivery.Status == models.DeliveryDelivered
+}
+
+// send firma el contenido de la entrega con el secreto de su suscripción y lo envía a su URL
+func (s *WebhookService) send(ctx context.Context, delivery *models.WebhookDelivery) (int, error) {
+	subscription := delivery.Subscription
+	if subscription == nil {
+		return 0, errPackage.ErrWebhookNotFound
+	}
+
+	secret, err := s.cryptManager.Decrypt(s.encryptionKey, subscription.Secret)
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(utils.TimeNow().Unix(), 10)
+	headers := map[string]string{
+		"Content-Type":  "application/json",
+		HeaderWebhookID: delivery.EventID,
+		HeaderEvent:     delivery.EventType,
+		HeaderTimestamp: timestamp,
+		HeaderSignature: SignPayload(string(secret), timestamp, delivery.Payload),
+	}
+
+	return s.sender.Send(ctx, subscription.URL, headers, delivery.Payload)
+}
+
+func (s *WebhookService) getSubscription(ctx context.Context, operation string, userID, id uint) (*models.WebhookSubscription, error) {
+	subscription, err := s.repo.GetSubscription(ctx, userID, id)
+	if err != nil {
+		if errors.Is(err, errPackage.ErrWebhookNotFound) {
+			return nil, shared_error.NewFormattedGeneralServiceError("WebhookService", operation, "WebhookNotFound", id)
+		}
+		return nil, shared_error.NewFormattedGeneralServiceWithError("WebhookService", operation, err, "FailedToGetWebhooks")
+	}
+
+	return subscription, nil
+}
+
+// SignPayload calcula la firma enviada en el encabezado X-Webhook-Signature: el HMAC-SHA256 en hexadecimal de
+// "timestamp.body" con el secreto de la suscripción, con el prefijo "sha256=". El receptor debe recalcularla para
+// verificar el origen del webhook y rechazar las marcas de tiempo antiguas para evitar reenvíos.
+func SignPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NextRetryDelay calcula la espera antes del siguiente intento según los intentos realizados: 1m, 2m, 4m... hasta
+// un máximo de RetryMaxDelay
+func NextRetryDelay(attempts int) time.Duration {
+	delay := RetryBaseDelay
+	for i := 1; i < attempts && delay < RetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, RetryMaxDelay)
+}
+
+// validateURL verifica que la URL sea absoluta http o https y, salvo que se permitan las redes privadas, que use https
+// y apunte a un host público
+func (s *WebhookService) validateURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return shared_error.NewFormattedGeneralServiceError("WebhookService", "validateURL", "InvalidWebhookURL", rawURL)
+	}
+
+	if !s.allowPrivateNetworks && !isPublicDestination(parsed) {
+		return shared_error.NewFormattedGeneralServiceError("WebhookService", "validateURL", "WebhookURLNotPublic", rawURL)
+	}
+
+	return nil
+}
+
+func validateSecret(secret string) error {
+	if len(secret) < MinSecretLength {
+		return shared_error.NewFormattedGeneralServiceError("WebhookService", "validateSecret", "InvalidWebhookSecret", MinSecretLength)
+	}
+
+	return nil
+}
+
+// normalizeEvents valida los eventos y elimina los duplicados
+func normalizeEvents(events []string) ([]string, error) {
+	if len(events) == 0 {
+		return nil, shared_error.NewFormattedGeneralServiceError("WebhookService", "normalizeEvents", "InvalidWebhookEvent", "")
+	}
+
+	seen := make(map[string]bool, len(events))
+	normalized := make([]string, 0, len(events))
+	for _, event := range events {
+		if !models.IsSupportedEvent(event) {
+			return nil, shared_error.NewFormattedGeneralServiceError("WebhookService", "normalizeEvents", "InvalidWebhookEvent", event)
+		}
+		if !seen[event] {
+			seen[event] = true
+			normalized = append(normalized, event)
+		}
+	}
+
+	return normalized, nil
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+	return string(runes[:length])
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook/models"
+)
+
+// WebhookPublisher publica los eventos del ciclo de vida de los DTE hacia los webhooks de los usuarios
+type WebhookPublisher interface {
+	// Publish registra una entrega por cada suscripción del dueño de la sucursal interesada en el evento y las envía
+	// en segundo plano. Los errores se registran sin afectar la operación que originó el evento.
+	Publish(ctx context.Context, branchID uint, eventType string, data interface{})
+}
+
+// WebhookManager define la gestión de suscripciones y entregas de webhooks
+type WebhookManager interface {
+	WebhookPublisher
+	// CreateSubscription registra una suscripción y retorna su secreto de firma
+	CreateSubscription(ctx context.Context, userID uint, input *models.SubscriptionInput) (*models.WebhookSubscription, error)
+	// ListSubscriptions obtiene las suscripciones de un usuario
+	ListSubscriptions(ctx context.Context, userID uint) ([]models.WebhookSubscription, error)
+	// UpdateSubscription modifica una suscripción de un usuario
+	UpdateSubscription(ctx context.Context, userID, id uint, input *models.SubscriptionInput) (*models.WebhookSubscription, error)
+	// DeleteSubscription elimina una suscripción de un usuario
+	DeleteSubscription(ctx context.Context, userID, id uint) error
+	// ListDeliveries obtiene el registro de entregas de un usuario
+	ListDeliveries(ctx context.Context, userID uint, filters *models.DeliveryFilters) ([]models.WebhookDelivery, error)
+	// Replay registra una nueva entrega del mismo evento y la envía de inmediato
+	Replay(ctx context.Context, userID, deliveryID uint) (*models.WebhookDelivery, error)
+	// DeliverPending reintenta las entregas pendientes y retorna cuántas fueron entregadas
+	DeliverPending(ctx context.Context) (int, error)
+}
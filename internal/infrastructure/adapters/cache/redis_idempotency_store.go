@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+)
+
+type RedisIdempotencyStore struct {
+	client *redis.Client
+}
+
+// NewRedisIdempotencyStore crea el almacenamiento de llaves de idempotencia sobre el cliente de Redis del caché
+func NewRedisIdempotencyStore(cache ports.CacheManager) idempotency.IdempotencyRepositoryPort {
+	return &RedisIdempotencyStore{
+		client: cache.GetRedisClient(),
+	}
+}
+
+// Reserve almacena el registro con SETNX para que solo una solicitud pueda reservar la llave
+func (s *RedisIdempotencyStore) Reserve(ctx context.Context, branchID uint, key string, record *models.IdempotencyRecord, ttl time.Duration) (bool, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return false, err
+	}
+
+	return s.client.SetNX(ctx, idempotencyKey(branchID, key), data, ttl).Result()
+}
+
+// Get obtiene el registro de una llave
+func (s *RedisIdempotencyStore) Get(ctx context.Context, branchID uint, key string) (*models.IdempotencyRecord, error) {
+	data, err := s.client.Get(ctx, idempotencyKey(branchID, key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, errPackage.ErrIdempotencyKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var record models.IdempotencyRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// Save reemplaza el registro de una llave
+func (s *RedisIdempotencyStore) Save(ctx context.Context, branchID uint, key string, record *models.IdempotencyRecord, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return s.client.Set(ctx, idempotencyKey(branchID, key), data, ttl).Err()
+}
+
+// Delete elimina el registro de una llave
+func (s *RedisIdempotencyStore) Delete(ctx context.Context, branchID uint, key string) error {
+	return s.client.Del(ctx, idempotencyKey(branchID, key)).Err()
+}
+
+func idempotencyKey(branchID uint, key string) string {
+	return fmt.Sprintf("idempotency:%d:%s", branchID, key)
+}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+)
+
+// renewScript extiende el bloqueo solo si su valor sigue siendo el dueño indicado
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// releaseScript elimina el bloqueo solo si su valor sigue siendo el dueño indicado
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+type RedisJobLocker struct {
+	client   *redis.Client
+	instance string
+}
+
+// NewRedisJobLocker crea el bloqueo distribuido de jobs sobre el cliente de Redis del caché. Cada réplica se identifica
+// con su host, su proceso y un sufijo aleatorio para distinguir reinicios.
+func NewRedisJobLocker(cache ports.CacheManager) jobs.JobLocker {
+	return &RedisJobLocker{
+		client:   cache.GetRedisClient(),
+		instance: newInstanceID(),
+	}
+}
+
+// Instance obtiene el identificador de esta réplica
+func (l *RedisJobLocker) Instance() string {
+	return l.instance
+}
+
+// Acquire adquiere el bloqueo con SETNX para que solo una réplica pueda tenerlo
+func (l *RedisJobLocker) Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	return l.client.SetNX(ctx, jobLockKey(name), l.instance, ttl).Result()
+}
+
+// Renew extiende el bloqueo si esta réplica sigue siendo su dueña
+func (l *RedisJobLocker) Renew(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	renewed, err := renewScript.Run(ctx, l.client, []string{jobLockKey(name)}, l.instance, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return renewed == 1, nil
+}
+
+// Release libera el bloqueo si esta réplica sigue siendo su dueña
+func (l *RedisJobLocker) Release(ctx context.Context, name string) error {
+	return releaseScript.Run(ctx, l.client, []string{jobLockKey(name)}, l.instance).Err()
+}
+
+// Owner obtiene el dueño del bloqueo y el tiempo que le queda
+func (l *RedisJobLocker) Owner(ctx context.Context, name string) (string, time.Duration, error) {
+	owner, err := l.client.Get(ctx, jobLockKey(name)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	ttl, err := l.client.PTTL(ctx, jobLockKey(name)).Result()
+	if err != nil {
+		return "", 0, err
+	}
+
+	return owner, max(ttl, 0), nil
+}
+
+func jobLockKey(name string) string {
+	return fmt.Sprintf("jobs:lock:%s", name)
+}
+
+func newInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+
+	return fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), hex.EncodeToString(suffix))
+}
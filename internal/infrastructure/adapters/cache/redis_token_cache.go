@@ -201,6 +201,29 @@ func (c *RedisTokenCache) GetCredentials(token string) (*models.HaciendaCredenti
 	return &creds, nil
 }
 
+// DeleteCredentials elimina de Redis las credenciales de Hacienda asociadas a un token
+func (c *RedisTokenCache) DeleteCredentials(token string) error {
+	key := fmt.Sprintf("hacienda:credentials:%s", token)
+	err := c.client.Del(c.ctx, key).Err()
+	if err != nil {
+		logs.Error("Failed to delete credentials from Redis", map[string]interface{}{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return shared_error.NewGeneralServiceError(
+			"RedisTokenCache",
+			"DeleteCredentials",
+			"failed to delete credentials from Redis",
+			err,
+		)
+	}
+
+	logs.Info("Credentials deleted successfully from Redis", map[string]interface{}{
+		"key": key,
+	})
+	return nil
+}
+
 // Delete elimina un token de Redis
 func (c *RedisTokenCache) Delete(token string) error {
 	key := "token:" + token
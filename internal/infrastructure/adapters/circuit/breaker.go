@@ -26,9 +26,11 @@ func NewCircuitBreaker(threshold int32, resetTime time.Duration) *CircuitBreaker
 	}
 }
 
+// AllowRequest indica si la solicitud puede enviarse. Un circuito abierto pasa a semi-abierto una vez transcurrido el
+// tiempo de reinicio, por lo que requiere el bloqueo de escritura
 func (cb *CircuitBreaker) AllowRequest() bool {
-	cb.mu.RLock()
-	defer cb.mu.RUnlock()
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
 
 	switch cb.state {
 	case constants.StateClosed:
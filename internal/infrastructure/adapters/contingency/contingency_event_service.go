@@ -8,7 +8,6 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/config"
 	"github.com/MarlonG1/api-facturacion-sv/config/drivers"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
-	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -28,6 +27,9 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 )
 
+// ContingencyEventReceivedStatus es el estado con el que Hacienda responde a un evento de contingencia recibido
+const ContingencyEventReceivedStatus = "RECIBIDO"
+
 // ContingencyEventService maneja la preparación y envío de eventos de contingencia
 type ContingencyEventService struct {
 	authManager  auth.AuthManager
@@ -35,7 +37,6 @@ type ContingencyEventService struct {
 	cache        authPorts.CacheManager
 	tokenService authPorts.TokenManager
 	signer       haciendaPorts.SignerManager
-	repo         contingency.ContingencyRepositoryPort
 	timeProvider authPorts.TimeProvider
 	httpClient   *http.Client
 	connection   *drivers.DbConnection
@@ -54,7 +55,6 @@ func NewContingencyEventService(
 	cache authPorts.CacheManager,
 	tokenService authPorts.TokenManager,
 	signer haciendaPorts.SignerManager,
-	repo contingency.ContingencyRepositoryPort,
 	timeProvider authPorts.TimeProvider,
 	connection *drivers.DbConnection,
 ) *ContingencyEventService {
@@ -64,7 +64,6 @@ func NewContingencyEventService(
 		cache:        cache,
 		tokenService: tokenService,
 		signer:       signer,
-		repo:         repo,
 		timeProvider: timeProvider,
 		connection:   connection,
 		httpClient: &http.Client{
@@ -78,31 +77,34 @@ func NewContingencyEventService(
 	}
 }
 
-// PrepareAndSendContingencyEvent prepara y envía un evento de contingencia
-func (s *ContingencyEventService) PrepareAndSendContingencyEvent(ctx context.Context, docs []dte.ContingencyDocument) error {
-	sqlDb, err := s.connection.Db.DB()
-	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "PrepareAndSendContingencyEvent", "failed to get sql db", err)
+// PrepareContingencyEvent prepara y firma el evento de contingencia de los documentos de una sucursal
+func (s *ContingencyEventService) PrepareContingencyEvent(ctx context.Context, docs []dte.ContingencyDocument) (*models.ContingencyEventRecord, error) {
+	if len(docs) == 0 {
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "PrepareContingencyEvent", "no documents to report", nil)
 	}
-	sqlDb.Ping()
 
-	client, err := s.authManager.GetIssuer(ctx, docs[0].BranchID)
+	sqlDb, err := s.connection.Db.DB()
 	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "PrepareAndSendContingencyEvent", "failed to get issuer info", err)
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "PrepareContingencyEvent", "failed to get sql db", err)
 	}
+	sqlDb.Ping()
 
-	reason, err := s.prepareContingencyReason(docs[0])
+	// 1. Obtener el emisor de la sucursal de los documentos
+	branchID := docs[0].BranchID
+	client, err := s.authManager.GetIssuer(ctx, branchID)
 	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "PrepareAndSendContingencyEvent", "failed to prepare contingency reason", err)
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "PrepareContingencyEvent", "failed to get issuer info", err)
 	}
 
+	// 2. Preparar el evento
+	now := utils.TimeNow()
 	event := &models.ContingencyEvent{
 		Identification: models.ContingencyIdentification{
 			Version:          3,
 			Ambient:          config.Server.AmbientCode,
 			GenerationCode:   strings.ToUpper(uuid.New().String()),
-			TransmissionDate: utils.TimeNow().Format("2006-01-02"),
-			TransmissionTime: utils.TimeNow().Format("15:04:05"),
+			TransmissionDate: now.Format("2006-01-02"),
+			TransmissionTime: now.Format("15:04:05"),
 		},
 		Issuer: models.ContingencyIssuer{
 			NIT:                  client.NIT,
@@ -117,10 +119,55 @@ func (s *ContingencyEventService) PrepareAndSendContingencyEvent(ctx context.Con
 			POSCode:              client.POSCode,
 		},
 		DTEDetails: s.prepareDTEDetails(docs),
-		Reason:     reason,
+		Reason:     s.prepareContingencyReason(docs),
+	}
+
+	// 3. Firmar el evento
+	jsonData, err := json.Marshal(event)
+	if err != nil {
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "PrepareContingencyEvent", "failed to marshal contingency event", err)
 	}
 
-	return s.sendContingencyEvent(ctx, event, docs[0].BranchID)
+	signedDoc, err := s.signer.SignDTE(ctx, jsonData, client.NIT)
+	if err != nil {
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "PrepareContingencyEvent", "failed to sign contingency event", err)
+	}
+
+	// 4. Registrar el evento pendiente de enviar
+	record := &models.ContingencyEventRecord{
+		ID:              event.Identification.GenerationCode,
+		BranchID:        branchID,
+		NIT:             client.NIT,
+		ContingencyType: event.Reason.ContingencyType,
+		Reason:          docs[0].Reason,
+		DocumentIDs:     make([]string, len(docs)),
+		SignedDocument:  signedDoc,
+		Status:          models.ContingencyEventPending,
+		CreatedAt:       now,
+	}
+	for i, doc := range docs {
+		record.DocumentIDs[i] = doc.ID
+	}
+
+	return record, nil
+}
+
+// SendContingencyEvent envía el evento firmado a Hacienda y registra su respuesta en el evento
+func (s *ContingencyEventService) SendContingencyEvent(ctx context.Context, record *models.ContingencyEventRecord) error {
+	response, err := s.sendContingencyEvent(ctx, record)
+	if err != nil {
+		return err
+	}
+
+	record.Status = models.ContingencyEventRejected
+	record.ReceptionStamp = response.ReceptionStamp
+	record.Message = response.Message
+	record.Observations = response.Observations
+	if response.Status == ContingencyEventReceivedStatus && !strings.Contains(response.Message, "no superadas") {
+		record.Status = models.ContingencyEventAccepted
+	}
+
+	return nil
 }
 
 // prepareDTEDetails prepara los detalles de los documentos para el evento de contingencia
@@ -136,41 +183,44 @@ func (s *ContingencyEventService) prepareDTEDetails(docs []dte.ContingencyDocume
 	return details
 }
 
-// prepareContingencyReason prepara la razón de contingencia
-func (s *ContingencyEventService) prepareContingencyReason(doc dte.ContingencyDocument) (models.ContingencyReason, error) {
+// prepareContingencyReason prepara la razón de contingencia, el periodo inicia con el documento más antiguo del evento
+func (s *ContingencyEventService) prepareContingencyReason(docs []dte.ContingencyDocument) models.ContingencyReason {
 	now := s.timeProvider.Now()
 
-	startTime, err := s.repo.GetFirstContingencyTimestamp(context.Background(), doc.BranchID)
-	if err != nil {
-		return models.ContingencyReason{}, shared_error.NewGeneralServiceError("ContingencyEventService", "prepareContingencyReason", "failed to get first contingency timestamp", err)
+	startTime := docs[0].CreatedAt
+	for _, doc := range docs[1:] {
+		if doc.CreatedAt.Before(startTime) {
+			startTime = doc.CreatedAt
+		}
 	}
+	startTime = startTime.In(now.Location())
 
 	return models.ContingencyReason{
 		StartDate:         startTime.Format("2006-01-02"),
 		EndDate:           now.Format("2006-01-02"),
 		StartTime:         startTime.Add(-60 * time.Second).Format("15:04:05"),
 		EndTime:           now.Add(10 * time.Second).Format("15:04:05"),
-		ContingencyType:   doc.ContingencyType,
-		ContingencyReason: doc.Reason,
-	}, nil
+		ContingencyType:   docs[0].ContingencyType,
+		ContingencyReason: docs[0].Reason,
+	}
 }
 
-// sendContingencyEvent envía el evento de contingencia a Hacienda
-func (s *ContingencyEventService) sendContingencyEvent(ctx context.Context, event *models.ContingencyEvent, branchID uint) error {
+// sendContingencyEvent envía el evento de contingencia firmado a Hacienda, retornando su respuesta
+func (s *ContingencyEventService) sendContingencyEvent(ctx context.Context, record *models.ContingencyEventRecord) (*models.ContingencyEventResponse, error) {
 	// Obtener el client y generar token del sistema
-	client, err := s.authManager.GetByNIT(ctx, event.Issuer.NIT)
+	client, err := s.authManager.GetByNIT(ctx, record.NIT)
 	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to get client", err)
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to get client", err)
 	}
-	token, err := s.generateMatchingToken(client, branchID)
+	token, err := s.generateMatchingToken(client, record.BranchID)
 	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to generate matching token", err)
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to generate matching token", err)
 	}
 
 	// Obtener credenciales
 	encryptedCreds, err := s.cache.GetCredentials(token)
 	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to get hacienda credentials", err)
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to get hacienda credentials", err)
 	}
 
 	// Obtener token de Hacienda
@@ -181,32 +231,22 @@ func (s *ContingencyEventService) sendContingencyEvent(ctx context.Context, even
 	)
 
 	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to get hacienda token", err)
-	}
-
-	jsonData, err := json.Marshal(event)
-	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to marshal contingency event", err)
-	}
-
-	signedDoc, err := s.signer.SignDTE(ctx, jsonData, client.NIT)
-	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to sign contingency event", err)
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to get hacienda token", err)
 	}
 
 	reqBody := &HaciendaContingencyRequest{
 		NIT:      client.NIT,
-		Document: signedDoc,
+		Document: record.SignedDocument,
 	}
 
-	jsonData, err = json.Marshal(reqBody)
+	jsonData, err := json.Marshal(reqBody)
 	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to marshal contingency request", err)
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to marshal contingency request", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", config.MHPaths.ContingencyURL, bytes.NewBuffer(jsonData))
 	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to create request", err)
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to create request", err)
 	}
 
 	req.Header.Set("Authorization", haciendaToken)
@@ -216,35 +256,35 @@ func (s *ContingencyEventService) sendContingencyEvent(ctx context.Context, even
 		"url":          config.MHPaths.ContingencyURL,
 		"method":       "POST",
 		"content-type": req.Header.Get("Content-Type"),
+		"eventID":      record.ID,
+		"documents":    len(record.DocumentIDs),
 	})
 
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to send request", err)
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to send request", err)
 	}
 	defer resp.Body.Close()
 
-	// Manejo de respuesta
-	var responseBody map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&responseBody); err != nil {
-		if err == io.EOF {
-			logs.Warn("Contingency event response is empty")
-			responseBody = nil
-		} else {
-			return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to decode response body", err)
-		}
+	// Manejo de respuesta, Hacienda informa el resultado en el cuerpo tanto si recibe como si rechaza el evento
+	var response models.ContingencyEventResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil && err != io.EOF {
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to decode response body", err)
 	}
 
 	logs.Info("Contingency event response", map[string]interface{}{
-		"statusCode": resp.StatusCode,
-		"body":       responseBody,
+		"statusCode":   resp.StatusCode,
+		"status":       response.Status,
+		"message":      response.Message,
+		"observations": response.Observations,
 	})
 
-	if resp.StatusCode != http.StatusOK || strings.Contains(responseBody["mensaje"].(string), "no superadas") {
-		return shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent", "failed to send contingency event", nil)
+	if response.Status == "" {
+		return nil, shared_error.NewGeneralServiceError("ContingencyEventService", "sendContingencyEvent",
+			fmt.Sprintf("contingency event without response status, HTTP %d", resp.StatusCode), nil)
 	}
 
-	return nil
+	return &response, nil
 }
 
 // generateMatchingToken genera un token para el cliente
@@ -13,6 +13,9 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
 )
 
+// HashedSecretPrefix identifica los API secrets almacenados como hash
+const HashedSecretPrefix = "sha256:"
+
 type CryptService struct{}
 
 func NewCryptService() ports.CryptManager {
@@ -37,6 +40,17 @@ func (cs *CryptService) GenerateAPISecret() (string, error) {
 	return base64.URLEncoding.EncodeToString(bytes), nil
 }
 
+// HashSecret obtiene el hash SHA-256 de un API secret con el que se almacena y se compara al autenticar
+func (cs *CryptService) HashSecret(secret string) string {
+	return HashSecret(secret)
+}
+
+// HashSecret obtiene el hash SHA-256 de un API secret en hexadecimal precedido de HashedSecretPrefix
+func HashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return HashedSecretPrefix + hex.EncodeToString(hash[:])
+}
+
 // DeriveKeyFromToken deriva una clave de un token dado a través de SHA-256
 func (cs *CryptService) deriveKeyFromToken(token string) *[32]byte {
 	hash := sha256.Sum256([]byte(token))
@@ -83,6 +97,31 @@ func (cs *CryptService) DecryptStruct(token string, data string) (models.Haciend
 	return creds, nil
 }
 
+// Encrypt encripta un contenido arbitrario con una llave derivada del token y lo convierte en un string
+func (cs *CryptService) Encrypt(token string, data []byte) (string, error) {
+	secret, err := cryptopasta.Encrypt(data, cs.deriveKeyFromToken(token))
+	if err != nil {
+		return "", shared_error.NewGeneralServiceError("Utils", "Encrypt", "error encrypting data", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(secret), nil
+}
+
+// Decrypt desencripta un string generado por Encrypt
+func (cs *CryptService) Decrypt(token string, data string) ([]byte, error) {
+	preDecodeData, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, shared_error.NewGeneralServiceError("Utils", "Decrypt", "error decoding base64 data", err)
+	}
+
+	decrypted, err := cryptopasta.Decrypt(preDecodeData, cs.deriveKeyFromToken(token))
+	if err != nil {
+		return nil, shared_error.NewGeneralServiceError("Utils", "Decrypt", "error decrypting data", err)
+	}
+
+	return decrypted, nil
+}
+
 // GenerateBulkAPIKeys es una funcion de tipo bulk que genera una cantidad determinada de API KEYS y API SECRETs
 func (cs *CryptService) GenerateBulkAPIKeys(amount int) ([]string, []string, error) {
 	var err error
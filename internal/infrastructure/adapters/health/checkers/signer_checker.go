@@ -7,6 +7,7 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 	"github.com/dimiro1/health/url"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/health/constants"
@@ -27,7 +28,13 @@ func (c *signerChecker) Name() string {
 	return "dte_signer"
 }
 
+// Check verifica el firmador activo: en modo externo consulta el endpoint de salud del firmador,
+// en modo nativo verifica que el directorio de certificados sea accesible
 func (c *signerChecker) Check() models.Health {
+	if config.Signer.Mode == config.SignerModeNative {
+		return c.checkCertificates()
+	}
+
 	checker := url.NewCheckerWithTimeout(config.Signer.Health, c.client.Timeout)
 	health := checker.Check()
 
@@ -48,3 +55,18 @@ func (c *signerChecker) Check() models.Health {
 		Details: utils.TranslateHealthUp(c.Name()),
 	}
 }
+
+func (c *signerChecker) checkCertificates() models.Health {
+	if _, err := os.ReadDir(config.Signer.CertificatesPath); err != nil {
+		return models.Health{
+			Status: constants.StatusDown,
+			Details: fmt.Sprintf("%s: %v", utils.TranslateHealthDown(c.Name()),
+				utils.TranslateHealthError("SignerCertificatesUnavailable", config.Signer.CertificatesPath)),
+		}
+	}
+
+	return models.Health{
+		Status:  constants.StatusUp,
+		Details: utils.TranslateHealthUp(c.Name()),
+	}
+}
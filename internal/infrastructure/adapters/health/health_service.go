@@ -4,6 +4,7 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/health"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/health/constants"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/health/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/health/checkers"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 	"gorm.io/gorm"
@@ -11,10 +12,12 @@ import (
 
 type healthService struct {
 	checkers []health.ComponentChecker
+	circuits map[string]ports.CircuitManager
 }
 
 type HealthServiceConfig struct {
-	DB *gorm.DB
+	DB       *gorm.DB
+	Circuits map[string]ports.CircuitManager
 }
 
 func NewHealthService(cfg *HealthServiceConfig) health.HealthManager {
@@ -26,6 +29,7 @@ func NewHealthService(cfg *HealthServiceConfig) health.HealthManager {
 			checkers.NewFileSystemChecker(),
 			checkers.NewSignerChecker(),
 		},
+		circuits: cfg.Circuits,
 	}
 	return service
 }
@@ -43,9 +47,18 @@ func (s *healthService) CheckHealth() (*models.HealthStatus, error) {
 		}
 	}
 
+	circuits := make(map[string]models.CircuitStatus, len(s.circuits))
+	for name, circuit := range s.circuits {
+		circuits[name] = models.CircuitStatus{
+			State:    circuit.GetState().String(),
+			Failures: circuit.GetFailureCount(),
+		}
+	}
+
 	return &models.HealthStatus{
 		Status:     status,
 		Components: components,
+		Circuits:   circuits,
 		Timestamp:  utils.TimeNow().Format("02-01-2006 15:04:05"),
 	}, nil
 }
@@ -0,0 +1,103 @@
+package mail
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+)
+
+// base64LineLength es la longitud máxima de las líneas de un adjunto codificado en base64 (RFC 2045)
+const base64LineLength = 76
+
+// buildMessage construye un mensaje multipart/mixed con el cuerpo en texto plano y los archivos adjuntos
+func buildMessage(from, fromName string, email *notification.Email) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	// 1. Encabezados del mensaje
+	sender := mail.Address{Name: fromName, Address: from}
+	headers := []struct{ key, value string }{
+		{"From", sender.String()},
+		{"To", strings.Join(email.To, ", ")},
+		{"Subject", mime.QEncoding.Encode("utf-8", email.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", messageID(from)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", fmt.Sprintf("multipart/mixed; boundary=%q", writer.Boundary())},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", header.key, header.value)
+	}
+	buf.WriteString("\r\n")
+
+	// 2. Cuerpo en texto plano
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	qp := quotedprintable.NewWriter(part)
+	if _, err = qp.Write([]byte(email.Body)); err != nil {
+		return nil, err
+	}
+	if err = qp.Close(); err != nil {
+		return nil, err
+	}
+
+	// 3. Archivos adjuntos codificados en base64
+	for _, attachment := range email.Attachments {
+		filename := mime.QEncoding.Encode("utf-8", attachment.Filename)
+		part, err = writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {fmt.Sprintf("%s; name=%q", attachment.ContentType, filename)},
+			"Content-Disposition":       {fmt.Sprintf("attachment; filename=%q", filename)},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		encoded := base64.StdEncoding.EncodeToString(attachment.Content)
+		for len(encoded) > base64LineLength {
+			if _, err = part.Write([]byte(encoded[:base64LineLength] + "\r\n")); err != nil {
+				return nil, err
+			}
+			encoded = encoded[base64LineLength:]
+		}
+		if _, err = part.Write([]byte(encoded + "\r\n")); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// messageID genera un identificador único para el mensaje usando el dominio del remitente
+func messageID(from string) string {
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 {
+		domain = from[at+1:]
+	}
+
+	random := make([]byte, 8)
+	_, _ = rand.Read(random)
+
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(random), domain)
+}
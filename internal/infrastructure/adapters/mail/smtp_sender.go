@@ -0,0 +1,121 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+)
+
+const (
+	// implicitTLSPort es el puerto en el que la conexión se cifra desde el inicio (SMTPS)
+	implicitTLSPort = "465"
+	// defaultTimeout es el tiempo máximo de una entrega si el contexto no define uno
+	defaultTimeout = 30 * time.Second
+)
+
+// SMTPConfig contiene los datos de conexión al servidor SMTP
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	FromName string
+}
+
+type SMTPSender struct {
+	config SMTPConfig
+}
+
+func NewSMTPSender(config SMTPConfig) ports.MailSender {
+	return &SMTPSender{
+		config: config,
+	}
+}
+
+// Send entrega el correo al servidor SMTP. La conexión usa STARTTLS si el servidor lo soporta, o TLS implícito
+// en el puerto 465, y se autentica solo si se configuró un usuario.
+func (s *SMTPSender) Send(ctx context.Context, email *notification.Email) error {
+	// 1. Construir el mensaje MIME
+	message, err := buildMessage(s.config.From, s.config.FromName, email)
+	if err != nil {
+		return fmt.Errorf("error building email message: %w", err)
+	}
+
+	// 2. Conectar con el servidor respetando el tiempo límite del contexto
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultTimeout)
+	}
+
+	dialer := &net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.config.Host, s.config.Port))
+	if err != nil {
+		return fmt.Errorf("error connecting to SMTP server: %w", err)
+	}
+	if err = conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return fmt.Errorf("error setting SMTP deadline: %w", err)
+	}
+
+	tlsConfig := &tls.Config{ServerName: s.config.Host}
+	if s.config.Port == implicitTLSPort {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error starting SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	// 3. Cifrar la conexión y autenticarse
+	if s.config.Port != implicitTLSPort {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err = client.StartTLS(tlsConfig); err != nil {
+				return fmt.Errorf("error starting TLS: %w", err)
+			}
+		}
+	}
+
+	if s.config.Username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("SMTP server does not support authentication")
+		}
+		if err = client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)); err != nil {
+			return fmt.Errorf("error authenticating with SMTP server: %w", err)
+		}
+	}
+
+	// 4. Enviar el mensaje a cada destinatario
+	if err = client.Mail(s.config.From); err != nil {
+		return fmt.Errorf("error setting sender: %w", err)
+	}
+
+	for _, to := range email.To {
+		if err = client.Rcpt(to); err != nil {
+			return fmt.Errorf("error setting recipient %s: %w", to, err)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("error starting message data: %w", err)
+	}
+	if _, err = writer.Write(message); err != nil {
+		writer.Close()
+		return fmt.Errorf("error writing message: %w", err)
+	}
+	if err = writer.Close(); err != nil {
+		return fmt.Errorf("error sending message: %w", err)
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,657 @@
+package printing
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/pdf"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/qrcode"
+)
+
+const (
+	margin       = 36.0
+	contentWidth = pdf.LetterWidth - 2*margin
+	footerHeight = 42.0
+	bottomLimit  = pdf.LetterHeight - margin - footerHeight
+
+	qrSize        = 84.0
+	logoMaxWidth  = 130.0
+	logoMaxHeight = 64.0
+
+	titleSize = 12.0
+	bodySize  = 8.0
+	smallSize = 7.0
+	lineGap   = 10.0
+	cellPad   = 3.0
+)
+
+var (
+	darkGray   = pdf.Color{R: 64, G: 64, B: 64}
+	mediumGray = pdf.Color{R: 120, G: 120, B: 120}
+	lightGray  = pdf.Color{R: 230, G: 230, B: 230}
+	borderGray = pdf.Color{R: 190, G: 190, B: 190}
+	alertRed   = pdf.Color{R: 190, G: 30, B: 45}
+)
+
+// DTEPDFRenderer genera la versión legible de los DTE a partir de su JSON almacenado
+type DTEPDFRenderer struct{}
+
+func NewDTEPDFRenderer() ports.DTEPDFRenderer {
+	return &DTEPDFRenderer{}
+}
+
+// layout mantiene la página actual y la posición vertical en la que se continúa dibujando
+type layout struct {
+	doc  *pdf.Document
+	page *pdf.Page
+	y    float64
+}
+
+// Render genera el PDF con el encabezado, emisor y receptor, ítems, totales, sello de recepción y QR de consulta
+func (r *DTEPDFRenderer) Render(document *dte.PrintableDTE) ([]byte, error) {
+	data := document.Document
+	identification := mapValue(data, "identificacion")
+
+	doc := pdf.New(pdf.LetterWidth, pdf.LetterHeight)
+	doc.SetTitle(fmt.Sprintf("%s %s", constants.DTETypeNames[document.DTEType], textValue(identification, "codigoGeneracion")))
+
+	l := &layout{doc: doc}
+	l.newPage()
+
+	// 1. Encabezado con logo, tipo de documento y código QR
+	if err := r.drawHeader(l, document); err != nil {
+		return nil, err
+	}
+
+	// 2. Identificación del documento
+	r.drawIdentification(l, document, identification)
+
+	// 3. Emisor y receptor
+	r.drawParties(l, document.DTEType, data)
+
+	// 4. Documentos relacionados
+	r.drawRelatedDocuments(l, data)
+
+	// 5. Cuerpo del documento
+	if body := mapValue(data, "cuerpoDocumento"); body != nil {
+		r.drawFields(l, "DETALLE DE LA LIQUIDACIÓN", body, accountingLiquidationFields)
+	} else {
+		r.drawItems(l, itemColumns(document.DTEType), listValue(data, "cuerpoDocumento"))
+	}
+
+	// 6. Totales, valor en letras y condición de la operación
+	r.drawSummary(l, data)
+
+	// 7. Datos de entrega, observaciones y apéndice
+	if extension := mapValue(data, "extension"); extension != nil {
+		r.drawFields(l, "INFORMACIÓN ADICIONAL", extension, extensionFields)
+	}
+	r.drawAppendix(l, data)
+
+	// 8. Pie de página en todas las páginas
+	r.drawFooters(doc, document.Footer)
+
+	return doc.Bytes()
+}
+
+func (r *DTEPDFRenderer) drawHeader(l *layout, document *dte.PrintableDTE) error {
+	top := l.y
+
+	// 1. Logo del usuario, escalado para conservar su proporción
+	if len(document.Logo) > 0 {
+		logo, err := l.doc.AddImage(document.Logo)
+		if err != nil {
+			logs.Warn("Failed to decode PDF logo, the document will be generated without it", map[string]interface{}{"error": err.Error()})
+		} else {
+			scale := math.Min(logoMaxWidth/float64(logo.Width()), logoMaxHeight/float64(logo.Height()))
+			l.page.Image(logo, margin, top, float64(logo.Width())*scale, float64(logo.Height())*scale)
+		}
+	}
+
+	// 2. Código QR de consulta pública
+	qr, err := qrcode.Encode(document.QRLink)
+	if err != nil {
+		return err
+	}
+	drawQR(l.page, qr, pdf.LetterWidth-margin-qrSize, top, qrSize)
+
+	// 3. Título del documento
+	center := pdf.LetterWidth / 2
+	l.page.TextCenter(center, top+14, pdf.HelveticaBold, 10, darkGray, "DOCUMENTO TRIBUTARIO ELECTRÓNICO")
+	l.page.TextCenter(center, top+32, pdf.HelveticaBold, titleSize, pdf.Black, strings.ToUpper(constants.DTETypeNames[document.DTEType]))
+
+	if banner, ok := statusBanners[document.Status]; ok {
+		l.page.TextCenter(center, top+52, pdf.HelveticaBold, 11, alertRed, banner)
+	}
+
+	l.page.TextCenter(pdf.LetterWidth-margin-qrSize/2, top+qrSize+9, pdf.Helvetica, smallSize, mediumGray, "Consulta pública")
+
+	l.y = top + qrSize + 18
+	return nil
+}
+
+func (r *DTEPDFRenderer) drawIdentification(l *layout, document *dte.PrintableDTE, identification map[string]interface{}) {
+	stamp := ""
+	if document.ReceptionStamp != nil {
+		stamp = *document.ReceptionStamp
+	}
+
+	transmission := labelValue(identification, "tipoOperacion", operationTypes)
+	if document.Transmission == constants.TransmissionContingency {
+		transmission = operationTypes["2"]
+	}
+
+	// Los valores extensos se ubican en la columna izquierda, que es más ancha
+	left := [][2]string{
+		{"Código de generación", textValue(identification, "codigoGeneracion")},
+		{"Número de control", textValue(identification, "numeroControl")},
+		{"Sello de recepción", stamp},
+		{"Fecha y hora de emisión", strings.TrimSpace(textValue(identification, "fecEmi") + " " + textValue(identification, "horEmi"))},
+	}
+	right := [][2]string{
+		{"Modelo de facturación", labelValue(identification, "tipoModelo", billingModels)},
+		{"Tipo de transmisión", transmission},
+		{"Moneda", textValue(identification, "tipoMoneda")},
+		{"Versión del JSON", textValue(identification, "version")},
+	}
+
+	l.sectionTitle("IDENTIFICACIÓN DEL DOCUMENTO")
+	l.ensure(float64(len(left)) * lineGap)
+
+	for i := range left {
+		y := l.y + float64(i)*lineGap + bodySize
+		l.page.Text(margin, y, pdf.HelveticaBold, bodySize, darkGray, left[i][0]+":")
+		l.page.Text(margin+108, y, pdf.Helvetica, bodySize, pdf.Black, left[i][1])
+		l.page.Text(margin+340, y, pdf.HelveticaBold, bodySize, darkGray, right[i][0]+":")
+		l.page.Text(margin+440, y, pdf.Helvetica, bodySize, pdf.Black, right[i][1])
+	}
+	l.y += float64(len(left))*lineGap + 6
+}
+
+func (r *DTEPDFRenderer) drawParties(l *layout, dteType string, data map[string]interface{}) {
+	issuerTitle, receiverTitle := partyTitles(dteType)
+	issuer := firstMap(data, "emisor", "donatario")
+	receiver := firstMap(data, "receptor", "sujetoExcluido", "donante")
+
+	width := (contentWidth - 10) / 2
+	issuerLines := partyLines(issuer, width)
+	receiverLines := partyLines(receiver, width)
+
+	height := 16 + float64(max(len(issuerLines), len(receiverLines)))*lineGap + 4
+	l.ensure(height)
+
+	for i, party := range []struct {
+		title string
+		lines [][2]string
+	}{{issuerTitle, issuerLines}, {receiverTitle, receiverLines}} {
+		x := margin + float64(i)*(width+10)
+		l.page.FillRect(x, l.y, width, 14, lightGray)
+		l.page.StrokeRect(x, l.y, width, height, 0.5, borderGray)
+		l.page.Text(x+cellPad, l.y+10, pdf.HelveticaBold, bodySize, pdf.Black, party.title)
+
+		y := l.y + 16
+		for _, line := range party.lines {
+			font := pdf.Helvetica
+			if line[0] == "bold" {
+				font = pdf.HelveticaBold
+			}
+			l.page.Text(x+cellPad, y+bodySize, font, bodySize, pdf.Black, line[1])
+			y += lineGap
+		}
+	}
+
+	l.y += height + 10
+}
+
+// partyLines genera las líneas del bloque de emisor o receptor, ajustadas al ancho disponible
+func partyLines(party map[string]interface{}, width float64) [][2]string {
+	if party == nil {
+		return [][2]string{{"", "No aplica"}}
+	}
+
+	var lines [][2]string
+	add := func(style, text string) {
+		if strings.TrimSpace(text) == "" {
+			return
+		}
+		font := pdf.Helvetica
+		if style == "bold" {
+			font = pdf.HelveticaBold
+		}
+		for _, line := range pdf.WrapText(font, bodySize, text, width-2*cellPad) {
+			lines = append(lines, [2]string{style, line})
+		}
+	}
+
+	add("bold", textValue(party, "nombre"))
+	add("", textValue(party, "nombreComercial"))
+
+	// 1. Documento de identificación
+	if nit := textValue(party, "nit"); nit != "" {
+		add("", "NIT: "+nit)
+	} else if number := textValue(party, "numDocumento"); number != "" {
+		docType := labelValue(party, "tipoDocumento", receiverDocumentTypes)
+		if docType == "" {
+			docType = "Documento"
+		}
+		add("", docType+": "+number)
+	}
+	if nrc := textValue(party, "nrc"); nrc != "" {
+		add("", "NRC: "+nrc)
+	}
+
+	// 2. Actividad económica y dirección
+	add("", textValue(party, "descActividad"))
+
+	address := textValue(mapValue(party, "direccion"), "complemento")
+	if address == "" {
+		address = textValue(party, "complemento")
+	}
+	if country := textValue(party, "nombrePais"); country != "" {
+		address = strings.TrimSpace(address + ", " + country)
+	}
+	add("", address)
+
+	// 3. Contacto
+	if phone := textValue(party, "telefono"); phone != "" {
+		add("", "Teléfono: "+phone)
+	}
+	if email := textValue(party, "correo"); email != "" {
+		add("", "Correo: "+email)
+	}
+
+	return lines
+}
+
+func (r *DTEPDFRenderer) drawRelatedDocuments(l *layout, data map[string]interface{}) {
+	related := listValue(data, "documentoRelacionado")
+	if len(related) == 0 {
+		return
+	}
+
+	columns := []column{
+		{title: "Tipo de documento", key: "tipoDocumento", width: 150},
+		{title: "Número de documento", key: "numeroDocumento"},
+		{title: "Fecha de emisión", key: "fechaEmision", width: 100},
+	}
+
+	l.sectionTitle("DOCUMENTOS RELACIONADOS")
+	r.drawTable(l, columns, related, func(col column, row map[string]interface{}) string {
+		if col.key == "tipoDocumento" {
+			code := textValue(row, col.key)
+			return strings.TrimSpace(code + " " + constants.DTETypeNames[code])
+		}
+		return formatValue(row[col.key], col)
+	})
+	l.y += 6
+}
+
+func (r *DTEPDFRenderer) drawItems(l *layout, columns []column, items []map[string]interface{}) {
+	l.sectionTitle("DETALLE")
+	r.drawTable(l, columns, items, func(col column, row map[string]interface{}) string {
+		return formatValue(row[col.key], col)
+	})
+	l.y += 6
+}
+
+// drawTable dibuja una tabla con encabezado, ajustando el texto de cada celda y repitiendo el encabezado en cada página
+func (r *DTEPDFRenderer) drawTable(l *layout, columns []column, rows []map[string]interface{}, cell func(column, map[string]interface{}) string) {
+	widths := columnWidths(columns)
+
+	drawHeader := func() {
+		l.page.FillRect(margin, l.y, contentWidth, 14, lightGray)
+		x := margin
+		for i, col := range columns {
+			if col.format == formatText {
+				l.page.Text(x+cellPad, l.y+10, pdf.HelveticaBold, smallSize, pdf.Black, col.title)
+			} else {
+				l.page.TextRight(x+widths[i]-cellPad, l.y+10, pdf.HelveticaBold, smallSize, pdf.Black, col.title)
+			}
+			x += widths[i]
+		}
+		l.y += 14
+	}
+
+	l.ensure(28)
+	drawHeader()
+
+	for _, row := range rows {
+		// 1. Ajustar el contenido de cada celda al ancho de su columna
+		cells := make([][]string, len(columns))
+		lines := 1
+		for i, col := range columns {
+			cells[i] = pdf.WrapText(pdf.Helvetica, bodySize, cell(col, row), widths[i]-2*cellPad)
+			lines = max(lines, len(cells[i]))
+		}
+		height := float64(lines)*lineGap + 4
+
+		// 2. Continuar en una nueva página si la fila no cabe
+		if l.ensure(height) {
+			drawHeader()
+		}
+
+		x := margin
+		for i, col := range columns {
+			for j, line := range cells[i] {
+				y := l.y + 2 + float64(j)*lineGap + bodySize
+				if col.format == formatText {
+					l.page.Text(x+cellPad, y, pdf.Helvetica, bodySize, pdf.Black, line)
+				} else {
+					l.page.TextRight(x+widths[i]-cellPad, y, pdf.Helvetica, bodySize, pdf.Black, line)
+				}
+			}
+			x += widths[i]
+		}
+
+		l.y += height
+		l.page.Line(margin, l.y, margin+contentWidth, l.y, 0.3, borderGray)
+	}
+}
+
+func (r *DTEPDFRenderer) drawSummary(l *layout, data map[string]interface{}) {
+	summary := mapValue(data, "resumen")
+	body := mapValue(data, "cuerpoDocumento")
+
+	// 1. Construir los totales presentes en el documento, incluyendo el detalle de los tributos
+	type totalLine struct {
+		label string
+		value string
+		bold  bool
+	}
+	var totals []totalLine
+	for _, f := range summaryFields {
+		if f.key == "tributos" {
+			for _, tax := range listValue(summary, "tributos") {
+				totals = append(totals, totalLine{label: textValue(tax, "descripcion"), value: formatAmountValue(tax["valor"])})
+			}
+			continue
+		}
+
+		if value, ok := summary[f.key]; ok && value != nil {
+			totals = append(totals, totalLine{label: f.label, value: formatAmountValue(value), bold: f.bold})
+		}
+	}
+
+	// 2. Valor en letras y condición de la operación
+	inWords := firstText(summary, "totalLetras", "totalIVAretenidoLetras")
+	if inWords == "" {
+		inWords = textValue(body, "totalLetras")
+	}
+	condition := labelValue(summary, "condicionOperacion", operationConditions)
+	observations := textValue(summary, "observaciones")
+
+	if len(totals) == 0 && inWords == "" {
+		return
+	}
+
+	// 3. El valor en letras se muestra a la izquierda y los totales a la derecha
+	leftWidth := contentWidth - 230
+	var left []string
+	if inWords != "" {
+		left = append(left, pdf.WrapText(pdf.Helvetica, bodySize, "Son: "+inWords, leftWidth)...)
+	}
+	if condition != "" {
+		left = append(left, "Condición de la operación: "+condition)
+	}
+	if observations != "" {
+		left = append(left, pdf.WrapText(pdf.Helvetica, bodySize, "Observaciones: "+observations, leftWidth)...)
+	}
+
+	height := float64(max(len(totals), len(left)))*lineGap + 6
+	l.ensure(height)
+
+	for i, line := range left {
+		l.page.Text(margin, l.y+float64(i)*lineGap+bodySize, pdf.Helvetica, bodySize, pdf.Black, line)
+	}
+
+	labelRight := margin + contentWidth - 80
+	for i, total := range totals {
+		font := pdf.Helvetica
+		if total.bold {
+			font = pdf.HelveticaBold
+		}
+		y := l.y + float64(i)*lineGap + bodySize
+		l.page.TextRight(labelRight, y, font, bodySize, darkGray, total.label+":")
+		l.page.TextRight(margin+contentWidth-cellPad, y, font, bodySize, pdf.Black, total.value)
+	}
+
+	l.y += height + 4
+}
+
+// drawFields dibuja una sección con los valores presentes en el documento en formato etiqueta-valor
+func (r *DTEPDFRenderer) drawFields(l *layout, title string, data map[string]interface{}, fields []field) {
+	var rows []field
+	for _, f := range fields {
+		if formatValue(data[f.key], column{format: f.format}) != "" {
+			rows = append(rows, f)
+		}
+	}
+	if len(rows) == 0 {
+		return
+	}
+
+	l.sectionTitle(title)
+	valueWidth := contentWidth - 160
+	for _, f := range rows {
+		font := pdf.Helvetica
+		if f.bold {
+			font = pdf.HelveticaBold
+		}
+
+		lines := pdf.WrapText(font, bodySize, formatValue(data[f.key], column{format: f.format}), valueWidth)
+		l.ensure(float64(len(lines)) * lineGap)
+
+		l.page.Text(margin, l.y+bodySize, pdf.HelveticaBold, bodySize, darkGray, f.label+":")
+		for _, line := range lines {
+			l.page.Text(margin+160, l.y+bodySize, font, bodySize, pdf.Black, line)
+			l.y += lineGap
+		}
+	}
+	l.y += 6
+}
+
+func (r *DTEPDFRenderer) drawAppendix(l *layout, data map[string]interface{}) {
+	appendix := listValue(data, "apendice")
+	if len(appendix) == 0 {
+		return
+	}
+
+	l.sectionTitle("APÉNDICE")
+	for _, entry := range appendix {
+		text := fmt.Sprintf("%s - %s: %s", textValue(entry, "campo"), textValue(entry, "etiqueta"), textValue(entry, "valor"))
+		for _, line := range pdf.WrapText(pdf.Helvetica, bodySize, text, contentWidth) {
+			l.ensure(lineGap)
+			l.page.Text(margin, l.y+bodySize, pdf.Helvetica, bodySize, pdf.Black, line)
+			l.y += lineGap
+		}
+	}
+}
+
+// drawFooters dibuja el pie de página del usuario y la numeración en cada página
+func (r *DTEPDFRenderer) drawFooters(doc *pdf.Document, footer string) {
+	pages := doc.Pages()
+	top := pdf.LetterHeight - margin - footerHeight + 10
+
+	for i, page := range pages {
+		page.Line(margin, top, margin+contentWidth, top, 0.5, borderGray)
+
+		y := top + 10
+		for _, line := range pdf.WrapText(pdf.Helvetica, smallSize, footer, contentWidth-70) {
+			if footer == "" || y > pdf.LetterHeight-margin {
+				break
+			}
+			page.Text(margin, y, pdf.Helvetica, smallSize, mediumGray, line)
+			y += 9
+		}
+
+		page.TextRight(margin+contentWidth, top+10, pdf.Helvetica, smallSize, mediumGray, fmt.Sprintf("Página %d de %d", i+1, len(pages)))
+	}
+}
+
+// drawQR dibuja el código QR como módulos vectoriales con su zona de silencio
+func drawQR(page *pdf.Page, qr *qrcode.Code, x, y, size float64) {
+	const quietZone = 2
+	module := size / float64(qr.Size+2*quietZone)
+
+	page.FillRect(x, y, size, size, pdf.White)
+	for row := 0; row < qr.Size; row++ {
+		for col := 0; col < qr.Size; col++ {
+			if qr.Dark(col, row) {
+				page.FillRect(x+float64(col+quietZone)*module, y+float64(row+quietZone)*module, module+0.05, module+0.05, pdf.Black)
+			}
+		}
+	}
+}
+
+func (l *layout) newPage() {
+	l.page = l.doc.AddPage()
+	l.y = margin
+}
+
+// ensure agrega una nueva página si el contenido de la altura indicada no cabe en la actual
+func (l *layout) ensure(height float64) bool {
+	if l.y+height <= bottomLimit {
+		return false
+	}
+	l.newPage()
+	return true
+}
+
+func (l *layout) sectionTitle(title string) {
+	l.ensure(32)
+	l.page.Text(margin, l.y+9, pdf.HelveticaBold, 9, pdf.Black, title)
+	l.page.Line(margin, l.y+12, margin+contentWidth, l.y+12, 0.8, darkGray)
+	l.y += 16
+}
+
+// columnWidths asigna el espacio restante a las columnas sin ancho definido
+func columnWidths(columns []column) []float64 {
+	widths := make([]float64, len(columns))
+	fixed, flexible := 0.0, 0
+	for _, col := range columns {
+		if col.width == 0 {
+			flexible++
+		}
+		fixed += col.width
+	}
+
+	for i, col := range columns {
+		widths[i] = col.width
+		if col.width == 0 {
+			widths[i] = (contentWidth - fixed) / float64(flexible)
+		}
+	}
+	return widths
+}
+
+func mapValue(data map[string]interface{}, key string) map[string]interface{} {
+	value, _ := data[key].(map[string]interface{})
+	return value
+}
+
+func firstMap(data map[string]interface{}, keys ...string) map[string]interface{} {
+	for _, key := range keys {
+		if value := mapValue(data, key); value != nil {
+			return value
+		}
+	}
+	return nil
+}
+
+func listValue(data map[string]interface{}, key string) []map[string]interface{} {
+	values, _ := data[key].([]interface{})
+
+	result := make([]map[string]interface{}, 0, len(values))
+	for _, value := range values {
+		if item, ok := value.(map[string]interface{}); ok {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func textValue(data map[string]interface{}, key string) string {
+	return formatValue(data[key], column{})
+}
+
+func firstText(data map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if value := textValue(data, key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func labelValue(data map[string]interface{}, key string, labels map[string]string) string {
+	return formatValue(data[key], column{labels: labels})
+}
+
+// formatValue convierte un valor del JSON del documento en texto según el formato de la columna
+func formatValue(value interface{}, col column) string {
+	var text string
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		text = v
+	case float64:
+		switch col.format {
+		case formatAmount:
+			return formatAmountValue(v)
+		default:
+			text = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	case bool:
+		text = strconv.FormatBool(v)
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			parts = append(parts, formatValue(item, column{}))
+		}
+		text = strings.Join(parts, ", ")
+	default:
+		text = fmt.Sprint(v)
+	}
+
+	if label, ok := col.labels[text]; ok {
+		return label
+	}
+	return text
+}
+
+// formatAmountValue formatea un monto con separador de miles y al menos dos decimales
+func formatAmountValue(value interface{}) string {
+	amount, ok := value.(float64)
+	if !ok {
+		return formatValue(value, column{})
+	}
+
+	decimals := 2
+	for decimals < 8 && math.Abs(amount*math.Pow10(decimals)-math.Round(amount*math.Pow10(decimals))) > 1e-6 {
+		decimals++
+	}
+
+	text := strconv.FormatFloat(math.Abs(amount), 'f', decimals, 64)
+	integer, fraction, _ := strings.Cut(text, ".")
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+	return sign + grouped.String() + "." + fraction
+}
@@ -0,0 +1,226 @@
+package printing
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+)
+
+// valueFormat indica cómo se muestra un valor numérico en la versión legible
+type valueFormat int
+
+const (
+	formatText valueFormat = iota
+	formatAmount
+	formatQuantity
+)
+
+// column describe una columna de la tabla de ítems. Un ancho de cero ocupa el espacio restante.
+type column struct {
+	title  string
+	key    string
+	width  float64
+	format valueFormat
+	labels map[string]string
+}
+
+// field describe un valor del documento con su etiqueta
+type field struct {
+	key    string
+	label  string
+	format valueFormat
+	bold   bool
+}
+
+var donationTypes = map[string]string{
+	"1": "Efectivo",
+	"2": "Bien",
+	"3": "Servicio",
+}
+
+var (
+	saleColumns = []column{
+		{title: "N°", key: "numItem", width: 22, format: formatQuantity},
+		{title: "Cant.", key: "cantidad", width: 40, format: formatQuantity},
+		{title: "Descripción", key: "descripcion"},
+		{title: "P. unitario", key: "precioUni", width: 58, format: formatAmount},
+		{title: "Descuento", key: "montoDescu", width: 52, format: formatAmount},
+		{title: "No sujetas", key: "ventaNoSuj", width: 55, format: formatAmount},
+		{title: "Exentas", key: "ventaExenta", width: 55, format: formatAmount},
+		{title: "Gravadas", key: "ventaGravada", width: 60, format: formatAmount},
+	}
+
+	exportColumns = []column{
+		{title: "N°", key: "numItem", width: 22, format: formatQuantity},
+		{title: "Cant.", key: "cantidad", width: 40, format: formatQuantity},
+		{title: "Descripción", key: "descripcion"},
+		{title: "P. unitario", key: "precioUni", width: 62, format: formatAmount},
+		{title: "Descuento", key: "montoDescu", width: 58, format: formatAmount},
+		{title: "No gravado", key: "noGravado", width: 62, format: formatAmount},
+		{title: "Gravadas", key: "ventaGravada", width: 66, format: formatAmount},
+	}
+
+	excludedSubjectColumns = []column{
+		{title: "N°", key: "numItem", width: 22, format: formatQuantity},
+		{title: "Cant.", key: "cantidad", width: 40, format: formatQuantity},
+		{title: "Descripción", key: "descripcion"},
+		{title: "P. unitario", key: "precioUni", width: 66, format: formatAmount},
+		{title: "Descuento", key: "montoDescu", width: 62, format: formatAmount},
+		{title: "Compra", key: "compra", width: 70, format: formatAmount},
+	}
+
+	retentionColumns = []column{
+		{title: "N°", key: "numItem", width: 22, format: formatQuantity},
+		{title: "Tipo DTE", key: "tipoDte", width: 40},
+		{title: "Documento", key: "numDocumento"},
+		{title: "Fecha", key: "fechaEmision", width: 55},
+		{title: "Descripción", key: "descripcion", width: 120},
+		{title: "Monto sujeto", key: "montoSujetoGrav", width: 65, format: formatAmount},
+		{title: "IVA retenido", key: "ivaRetenido", width: 62, format: formatAmount},
+	}
+
+	liquidationColumns = []column{
+		{title: "N°", key: "numItem", width: 22, format: formatQuantity},
+		{title: "Tipo DTE", key: "tipoDte", width: 40},
+		{title: "Documento", key: "numeroDocumento"},
+		{title: "Fecha", key: "fechaGeneracion", width: 55},
+		{title: "No sujetas", key: "ventaNoSuj", width: 55, format: formatAmount},
+		{title: "Exentas", key: "ventaExenta", width: 55, format: formatAmount},
+		{title: "Gravadas", key: "ventaGravada", width: 60, format: formatAmount},
+		{title: "IVA", key: "ivaItem", width: 55, format: formatAmount},
+	}
+
+	donationColumns = []column{
+		{title: "N°", key: "numItem", width: 22, format: formatQuantity},
+		{title: "Tipo", key: "tipoDonacion", width: 50, labels: donationTypes},
+		{title: "Cant.", key: "cantidad", width: 40, format: formatQuantity},
+		{title: "Descripción", key: "descripcion"},
+		{title: "Valor unitario", key: "valorUni", width: 66, format: formatAmount},
+		{title: "Depreciación", key: "depreciacion", width: 62, format: formatAmount},
+		{title: "Valor", key: "valor", width: 66, format: formatAmount},
+	}
+)
+
+// itemColumns obtiene las columnas de la tabla de ítems según el tipo de documento
+func itemColumns(dteType string) []column {
+	switch dteType {
+	case constants.FacturaExportacionElectronica:
+		return exportColumns
+	case constants.FacturaSujetoExcluidoElectronica:
+		return excludedSubjectColumns
+	case constants.ComprobanteRetencionElectronico:
+		return retentionColumns
+	case constants.ComprobanteLiquidacionElectronico:
+		return liquidationColumns
+	case constants.ComprobanteDonacionElectronico:
+		return donationColumns
+	default:
+		return saleColumns
+	}
+}
+
+// summaryFields son los totales que se muestran en el resumen, en orden. Solo se muestran los presentes en el documento.
+var summaryFields = []field{
+	{key: "totalNoSuj", label: "Total no sujetas", format: formatAmount},
+	{key: "totalExenta", label: "Total exentas", format: formatAmount},
+	{key: "totalGravada", label: "Total gravadas", format: formatAmount},
+	{key: "totalExportacion", label: "Total exportaciones", format: formatAmount},
+	{key: "totalCompra", label: "Total compras", format: formatAmount},
+	{key: "subTotalVentas", label: "Suma de ventas", format: formatAmount},
+	{key: "descuNoSuj", label: "Descuento a no sujetas", format: formatAmount},
+	{key: "descuExenta", label: "Descuento a exentas", format: formatAmount},
+	{key: "descuGravada", label: "Descuento a gravadas", format: formatAmount},
+	{key: "descuento", label: "Descuento", format: formatAmount},
+	{key: "descu", label: "Descuento", format: formatAmount},
+	{key: "totalDescu", label: "Total descuentos", format: formatAmount},
+	{key: "tributos"},
+	{key: "subTotal", label: "Sub-total", format: formatAmount},
+	{key: "ivaPerci1", label: "IVA percibido", format: formatAmount},
+	{key: "ivaPerci", label: "IVA percibido", format: formatAmount},
+	{key: "ivaRete1", label: "IVA retenido", format: formatAmount},
+	{key: "reteRenta", label: "Retención de renta", format: formatAmount},
+	{key: "seguro", label: "Seguro", format: formatAmount},
+	{key: "flete", label: "Flete", format: formatAmount},
+	{key: "montoTotalOperacion", label: "Monto total de la operación", format: formatAmount},
+	{key: "totalNoGravado", label: "Total otros montos no afectos", format: formatAmount},
+	{key: "saldoFavor", label: "Saldo a favor", format: formatAmount},
+	{key: "totalIva", label: "IVA incluido", format: formatAmount},
+	{key: "totalSujetoRetencion", label: "Total sujeto a retención", format: formatAmount},
+	{key: "totalIVAretenido", label: "Total IVA retenido", format: formatAmount, bold: true},
+	{key: "valorTotal", label: "Valor total", format: formatAmount, bold: true},
+	{key: "total", label: "Total", format: formatAmount, bold: true},
+	{key: "totalPagar", label: "Total a pagar", format: formatAmount, bold: true},
+}
+
+// accountingLiquidationFields son los valores del cuerpo del Documento Contable de Liquidación, que no posee ítems
+var accountingLiquidationFields = []field{
+	{key: "periodoLiquidacionFechaInicio", label: "Inicio del periodo"},
+	{key: "periodoLiquidacionFechaFin", label: "Fin del periodo"},
+	{key: "codLiquidacion", label: "Código de liquidación"},
+	{key: "cantidadDoc", label: "Cantidad de documentos", format: formatQuantity},
+	{key: "valorOperaciones", label: "Valor de las operaciones", format: formatAmount},
+	{key: "montoSinPercepcion", label: "Monto sin percepción", format: formatAmount},
+	{key: "descripSinPercepcion", label: "Descripción sin percepción"},
+	{key: "subTotal", label: "Sub-total", format: formatAmount},
+	{key: "iva", label: "IVA", format: formatAmount},
+	{key: "montoSujetoPercepcion", label: "Monto sujeto a percepción", format: formatAmount},
+	{key: "ivaPercibido", label: "IVA percibido", format: formatAmount},
+	{key: "comision", label: "Comisión", format: formatAmount},
+	{key: "porcentComision", label: "Porcentaje de comisión"},
+	{key: "ivaComision", label: "IVA de la comisión", format: formatAmount},
+	{key: "liquidoApagar", label: "Líquido a pagar", format: formatAmount, bold: true},
+	{key: "observaciones", label: "Observaciones"},
+}
+
+// extensionFields son los datos de entrega y recepción del documento
+var extensionFields = []field{
+	{key: "nombEntrega", label: "Responsable de entregar"},
+	{key: "docuEntrega", label: "Documento de quien entrega"},
+	{key: "nombRecibe", label: "Responsable de recibir"},
+	{key: "docuRecibe", label: "Documento de quien recibe"},
+	{key: "codEmpleado", label: "Código de empleado"},
+	{key: "placaVehiculo", label: "Placa del vehículo"},
+	{key: "observaciones", label: "Observaciones"},
+}
+
+var (
+	billingModels = map[string]string{
+		"1": "Previo",
+		"2": "Diferido",
+	}
+
+	operationTypes = map[string]string{
+		"1": "Normal",
+		"2": "Contingencia",
+	}
+
+	operationConditions = map[string]string{
+		"1": "Contado",
+		"2": "A crédito",
+		"3": "Otro",
+	}
+
+	receiverDocumentTypes = map[string]string{
+		constants.NIT:             "NIT",
+		constants.DUI:             "DUI",
+		constants.CarnetResidente: "Carnet de residente",
+		constants.Pasaporte:       "Pasaporte",
+		constants.OtroDocumento:   "Otro",
+	}
+
+	statusBanners = map[string]string{
+		constants.DocumentInvalid:  "DOCUMENTO INVALIDADO",
+		constants.DocumentRejected: "DOCUMENTO RECHAZADO POR HACIENDA",
+		constants.DocumentPending:  "DOCUMENTO PENDIENTE DE TRANSMISIÓN",
+	}
+)
+
+// partyTitles obtiene los títulos de los bloques de emisor y receptor según el tipo de documento
+func partyTitles(dteType string) (string, string) {
+	switch dteType {
+	case constants.ComprobanteDonacionElectronico:
+		return "DONATARIO", "DONANTE"
+	case constants.FacturaSujetoExcluidoElectronica:
+		return "EMISOR", "SUJETO EXCLUIDO"
+	default:
+		return "EMISOR", "RECEPTOR"
+	}
+}
@@ -3,14 +3,19 @@ package repositories
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
+
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
 	"gorm.io/gorm"
 
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
 	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 )
 
 type AuthRepository struct {
@@ -76,7 +81,7 @@ func (r *AuthRepository) GetByBranchApiKey(ctx context.Context, apiKey string) (
 	var branch db_models.BranchOffice
 
 	// 1. Obtener la sucursal por su API key
-	result := r.db.WithContext(ctx).Where("api_key = ? AND is_active = ?", apiKey, true).First(&branch)
+	result := whereAPIKey(r.db.WithContext(ctx), apiKey).Where("is_active = ?", true).First(&branch)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, errPackage.ErrBranchOfficeNotFound
@@ -119,7 +124,7 @@ func (r *AuthRepository) GetByBranchApiKey(ctx context.Context, apiKey string) (
 func (r *AuthRepository) GetBranchByBranchApiKey(ctx context.Context, apiKey string) (*user.BranchOffice, error) {
 	var branch db_models.BranchOffice
 
-	result := r.db.WithContext(ctx).Preload("Address").Where("api_key = ?", apiKey).First(&branch)
+	result := whereAPIKey(r.db.WithContext(ctx).Preload("Address"), apiKey).First(&branch)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, errPackage.ErrBranchOfficeNotFound
@@ -140,6 +145,12 @@ func (r *AuthRepository) GetBranchByBranchApiKey(ctx context.Context, apiKey str
 		POSCode:             branch.POSCode,
 		POSCodeMH:           branch.POSCodeMH,
 		IsActive:            branch.IsActive,
+		AsyncEmission:       branch.AsyncEmission,
+		PreviousAPIKey:      branch.PreviousAPIKey,
+		PreviousAPISecret:   branch.PreviousAPISecret,
+		PreviousExpiresAt:   branch.PreviousExpiresAt,
+		Role:                branch.Role,
+		Scopes:              splitScopes(branch.Scopes),
 	}
 
 	if localUser.Address != nil {
@@ -242,6 +253,9 @@ func (r *AuthRepository) Create(ctx context.Context, user *user.User) error {
 				POSCode:             user.BranchOffices[i].POSCode,
 				POSCodeMH:           user.BranchOffices[i].POSCodeMH,
 				IsActive:            user.BranchOffices[i].IsActive,
+				AsyncEmission:       user.BranchOffices[i].AsyncEmission,
+				Role:                user.BranchOffices[i].Role,
+				Scopes:              joinScopes(user.BranchOffices[i].Scopes),
 			}
 
 			if err := tx.Create(&dbBranch).Error; err != nil {
@@ -266,7 +280,14 @@ func (r *AuthRepository) Create(ctx context.Context, user *user.User) error {
 			user.BranchOffices[i].ID = dbBranch.ID
 		}
 
-		return nil
+		// 7. Registrar el correo del usuario para recibir las notificaciones de sus eventos
+		notifiableUser := db_models.NotifiableUser{
+			UserID:     dbUser.ID,
+			EntityType: notification.EntityClient,
+			Email:      user.Email,
+		}
+
+		return tx.Create(&notifiableUser).Error
 	})
 }
 
@@ -316,12 +337,23 @@ func (r *AuthRepository) UpdateBranchOffices(ctx context.Context, userID uint, b
 				POSCode:             branch.POSCode,
 				POSCodeMH:           branch.POSCodeMH,
 				IsActive:            branch.IsActive,
+				AsyncEmission:       branch.AsyncEmission,
+				Role:                branch.Role,
 			}
 
 			if err := tx.Model(&dbBranch).Updates(dbBranch).Error; err != nil {
 				return err
 			}
 
+			// 2.1 Los campos booleanos y los scopes se actualizan aparte porque Updates omite los valores en cero
+			if err := tx.Model(&dbBranch).Updates(map[string]interface{}{
+				"is_active":      branch.IsActive,
+				"async_emission": branch.AsyncEmission,
+				"scopes":         joinScopes(branch.Scopes),
+			}).Error; err != nil {
+				return err
+			}
+
 			// 3. Si hay dirección, actualizarla también
 			if branch.Address != nil {
 				dbAddress := db_models.Address{
@@ -404,6 +436,12 @@ func (r *AuthRepository) GetBranchByBranchID(ctx context.Context, branchID uint)
 		POSCode:             branch.POSCode,
 		POSCodeMH:           branch.POSCodeMH,
 		IsActive:            branch.IsActive,
+		AsyncEmission:       branch.AsyncEmission,
+		PreviousAPIKey:      branch.PreviousAPIKey,
+		PreviousAPISecret:   branch.PreviousAPISecret,
+		PreviousExpiresAt:   branch.PreviousExpiresAt,
+		Role:                branch.Role,
+		Scopes:              splitScopes(branch.Scopes),
 		User: &user.User{
 			ID:                   branch.User.ID,
 			Status:               branch.User.Status,
@@ -417,7 +455,7 @@ func (r *AuthRepository) GetBranchByBranchID(ctx context.Context, branchID uint)
 		},
 	}
 
-	if localBranch.Address != nil {
+	if branch.Address != nil {
 		localBranch.Address = &user.Address{
 			Municipality: branch.Address.Municipality,
 			Department:   branch.Address.Department,
@@ -531,6 +569,9 @@ func (r *AuthRepository) GetMatrixBranch(ctx context.Context, userID uint) (*use
 		POSCode:             branch.POSCode,
 		POSCodeMH:           branch.POSCodeMH,
 		IsActive:            branch.IsActive,
+		AsyncEmission:       branch.AsyncEmission,
+		Role:                branch.Role,
+		Scopes:              splitScopes(branch.Scopes),
 		Address: &user.Address{
 			Municipality: branch.Address.Municipality,
 			Department:   branch.Address.Department,
@@ -538,3 +579,170 @@ func (r *AuthRepository) GetMatrixBranch(ctx context.Context, userID uint) (*use
 		},
 	}, nil
 }
+
+// ListBranchOffices obtiene todas las sucursales de un usuario, activas e inactivas, en el orden en que se registraron
+func (r *AuthRepository) ListBranchOffices(ctx context.Context, userID uint) ([]user.BranchOffice, error) {
+	var dbBranches []db_models.BranchOffice
+	err := r.db.WithContext(ctx).
+		Preload("Address").
+		Where("user_id = ?", userID).
+		Order("id ASC").
+		Find(&dbBranches).Error
+	if err != nil {
+		return nil, err
+	}
+
+	branches := make([]user.BranchOffice, len(dbBranches))
+	for i, branch := range dbBranches {
+		branches[i] = toDomainBranch(branch)
+	}
+
+	return branches, nil
+}
+
+// CreateBranchOffice crea una sucursal de un usuario junto a su dirección
+func (r *AuthRepository) CreateBranchOffice(ctx context.Context, userID uint, branch *user.BranchOffice) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Crear sucursal
+		dbBranch := db_models.BranchOffice{
+			UserID:              userID,
+			EstablishmentCode:   branch.EstablishmentCode,
+			EstablishmentCodeMH: branch.EstablishmentCodeMH,
+			Email:               branch.Email,
+			APIKey:              branch.APIKey,
+			APISecret:           branch.APISecret,
+			Phone:               branch.Phone,
+			EstablishmentType:   branch.EstablishmentType,
+			POSCode:             branch.POSCode,
+			POSCodeMH:           branch.POSCodeMH,
+			IsActive:            branch.IsActive,
+			AsyncEmission:       branch.AsyncEmission,
+			Role:                branch.Role,
+			Scopes:              joinScopes(branch.Scopes),
+		}
+
+		if err := tx.Create(&dbBranch).Error; err != nil {
+			return err
+		}
+
+		// 2. Si la sucursal tiene dirección, crearla también
+		if branch.Address != nil {
+			dbAddress := db_models.Address{
+				BranchID:     dbBranch.ID,
+				Municipality: branch.Address.Municipality,
+				Department:   branch.Address.Department,
+				Complement:   branch.Address.Complement,
+			}
+
+			if err := tx.Create(&dbAddress).Error; err != nil {
+				return err
+			}
+		}
+
+		// 3. Actualizar IDs en el modelo de dominio
+		branch.ID = dbBranch.ID
+		branch.UserID = userID
+		return nil
+	})
+}
+
+// SetBranchOfficeStatus activa o desactiva una sucursal de un usuario
+func (r *AuthRepository) SetBranchOfficeStatus(ctx context.Context, userID uint, branchID uint, active bool) error {
+	result := r.db.WithContext(ctx).
+		Model(&db_models.BranchOffice{}).
+		Where("id = ? AND user_id = ?", branchID, userID).
+		Update("is_active", active)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		var count int64
+		r.db.WithContext(ctx).Model(&db_models.BranchOffice{}).Where("id = ? AND user_id = ?", branchID, userID).Count(&count)
+		if count == 0 {
+			return errPackage.ErrBranchDoesNotBelong
+		}
+	}
+
+	return nil
+}
+
+// RotateBranchCredentials reemplaza las credenciales de una sucursal de un usuario y conserva las actuales como
+// credenciales anteriores hasta previousExpiresAt
+func (r *AuthRepository) RotateBranchCredentials(ctx context.Context, userID uint, branchID uint, apiKey, apiSecret string, previousExpiresAt time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Obtener las credenciales actuales de la sucursal
+		var branch db_models.BranchOffice
+		result := tx.Where("id = ? AND user_id = ?", branchID, userID).First(&branch)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return errPackage.ErrBranchDoesNotBelong
+			}
+			return result.Error
+		}
+
+		// 2. Conservar las credenciales actuales como anteriores y asignar las nuevas
+		return tx.Model(&branch).Updates(map[string]interface{}{
+			"previous_api_key":    branch.APIKey,
+			"previous_api_secret": branch.APISecret,
+			"previous_expires_at": previousExpiresAt,
+			"api_key":             apiKey,
+			"api_secret":          apiSecret,
+		}).Error
+	})
+}
+
+// whereAPIKey filtra la sucursal por su API key o por el API key anterior a su última rotación mientras siga en su
+// periodo de gracia
+func whereAPIKey(db *gorm.DB, apiKey string) *gorm.DB {
+	return db.Where("(api_key = ? OR (previous_api_key = ? AND previous_expires_at > ?))", apiKey, apiKey, utils.TimeNow())
+}
+
+// toDomainBranch convierte una sucursal de base de datos con su dirección precargada al modelo de dominio
+func toDomainBranch(branch db_models.BranchOffice) user.BranchOffice {
+	localBranch := user.BranchOffice{
+		ID:                  branch.ID,
+		UserID:              branch.UserID,
+		EstablishmentCode:   branch.EstablishmentCode,
+		EstablishmentCodeMH: branch.EstablishmentCodeMH,
+		Email:               branch.Email,
+		APIKey:              branch.APIKey,
+		APISecret:           branch.APISecret,
+		Phone:               branch.Phone,
+		EstablishmentType:   branch.EstablishmentType,
+		POSCode:             branch.POSCode,
+		POSCodeMH:           branch.POSCodeMH,
+		IsActive:            branch.IsActive,
+		AsyncEmission:       branch.AsyncEmission,
+		PreviousAPIKey:      branch.PreviousAPIKey,
+		PreviousAPISecret:   branch.PreviousAPISecret,
+		PreviousExpiresAt:   branch.PreviousExpiresAt,
+		Role:                branch.Role,
+		Scopes:              splitScopes(branch.Scopes),
+	}
+
+	if branch.Address != nil {
+		localBranch.Address = &user.Address{
+			ID:           branch.Address.ID,
+			BranchID:     branch.Address.BranchID,
+			Municipality: branch.Address.Municipality,
+			Department:   branch.Address.Department,
+			Complement:   branch.Address.Complement,
+		}
+	}
+
+	return localBranch
+}
+
+// joinScopes convierte los scopes adicionales de una sucursal a la lista separada por comas que se almacena
+func joinScopes(scopes []string) string {
+	return strings.Join(scopes, ",")
+}
+
+// splitScopes convierte la lista de scopes almacenada a los scopes adicionales de una sucursal
+func splitScopes(scopes string) []string {
+	if scopes == "" {
+		return nil
+	}
+	return strings.Split(scopes, ",")
+}
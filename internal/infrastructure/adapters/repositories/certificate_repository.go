@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate/models"
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type CertificateRepository struct {
+	db *gorm.DB
+}
+
+func NewCertificateRepository(db *gorm.DB) certificate.CertificateRepositoryPort {
+	return &CertificateRepository{
+		db: db,
+	}
+}
+
+// Activate almacena un certificado como activo, desactiva los anteriores del usuario y actualiza su contraseña privada
+func (r *CertificateRepository) Activate(ctx context.Context, cert *models.SigningCertificate, passwordPri string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := utils.TimeNow()
+
+		// 1. Desactivar los certificados activos del usuario
+		if err := tx.Model(&db_models.SigningCertificate{}).
+			Where("user_id = ? AND is_active = ?", cert.UserID, true).
+			Updates(map[string]interface{}{
+				"is_active":      false,
+				"deactivated_at": now,
+				"updated_at":     now,
+			}).Error; err != nil {
+			return err
+		}
+
+		// 2. Crear el nuevo certificado activo
+		dbCert := db_models.SigningCertificate{
+			UserID:           cert.UserID,
+			NIT:              cert.NIT,
+			Fingerprint:      cert.Fingerprint,
+			EncryptedContent: cert.EncryptedContent,
+			ExpiresAt:        cert.ExpiresAt,
+			IsActive:         true,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+		if err := tx.Create(&dbCert).Error; err != nil {
+			return err
+		}
+		cert.ID = dbCert.ID
+
+		// 3. Actualizar la contraseña privada con la que se firma
+		return tx.Model(&db_models.User{}).
+			Where("id = ?", cert.UserID).
+			Updates(map[string]interface{}{
+				"password_pri": passwordPri,
+				"updated_at":   now,
+			}).Error
+	})
+}
+
+// GetActiveByNIT obtiene el certificado activo de un NIT
+func (r *CertificateRepository) GetActiveByNIT(ctx context.Context, nit string) (*models.SigningCertificate, error) {
+	var dbCert db_models.SigningCertificate
+
+	result := r.db.WithContext(ctx).
+		Where("nit = ? AND is_active = ?", nit, true).
+		Order("created_at DESC").
+		First(&dbCert)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errPackage.ErrCertificateNotFound
+		}
+		return nil, result.Error
+	}
+
+	return toDomainCertificate(&dbCert), nil
+}
+
+// ListByUser obtiene todos los certificados registrados por un usuario, del más reciente al más antiguo
+func (r *CertificateRepository) ListByUser(ctx context.Context, userID uint) ([]models.SigningCertificate, error) {
+	var dbCerts []db_models.SigningCertificate
+
+	result := r.db.WithContext(ctx).
+		Omit("encrypted_content").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&dbCerts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	certs := make([]models.SigningCertificate, len(dbCerts))
+	for i := range dbCerts {
+		certs[i] = *toDomainCertificate(&dbCerts[i])
+	}
+
+	return certs, nil
+}
+
+// Deactivate desactiva un certificado de un usuario
+func (r *CertificateRepository) Deactivate(ctx context.Context, userID, id uint) error {
+	now := utils.TimeNow()
+
+	result := r.db.WithContext(ctx).
+		Model(&db_models.SigningCertificate{}).
+		Where("id = ? AND user_id = ? AND is_active = ?", id, userID, true).
+		Updates(map[string]interface{}{
+			"is_active":      false,
+			"deactivated_at": now,
+			"updated_at":     now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errPackage.ErrCertificateNotFound
+	}
+
+	return nil
+}
+
+// GetExpiring obtiene los certificados activos que vencen antes de la fecha indicada y aún no han sido notificados
+func (r *CertificateRepository) GetExpiring(ctx context.Context, until time.Time) ([]models.SigningCertificate, error) {
+	var dbCerts []db_models.SigningCertificate
+
+	result := r.db.WithContext(ctx).
+		Omit("encrypted_content").
+		Where("is_active = ? AND expiry_notified_at IS NULL AND expires_at <= ?", true, until).
+		Find(&dbCerts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	certs := make([]models.SigningCertificate, len(dbCerts))
+	for i := range dbCerts {
+		certs[i] = *toDomainCertificate(&dbCerts[i])
+	}
+
+	return certs, nil
+}
+
+// MarkExpiryNotified registra el evento de vencimiento y marca el certificado como notificado en una misma transacción
+func (r *CertificateRepository) MarkExpiryNotified(ctx context.Context, id uint, domainEvent *event.DomainEvent) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Registrar el evento de dominio
+		if err := createDomainEvent(tx, domainEvent); err != nil {
+			return err
+		}
+
+		// 2. Marcar el certificado como notificado
+		return tx.Model(&db_models.SigningCertificate{}).
+			Where("id = ?", id).
+			Update("expiry_notified_at", utils.TimeNow()).Error
+	})
+}
+
+func toDomainCertificate(dbCert *db_models.SigningCertificate) *models.SigningCertificate {
+	return &models.SigningCertificate{
+		ID:               dbCert.ID,
+		UserID:           dbCert.UserID,
+		NIT:              dbCert.NIT,
+		Fingerprint:      dbCert.Fingerprint,
+		ExpiresAt:        dbCert.ExpiresAt,
+		IsActive:         dbCert.IsActive,
+		CreatedAt:        dbCert.CreatedAt,
+		DeactivatedAt:    dbCert.DeactivatedAt,
+		ExpiryNotifiedAt: dbCert.ExpiryNotifiedAt,
+		EncryptedContent: dbCert.EncryptedContent,
+	}
+}
@@ -2,16 +2,18 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	contingencyModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"log"
-	"time"
 
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
@@ -41,16 +43,26 @@ func (r *ContingencyRepository) Create(ctx context.Context, doc *dte.Contingency
 	return r.db.WithContext(ctx).Create(contingencyDoc).Error
 }
 
-func (r *ContingencyRepository) GetPending(ctx context.Context, limit int) ([]dte.ContingencyDocument, error) {
+func (r *ContingencyRepository) GetPending(ctx context.Context, limit int, excludedNITs []string) ([]dte.ContingencyDocument, error) {
 	var dbDocs []db_models.ContingencyDocument
 	// 1. Obtener los documentos en estado PENDING para procesar (JOIN con dte_details)
-	err := r.db.WithContext(ctx).
+	query := r.db.WithContext(ctx).
 		Preload("Document").
 		Preload("Branch").
 		Preload("Branch.User").
 		Preload("Branch.Address").
 		Joins("JOIN dte_details ON contingency_documents.document_id = dte_details.id").
-		Where("dte_details.status = ?", constants.DocumentPending).
+		Where("dte_details.status = ?", constants.DocumentPending)
+
+	// Excluir los NIT que se retransmiten con su propia programación
+	if len(excludedNITs) > 0 {
+		query = query.
+			Joins("JOIN branch_offices ON contingency_documents.branch_id = branch_offices.id").
+			Joins("JOIN users ON branch_offices.user_id = users.id").
+			Where("users.nit NOT IN ?", excludedNITs)
+	}
+
+	err := query.
 		Limit(limit).
 		Order("contingency_documents.created_at asc").
 		Find(&dbDocs).Error
@@ -64,9 +76,190 @@ func (r *ContingencyRepository) GetPending(ctx context.Context, limit int) ([]dt
 		docs[i] = convertToDomainModel(&doc)
 	}
 
+	// 3. Obtener el estado de los eventos que informaron los documentos
+	if err = r.loadEventStatuses(ctx, docs); err != nil {
+		return nil, err
+	}
+
 	return docs, nil
 }
 
+// GetPendingByNIT obtiene los documentos en estado PENDING de las sucursales de un NIT para procesar
+func (r *ContingencyRepository) GetPendingByNIT(ctx context.Context, nit string, limit int) ([]dte.ContingencyDocument, error) {
+	var dbDocs []db_models.ContingencyDocument
+	err := r.db.WithContext(ctx).
+		Preload("Document").
+		Preload("Branch").
+		Preload("Branch.User").
+		Preload("Branch.Address").
+		Joins("JOIN dte_details ON contingency_documents.document_id = dte_details.id").
+		Joins("JOIN branch_offices ON contingency_documents.branch_id = branch_offices.id").
+		Joins("JOIN users ON branch_offices.user_id = users.id").
+		Where("dte_details.status = ? AND users.nit = ?", constants.DocumentPending, nit).
+		Limit(limit).
+		Order("contingency_documents.created_at asc").
+		Find(&dbDocs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]dte.ContingencyDocument, len(dbDocs))
+	for i, doc := range dbDocs {
+		docs[i] = convertToDomainModel(&doc)
+	}
+
+	if err = r.loadEventStatuses(ctx, docs); err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+// loadEventStatuses asigna a cada documento el estado del evento de contingencia que lo informó
+func (r *ContingencyRepository) loadEventStatuses(ctx context.Context, docs []dte.ContingencyDocument) error {
+	var eventIDs []string
+	for _, doc := range docs {
+		if doc.ContingencyEventID != nil {
+			eventIDs = append(eventIDs, *doc.ContingencyEventID)
+		}
+	}
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	var events []db_models.ContingencyEvent
+	if err := r.db.WithContext(ctx).
+		Select("id", "status").
+		Where("id IN ?", eventIDs).
+		Find(&events).Error; err != nil {
+		return err
+	}
+
+	statuses := make(map[string]string, len(events))
+	for _, event := range events {
+		statuses[event.ID] = event.Status
+	}
+	for i := range docs {
+		if docs[i].ContingencyEventID != nil {
+			docs[i].ContingencyEventStatus = statuses[*docs[i].ContingencyEventID]
+		}
+	}
+
+	return nil
+}
+
+// List obtiene una página de los documentos en contingencia de las sucursales de un usuario, del más antiguo al más
+// reciente para mostrar primero los documentos más próximos a vencer su plazo de transmisión
+func (r *ContingencyRepository) List(ctx context.Context, userID uint, filters *contingencyModels.ContingencyFilters) ([]dte.ContingencyDocument, int64, error) {
+	// 1. Construir la consulta con los filtros
+	query := r.db.WithContext(ctx).
+		Model(&db_models.ContingencyDocument{}).
+		Joins("JOIN dte_details ON contingency_documents.document_id = dte_details.id").
+		Joins("JOIN branch_offices ON contingency_documents.branch_id = branch_offices.id").
+		Where("branch_offices.user_id = ?", userID)
+
+	if filters.BranchID != nil {
+		query = query.Where("contingency_documents.branch_id = ?", *filters.BranchID)
+	}
+	if filters.Status != "" {
+		query = query.Where("dte_details.status = ?", filters.Status)
+	}
+
+	// 2. Contar los documentos que cumplen los filtros
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 3. Obtener la página solicitada
+	var dbDocs []db_models.ContingencyDocument
+	err := query.
+		Preload("Document").
+		Preload("Branch").
+		Preload("Branch.User").
+		Order("contingency_documents.created_at ASC, contingency_documents.id ASC").
+		Offset((filters.Page - 1) * filters.PageSize).
+		Limit(filters.PageSize).
+		Find(&dbDocs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	docs := make([]dte.ContingencyDocument, len(dbDocs))
+	for i, doc := range dbDocs {
+		docs[i] = convertToDomainModel(&doc)
+	}
+
+	return docs, total, nil
+}
+
+// GetByID obtiene un documento en contingencia de las sucursales de un usuario
+func (r *ContingencyRepository) GetByID(ctx context.Context, userID uint, id string) (*dte.ContingencyDocument, error) {
+	var dbDoc db_models.ContingencyDocument
+	err := r.db.WithContext(ctx).
+		Preload("Document").
+		Preload("Branch").
+		Preload("Branch.User").
+		Joins("JOIN branch_offices ON contingency_documents.branch_id = branch_offices.id").
+		Where("contingency_documents.id = ? AND branch_offices.user_id = ?", id, userID).
+		First(&dbDoc).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.ErrContingencyDocumentNotFound
+		}
+		return nil, err
+	}
+
+	doc := convertToDomainModel(&dbDoc)
+	return &doc, nil
+}
+
+// UpdateClassification actualiza el tipo y el motivo de contingencia de un documento
+func (r *ContingencyRepository) UpdateClassification(ctx context.Context, id string, contingencyType int8, reason string) error {
+	return r.db.WithContext(ctx).
+		Model(&db_models.ContingencyDocument{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"type":       contingencyType,
+			"reason":     reason,
+			"updated_at": utils.TimeNow(),
+		}).Error
+}
+
+// Cancel marca como rechazado el documento asociado a la contingencia, retirándolo de la cola de retransmisión, y
+// registra la observación de la cancelación. Solo se cancelan los documentos que siguen pendientes.
+func (r *ContingencyRepository) Cancel(ctx context.Context, id string, observation string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Obtener el documento de contingencia
+		var contingencyDoc db_models.ContingencyDocument
+		if err := tx.Where("id = ?", id).First(&contingencyDoc).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errPackage.ErrContingencyDocumentNotFound
+			}
+			return err
+		}
+
+		// 2. Marcar el documento asociado como rechazado solo si sigue pendiente
+		result := tx.Model(&db_models.DTEDetails{}).
+			Where("id = ? AND status = ?", contingencyDoc.DocumentID, constants.DocumentPending).
+			Update("status", constants.DocumentRejected)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return errPackage.ErrContingencyDocumentNotPending
+		}
+
+		// 3. Registrar la observación de la cancelación
+		return tx.Model(&db_models.ContingencyDocument{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"observations": observation,
+				"updated_at":   utils.TimeNow(),
+			}).Error
+	})
+}
+
 func (r *ContingencyRepository) UpdateBatch(ctx context.Context, ids []string, observations []string, stamps map[string]string, batchID string, mhBatchID string, status string) error {
 	// 1. Iniciar una transacción para asegurar la atomicidad de las operaciones
 	tx := r.db.WithContext(ctx).Begin()
@@ -165,34 +358,151 @@ func (r *ContingencyRepository) UpdateBatch(ctx context.Context, ids []string, o
 	return tx.Commit().Error
 }
 
-func (r *ContingencyRepository) GetFirstContingencyTimestamp(ctx context.Context, branchID uint) (*time.Time, error) {
-	var doc db_models.ContingencyDocument
+// SaveEvent almacena el evento de contingencia pendiente de enviar y lo asocia a los documentos que informa, de modo que
+// la asociación se conserve aunque luego falle el registro de la respuesta de Hacienda
+func (r *ContingencyRepository) SaveEvent(ctx context.Context, event *contingencyModels.ContingencyEventRecord) error {
+	observations, err := encodeEventObservations(event.Observations)
+	if err != nil {
+		return err
+	}
 
-	err := r.db.WithContext(ctx).
-		Joins("JOIN dte_details ON contingency_documents.document_id = dte_details.id").
-		Where("contingency_documents.branch_id = ? AND dte_details.status = ?", branchID, constants.DocumentPending).
-		Order("contingency_documents.created_at ASC").
-		Limit(1).
-		Select("contingency_documents.created_at").
-		First(&doc).Error
+	dbEvent := &db_models.ContingencyEvent{
+		ID:              event.ID,
+		BranchID:        event.BranchID,
+		NIT:             event.NIT,
+		ContingencyType: event.ContingencyType,
+		Reason:          event.Reason,
+		SignedDocument:  event.SignedDocument,
+		Status:          event.Status,
+		ReceptionStamp:  event.ReceptionStamp,
+		Message:         event.Message,
+		Observations:    observations,
+		CreatedAt:       event.CreatedAt,
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Almacenar el evento
+		if err := tx.Create(dbEvent).Error; err != nil {
+			return err
+		}
 
+		// 2. Asociar el evento a sus documentos
+		if len(event.DocumentIDs) == 0 {
+			return nil
+		}
+
+		return tx.Model(&db_models.ContingencyDocument{}).
+			Where("id IN ?", event.DocumentIDs).
+			Updates(map[string]interface{}{
+				"contingency_event_id": event.ID,
+				"updated_at":           utils.TimeNow(),
+			}).Error
+	})
+}
+
+// GetEvent obtiene un evento de contingencia con su documento firmado, sus observaciones y los documentos asociados
+func (r *ContingencyRepository) GetEvent(ctx context.Context, id string) (*contingencyModels.ContingencyEventRecord, error) {
+	// 1. Obtener el evento
+	var dbEvent db_models.ContingencyEvent
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&dbEvent).Error; err != nil {
+		return nil, err
+	}
+
+	// 2. Obtener los documentos asociados al evento
+	var documentIDs []string
+	if err := r.db.WithContext(ctx).
+		Model(&db_models.ContingencyDocument{}).
+		Where("contingency_event_id = ?", id).
+		Pluck("id", &documentIDs).Error; err != nil {
+		return nil, err
+	}
+
+	record := &contingencyModels.ContingencyEventRecord{
+		ID:              dbEvent.ID,
+		BranchID:        dbEvent.BranchID,
+		NIT:             dbEvent.NIT,
+		ContingencyType: dbEvent.ContingencyType,
+		Reason:          dbEvent.Reason,
+		DocumentIDs:     documentIDs,
+		SignedDocument:  dbEvent.SignedDocument,
+		Status:          dbEvent.Status,
+		ReceptionStamp:  dbEvent.ReceptionStamp,
+		Message:         dbEvent.Message,
+		CreatedAt:       dbEvent.CreatedAt,
+	}
+
+	if dbEvent.Observations != nil {
+		if err := json.Unmarshal([]byte(*dbEvent.Observations), &record.Observations); err != nil {
+			return nil, err
+		}
+	}
+
+	return record, nil
+}
+
+// UpdateEvent almacena la respuesta de Hacienda al evento. Si no fue aceptado, retira la asociación de los documentos
+// para que se informen en un nuevo evento
+func (r *ContingencyRepository) UpdateEvent(ctx context.Context, event *contingencyModels.ContingencyEventRecord) error {
+	observations, err := encodeEventObservations(event.Observations)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Actualizar la respuesta del evento
+		if err := tx.Model(&db_models.ContingencyEvent{}).
+			Where("id = ?", event.ID).
+			Updates(map[string]interface{}{
+				"status":          event.Status,
+				"reception_stamp": event.ReceptionStamp,
+				"message":         event.Message,
+				"observations":    observations,
+			}).Error; err != nil {
+			return err
+		}
+
+		// 2. Retirar la asociación de los documentos de un evento no aceptado
+		if event.IsAccepted() {
+			return nil
 		}
-		return nil, fmt.Errorf("error getting first contingency timestamp: %w", err)
+
+		return tx.Model(&db_models.ContingencyDocument{}).
+			Where("contingency_event_id = ?", event.ID).
+			Updates(map[string]interface{}{
+				"contingency_event_id": nil,
+				"updated_at":           utils.TimeNow(),
+			}).Error
+	})
+}
+
+// encodeEventObservations codifica en JSON las observaciones de Hacienda a un evento, nil si no tiene
+func encodeEventObservations(observations []string) (*string, error) {
+	if len(observations) == 0 {
+		return nil, nil
 	}
 
-	return &doc.CreatedAt, nil
+	data, err := json.Marshal(observations)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := string(data)
+	return &encoded, nil
 }
 
 func convertToDomainModel(doc *db_models.ContingencyDocument) dte.ContingencyDocument {
 	return dte.ContingencyDocument{
-		ID:              doc.ID,
-		BranchID:        doc.BranchID,
-		DocumentID:      doc.DocumentID,
-		ContingencyType: doc.ContingencyType,
-		Reason:          doc.Reason,
+		ID:                 doc.ID,
+		BranchID:           doc.BranchID,
+		DocumentID:         doc.DocumentID,
+		ContingencyType:    doc.ContingencyType,
+		Reason:             doc.Reason,
+		BatchID:            doc.BatchID,
+		MHBatchID:          doc.MHBatchID,
+		Observations:       doc.Observations,
+		ContingencyEventID: doc.ContingencyEventID,
+		CreatedAt:          doc.CreatedAt,
+		UpdatedAt:          doc.UpdatedAt,
 		Document: &dte.DTEDetails{
 			ID:             doc.Document.ID,
 			DTEType:        doc.Document.DTEType,
@@ -203,6 +513,7 @@ func convertToDomainModel(doc *db_models.ContingencyDocument) dte.ContingencyDoc
 			JSONData:       doc.Document.JSONData,
 		},
 		Branch: &user.BranchOffice{
+			ID: doc.Branch.ID,
 			User: &user.User{
 				ID:                   doc.Branch.User.ID,
 				Status:               doc.Branch.User.Status,
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"gorm.io/gorm"
+	"strings"
 	"time"
 
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
@@ -59,7 +60,7 @@ func (D *DTERepository) Create(ctx context.Context, document interface{}, transm
 	// 4. Guardar en la base de datos
 	result := D.db.WithContext(ctx).Create(dteDocument)
 	if result.Error != nil {
-		return err
+		return result.Error
 	}
 
 	return nil
@@ -101,7 +102,22 @@ func (D *DTERepository) GenerateBalanceTransaction(ctx context.Context, branchID
 		return handleGormErr(result.Error, "GenerateBalanceTransaction")
 	}
 
-	// 2. Crear un nuevo balance de transacción
+	// 2. Omitir la transacción si el documento de ajuste ya registró una del mismo tipo, de modo que reintentar las
+	// operaciones de un documento no afecte su saldo dos veces, la invalidación del documento de ajuste es otro tipo
+	var existing int64
+	result = D.db.WithContext(ctx).
+		Model(&db_models.DTEBalanceTransaction{}).
+		Where("balance_control_id = ? AND adjustment_document_id = ? AND transaction_type = ?",
+			balanceControl.ID, transaction.AdjustmentDocumentID, transaction.TransactionType).
+		Count(&existing)
+	if result.Error != nil {
+		return result.Error
+	}
+	if existing > 0 {
+		return nil
+	}
+
+	// 3. Crear un nuevo balance de transacción
 	dteTransaction := &db_models.DTEBalanceTransaction{
 		BalanceControlID:     balanceControl.ID,
 		BalanceControl:       &balanceControl,
@@ -112,9 +128,13 @@ func (D *DTERepository) GenerateBalanceTransaction(ctx context.Context, branchID
 		NotSubjectAmount:     transaction.NotSubjectAmount,
 	}
 
-	// 2. Guardar en la base de datos
+	// 4. Guardar en la base de datos, si un reintento concurrente ya la registró el índice único rechaza la inserción y
+	// el saldo no se actualiza
 	result = D.db.WithContext(ctx).Create(dteTransaction)
 	if result.Error != nil {
+		if isDuplicatedKeyErr(result.Error) {
+			return nil
+		}
 		return result.Error
 	}
 
@@ -361,6 +381,15 @@ func loadFilters(query *gorm.DB, filters *dte.DTEFilters) {
 	}
 }
 
+// isDuplicatedKeyErr indica si el error se debe a la violación de un índice único
+func isDuplicatedKeyErr(err error) bool {
+	errMsg := strings.ToLower(err.Error())
+	return errors.Is(err, gorm.ErrDuplicatedKey) ||
+		strings.Contains(errMsg, "duplicate entry") || // MySQL
+		strings.Contains(errMsg, "unique constraint") || // PostgreSQL
+		strings.Contains(errMsg, "violates unique") // PostgreSQL
+}
+
 func handleGormErr(err error, operation string) error {
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return shared_error.NewFormattedGeneralServiceError("DTERepo", operation, "NotFound")
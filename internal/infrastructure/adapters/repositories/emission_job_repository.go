@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission"
+	emissionModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// emissionReadyCondition selecciona los jobs en cola cuyo próximo intento ya se cumplió y los jobs en proceso cuya reserva
+// expiró porque el worker que los procesaba se detuvo
+const emissionReadyCondition = "(status = ? AND next_attempt_at <= ?) OR (status = ? AND locked_until <= ?)"
+
+type EmissionJobRepository struct {
+	db *gorm.DB
+}
+
+func NewEmissionJobRepository(db *gorm.DB) emission.EmissionRepositoryPort {
+	return &EmissionJobRepository{
+		db: db,
+	}
+}
+
+// Create registra un job en la cola
+func (r *EmissionJobRepository) Create(ctx context.Context, job *emissionModels.EmissionJob) error {
+	dbJob := db_models.EmissionJob{
+		BranchID:      job.BranchID,
+		DocumentID:    job.DocumentID,
+		DTEType:       job.DTEType,
+		Status:        job.Status,
+		NextAttemptAt: job.NextAttemptAt,
+		CreatedAt:     job.CreatedAt,
+		UpdatedAt:     job.UpdatedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&dbJob).Error; err != nil {
+		return err
+	}
+
+	job.ID = dbJob.ID
+	return nil
+}
+
+// GetReady obtiene los jobs listos para procesarse, comenzando por los más antiguos
+func (r *EmissionJobRepository) GetReady(ctx context.Context, now time.Time, limit int) ([]emissionModels.EmissionJob, error) {
+	var dbJobs []db_models.EmissionJob
+
+	result := r.db.WithContext(ctx).
+		Where(emissionReadyCondition, emissionModels.JobQueued, now, emissionModels.JobProcessing, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&dbJobs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	jobs := make([]emissionModels.EmissionJob, len(dbJobs))
+	for i := range dbJobs {
+		jobs[i] = *toDomainEmissionJob(&dbJobs[i])
+	}
+
+	return jobs, nil
+}
+
+// Claim reserva el job solo si sigue listo para procesarse, de modo que un único worker lo transmita
+func (r *EmissionJobRepository) Claim(ctx context.Context, id uint, now, until time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&db_models.EmissionJob{}).
+		Where("id = ?", id).
+		Where(emissionReadyCondition, emissionModels.JobQueued, now, emissionModels.JobProcessing, now).
+		Updates(map[string]interface{}{
+			"status":       emissionModels.JobProcessing,
+			"locked_until": until,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// Update actualiza el estado de un job
+func (r *EmissionJobRepository) Update(ctx context.Context, job *emissionModels.EmissionJob) error {
+	return r.db.WithContext(ctx).
+		Model(&db_models.EmissionJob{}).
+		Where("id = ?", job.ID).
+		Updates(map[string]interface{}{
+			"status":          job.Status,
+			"attempts":        job.Attempts,
+			"last_error":      job.LastError,
+			"next_attempt_at": job.NextAttemptAt,
+			"locked_until":    job.LockedUntil,
+			"updated_at":      utils.TimeNow(),
+		}).Error
+}
+
+// GetByDocumentID obtiene el job de un documento de la sucursal
+func (r *EmissionJobRepository) GetByDocumentID(ctx context.Context, branchID uint, documentID string) (*emissionModels.EmissionJob, error) {
+	var dbJob db_models.EmissionJob
+
+	err := r.db.WithContext(ctx).
+		Where("document_id = ? AND branch_id = ?", documentID, branchID).
+		First(&dbJob).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.ErrEmissionJobNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainEmissionJob(&dbJob), nil
+}
+
+func toDomainEmissionJob(dbJob *db_models.EmissionJob) *emissionModels.EmissionJob {
+	return &emissionModels.EmissionJob{
+		ID:            dbJob.ID,
+		BranchID:      dbJob.BranchID,
+		DocumentID:    dbJob.DocumentID,
+		DTEType:       dbJob.DTEType,
+		Status:        dbJob.Status,
+		Attempts:      dbJob.Attempts,
+		LastError:     dbJob.LastError,
+		NextAttemptAt: dbJob.NextAttemptAt,
+		LockedUntil:   dbJob.LockedUntil,
+		CreatedAt:     dbJob.CreatedAt,
+		UpdatedAt:     dbJob.UpdatedAt,
+	}
+}
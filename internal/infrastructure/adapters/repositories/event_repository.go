@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
+)
+
+type EventRepository struct {
+	db *gorm.DB
+}
+
+func NewEventRepository(db *gorm.DB) events.EventRepositoryPort {
+	return &EventRepository{
+		db: db,
+	}
+}
+
+// Create registra el evento de dominio, resolviendo el dueño de la sucursal en la misma transacción si no se indica
+func (r *EventRepository) Create(ctx context.Context, domainEvent *event.DomainEvent) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Asociar el evento al dueño de la sucursal
+		if domainEvent.UserID == 0 {
+			var branch db_models.BranchOffice
+			if err := tx.Select("user_id").Where("id = ?", domainEvent.BranchID).First(&branch).Error; err != nil {
+				return err
+			}
+			domainEvent.UserID = branch.UserID
+		}
+
+		// 2. Registrar el evento
+		return createDomainEvent(tx, domainEvent)
+	})
+}
+
+// List obtiene los eventos de un usuario que cumplen los filtros, del más reciente al más antiguo
+func (r *EventRepository) List(ctx context.Context, userID uint, filters *models.EventFilters) ([]event.DomainEvent, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&db_models.DomainEvent{}).
+		Where("user_id = ?", userID)
+
+	if filters.BranchID != nil {
+		query = query.Where("branch_id = ?", *filters.BranchID)
+	}
+	if filters.EventType != "" {
+		query = query.Where("event_type = ?", filters.EventType)
+	}
+	if filters.StartDate != nil {
+		query = query.Where("occurred_at >= ?", *filters.StartDate)
+	}
+	if filters.EndDate != nil {
+		query = query.Where("occurred_at <= ?", *filters.EndDate)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var dbEvents []db_models.DomainEvent
+	err := query.
+		Order("occurred_at DESC, id DESC").
+		Offset((filters.Page - 1) * filters.PageSize).
+		Limit(filters.PageSize).
+		Find(&dbEvents).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	domainEvents := make([]event.DomainEvent, len(dbEvents))
+	for i := range dbEvents {
+		domainEvents[i] = event.DomainEvent{
+			ID:         dbEvents[i].ID,
+			UserID:     dbEvents[i].UserID,
+			BranchID:   dbEvents[i].BranchID,
+			EventType:  dbEvents[i].EventType,
+			Payload:    dbEvents[i].Payload,
+			OccurredAt: dbEvents[i].OccurredAt,
+		}
+	}
+
+	return domainEvents, total, nil
+}
+
+// createDomainEvent registra un evento de dominio dentro de la transacción de la operación que lo origina
+func createDomainEvent(tx *gorm.DB, domainEvent *event.DomainEvent) error {
+	dbEvent := db_models.DomainEvent{
+		UserID:     domainEvent.UserID,
+		BranchID:   domainEvent.BranchID,
+		EventType:  domainEvent.EventType,
+		Payload:    domainEvent.Payload,
+		OccurredAt: domainEvent.OccurredAt,
+	}
+	if err := tx.Create(&dbEvent).Error; err != nil {
+		return err
+	}
+
+	domainEvent.ID = dbEvent.ID
+	return nil
+}
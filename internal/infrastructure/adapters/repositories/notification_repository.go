@@ -0,0 +1,177 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	notificationModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/notification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) notification.NotificationRepositoryPort {
+	return &NotificationRepository{
+		db: db,
+	}
+}
+
+// CreateWithEvent registra el evento de dominio y su notificación en una misma transacción
+func (r *NotificationRepository) CreateWithEvent(ctx context.Context, domainEvent *event.DomainEvent, userNotification *notificationModels.UserNotification) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Registrar el evento de dominio
+		if err := createDomainEvent(tx, domainEvent); err != nil {
+			return err
+		}
+
+		// 2. Registrar la notificación asociada al evento
+		dbNotification := db_models.UserNotification{
+			UserID:           userNotification.UserID,
+			EventID:          domainEvent.ID,
+			NotificationType: userNotification.NotificationType,
+			Message:          userNotification.Message,
+			DeliveryStatus:   userNotification.DeliveryStatus,
+			DeliveryAt:       userNotification.DeliveryAt,
+			Recipient:        userNotification.Recipient,
+			ReferenceID:      userNotification.ReferenceID,
+			Attempts:         userNotification.Attempts,
+			NextAttemptAt:    userNotification.NextAttemptAt,
+		}
+		if err := tx.Create(&dbNotification).Error; err != nil {
+			return err
+		}
+
+		userNotification.ID = dbNotification.ID
+		userNotification.EventID = domainEvent.ID
+		return nil
+	})
+}
+
+// CreateForEvent registra las notificaciones de un evento de dominio ya registrado en una misma transacción
+func (r *NotificationRepository) CreateForEvent(ctx context.Context, userNotifications []*notificationModels.UserNotification) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, userNotification := range userNotifications {
+			dbNotification := db_models.UserNotification{
+				UserID:           userNotification.UserID,
+				EventID:          userNotification.EventID,
+				NotificationType: userNotification.NotificationType,
+				Message:          userNotification.Message,
+				DeliveryStatus:   userNotification.DeliveryStatus,
+				DeliveryAt:       userNotification.DeliveryAt,
+				Recipient:        userNotification.Recipient,
+				ReferenceID:      userNotification.ReferenceID,
+				Attempts:         userNotification.Attempts,
+				NextAttemptAt:    userNotification.NextAttemptAt,
+			}
+			if err := tx.Create(&dbNotification).Error; err != nil {
+				return err
+			}
+
+			userNotification.ID = dbNotification.ID
+		}
+
+		return nil
+	})
+}
+
+// GetNotifiableUsers obtiene los usuarios notificables registrados por un usuario
+func (r *NotificationRepository) GetNotifiableUsers(ctx context.Context, userID uint) ([]notificationModels.NotifiableUser, error) {
+	var dbUsers []db_models.NotifiableUser
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&dbUsers).Error; err != nil {
+		return nil, err
+	}
+
+	users := make([]notificationModels.NotifiableUser, len(dbUsers))
+	for i := range dbUsers {
+		users[i] = notificationModels.NotifiableUser{
+			ID:          dbUsers[i].ID,
+			UserID:      dbUsers[i].UserID,
+			EntityType:  dbUsers[i].EntityType,
+			Email:       dbUsers[i].Email,
+			EnabledPush: dbUsers[i].EnabledPush,
+		}
+	}
+
+	return users, nil
+}
+
+// Claim reserva una notificación pendiente actualizando su próximo intento solo si este ya se cumplió
+func (r *NotificationRepository) Claim(ctx context.Context, id uint, now, until time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&db_models.UserNotification{}).
+		Where("id = ? AND delivery_status = ? AND next_attempt_at <= ?", id, notificationModels.DeliveryPending, now).
+		Update("next_attempt_at", until)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// UpdateDelivery actualiza el estado de entrega de una notificación
+func (r *NotificationRepository) UpdateDelivery(ctx context.Context, userNotification *notificationModels.UserNotification) error {
+	return r.db.WithContext(ctx).
+		Model(&db_models.UserNotification{}).
+		Where("id = ?", userNotification.ID).
+		Updates(map[string]interface{}{
+			"delivery_status": userNotification.DeliveryStatus,
+			"delivery_at":     userNotification.DeliveryAt,
+			"attempts":        userNotification.Attempts,
+			"last_error":      userNotification.LastError,
+			"next_attempt_at": userNotification.NextAttemptAt,
+		}).Error
+}
+
+// GetPending obtiene las notificaciones pendientes de un tipo cuyo próximo intento ya se cumplió, incluyendo la
+// sucursal del evento que las originó
+func (r *NotificationRepository) GetPending(ctx context.Context, notificationType string, now time.Time, limit int) ([]notificationModels.UserNotification, error) {
+	var dbNotifications []db_models.UserNotification
+
+	result := r.db.WithContext(ctx).
+		Preload("Event").
+		Where("notification_type = ? AND delivery_status = ? AND next_attempt_at <= ?", notificationType, notificationModels.DeliveryPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&dbNotifications)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	notifications := make([]notificationModels.UserNotification, len(dbNotifications))
+	for i := range dbNotifications {
+		notifications[i] = *toDomainNotification(&dbNotifications[i])
+	}
+
+	return notifications, nil
+}
+
+func toDomainNotification(dbNotification *db_models.UserNotification) *notificationModels.UserNotification {
+	userNotification := &notificationModels.UserNotification{
+		ID:               dbNotification.ID,
+		UserID:           dbNotification.UserID,
+		EventID:          dbNotification.EventID,
+		NotificationType: dbNotification.NotificationType,
+		Message:          dbNotification.Message,
+		DeliveryStatus:   dbNotification.DeliveryStatus,
+		DeliveryAt:       dbNotification.DeliveryAt,
+		Recipient:        dbNotification.Recipient,
+		ReferenceID:      dbNotification.ReferenceID,
+		Attempts:         dbNotification.Attempts,
+		LastError:        dbNotification.LastError,
+		NextAttemptAt:    dbNotification.NextAttemptAt,
+	}
+
+	if dbNotification.Event != nil {
+		userNotification.BranchID = dbNotification.Event.BranchID
+		userNotification.EventType = dbNotification.Event.EventType
+	}
+
+	return userNotification
+}
@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox"
+	outboxModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
+)
+
+// outboxDueCondition selecciona los documentos pendientes de completarse cuyo próximo intento ya se cumplió y que no
+// están reservados por la solicitud que los transmitió o por otra ejecución del job
+const outboxDueCondition = "status IN ? AND next_attempt_at <= ? AND (locked_until IS NULL OR locked_until <= ?)"
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) outbox.OutboxRepositoryPort {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// Create registra la intención de transmitir un documento
+func (r *OutboxRepository) Create(ctx context.Context, entry *outboxModels.OutboxEntry) error {
+	dbEntry := db_models.DTEOutboxEntry{
+		BranchID:       entry.BranchID,
+		GenerationCode: entry.GenerationCode,
+		ControlNumber:  entry.ControlNumber,
+		DTEType:        entry.DTEType,
+		Status:         entry.Status,
+		Document:       entry.Document,
+		CreatedAt:      entry.CreatedAt,
+		UpdatedAt:      entry.UpdatedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&dbEntry).Error; err != nil {
+		return err
+	}
+
+	entry.ID = dbEntry.ID
+	return nil
+}
+
+// Update actualiza el estado y el resultado de la transmisión de un documento en una sola sentencia
+func (r *OutboxRepository) Update(ctx context.Context, entry *outboxModels.OutboxEntry) error {
+	return r.db.WithContext(ctx).
+		Model(&db_models.DTEOutboxEntry{}).
+		Where("id = ?", entry.ID).
+		Updates(map[string]interface{}{
+			"status":          entry.Status,
+			"reception_stamp": entry.ReceptionStamp,
+			"mh_response":     entry.MHResponse,
+			"attempts":        entry.Attempts,
+			"last_error":      entry.LastError,
+			"next_attempt_at": entry.NextAttemptAt,
+			"locked_until":    entry.LockedUntil,
+			"updated_at":      entry.UpdatedAt,
+		}).Error
+}
+
+// GetDue obtiene los documentos recibidos por Hacienda pendientes de completarse que no están reservados, comenzando
+// por los más antiguos
+func (r *OutboxRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]outboxModels.OutboxEntry, error) {
+	var dbEntries []db_models.DTEOutboxEntry
+
+	err := r.db.WithContext(ctx).
+		Where(outboxDueCondition, []string{outboxModels.EntryTransmitted, outboxModels.EntrySaved}, now, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&dbEntries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOutboxEntries(dbEntries), nil
+}
+
+// Claim reserva el documento solo si sigue pendiente de completarse y no está reservado, de modo que un único proceso
+// lo complete
+func (r *OutboxRepository) Claim(ctx context.Context, id uint, now, until time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&db_models.DTEOutboxEntry{}).
+		Where("id = ?", id).
+		Where(outboxDueCondition, []string{outboxModels.EntryTransmitted, outboxModels.EntrySaved}, now, now).
+		Updates(map[string]interface{}{
+			"locked_until": until,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// GetInterrupted obtiene los documentos pendientes de transmisión registrados en el rango indicado
+func (r *OutboxRepository) GetInterrupted(ctx context.Context, since, before time.Time, limit int) ([]outboxModels.OutboxEntry, error) {
+	var dbEntries []db_models.DTEOutboxEntry
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND created_at >= ? AND created_at < ?", outboxModels.EntryPending, since, before).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&dbEntries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOutboxEntries(dbEntries), nil
+}
+
+func toDomainOutboxEntries(dbEntries []db_models.DTEOutboxEntry) []outboxModels.OutboxEntry {
+	entries := make([]outboxModels.OutboxEntry, len(dbEntries))
+	for i, e := range dbEntries {
+		entries[i] = outboxModels.OutboxEntry{
+			ID:             e.ID,
+			BranchID:       e.BranchID,
+			GenerationCode: e.GenerationCode,
+			ControlNumber:  e.ControlNumber,
+			DTEType:        e.DTEType,
+			Status:         e.Status,
+			Document:       e.Document,
+			ReceptionStamp: e.ReceptionStamp,
+			MHResponse:     e.MHResponse,
+			Attempts:       e.Attempts,
+			LastError:      e.LastError,
+			NextAttemptAt:  e.NextAttemptAt,
+			LockedUntil:    e.LockedUntil,
+			CreatedAt:      e.CreatedAt,
+			UpdatedAt:      e.UpdatedAt,
+		}
+	}
+
+	return entries
+}
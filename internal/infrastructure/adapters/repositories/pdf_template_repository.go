@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"gorm.io/gorm"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/pdf_template/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
+)
+
+type PDFTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewPDFTemplateRepository(db *gorm.DB) pdf_template.PDFTemplateRepositoryPort {
+	return &PDFTemplateRepository{
+		db: db,
+	}
+}
+
+// GetByUserID obtiene la plantilla de un usuario
+func (r *PDFTemplateRepository) GetByUserID(ctx context.Context, userID uint) (*models.PDFTemplate, error) {
+	var dbTemplate db_models.PDFTemplate
+
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&dbTemplate)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errPackage.ErrPDFTemplateNotFound
+		}
+		return nil, result.Error
+	}
+
+	logo, err := base64.StdEncoding.DecodeString(dbTemplate.Logo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PDFTemplate{
+		UserID:       dbTemplate.UserID,
+		Logo:         logo,
+		LogoMimeType: dbTemplate.LogoMimeType,
+		Footer:       dbTemplate.Footer,
+		UpdatedAt:    dbTemplate.UpdatedAt,
+	}, nil
+}
+
+// Save crea o actualiza la plantilla de un usuario
+func (r *PDFTemplateRepository) Save(ctx context.Context, template *models.PDFTemplate) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. Buscar la plantilla existente del usuario
+		var dbTemplate db_models.PDFTemplate
+		result := tx.Where("user_id = ?", template.UserID).First(&dbTemplate)
+		if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return result.Error
+		}
+
+		// 2. Crear o actualizar la plantilla
+		dbTemplate.UserID = template.UserID
+		dbTemplate.Logo = base64.StdEncoding.EncodeToString(template.Logo)
+		dbTemplate.LogoMimeType = template.LogoMimeType
+		dbTemplate.Footer = template.Footer
+		dbTemplate.UpdatedAt = template.UpdatedAt
+		if dbTemplate.ID == 0 {
+			dbTemplate.CreatedAt = template.UpdatedAt
+		}
+
+		return tx.Save(&dbTemplate).Error
+	})
+}
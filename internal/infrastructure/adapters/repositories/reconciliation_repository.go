@@ -0,0 +1,184 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type ReconciliationRepository struct {
+	db *gorm.DB
+}
+
+func NewReconciliationRepository(db *gorm.DB) reconciliation.ReconciliationRepositoryPort {
+	return &ReconciliationRepository{
+		db: db,
+	}
+}
+
+// GetPendingDocuments obtiene los documentos pendientes creados antes de la fecha indicada, del más antiguo al más reciente
+func (r *ReconciliationRepository) GetPendingDocuments(ctx context.Context, createdBefore time.Time, limit int) ([]models.PendingDocument, error) {
+	var dbDocuments []db_models.DTEDocument
+	err := r.db.WithContext(ctx).
+		Preload("Document").
+		Joins("JOIN dte_details ON dte_documents.document_id = dte_details.id").
+		Where("dte_details.status = ? AND dte_documents.created_at < ?", constants.DocumentPending, createdBefore).
+		Order("dte_documents.created_at ASC").
+		Limit(limit).
+		Find(&dbDocuments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	documents := make([]models.PendingDocument, 0, len(dbDocuments))
+	for _, doc := range dbDocuments {
+		if doc.Document == nil {
+			continue
+		}
+		documents = append(documents, models.PendingDocument{
+			GenerationCode: doc.DocumentID,
+			BranchID:       doc.BranchID,
+			DTEType:        doc.Document.DTEType,
+			ControlNumber:  doc.Document.ControlNumber,
+			Transmission:   doc.Document.Transmission,
+			JSONData:       doc.Document.JSONData,
+			CreatedAt:      doc.CreatedAt,
+		})
+	}
+
+	return documents, nil
+}
+
+// GetUnreconciledSequences obtiene los números de secuencia fallidos registrados desde la fecha indicada que aún no se concilian
+func (r *ReconciliationRepository) GetUnreconciledSequences(ctx context.Context, since time.Time, limit int) ([]models.FailedSequence, error) {
+	var dbSequences []db_models.FailedSequenceNumber
+	err := r.db.WithContext(ctx).
+		Where("reconciled_at IS NULL AND created_at >= ?", since).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&dbSequences).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sequences := make([]models.FailedSequence, len(dbSequences))
+	for i, seq := range dbSequences {
+		sequences[i] = models.FailedSequence{
+			ID:                  seq.ID,
+			BranchID:            seq.BranchID,
+			DTEType:             seq.DTEType,
+			SequenceNumber:      seq.SequenceNumber,
+			OriginalRequestData: seq.OriginalRequestData,
+			CreatedAt:           seq.CreatedAt,
+		}
+	}
+
+	return sequences, nil
+}
+
+// MarkSequenceReconciled marca un número de secuencia fallido como conciliado
+func (r *ReconciliationRepository) MarkSequenceReconciled(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).
+		Model(&db_models.FailedSequenceNumber{}).
+		Where("id = ?", id).
+		Update("reconciled_at", utils.TimeNow()).Error
+}
+
+// DocumentExists indica si el documento con el código de generación indicado está almacenado
+func (r *ReconciliationRepository) DocumentExists(ctx context.Context, generationCode string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&db_models.DTEDocument{}).
+		Where("document_id = ?", generationCode).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// SaveDiscrepancy registra una discrepancia encontrada por la conciliación
+func (r *ReconciliationRepository) SaveDiscrepancy(ctx context.Context, discrepancy *models.Discrepancy) error {
+	dbDiscrepancy := &db_models.ReconciliationDiscrepancy{
+		UserID:         discrepancy.UserID,
+		BranchID:       discrepancy.BranchID,
+		GenerationCode: discrepancy.GenerationCode,
+		ControlNumber:  discrepancy.ControlNumber,
+		DTEType:        discrepancy.DTEType,
+		Source:         discrepancy.Source,
+		LocalStatus:    discrepancy.LocalStatus,
+		MHStatus:       discrepancy.MHStatus,
+		ReceptionStamp: discrepancy.ReceptionStamp,
+		Action:         discrepancy.Action,
+		Detail:         discrepancy.Detail,
+		DetectedAt:     discrepancy.DetectedAt,
+	}
+
+	if err := r.db.WithContext(ctx).Create(dbDiscrepancy).Error; err != nil {
+		return err
+	}
+
+	discrepancy.ID = dbDiscrepancy.ID
+	return nil
+}
+
+// ListDiscrepancies obtiene las discrepancias de un usuario que cumplen los filtros, de la más reciente a la más antigua
+func (r *ReconciliationRepository) ListDiscrepancies(ctx context.Context, userID uint, filters *models.DiscrepancyFilters) ([]models.Discrepancy, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&db_models.ReconciliationDiscrepancy{}).
+		Where("user_id = ?", userID)
+
+	if filters.BranchID != nil {
+		query = query.Where("branch_id = ?", *filters.BranchID)
+	}
+	if filters.Source != "" {
+		query = query.Where("source = ?", filters.Source)
+	}
+	if filters.Action != "" {
+		query = query.Where("action = ?", filters.Action)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var dbDiscrepancies []db_models.ReconciliationDiscrepancy
+	err := query.
+		Order("detected_at DESC, id DESC").
+		Offset((filters.Page - 1) * filters.PageSize).
+		Limit(filters.PageSize).
+		Find(&dbDiscrepancies).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	discrepancies := make([]models.Discrepancy, len(dbDiscrepancies))
+	for i, d := range dbDiscrepancies {
+		discrepancies[i] = models.Discrepancy{
+			ID:             d.ID,
+			UserID:         d.UserID,
+			BranchID:       d.BranchID,
+			GenerationCode: d.GenerationCode,
+			ControlNumber:  d.ControlNumber,
+			DTEType:        d.DTEType,
+			Source:         d.Source,
+			LocalStatus:    d.LocalStatus,
+			MHStatus:       d.MHStatus,
+			ReceptionStamp: d.ReceptionStamp,
+			Action:         d.Action,
+			Detail:         d.Detail,
+			DetectedAt:     d.DetectedAt,
+		}
+	}
+
+	return discrepancies, total, nil
+}
@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type RetransmissionScheduleRepository struct {
+	db *gorm.DB
+}
+
+func NewRetransmissionScheduleRepository(db *gorm.DB) contingency.RetransmissionScheduleRepositoryPort {
+	return &RetransmissionScheduleRepository{
+		db: db,
+	}
+}
+
+// Get obtiene la programación propia de un usuario
+func (r *RetransmissionScheduleRepository) Get(ctx context.Context, userID uint) (*models.RetransmissionSchedule, error) {
+	var dbSchedule db_models.RetransmissionSchedule
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&dbSchedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.ErrRetransmissionScheduleNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainRetransmissionSchedule(&dbSchedule), nil
+}
+
+// List obtiene las programaciones propias de todos los usuarios
+func (r *RetransmissionScheduleRepository) List(ctx context.Context) ([]models.RetransmissionSchedule, error) {
+	var dbSchedules []db_models.RetransmissionSchedule
+
+	if err := r.db.WithContext(ctx).Order("nit ASC").Find(&dbSchedules).Error; err != nil {
+		return nil, err
+	}
+
+	schedules := make([]models.RetransmissionSchedule, 0, len(dbSchedules))
+	for i := range dbSchedules {
+		schedules = append(schedules, *toDomainRetransmissionSchedule(&dbSchedules[i]))
+	}
+
+	return schedules, nil
+}
+
+// Save crea o reemplaza la programación de un usuario
+func (r *RetransmissionScheduleRepository) Save(ctx context.Context, schedule *models.RetransmissionSchedule) error {
+	updatedAt := utils.TimeNow()
+	if schedule.UpdatedAt != nil {
+		updatedAt = *schedule.UpdatedAt
+	}
+
+	dbSchedule := db_models.RetransmissionSchedule{
+		UserID:              schedule.UserID,
+		NIT:                 schedule.NIT,
+		Cron:                schedule.Cron,
+		Windows:             schedule.Windows,
+		BatchSize:           schedule.BatchSize,
+		MaxExecutionMinutes: schedule.MaxExecutionMinutes,
+		UpdatedAt:           updatedAt,
+	}
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"nit", "cron", "windows", "batch_size", "max_execution_minutes", "updated_at"}),
+		}).
+		Create(&dbSchedule).Error
+}
+
+// Delete elimina la programación propia de un usuario
+func (r *RetransmissionScheduleRepository) Delete(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&db_models.RetransmissionSchedule{}).Error
+}
+
+func toDomainRetransmissionSchedule(dbSchedule *db_models.RetransmissionSchedule) *models.RetransmissionSchedule {
+	updatedAt := dbSchedule.UpdatedAt
+	return &models.RetransmissionSchedule{
+		UserID:              dbSchedule.UserID,
+		NIT:                 dbSchedule.NIT,
+		Cron:                dbSchedule.Cron,
+		Windows:             dbSchedule.Windows,
+		BatchSize:           dbSchedule.BatchSize,
+		MaxExecutionMinutes: dbSchedule.MaxExecutionMinutes,
+		UpdatedAt:           &updatedAt,
+	}
+}
@@ -0,0 +1,307 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
+	webhookModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// webhookPendingCondition selecciona las entregas pendientes cuyo próximo intento ya se cumplió y que no están
+// reservadas por otro envío
+const webhookPendingCondition = "webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ? AND (webhook_deliveries.locked_until IS NULL OR webhook_deliveries.locked_until <= ?)"
+
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) webhook.WebhookRepositoryPort {
+	return &WebhookRepository{
+		db: db,
+	}
+}
+
+// CreateSubscription registra una suscripción
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, subscription *webhookModels.WebhookSubscription) error {
+	dbSubscription := toDBWebhookSubscription(subscription)
+	if err := r.db.WithContext(ctx).Create(dbSubscription).Error; err != nil {
+		return err
+	}
+
+	subscription.ID = dbSubscription.ID
+	return nil
+}
+
+// ListSubscriptions obtiene las suscripciones de un usuario
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context, userID uint) ([]webhookModels.WebhookSubscription, error) {
+	var dbSubscriptions []db_models.WebhookSubscription
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&dbSubscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainWebhookSubscriptions(dbSubscriptions), nil
+}
+
+// GetSubscription obtiene una suscripción de un usuario
+func (r *WebhookRepository) GetSubscription(ctx context.Context, userID, id uint) (*webhookModels.WebhookSubscription, error) {
+	var dbSubscription db_models.WebhookSubscription
+
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&dbSubscription).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainWebhookSubscription(&dbSubscription), nil
+}
+
+// UpdateSubscription actualiza la URL, los eventos, el secreto y el estado de una suscripción
+func (r *WebhookRepository) UpdateSubscription(ctx context.Context, subscription *webhookModels.WebhookSubscription) error {
+	return r.db.WithContext(ctx).
+		Model(&db_models.WebhookSubscription{}).
+		Where("id = ? AND user_id = ?", subscription.ID, subscription.UserID).
+		Updates(map[string]interface{}{
+			"url":        subscription.URL,
+			"events":     strings.Join(subscription.Events, ","),
+			"secret":     subscription.Secret,
+			"is_active":  subscription.IsActive,
+			"updated_at": subscription.UpdatedAt,
+		}).Error
+}
+
+// DeleteSubscription elimina lógicamente una suscripción para conservar el registro de sus entregas
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, userID, id uint) error {
+	return r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&db_models.WebhookSubscription{}).Error
+}
+
+// GetActiveSubscriptionsByBranch obtiene las suscripciones activas del usuario dueño de la sucursal
+func (r *WebhookRepository) GetActiveSubscriptionsByBranch(ctx context.Context, branchID uint) ([]webhookModels.WebhookSubscription, error) {
+	var dbSubscriptions []db_models.WebhookSubscription
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN branch_offices ON branch_offices.user_id = webhook_subscriptions.user_id").
+		Where("branch_offices.id = ? AND webhook_subscriptions.is_active = ?", branchID, true).
+		Find(&dbSubscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainWebhookSubscriptions(dbSubscriptions), nil
+}
+
+// CreateDeliveries registra las entregas de un evento en una sola transacción
+func (r *WebhookRepository) CreateDeliveries(ctx context.Context, deliveries []*webhookModels.WebhookDelivery) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, delivery := range deliveries {
+			dbDelivery := db_models.WebhookDelivery{
+				SubscriptionID: delivery.SubscriptionID,
+				EventID:        delivery.EventID,
+				EventType:      delivery.EventType,
+				Payload:        string(delivery.Payload),
+				Status:         delivery.Status,
+				NextAttemptAt:  delivery.NextAttemptAt,
+				ReplayOf:       delivery.ReplayOf,
+				CreatedAt:      delivery.CreatedAt,
+				UpdatedAt:      delivery.CreatedAt,
+			}
+			if err := tx.Create(&dbDelivery).Error; err != nil {
+				return err
+			}
+
+			delivery.ID = dbDelivery.ID
+		}
+
+		return nil
+	})
+}
+
+// GetPendingDeliveries obtiene las entregas pendientes de suscripciones activas, comenzando por las más antiguas
+func (r *WebhookRepository) GetPendingDeliveries(ctx context.Context, now time.Time, limit int) ([]webhookModels.WebhookDelivery, error) {
+	var dbDeliveries []db_models.WebhookDelivery
+
+	err := r.db.WithContext(ctx).
+		Joins("Subscription").
+		Where(webhookPendingCondition, webhookModels.DeliveryPending, now, now).
+		Where("Subscription.is_active = ?", true).
+		Order("webhook_deliveries.next_attempt_at ASC").
+		Limit(limit).
+		Find(&dbDeliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainWebhookDeliveries(dbDeliveries), nil
+}
+
+// ClaimDelivery reserva la entrega solo si sigue pendiente y no está reservada
+func (r *WebhookRepository) ClaimDelivery(ctx context.Context, id uint, now, until time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&db_models.WebhookDelivery{}).
+		Where("id = ?", id).
+		Where(webhookPendingCondition, webhookModels.DeliveryPending, now, now).
+		Updates(map[string]interface{}{
+			"locked_until": until,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// UpdateDelivery actualiza el resultado de una entrega y libera su reserva
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, delivery *webhookModels.WebhookDelivery) error {
+	return r.db.WithContext(ctx).
+		Model(&db_models.WebhookDelivery{}).
+		Where("id = ?", delivery.ID).
+		Updates(map[string]interface{}{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"response_status": delivery.ResponseStatus,
+			"last_error":      delivery.LastError,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"delivered_at":    delivery.DeliveredAt,
+			"locked_until":    nil,
+			"updated_at":      utils.TimeNow(),
+		}).Error
+}
+
+// ListDeliveries obtiene el registro de entregas de las suscripciones de un usuario, incluyendo las eliminadas
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, userID uint, filters *webhookModels.DeliveryFilters) ([]webhookModels.WebhookDelivery, error) {
+	var dbDeliveries []db_models.WebhookDelivery
+
+	query := r.db.WithContext(ctx).
+		Joins("JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id").
+		Where("webhook_subscriptions.user_id = ?", userID)
+
+	if filters != nil {
+		if filters.SubscriptionID != nil {
+			query = query.Where("webhook_deliveries.subscription_id = ?", *filters.SubscriptionID)
+		}
+		if filters.Status != "" {
+			query = query.Where("webhook_deliveries.status = ?", filters.Status)
+		}
+		if filters.EventType != "" {
+			query = query.Where("webhook_deliveries.event_type = ?", filters.EventType)
+		}
+		if filters.Limit > 0 {
+			query = query.Limit(filters.Limit)
+		}
+	}
+
+	err := query.
+		Order("webhook_deliveries.created_at DESC, webhook_deliveries.id DESC").
+		Find(&dbDeliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainWebhookDeliveries(dbDeliveries), nil
+}
+
+// GetDelivery obtiene una entrega de un usuario con su suscripción, si la suscripción fue eliminada no se incluye
+func (r *WebhookRepository) GetDelivery(ctx context.Context, userID, id uint) (*webhookModels.WebhookDelivery, error) {
+	var dbDelivery db_models.WebhookDelivery
+
+	err := r.db.WithContext(ctx).
+		Preload("Subscription").
+		Joins("JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id").
+		Where("webhook_deliveries.id = ? AND webhook_subscriptions.user_id = ?", id, userID).
+		First(&dbDelivery).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errPackage.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	return toDomainWebhookDelivery(&dbDelivery), nil
+}
+
+func toDBWebhookSubscription(subscription *webhookModels.WebhookSubscription) *db_models.WebhookSubscription {
+	return &db_models.WebhookSubscription{
+		ID:        subscription.ID,
+		UserID:    subscription.UserID,
+		URL:       subscription.URL,
+		Events:    strings.Join(subscription.Events, ","),
+		Secret:    subscription.Secret,
+		IsActive:  subscription.IsActive,
+		CreatedAt: subscription.CreatedAt,
+		UpdatedAt: subscription.UpdatedAt,
+	}
+}
+
+func toDomainWebhookSubscription(dbSubscription *db_models.WebhookSubscription) *webhookModels.WebhookSubscription {
+	return &webhookModels.WebhookSubscription{
+		ID:        dbSubscription.ID,
+		UserID:    dbSubscription.UserID,
+		URL:       dbSubscription.URL,
+		Events:    strings.Split(dbSubscription.Events, ","),
+		Secret:    dbSubscription.Secret,
+		IsActive:  dbSubscription.IsActive,
+		CreatedAt: dbSubscription.CreatedAt,
+		UpdatedAt: dbSubscription.UpdatedAt,
+	}
+}
+
+func toDomainWebhookSubscriptions(dbSubscriptions []db_models.WebhookSubscription) []webhookModels.WebhookSubscription {
+	subscriptions := make([]webhookModels.WebhookSubscription, len(dbSubscriptions))
+	for i := range dbSubscriptions {
+		subscriptions[i] = *toDomainWebhookSubscription(&dbSubscriptions[i])
+	}
+
+	return subscriptions
+}
+
+func toDomainWebhookDelivery(dbDelivery *db_models.WebhookDelivery) *webhookModels.WebhookDelivery {
+	delivery := &webhookModels.WebhookDelivery{
+		ID:             dbDelivery.ID,
+		SubscriptionID: dbDelivery.SubscriptionID,
+		EventID:        dbDelivery.EventID,
+		EventType:      dbDelivery.EventType,
+		Payload:        []byte(dbDelivery.Payload),
+		Status:         dbDelivery.Status,
+		Attempts:       dbDelivery.Attempts,
+		ResponseStatus: dbDelivery.ResponseStatus,
+		LastError:      dbDelivery.LastError,
+		NextAttemptAt:  dbDelivery.NextAttemptAt,
+		DeliveredAt:    dbDelivery.DeliveredAt,
+		ReplayOf:       dbDelivery.ReplayOf,
+		CreatedAt:      dbDelivery.CreatedAt,
+	}
+
+	if dbDelivery.Subscription != nil {
+		delivery.Subscription = toDomainWebhookSubscription(dbDelivery.Subscription)
+	}
+
+	return delivery
+}
+
+func toDomainWebhookDeliveries(dbDeliveries []db_models.WebhookDelivery) []webhookModels.WebhookDelivery {
+	deliveries := make([]webhookModels.WebhookDelivery, len(dbDeliveries))
+	for i := range dbDeliveries {
+		deliveries[i] = *toDomainWebhookDelivery(&dbDeliveries[i])
+	}
+
+	return deliveries
+}
@@ -3,6 +3,7 @@ package signing
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/MarlonG1/api-facturacion-sv/config"
 	ports2 "github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
@@ -14,6 +15,8 @@ import (
 	"time"
 
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/error"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
@@ -24,6 +27,7 @@ type HaciendaAuthService struct {
 	client      *http.Client
 	cache       ports.CacheManager
 	authService auth.AuthManager
+	circuit     ports.CircuitManager
 }
 
 type haciendaAuthRequest struct {
@@ -39,12 +43,14 @@ type haciendaAuthResponse struct {
 	} `json:"body"`
 }
 
-// NewHaciendaAuthService crea una instancia de HaciendaAuthService. Recibe un cache de tokens de Hacienda.
-func NewHaciendaAuthService(cache ports.CacheManager, authService auth.AuthManager) ports2.HaciendaAuthManager {
+// NewHaciendaAuthService crea una instancia de HaciendaAuthService. Recibe un cache de tokens de Hacienda y el circuit
+// breaker que protege las solicitudes de autenticación.
+func NewHaciendaAuthService(cache ports.CacheManager, authService auth.AuthManager, circuit ports.CircuitManager) ports2.HaciendaAuthManager {
 	return &HaciendaAuthService{
 		authService: authService,
 		client:      &http.Client{},
 		cache:       cache,
+		circuit:     circuit,
 	}
 }
 
@@ -142,12 +148,30 @@ func (s *HaciendaAuthService) authenticateWithHacienda(ctx context.Context, cred
 		return "", err
 	}
 
+	// Evitar la solicitud mientras la autenticación de Hacienda se considera no disponible
+	if !s.circuit.AllowRequest() {
+		logs.Warn("Circuit breaker preventing authentication with Hacienda", map[string]interface{}{
+			"state": s.circuit.GetState().String(),
+		})
+		return "", dte_errors.NewCircuitOpenError(constants.CircuitHaciendaAuth)
+	}
+
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return "", s.httpRequestError(err)
+		if !errors.Is(err, context.Canceled) {
+			s.circuit.RecordFailure()
+		}
+		return "", dte_errors.NewServiceUnavailableError(constants.CircuitHaciendaAuth, s.httpRequestError(err))
 	}
 	defer resp.Body.Close()
 
+	// Una respuesta 5xx indica que el servicio no está disponible, cualquier otra que está respondiendo
+	if resp.StatusCode >= http.StatusInternalServerError {
+		s.circuit.RecordFailure()
+		return "", dte_errors.NewServiceUnavailableError(constants.CircuitHaciendaAuth, s.verifyAuthResponse(resp))
+	}
+	s.circuit.RecordSuccess()
+
 	if err := s.verifyAuthResponse(resp); err != nil {
 		return "", err
 	}
@@ -0,0 +1,162 @@
+package signer
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CertificateSource obtiene el certificado emitido por el Ministerio de Hacienda para un NIT
+type CertificateSource interface {
+	Load(ctx context.Context, nit string) ([]byte, error)
+}
+
+// MHCertificate representa el archivo XML del certificado que entrega el Ministerio de Hacienda (<NIT>.crt)
+type MHCertificate struct {
+	XMLName    xml.Name `xml:"CertificadoMH"`
+	NIT        string   `xml:"nit"`
+	PublicKey  MHKey    `xml:"publicKey"`
+	PrivateKey MHKey    `xml:"privateKey"`
+	Activated  bool     `xml:"activated"`
+	NotAfter   string   `xml:"certificado>basicEstructure>validity>notAfter"`
+}
+
+// MHKey representa una llave dentro del certificado, codificada en base64 (X.509 o PKCS#8)
+// junto con el hash SHA-512 de su contraseña
+type MHKey struct {
+	KeyType   string `xml:"keyType"`
+	Algorithm string `xml:"algorithm"`
+	Encoded   string `xml:"encodied"`
+	Format    string `xml:"format"`
+	Password  string `xml:"clave"`
+}
+
+// fileCertificateSource lee los certificados desde un directorio con el formato <NIT>.crt,
+// el mismo utilizado por el firmador del Ministerio de Hacienda
+type fileCertificateSource struct {
+	dir string
+}
+
+// NewFileCertificateSource crea una fuente de certificados basada en el sistema de archivos
+func NewFileCertificateSource(dir string) CertificateSource {
+	return &fileCertificateSource{dir: dir}
+}
+
+func (s *fileCertificateSource) Load(_ context.Context, nit string) ([]byte, error) {
+	// 1. Sin directorio configurado no se buscan certificados, de lo contrario se leerían del directorio de trabajo
+	if s.dir == "" {
+		return nil, fmt.Errorf("certificates directory is not configured")
+	}
+
+	// 2. Evitar que el NIT permita salir del directorio de certificados
+	if nit == "" || strings.ContainsAny(nit, `/\.`) {
+		return nil, fmt.Errorf("invalid NIT %q for certificate lookup", nit)
+	}
+
+	// 3. Leer el certificado del NIT
+	return os.ReadFile(filepath.Join(s.dir, nit+".crt"))
+}
+
+// ParseMHCertificate interpreta el contenido XML de un certificado del Ministerio de Hacienda
+func ParseMHCertificate(data []byte) (*MHCertificate, error) {
+	var cert MHCertificate
+	if err := xml.Unmarshal(data, &cert); err != nil {
+		return nil, fmt.Errorf("error decoding certificate: %w", err)
+	}
+
+	if cert.PrivateKey.Encoded == "" {
+		return nil, fmt.Errorf("certificate does not contain a private key")
+	}
+
+	return &cert, nil
+}
+
+// CheckPassword verifica que la contraseña privada corresponda al hash almacenado en el certificado
+func (c *MHCertificate) CheckPassword(password string) bool {
+	sum := sha512.Sum512([]byte(password))
+	expected := strings.ToLower(strings.TrimSpace(c.PrivateKey.Password))
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(expected)) == 1
+}
+
+// RSAPrivateKey decodifica la llave privada PKCS#8 del certificado
+func (c *MHCertificate) RSAPrivateKey() (*rsa.PrivateKey, error) {
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(c.PrivateKey.Encoded))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding private key: %w", err)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// RSAPublicKey decodifica la llave pública X.509 del certificado
+func (c *MHCertificate) RSAPublicKey() (*rsa.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(c.PublicKey.Encoded))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding public key: %w", err)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// Fingerprint calcula la huella SHA-256 de la llave pública del certificado
+func (c *MHCertificate) Fingerprint() string {
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(c.PublicKey.Encoded))
+	if err != nil {
+		der = []byte(c.PublicKey.Encoded)
+	}
+
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// ExpiresAt obtiene la fecha de vencimiento del certificado si está presente, ya sea en milisegundos
+// desde epoch (formato del firmador de Hacienda) o en formato RFC3339
+func (c *MHCertificate) ExpiresAt() *time.Time {
+	value := strings.TrimSpace(c.NotAfter)
+	if value == "" {
+		return nil
+	}
+
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		expiresAt := time.UnixMilli(millis)
+		return &expiresAt
+	}
+
+	if expiresAt, err := time.Parse(time.RFC3339, value); err == nil {
+		return &expiresAt
+	}
+
+	return nil
+}
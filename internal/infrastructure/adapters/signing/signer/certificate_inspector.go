@@ -0,0 +1,41 @@
+package signer
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+// CertificateInspector valida los certificados del Ministerio de Hacienda antes de almacenarlos
+type CertificateInspector struct{}
+
+func NewCertificateInspector() certificate.CertificateInspector {
+	return &CertificateInspector{}
+}
+
+func (i *CertificateInspector) Inspect(content []byte, passwordPri string) (*models.CertificateInfo, error) {
+	// 1. Interpretar el certificado
+	cert, err := ParseMHCertificate(content)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateInspector", "Inspect", err, "InvalidCertificateFile")
+	}
+
+	// 2. Validar la contraseña privada y que las llaves puedan utilizarse para firmar y verificar
+	if !cert.CheckPassword(passwordPri) {
+		return nil, shared_error.NewFormattedGeneralServiceError("CertificateInspector", "Inspect", "InvalidCertificatePassword", cert.NIT)
+	}
+
+	if _, err := cert.RSAPrivateKey(); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateInspector", "Inspect", err, "InvalidCertificate", cert.NIT)
+	}
+
+	if _, err := cert.RSAPublicKey(); err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateInspector", "Inspect", err, "InvalidCertificate", cert.NIT)
+	}
+
+	return &models.CertificateInfo{
+		NIT:         cert.NIT,
+		Fingerprint: cert.Fingerprint(),
+		ExpiresAt:   cert.ExpiresAt(),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package signer
+
+import (
+	"context"
+	"crypto/rsa"
+
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+// CertificateKeyProvider obtiene la llave pública de los emisores a partir de sus certificados del Ministerio de Hacienda
+type CertificateKeyProvider struct {
+	source CertificateSource
+}
+
+func NewCertificateKeyProvider(source CertificateSource) *CertificateKeyProvider {
+	return &CertificateKeyProvider{
+		source: source,
+	}
+}
+
+func (p *CertificateKeyProvider) GetPublicKey(ctx context.Context, nit string) (*rsa.PublicKey, error) {
+	// 1. Obtener el certificado del emisor
+	data, err := p.source.Load(ctx, nit)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateKeyProvider", "GetPublicKey", err, "CertificateNotFound", nit)
+	}
+
+	// 2. Extraer la llave pública del certificado
+	cert, err := ParseMHCertificate(data)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateKeyProvider", "GetPublicKey", err, "InvalidCertificate", nit)
+	}
+
+	key, err := cert.RSAPublicKey()
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("CertificateKeyProvider", "GetPublicKey", err, "InvalidCertificate", nit)
+	}
+
+	return key, nil
+}
@@ -4,9 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/MarlonG1/api-facturacion-sv/config"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
 	"io/ioutil"
 	"net/http"
@@ -18,6 +22,7 @@ import (
 type DTESigner struct {
 	clientRepo auth.AuthRepositoryPort
 	client     *http.Client
+	circuit    ports.CircuitManager
 }
 
 type SignRequest struct {
@@ -41,10 +46,12 @@ type SpringBootError struct {
 	Path      string `json:"path"`
 }
 
-func NewDTESigner(clientRepo auth.AuthRepositoryPort) *DTESigner {
+// NewDTESigner crea el firmador que utiliza el servicio externo de firma, protegido con el circuit breaker indicado
+func NewDTESigner(clientRepo auth.AuthRepositoryPort, circuit ports.CircuitManager) *DTESigner {
 	return &DTESigner{
 		clientRepo: clientRepo,
 		client:     &http.Client{Timeout: 2 * time.Second},
+		circuit:    circuit,
 	}
 }
 
@@ -81,9 +88,20 @@ func (s *DTESigner) SignDTE(ctx context.Context, dte json.RawMessage, nit string
 
 	httpReq.Header.Set("Content-Type", "application/json")
 
+	// Evitar la solicitud mientras el servicio de firma se considera no disponible
+	if !s.circuit.AllowRequest() {
+		logs.Warn("Circuit breaker preventing request to signer service", map[string]interface{}{
+			"state": s.circuit.GetState().String(),
+		})
+		return "", dte_errors.NewCircuitOpenError(constants.CircuitSigner)
+	}
+
 	resp, err := s.client.Do(httpReq)
 	if err != nil {
-		return "", fmt.Errorf("error calling signer service: %w", err)
+		if !errors.Is(err, context.Canceled) {
+			s.circuit.RecordFailure()
+		}
+		return "", dte_errors.NewServiceUnavailableError(constants.CircuitSigner, fmt.Errorf("error calling signer service: %w", err))
 	}
 	defer resp.Body.Close()
 
@@ -93,6 +111,13 @@ func (s *DTESigner) SignDTE(ctx context.Context, dte json.RawMessage, nit string
 		return "", fmt.Errorf("error reading response body: %w", err)
 	}
 
+	// Una respuesta 5xx indica que el servicio no está disponible, los errores de la solicitud no cuentan como fallas
+	if resp.StatusCode >= http.StatusInternalServerError {
+		s.circuit.RecordFailure()
+	} else {
+		s.circuit.RecordSuccess()
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		var springError SpringBootError
 		if err := json.Unmarshal(body, &springError); err == nil {
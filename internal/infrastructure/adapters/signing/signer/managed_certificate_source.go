@@ -0,0 +1,41 @@
+package signer
+
+import (
+	"context"
+	"errors"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+)
+
+// activeCertificateProvider obtiene el certificado activo registrado para un NIT
+type activeCertificateProvider interface {
+	GetActiveCertificateData(ctx context.Context, nit string) ([]byte, error)
+}
+
+// managedCertificateSource obtiene los certificados registrados mediante la API y, si el NIT no posee
+// un certificado activo registrado, utiliza la fuente alterna (por ejemplo, el directorio de certificados)
+type managedCertificateSource struct {
+	provider activeCertificateProvider
+	fallback CertificateSource
+}
+
+// NewManagedCertificateSource crea una fuente de certificados basada en los certificados registrados
+func NewManagedCertificateSource(provider activeCertificateProvider, fallback CertificateSource) CertificateSource {
+	return &managedCertificateSource{
+		provider: provider,
+		fallback: fallback,
+	}
+}
+
+func (s *managedCertificateSource) Load(ctx context.Context, nit string) ([]byte, error) {
+	data, err := s.provider.GetActiveCertificateData(ctx, nit)
+	if err == nil {
+		return data, nil
+	}
+
+	if errors.Is(err, errPackage.ErrCertificateNotFound) && s.fallback != nil {
+		return s.fallback.Load(ctx, nit)
+	}
+
+	return nil, err
+}
@@ -0,0 +1,141 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+// jwsHeader es el encabezado que utiliza el firmador del Ministerio de Hacienda para los DTE
+var jwsHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS512"}`))
+
+// certificateCacheTTL es el tiempo que un certificado permanece en memoria antes de volver a cargarse,
+// de modo que las renovaciones y desactivaciones se apliquen sin reiniciar el servicio
+const certificateCacheTTL = 5 * time.Minute
+
+type cachedCertificate struct {
+	cert     *MHCertificate
+	loadedAt time.Time
+}
+
+// NativeDTESigner firma los DTE en el mismo proceso con el certificado del Ministerio de Hacienda,
+// generando el JWS compacto RS512 que espera Hacienda sin depender del firmador externo
+type NativeDTESigner struct {
+	clientRepo   auth.AuthRepositoryPort
+	source       CertificateSource
+	timeProvider ports.TimeProvider
+	mu           sync.RWMutex
+	certs        map[string]cachedCertificate
+}
+
+func NewNativeDTESigner(clientRepo auth.AuthRepositoryPort, source CertificateSource, timeProvider ports.TimeProvider) *NativeDTESigner {
+	return &NativeDTESigner{
+		clientRepo:   clientRepo,
+		source:       source,
+		timeProvider: timeProvider,
+		certs:        make(map[string]cachedCertificate),
+	}
+}
+
+func (s *NativeDTESigner) SignDTE(ctx context.Context, dte json.RawMessage, nit string) (string, error) {
+	// 1. Obtener al cliente para validar la contraseña privada del certificado
+	client, err := s.clientRepo.GetByNIT(ctx, nit)
+	if err != nil {
+		return "", shared_error.NewGeneralServiceError("NativeDTESigner", "SignDTE", "Error getting client by NIT", err)
+	}
+
+	// 2. Obtener la llave privada del certificado del NIT
+	key, err := s.privateKey(ctx, nit, client.PasswordPri)
+	if err != nil {
+		return "", err
+	}
+
+	// 3. Compactar el JSON del documento, igual que el firmador de Hacienda
+	var payload bytes.Buffer
+	if err := json.Compact(&payload, dte); err != nil {
+		return "", shared_error.NewFormattedGeneralServiceWithError("NativeDTESigner", "SignDTE", err, "FailedToSignDTE")
+	}
+
+	// 4. Firmar el contenido con RS512
+	signingInput := jwsHeader + "." + base64.RawURLEncoding.EncodeToString(payload.Bytes())
+	signature, err := jwt.SigningMethodRS512.Sign(signingInput, key)
+	if err != nil {
+		return "", shared_error.NewFormattedGeneralServiceWithError("NativeDTESigner", "SignDTE", err, "FailedToSignDTE")
+	}
+
+	logs.Info("Document signed successfully", map[string]interface{}{"nit": nit})
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Invalidate descarta el certificado almacenado en memoria para un NIT, de modo que se vuelva
+// a cargar en la siguiente firma (por ejemplo, después de renovar el certificado)
+func (s *NativeDTESigner) Invalidate(nit string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.certs, nit)
+}
+
+// privateKey obtiene la llave privada del NIT validando la contraseña contra el certificado.
+// Si la contraseña no coincide con el certificado en memoria, se recarga por si fue renovado.
+func (s *NativeDTESigner) privateKey(ctx context.Context, nit, password string) (*rsa.PrivateKey, error) {
+	cert, cached, err := s.certificate(ctx, nit)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cert.CheckPassword(password) && cached {
+		s.Invalidate(nit)
+		if cert, _, err = s.certificate(ctx, nit); err != nil {
+			return nil, err
+		}
+	}
+
+	if !cert.CheckPassword(password) {
+		return nil, shared_error.NewFormattedGeneralServiceError("NativeDTESigner", "SignDTE", "InvalidCertificatePassword", nit)
+	}
+
+	key, err := cert.RSAPrivateKey()
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("NativeDTESigner", "SignDTE", err, "InvalidCertificate", nit)
+	}
+
+	return key, nil
+}
+
+// certificate obtiene el certificado del NIT desde memoria o desde la fuente configurada,
+// indicando si provino de memoria
+func (s *NativeDTESigner) certificate(ctx context.Context, nit string) (*MHCertificate, bool, error) {
+	s.mu.RLock()
+	entry, ok := s.certs[nit]
+	s.mu.RUnlock()
+	if ok && s.timeProvider.Now().Sub(entry.loadedAt) < certificateCacheTTL {
+		return entry.cert, true, nil
+	}
+
+	data, err := s.source.Load(ctx, nit)
+	if err != nil {
+		return nil, false, shared_error.NewFormattedGeneralServiceWithError("NativeDTESigner", "SignDTE", err, "CertificateNotFound", nit)
+	}
+
+	cert, err := ParseMHCertificate(data)
+	if err != nil {
+		return nil, false, shared_error.NewFormattedGeneralServiceWithError("NativeDTESigner", "SignDTE", err, "InvalidCertificate", nit)
+	}
+
+	s.mu.Lock()
+	s.certs[nit] = cachedCertificate{cert: cert, loadedAt: s.timeProvider.Now()}
+	s.mu.Unlock()
+
+	return cert, false, nil
+}
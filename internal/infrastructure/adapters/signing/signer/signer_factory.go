@@ -0,0 +1,20 @@
+package signer
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/config"
+	appPorts "github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+)
+
+// NewSignerManager crea el firmador de DTE según el modo configurado en SIGNER_MODE. En modo nativo
+// los certificados se obtienen de la fuente indicada; en modo externo las solicitudes al servicio de firma se
+// protegen con el circuit breaker indicado
+func NewSignerManager(clientRepo auth.AuthRepositoryPort, source CertificateSource, circuit ports.CircuitManager) appPorts.SignerManager {
+	if config.Signer.Mode == config.SignerModeNative {
+		return NewNativeDTESigner(clientRepo, source, &transmitter.RealTimeProvider{})
+	}
+
+	return NewDTESigner(clientRepo, circuit)
+}
@@ -13,6 +13,9 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 )
 
+// MaxIssuedTokens es la cantidad máxima de tokens emitidos que se registran por sucursal para poder revocarlos
+const MaxIssuedTokens = 1000
+
 type JWTService struct {
 	SecretKey    string
 	cacheService ports.CacheManager
@@ -36,6 +39,8 @@ func (s *JWTService) GenerateToken(claims *models.AuthClaims, tokenLifetime time
 		"branch_sub": claims.BranchID,
 		"auth_type":  claims.AuthType,
 		"nit":        claims.NIT,
+		"role":       claims.Role,
+		"scopes":     claims.Scopes,
 		"exp":        exp.Unix(),
 		"iat":        now.Unix(),
 	})
@@ -94,6 +99,14 @@ func (s *JWTService) GenerateToken(claims *models.AuthClaims, tokenLifetime time
 		)
 	}
 
+	// Registrar el token entre los emitidos para la sucursal, la rotación de sus credenciales los revoca
+	if err = s.trackIssuedToken(claims.BranchID, signedToken); err != nil {
+		logs.Warn("Failed to track issued token", map[string]interface{}{
+			"branchID": claims.BranchID,
+			"error":    err.Error(),
+		})
+	}
+
 	logs.Info("Token generated successfully", map[string]interface{}{
 		"clientID": claims.ClientID,
 	})
@@ -224,6 +237,48 @@ func (s *JWTService) RevokeToken(token string) error {
 	return nil
 }
 
+// RevokeIssuedTokens revoca con RevokeToken los tokens registrados para la sucursal y los retira del registro. Los
+// tokens emitidos mientras se revocan se conservan en el registro.
+func (s *JWTService) RevokeIssuedTokens(branchID uint) (int, error) {
+	key := issuedTokensKey(branchID)
+
+	tokens, err := s.cacheService.LRange(key, 0, -1)
+	if err != nil {
+		return 0, shared_error.NewGeneralServiceError("JWTService", "RevokeIssuedTokens", "failed to get issued tokens", err)
+	}
+
+	for _, token := range tokens {
+		if err = s.RevokeToken(token); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = s.cacheService.LTrim(key, int64(len(tokens)), -1); err != nil {
+		return 0, shared_error.NewGeneralServiceError("JWTService", "RevokeIssuedTokens", "failed to clear issued tokens", err)
+	}
+
+	logs.Info("Issued tokens revoked successfully", map[string]interface{}{
+		"branchID": branchID,
+		"tokens":   len(tokens),
+	})
+
+	return len(tokens), nil
+}
+
+// trackIssuedToken registra un token emitido para la sucursal conservando solo los últimos MaxIssuedTokens
+func (s *JWTService) trackIssuedToken(branchID uint, token string) error {
+	key := issuedTokensKey(branchID)
+	if err := s.cacheService.RPush(key, []byte(token)); err != nil {
+		return err
+	}
+
+	return s.cacheService.LTrim(key, -MaxIssuedTokens, -1)
+}
+
+func issuedTokensKey(branchID uint) string {
+	return fmt.Sprintf("token:issued:%d", branchID)
+}
+
 // GetSecretKey retorna la clave secreta para firmar los tokens.
 func (s *JWTService) GetSecretKey() string {
 	return s.SecretKey
@@ -10,7 +10,6 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/config/drivers"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
 	batchPorts "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter"
-	ports2 "github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"io"
 	"net"
 	"net/http"
@@ -20,8 +19,11 @@ import (
 	authPorts "github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
 	authModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/circuit"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/transmitter/hacienda_error"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
@@ -35,11 +37,11 @@ type BatchTransmitterService struct {
 	haciendaAuth    authPorts.HaciendaAuthManager
 	signer          authPorts.SignerManager
 	contingencyRepo contingency.ContingencyRepositoryPort
-	timeProvider    ports2.TimeProvider
 	config          *models.TransmissionConfig
 	httpClient      *http.Client
 	circuitBreaker  *circuit.CircuitBreaker
 	connection      *drivers.DbConnection
+	events          events.EventPublisher
 }
 
 // NewBatchTransmitterService constructor para BatchTransmitterService
@@ -48,16 +50,16 @@ func NewBatchTransmitterService(
 	signer authPorts.SignerManager,
 	contingencyRepo contingency.ContingencyRepositoryPort,
 	config *models.TransmissionConfig,
-	timeProvider ports2.TimeProvider,
 	connection *drivers.DbConnection,
+	eventPublisher events.EventPublisher,
 ) batchPorts.BatchTransmitterPort {
 	return &BatchTransmitterService{
 		haciendaAuth:    haciendaAuth,
 		signer:          signer,
 		contingencyRepo: contingencyRepo,
 		config:          config,
-		timeProvider:    timeProvider,
 		connection:      connection,
+		events:          eventPublisher,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 			Transport: &http.Transport{
@@ -149,7 +151,11 @@ func (s *BatchTransmitterService) getHaciendaTokenWithRetry(
 			return "", err
 		}
 
-		s.sleep(attempt)
+		if attempt+1 < retryPolicy.MaxAttempts {
+			if waitErr := retryPolicy.Wait(ctx, attempt+1); waitErr != nil {
+				return "", waitErr
+			}
+		}
 	}
 
 	return "", shared_error.NewGeneralServiceError("BatchTransmitterService", "getHaciendaTokenWithRetry", "max retry attempts reached", err)
@@ -175,7 +181,11 @@ func (s *BatchTransmitterService) sendBatchWithRetry(
 			return nil, err
 		}
 
-		s.sleep(attempt)
+		if attempt+1 < retryPolicy.MaxAttempts {
+			if waitErr := retryPolicy.Wait(ctx, attempt+1); waitErr != nil {
+				return nil, waitErr
+			}
+		}
 	}
 
 	return nil, shared_error.NewGeneralServiceError("BatchTransmitterService", "sendBatchWithRetry", "max retry attempts reached", err)
@@ -360,11 +370,63 @@ func (s *BatchTransmitterService) VerifyContingencyBatchStatus(
 				"totalRejected":  len(status.Rejected),
 			})
 
+			s.publishBatchResult(ctx, batchID, mhBatchID, branchID, status, docsMap)
+
 			return nil
 		}
 	}
 }
 
+// publishBatchResult publica los eventos con el resultado de cada documento del lote y el lote procesado
+func (s *BatchTransmitterService) publishBatchResult(
+	ctx context.Context,
+	batchID string,
+	mhBatchID string,
+	branchID uint,
+	status *models.ConsultBatchResponse,
+	docsMap map[string]dte.ContingencyDocument,
+) {
+	if s.events == nil {
+		return
+	}
+
+	batchData := event.BatchPayload{
+		BatchID:   batchID,
+		MHBatchID: mhBatchID,
+		Processed: []string{},
+		Rejected:  []string{},
+	}
+
+	for _, processed := range status.Processed {
+		if _, exists := docsMap[processed.GenerationCode]; !exists {
+			continue
+		}
+
+		receptionStamp := processed.ReceptionStamp
+		s.events.Publish(ctx, branchID, event.DTEReceived, event.DTEPayload{
+			GenerationCode: processed.GenerationCode,
+			Status:         constants.DocumentReceived,
+			ReceptionStamp: &receptionStamp,
+		})
+		batchData.Processed = append(batchData.Processed, processed.GenerationCode)
+	}
+
+	for _, rejected := range status.Rejected {
+		if _, exists := docsMap[rejected.GenerationCode]; !exists {
+			continue
+		}
+
+		s.events.Publish(ctx, branchID, event.DTERejected, event.DTEPayload{
+			GenerationCode: rejected.GenerationCode,
+			Status:         constants.DocumentRejected,
+			Reason:         rejected.DescriptionMessage,
+		})
+		batchData.Rejected = append(batchData.Rejected, rejected.GenerationCode)
+	}
+
+	s.events.Publish(ctx, branchID, event.ContingencyBatchProcessed, batchData)
+}
+
 // checkBatchStatus verifica el estado de un lote en Hacienda
 func (s *BatchTransmitterService) checkBatchStatus(ctx context.Context, batchID string, haciendaToken string) (*models.ConsultBatchResponse, bool, error) {
 	req, err := http.NewRequestWithContext(
@@ -422,6 +484,15 @@ func (s *BatchTransmitterService) checkBatchStatus(ctx context.Context, batchID
 
 // shouldRetry determina si se debe reintentar una operación
 func (s *BatchTransmitterService) shouldRetry(err error) bool {
+	// Circuit breaker abierto - no reintentar hasta que se cumpla su tiempo de reinicio
+	var unavailableErr *dte_errors.ServiceUnavailableError
+	if errors.As(err, &unavailableErr) && unavailableErr.CircuitOpen {
+		logs.Info("Circuit breaker open, will not retry", map[string]interface{}{
+			"component": unavailableErr.Component,
+		})
+		return false
+	}
+
 	// Errores de red/conexión - siempre reintentar
 	var netErr *net.OpError
 	if errors.As(err, &netErr) {
@@ -495,13 +566,3 @@ func (s *BatchTransmitterService) shouldRetry(err error) bool {
 	})
 	return true
 }
-
-// sleep implementa el backoff exponencial para reintentos
-func (s *BatchTransmitterService) sleep(attempt int) {
-	retryPolicy := s.config.GetRetryPolicy()
-	backoff := retryPolicy.InitialInterval * time.Duration(float64(attempt)*retryPolicy.BackoffFactor)
-	if backoff > retryPolicy.MaxInterval {
-		backoff = retryPolicy.MaxInterval
-	}
-	s.timeProvider.Sleep(backoff)
-}
@@ -9,12 +9,15 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/config"
 	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
 	models2 "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
 	ports2 "github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/transmitter/hacienda_error"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/transmitter/processors"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"time"
@@ -32,15 +35,19 @@ type MHTransmitter struct {
 	failedSequenceRepo ports2.FailedSequenceNumberRepositoryPort
 	httpClient         *http.Client
 	processors         map[string]DocumentProcessor
+	circuit            ports2.CircuitManager
 }
 
-func NewMHTransmitter(haciendaAuth ports.HaciendaAuthManager, failedSequenceRepo ports2.FailedSequenceNumberRepositoryPort) ports.DTETransmitter {
+// NewMHTransmitter crea el transmisor de documentos a Hacienda. Las solicitudes de recepción y consulta se protegen
+// con el circuit breaker indicado, de modo que mientras Hacienda no está disponible fallan sin esperar su respuesta.
+func NewMHTransmitter(haciendaAuth ports.HaciendaAuthManager, failedSequenceRepo ports2.FailedSequenceNumberRepositoryPort, circuit ports2.CircuitManager) ports.DTETransmitter {
 	t := &MHTransmitter{
 		haciendaAuth:       haciendaAuth,
 		failedSequenceRepo: failedSequenceRepo,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: config.TransmissionRequestTimeoutSeconds * time.Second,
 		},
+		circuit:    circuit,
 		processors: make(map[string]DocumentProcessor),
 	}
 
@@ -88,8 +95,23 @@ func (t *MHTransmitter) Transmit(ctx context.Context, document interface{}, sign
 	return processor.ProcessResponse(resp)
 }
 
+// CheckDocumentStatus consulta el estado de un documento en Hacienda. Si el contexto contiene el token del sistema se
+// obtiene su token de Hacienda, de lo contrario se utiliza el de la última transmisión.
 func (t *MHTransmitter) CheckDocumentStatus(ctx context.Context, document interface{}, nit string) (*models2.TransmitResult, error) {
 	_, dteType, generationCode, _, err := processors.GetDocumentRequestData(document)
+	if err != nil {
+		return nil, err
+	}
+
+	if !t.circuit.AllowRequest() {
+		return nil, dte_errors.NewCircuitOpenError(constants.CircuitHaciendaReception)
+	}
+
+	if systemToken, ok := ctx.Value("token").(string); ok && systemToken != "" {
+		if err = t.getHaciendaToken(ctx, systemToken); err != nil {
+			return nil, err
+		}
+	}
 
 	haciendaReqBody := HaciendaConsultRequest{
 		IssuerNIT:      nit,
@@ -108,7 +130,7 @@ func (t *MHTransmitter) CheckDocumentStatus(ctx context.Context, document interf
 	req.Header.Set("Authorization", t.HaciendaToken)
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := t.httpClient.Do(req)
+	resp, err := t.send(req)
 	if err != nil {
 		logs.Error("Failed to check document status", map[string]interface{}{
 			"error": err.Error(),
@@ -118,7 +140,7 @@ func (t *MHTransmitter) CheckDocumentStatus(ctx context.Context, document interf
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusAccepted {
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
 		logs.Error("Failed to check document status", map[string]interface{}{
 			"status": resp.Status,
 		})
@@ -141,6 +163,14 @@ func (t *MHTransmitter) CheckDocumentStatus(ctx context.Context, document interf
 }
 
 func (t *MHTransmitter) SendToHacienda(ctx context.Context, request *models2.HaciendaRequest, systemToken string) (*models2.HaciendaResponse, error) {
+	// Evitar la solicitud mientras Hacienda se considera no disponible
+	if !t.circuit.AllowRequest() {
+		logs.Warn("Circuit breaker preventing request to Hacienda", map[string]interface{}{
+			"state": t.circuit.GetState().String(),
+		})
+		return nil, dte_errors.NewCircuitOpenError(constants.CircuitHaciendaReception)
+	}
+
 	err := t.getHaciendaToken(ctx, systemToken)
 	if err != nil {
 		return nil, err
@@ -165,7 +195,7 @@ func (t *MHTransmitter) SendToHacienda(ctx context.Context, request *models2.Hac
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", "HaciendaApp/1.0")
 
-	resp, err := t.httpClient.Do(req)
+	resp, err := t.send(req)
 	if err != nil {
 		logs.Error("Failed to send to Hacienda", map[string]interface{}{
 			"error": err.Error(),
@@ -204,6 +234,34 @@ func (t *MHTransmitter) SendToHacienda(ctx context.Context, request *models2.Hac
 	return &response, nil
 }
 
+// send envía la solicitud a Hacienda y registra su disponibilidad en el circuit breaker. Los errores de red, los
+// timeouts y las respuestas 5xx cuentan como fallas y se retornan como ServiceUnavailableError; cualquier otra
+// respuesta, incluido un rechazo, indica que Hacienda está disponible. La cancelación de la solicitud no se registra.
+func (t *MHTransmitter) send(req *http.Request) (*http.Response, error) {
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			t.circuit.RecordFailure()
+		}
+		return nil, dte_errors.NewServiceUnavailableError(constants.CircuitHaciendaReception, err)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		t.circuit.RecordFailure()
+		return nil, dte_errors.NewServiceUnavailableError(constants.CircuitHaciendaReception, &hacienda_error.HTTPResponseError{
+			StatusCode: resp.StatusCode,
+			Body:       body,
+			URL:        req.URL.String(),
+			Method:     req.Method,
+		})
+	}
+
+	t.circuit.RecordSuccess()
+	return resp, nil
+}
+
 func (t *MHTransmitter) handleFailedSequence(ctx context.Context, err error, document interface{}, req *models2.HaciendaRequest) {
 	// Only register if it's a Hacienda error
 	var haciendaErr *hacienda_error.HaciendaResponseError
@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
+)
+
+const (
+	// requestTimeout es el tiempo máximo de espera de la respuesta del endpoint del usuario
+	requestTimeout = 10 * time.Second
+	// userAgent identifica a la API ante los endpoints de los usuarios
+	userAgent = "api-facturacion-sv-webhooks/1.0"
+	// maxResponseSnippet es la cantidad de bytes de la respuesta incluidos en el error de una entrega fallida
+	maxResponseSnippet = 256
+)
+
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender crea el emisor de webhooks. Salvo que allowPrivateNetworks esté habilitado, las conexiones solo se
+// establecen con direcciones públicas, verificadas tras resolver el nombre del host para que un dominio que resuelve a
+// la red interna no pueda usarse como destino.
+func NewHTTPSender(allowPrivateNetworks bool) ports.WebhookSender {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivateNetworks {
+		dialer := &net.Dialer{Timeout: requestTimeout, Control: rejectNonPublicAddress}
+		transport.DialContext = dialer.DialContext
+		// Sin proxy la dirección verificada es la del endpoint y no la del proxy
+		transport.Proxy = nil
+	}
+
+	return &HTTPSender{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+			// Las redirecciones no se siguen para que el cuerpo firmado solo llegue a la URL registrada
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// rejectNonPublicAddress impide la conexión con direcciones de loopback, privadas, de enlace local o reservadas
+func rejectNonPublicAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !webhook.IsPublicIP(ip) {
+		return fmt.Errorf("webhook destination %s is not a public address", host)
+	}
+
+	return nil
+}
+
+// Send envía el webhook mediante POST. Cualquier estado distinto de 2xx se considera una entrega fallida.
+func (s *HTTPSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("error building webhook request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", userAgent)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error sending webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSnippet))
+		return resp.StatusCode, fmt.Errorf("webhook endpoint responded with status %d: %s", resp.StatusCode, string(snippet))
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
@@ -30,6 +30,48 @@ var EndpointExamples = map[string]ExampleConfig{
 		Title:        "Nota de Crédito",
 		Description:  "Este endpoint permite crear y emitir una Nota de Crédito electrónica.",
 	},
+	"debitnote": {
+		RequestFile:  "jsonExamples/debitnote_request.json",
+		ResponseFile: "jsonExamples/debitnote_response.json",
+		Title:        "Nota de Débito",
+		Description:  "Este endpoint permite crear y emitir una Nota de Débito electrónica.",
+	},
+	"fse": {
+		RequestFile:  "jsonExamples/fse_request.json",
+		ResponseFile: "jsonExamples/fse_response.json",
+		Title:        "Factura Sujeto Excluido",
+		Description:  "Este endpoint permite crear y emitir una Factura de Sujeto Excluido electrónica.",
+	},
+	"export": {
+		RequestFile:  "jsonExamples/export_request.json",
+		ResponseFile: "jsonExamples/export_response.json",
+		Title:        "Factura de Exportación",
+		Description:  "Este endpoint permite crear y emitir una Factura de Exportación electrónica.",
+	},
+	"remission": {
+		RequestFile:  "jsonExamples/remission_request.json",
+		ResponseFile: "jsonExamples/remission_response.json",
+		Title:        "Nota de Remisión",
+		Description:  "Este endpoint permite crear y emitir una Nota de Remisión electrónica.",
+	},
+	"liquidation": {
+		RequestFile:  "jsonExamples/liquidation_request.json",
+		ResponseFile: "jsonExamples/liquidation_response.json",
+		Title:        "Comprobante de Liquidación",
+		Description:  "Este endpoint permite crear y emitir un Comprobante de Liquidación electrónico.",
+	},
+	"accounting-liquidation": {
+		RequestFile:  "jsonExamples/accounting_liquidation_request.json",
+		ResponseFile: "jsonExamples/accounting_liquidation_response.json",
+		Title:        "Documento Contable de Liquidación",
+		Description:  "Este endpoint permite crear y emitir un Documento Contable de Liquidación electrónico.",
+	},
+	"donation": {
+		RequestFile:  "jsonExamples/donation_request.json",
+		ResponseFile: "jsonExamples/donation_response.json",
+		Title:        "Comprobante de Donación",
+		Description:  "Este endpoint permite crear y emitir un Comprobante de Donación electrónico.",
+	},
 	"retention": {
 		RequestFile:  "jsonExamples/retention_request.json",
 		ResponseFile: "jsonExamples/retention_response.json",
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/helpers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type BranchHandler struct {
+	branchUseCase *auth.BranchUseCase
+	respWriter    *response.ResponseWriter
+}
+
+func NewBranchHandler(branchUseCase *auth.BranchUseCase) *BranchHandler {
+	return &BranchHandler{
+		branchUseCase: branchUseCase,
+		respWriter:    response.NewResponseWriter(),
+	}
+}
+
+// List maneja la solicitud HTTP para listar las sucursales del usuario
+// List godoc
+// @Summary Listar sucursales
+// @Description Obtiene las sucursales activas e inactivas del usuario sin sus API secrets, con el rol y los scopes de sus credenciales. Administrar sucursales requiere el scope admin:branches.
+// @Tags Branches
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {array} user.BranchOfficeResponse
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /auth/branches [get]
+func (h *BranchHandler) List(w http.ResponseWriter, r *http.Request) {
+	branches, err := h.branchUseCase.List(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, branches, nil)
+}
+
+// Create maneja la solicitud HTTP para registrar una sucursal
+// Create godoc
+// @Summary Registrar sucursal
+// @Description Registra una sucursal activa y genera su API key y API secret. El API secret solo se muestra en esta respuesta y no puede registrarse otra casa matriz. Si no se indica el rol, las credenciales tienen el rol operator.
+// @Tags Branches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param branch body structs.BranchOfficeRequest true "Sucursal"
+// @Success 201 {object} user.BranchOfficeResponse
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /auth/branches [post]
+func (h *BranchHandler) Create(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar la solicitud
+	var req structs.BranchOfficeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Registrar la sucursal
+	branch, err := h.branchUseCase.Create(r.Context(), &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusCreated, branch, nil)
+}
+
+// Update maneja la solicitud HTTP para modificar una sucursal
+// Update godoc
+// @Summary Modificar sucursal
+// @Description Modifica los códigos de establecimiento y punto de venta, los códigos de Hacienda, el correo, el teléfono, la dirección o los permisos de una sucursal; los campos omitidos conservan su valor. Las credenciales de la casa matriz conservan el rol admin.
+// @Tags Branches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "ID de la sucursal"
+// @Param branch body structs.BranchOfficeRequest true "Cambios de la sucursal"
+// @Success 200 {object} user.BranchOfficeResponse
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /auth/branches/{id} [put]
+func (h *BranchHandler) Update(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el ID de la sucursal y decodificar la solicitud
+	id := helpers.GetRequestVar(r, "id")
+
+	var req structs.BranchOfficeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Modificar la sucursal
+	branch, err := h.branchUseCase.Update(r.Context(), id, &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, branch, nil)
+}
+
+// Deactivate maneja la solicitud HTTP para desactivar una sucursal
+// Deactivate godoc
+// @Summary Desactivar sucursal
+// @Description Desactiva una sucursal, sus credenciales dejan de poder iniciar sesión. La casa matriz no puede desactivarse.
+// @Tags Branches
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "ID de la sucursal"
+// @Success 200 {object} user.BranchOfficeResponse
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /auth/branches/{id}/deactivate [post]
+func (h *BranchHandler) Deactivate(w http.ResponseWriter, r *http.Request) {
+	h.setStatus(w, r, false)
+}
+
+// Reactivate maneja la solicitud HTTP para reactivar una sucursal
+// Reactivate godoc
+// @Summary Reactivar sucursal
+// @Description Reactiva una sucursal desactivada con sus mismas credenciales
+// @Tags Branches
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "ID de la sucursal"
+// @Success 200 {object} user.BranchOfficeResponse
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /auth/branches/{id}/reactivate [post]
+func (h *BranchHandler) Reactivate(w http.ResponseWriter, r *http.Request) {
+	h.setStatus(w, r, true)
+}
+
+// RotateCredentials maneja la solicitud HTTP para rotar las credenciales de una sucursal
+// RotateCredentials godoc
+// @Summary Rotar credenciales de sucursal
+// @Description Genera un API key y un API secret nuevos para la sucursal y revoca los tokens emitidos con las credenciales anteriores. Las credenciales anteriores siguen siendo válidas durante el periodo de gracia y los tokens emitidos con ellas no lo superan. El API secret solo se muestra en esta respuesta.
+// @Tags Branches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "ID de la sucursal"
+// @Param rotation body structs.CredentialsRotationRequest false "Periodo de gracia de las credenciales anteriores"
+// @Success 200 {object} models.RotatedCredentials
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /auth/branches/{id}/rotate-credentials [post]
+func (h *BranchHandler) RotateCredentials(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el ID de la sucursal y decodificar la solicitud opcional
+	id := helpers.GetRequestVar(r, "id")
+
+	var req structs.CredentialsRotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Rotar las credenciales
+	credentials, err := h.branchUseCase.RotateCredentials(r.Context(), id, &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, credentials, nil)
+}
+
+func (h *BranchHandler) setStatus(w http.ResponseWriter, r *http.Request, active bool) {
+	// 1. Obtener el ID de la sucursal
+	id := helpers.GetRequestVar(r, "id")
+
+	// 2. Actualizar el estado de la sucursal
+	branch, err := h.branchUseCase.SetStatus(r.Context(), id, active)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, branch, nil)
+}
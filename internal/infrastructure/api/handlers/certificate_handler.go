@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/certificate"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/helpers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type CertificateHandler struct {
+	certificateUseCase *certificate.CertificateUseCase
+	respWriter         *response.ResponseWriter
+}
+
+func NewCertificateHandler(certificateUseCase *certificate.CertificateUseCase) *CertificateHandler {
+	return &CertificateHandler{
+		certificateUseCase: certificateUseCase,
+		respWriter:         response.NewResponseWriter(),
+	}
+}
+
+// Upload maneja la solicitud HTTP para registrar el certificado de firma del NIT autenticado
+// Upload godoc
+// @Summary Registrar certificado de firma
+// @Description Registra el certificado del Ministerio de Hacienda (<NIT>.crt en base64) con su contraseña privada. El contenido se almacena encriptado.
+// @Tags Certificates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param certificate body structs.UploadCertificateRequest true "Certificado de firma"
+// @Success 201 {object} models.SigningCertificate
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /certificates [post]
+func (h *CertificateHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar la solicitud
+	var req structs.UploadCertificateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Registrar el certificado
+	cert, err := h.certificateUseCase.Upload(r.Context(), &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusCreated, cert, nil)
+}
+
+// Rotate maneja la solicitud HTTP para renovar el certificado de firma activo del NIT autenticado
+// Rotate godoc
+// @Summary Renovar certificado de firma
+// @Description Reemplaza el certificado activo por uno nuevo, desactivando el anterior y actualizando la contraseña privada
+// @Tags Certificates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param certificate body structs.UploadCertificateRequest true "Nuevo certificado de firma"
+// @Success 200 {object} models.SigningCertificate
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /certificates/rotate [post]
+func (h *CertificateHandler) Rotate(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar la solicitud
+	var req structs.UploadCertificateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Renovar el certificado
+	cert, err := h.certificateUseCase.Rotate(r.Context(), &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, cert, nil)
+}
+
+// List maneja la solicitud HTTP para listar los certificados del usuario autenticado
+// List godoc
+// @Summary Listar certificados de firma
+// @Description Obtiene los certificados registrados con su fecha de vencimiento, sin exponer su contenido
+// @Tags Certificates
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {array} models.SigningCertificate
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /certificates [get]
+func (h *CertificateHandler) List(w http.ResponseWriter, r *http.Request) {
+	certs, err := h.certificateUseCase.List(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, certs, nil)
+}
+
+// Deactivate maneja la solicitud HTTP para desactivar un certificado del usuario autenticado
+// Deactivate godoc
+// @Summary Desactivar certificado de firma
+// @Description Desactiva un certificado; el registro se conserva para auditoría
+// @Tags Certificates
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "ID del certificado"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /certificates/{id} [delete]
+func (h *CertificateHandler) Deactivate(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el ID del certificado
+	id := helpers.GetRequestVar(r, "id")
+
+	// 2. Desactivar el certificado
+	if err := h.certificateUseCase.Deactivate(r.Context(), id); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{"id": id, "is_active": false}, nil)
+}
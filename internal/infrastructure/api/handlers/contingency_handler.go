@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/helpers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type ContingencyHandler struct {
+	contingencyUseCase *contingency.ContingencyUseCase
+	respWriter         *response.ResponseWriter
+}
+
+func NewContingencyHandler(contingencyUseCase *contingency.ContingencyUseCase) *ContingencyHandler {
+	return &ContingencyHandler{
+		contingencyUseCase: contingencyUseCase,
+		respWriter:         response.NewResponseWriter(),
+	}
+}
+
+// List maneja la solicitud HTTP para consultar la cola de documentos en contingencia de las sucursales del usuario
+// List godoc
+// @Summary Listar documentos en contingencia
+// @Description Obtiene lista paginada de los documentos en contingencia, del más antiguo al más reciente, con el tipo, motivo, lotes, observaciones y el tiempo restante del plazo de 72 horas del MH
+// @Tags Contingency
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param branch_id query int false "ID de la sucursal"
+// @Param status query string false "Estado del documento" Enums(PENDING,RECEIVED,REJECTED,INVALIDATED) default(PENDING)
+// @Param page query int false "Número de página" default(1)
+// @Param page_size query int false "Elementos por página (máximo 100)" default(20)
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /contingency/documents [get]
+func (h *ContingencyHandler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	result, err := h.contingencyUseCase.List(r.Context(), &structs.ContingencyListRequest{
+		BranchID: query.Get("branch_id"),
+		Status:   query.Get("status"),
+		Page:     query.Get("page"),
+		PageSize: query.Get("page_size"),
+	})
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, result, nil)
+}
+
+// Get maneja la solicitud HTTP para consultar un documento en contingencia
+// Get godoc
+// @Summary Obtener documento en contingencia
+// @Description Obtiene un documento en contingencia con el tiempo restante del plazo de 72 horas del MH
+// @Tags Contingency
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "ID del documento en contingencia"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /contingency/documents/{id} [get]
+func (h *ContingencyHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id := helpers.GetRequestVar(r, "id")
+
+	document, err := h.contingencyUseCase.Get(r.Context(), id)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, document, nil)
+}
+
+// Retransmit maneja la solicitud HTTP para retransmitir a demanda los documentos pendientes del NIT del usuario
+// Retransmit godoc
+// @Summary Retransmitir documentos en contingencia
+// @Description Inicia en segundo plano la retransmisión de los documentos pendientes del NIT autenticado sin esperar al job de contingencia
+// @Tags Contingency
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Success 202 {object} map[string]interface{}
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /contingency/retransmit [post]
+func (h *ContingencyHandler) Retransmit(w http.ResponseWriter, r *http.Request) {
+	result, err := h.contingencyUseCase.Retransmit(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	// Sin documentos pendientes no se inicia ninguna retransmisión
+	status := http.StatusAccepted
+	if result.Documents == 0 {
+		status = http.StatusOK
+	}
+
+	h.respWriter.Success(w, status, result, nil)
+}
+
+// Reclassify maneja la solicitud HTTP para cambiar el tipo y el motivo de contingencia de un documento pendiente
+// Reclassify godoc
+// @Summary Reclasificar documento en contingencia
+// @Description Cambia el tipo y el motivo de contingencia de un documento pendiente; el motivo es obligatorio solo para el tipo 5 (Otro motivo)
+// @Tags Contingency
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "ID del documento en contingencia"
+// @Param classification body structs.ContingencyReclassifyRequest true "Nueva clasificación"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /contingency/documents/{id}/classification [put]
+func (h *ContingencyHandler) Reclassify(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el ID del documento y decodificar la solicitud
+	id := helpers.GetRequestVar(r, "id")
+
+	var req structs.ContingencyReclassifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Reclasificar el documento
+	document, err := h.contingencyUseCase.Reclassify(r.Context(), id, &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, document, nil)
+}
+
+// Cancel maneja la solicitud HTTP para retirar un documento pendiente de la cola de contingencia
+// Cancel godoc
+// @Summary Cancelar documento en contingencia
+// @Description Marca como rechazado un documento pendiente para que el job de contingencia no vuelva a transmitirlo, registrando el motivo como observación
+// @Tags Contingency
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "ID del documento en contingencia"
+// @Param cancel body structs.ContingencyCancelRequest false "Motivo de la cancelación"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /contingency/documents/{id}/cancel [post]
+func (h *ContingencyHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el ID del documento y decodificar la solicitud, el cuerpo es opcional
+	id := helpers.GetRequestVar(r, "id")
+
+	var req structs.ContingencyCancelRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+			h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+			return
+		}
+	}
+
+	// 2. Cancelar el documento
+	if err := h.contingencyUseCase.Cancel(r.Context(), id, &req); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{"id": id, "cancelled": true}, nil)
+}
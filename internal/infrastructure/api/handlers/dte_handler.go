@@ -2,7 +2,11 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/MarlonG1/api-facturacion-sv/internal/application/dte"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/helpers"
@@ -15,18 +19,30 @@ type DTEHandler struct {
 	GenericHandler      *GenericCreatorDTEHandler
 	dteConsultUseCase   *dte.DTEConsultUseCase
 	invalidationUseCase *dte.InvalidationUseCase
+	verifyUseCase       *dte.DTEVerifyUseCase
+	pdfUseCase          *dte.DTEPDFUseCase
+	deliveryUseCase     *dte.DTEDeliveryUseCase
+	emissionUseCase     *dte.AsyncEmissionUseCase
 	respWriter          *response.ResponseWriter
 }
 
 func NewDTEHandler(
 	dteConsultUseCase *dte.DTEConsultUseCase,
 	invalidationUseCase *dte.InvalidationUseCase,
+	verifyUseCase *dte.DTEVerifyUseCase,
+	pdfUseCase *dte.DTEPDFUseCase,
+	deliveryUseCase *dte.DTEDeliveryUseCase,
+	emissionUseCase *dte.AsyncEmissionUseCase,
 	genericHandler *GenericCreatorDTEHandler,
 ) *DTEHandler {
 	return &DTEHandler{
 		GenericHandler:      genericHandler,
 		dteConsultUseCase:   dteConsultUseCase,
 		invalidationUseCase: invalidationUseCase,
+		verifyUseCase:       verifyUseCase,
+		pdfUseCase:          pdfUseCase,
+		deliveryUseCase:     deliveryUseCase,
+		emissionUseCase:     emissionUseCase,
 		respWriter:          response.NewResponseWriter(),
 	}
 }
@@ -64,6 +80,108 @@ func (h *DTEHandler) GetByGenerationCode(w http.ResponseWriter, r *http.Request)
 	h.respWriter.Success(w, http.StatusOK, dte, nil)
 }
 
+// GetStatus maneja la solicitud HTTP para consultar el estado de un DTE emitido de forma asíncrona
+// GetStatus godoc
+// @Summary Consultar estado de emisión del DTE
+// @Description Obtiene el estado de un DTE y de su transmisión a Hacienda. Los documentos emitidos con async=true permanecen como PENDING hasta que un worker los transmite; el job indica los intentos realizados y el último error.
+// @Tags DTE
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Código de generación del DTE" format(uuid)
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/{id}/status [get]
+func (h *DTEHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el código de generación
+	generationCode := helpers.GetRequestVar(r, "id")
+
+	// 2. Obtener el estado ejecutando el caso de uso
+	status, err := h.emissionUseCase.GetStatus(r.Context(), generationCode)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, status, nil)
+}
+
+// GetPDF maneja la solicitud HTTP para obtener la versión legible de un DTE
+// GetPDF godoc
+// @Summary Obtener versión legible del DTE
+// @Description Genera la representación gráfica (PDF) de un DTE con el código QR de consulta pública y la plantilla del usuario
+// @Tags DTE
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Código de generación del DTE" format(uuid)
+// @Success 200 {file} file
+// @Failure 401 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/{id}/pdf [get]
+func (h *DTEHandler) GetPDF(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el código de generación
+	generationCode := helpers.GetRequestVar(r, "id")
+
+	// 2. Generar el PDF ejecutando el caso de uso
+	pdf, err := h.pdfUseCase.GeneratePDF(r.Context(), generationCode)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%s.pdf", generationCode))
+	w.Header().Set("Content-Length", strconv.Itoa(len(pdf)))
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write(pdf); err != nil {
+		logs.Error("Failed to write PDF response", map[string]interface{}{"error": err.Error()})
+	}
+}
+
+// Resend maneja la solicitud HTTP para reenviar un DTE por correo
+// Resend godoc
+// @Summary Reenviar DTE por correo
+// @Description Envía el JSON firmado y la versión legible (PDF) de un DTE al correo del receptor o al correo indicado. Si el envío falla, la notificación queda pendiente y se reintenta automáticamente.
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "Código de generación del DTE" format(uuid)
+// @Param resend body structs.ResendDTERequest false "Correo de destino opcional"
+// @Success 200 {object} notification.UserNotification
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/{id}/resend [post]
+func (h *DTEHandler) Resend(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el código de generación y el correo opcional
+	generationCode := helpers.GetRequestVar(r, "id")
+
+	var req structs.ResendDTERequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+			h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+			return
+		}
+	}
+
+	// 2. Reenviar el documento ejecutando el caso de uso
+	delivery, err := h.deliveryUseCase.Resend(r.Context(), generationCode, req.Email)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, delivery, nil)
+}
+
 // GetAll maneja la solicitud HTTP para obtener todos los DTEs
 // GetAll godoc
 // @Summary Listar DTEs
@@ -128,3 +246,37 @@ func (h *DTEHandler) InvalidateDocument(w http.ResponseWriter, r *http.Request)
 
 	h.respWriter.Success(w, http.StatusOK, invalidation, nil)
 }
+
+// VerifyDocument maneja la solicitud HTTP para verificar la firma de un DTE
+// VerifyDocument godoc
+// @Summary Verificar DTE firmado
+// @Description Verifica la firma RS512 de un DTE con el certificado de su emisor y compara el contenido firmado con el DTE almacenado
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param verification body structs.VerifyDTERequest true "DTE firmado en formato JWS compacto"
+// @Success 200 {object} dte.DTEVerification
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/verify [post]
+func (h *DTEHandler) VerifyDocument(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar la solicitud de verificación
+	var req structs.VerifyDTERequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Ejecutar el caso de uso de verificación
+	verification, err := h.verifyUseCase.Verify(r.Context(), req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, verification, nil)
+}
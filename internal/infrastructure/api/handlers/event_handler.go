@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/events"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+type EventHandler struct {
+	eventUseCase *events.EventUseCase
+	respWriter   *response.ResponseWriter
+}
+
+func NewEventHandler(eventUseCase *events.EventUseCase) *EventHandler {
+	return &EventHandler{
+		eventUseCase: eventUseCase,
+		respWriter:   response.NewResponseWriter(),
+	}
+}
+
+// List maneja la solicitud HTTP para consultar los eventos de dominio de las sucursales del usuario
+// List godoc
+// @Summary Listar eventos
+// @Description Obtiene lista paginada de los eventos registrados en las sucursales del usuario, del más reciente al más antiguo
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param branch_id query int false "ID de la sucursal"
+// @Param type query string false "Tipo de evento" Enums(DTE_RECEIVED,DTE_REJECTED,DTE_CONTINGENCY,CONTINGENCY_BATCH_PROCESSED,DTE_INVALIDATED,CREDIT_NOTE_BALANCE_EXHAUSTED,CERTIFICATE_EXPIRING,DTE_DELIVERY_REQUESTED,USER_REGISTERED)
+// @Param startDate query string false "Fecha inicio (RFC3339)"
+// @Param endDate query string false "Fecha fin (RFC3339)"
+// @Param page query int false "Número de página" default(1)
+// @Param page_size query int false "Elementos por página (máximo 100)" default(20)
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /events [get]
+func (h *EventHandler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	result, err := h.eventUseCase.List(r.Context(), &structs.EventListRequest{
+		BranchID:  query.Get("branch_id"),
+		EventType: query.Get("type"),
+		StartDate: query.Get("startDate"),
+		EndDate:   query.Get("endDate"),
+		Page:      query.Get("page"),
+		PageSize:  query.Get("page_size"),
+	})
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, result, nil)
+}
@@ -1,24 +1,37 @@
 ﻿package handlers
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"reflect"
 	"strings"
 
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/helpers"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
 )
 
+const (
+	// IdempotencyKeyHeader es el encabezado con el que el cliente identifica una solicitud que puede reintentar
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader indica que la respuesta corresponde a una solicitud anterior con la misma llave
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
 // GenericCreatorDTEHandler maneja las solicitudes para crear cualquier tipo de documento DTE
 type GenericCreatorDTEHandler struct {
 	documentConfigs    map[string]helpers.DocumentConfig
 	respWriter         *response.ResponseWriter
 	contingencyHandler *helpers.ContingencyHandler
+	idempotency        idempotency.IdempotencyManager
 }
 
 // NewGenericDTEHandler crea una nueva instancia de GenericCreatorDTEHandler
@@ -30,6 +43,12 @@ func NewGenericDTEHandler(contingencyHandler *helpers.ContingencyHandler) *Gener
 	}
 }
 
+// WithIdempotency habilita el encabezado Idempotency-Key en la creación de documentos
+func (h *GenericCreatorDTEHandler) WithIdempotency(manager idempotency.IdempotencyManager) *GenericCreatorDTEHandler {
+	h.idempotency = manager
+	return h
+}
+
 // RegisterDocument registra un nuevo tipo de documento para ser manejado
 func (h *GenericCreatorDTEHandler) RegisterDocument(path string, config helpers.DocumentConfig) {
 	h.documentConfigs[path] = config
@@ -268,10 +287,15 @@ func (h *GenericCreatorDTEHandler) RegisterDocument(path string, config helpers.
 // @Produce json
 // @Security BearerAuth
 // @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param async query bool false "Emitir de forma asíncrona; si se omite se usa la configuración de la sucursal"
 // @Param invoice body map[string]interface{} true "Datos de la factura"
 // @Success 201 {object} map[string]interface{}
+// @Success 202 {object} map[string]interface{}
 // @Failure 400 {object} response.APIError
 // @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
 // @Failure 500 {object} response.APIError
 // @Router /dte/invoices [post]
 func (h *GenericCreatorDTEHandler) CreateInvoice(w http.ResponseWriter, r *http.Request) {
@@ -480,10 +504,15 @@ func (h *GenericCreatorDTEHandler) CreateInvoice(w http.ResponseWriter, r *http.
 // @Produce json
 // @Security BearerAuth
 // @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param async query bool false "Emitir de forma asíncrona; si se omite se usa la configuración de la sucursal"
 // @Param ccf body object true "Datos de CCF"
 // @Success 201 {object} map[string]interface{}
+// @Success 202 {object} map[string]interface{}
 // @Failure 400 {object} response.APIError
 // @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
 // @Failure 500 {object} response.APIError
 // @Router /dte/ccf [post]
 func (h *GenericCreatorDTEHandler) CreateCCF(w http.ResponseWriter, r *http.Request) {
@@ -694,57 +723,92 @@ func (h *GenericCreatorDTEHandler) CreateCCF(w http.ResponseWriter, r *http.Requ
 // @Produce json
 // @Security BearerAuth
 // @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
 // @Param creditnote body object true "Datos de la nota de credito"
 // @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} response.APIError
 // @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
 // @Failure 500 {object} response.APIError
 // @Router /dte/creditnote [post]
 func (h *GenericCreatorDTEHandler) CreateCreditNote(w http.ResponseWriter, r *http.Request) {
 	h.HandleCreate(w, r)
 }
 
-// CreateRetention godoc
-// @Summary Crear Comprobante de Retencion
-// @Description // @Description Este endpoint permite crear y emitir un Comprobante de Retención electrónico.
+// CreateDebitNote godoc
+// @Summary Crear Nota de Debito
+// @Description Este endpoint permite crear y emitir una Nota de Débito electrónica.
 // @Description 
 // @Description ## Ejemplo de Solicitud
 // @Description ```json
 // @Description {
 // @Description     "items": [
 // @Description         {
-// @Description             "type": 2,
-// @Description             "document_number": "1EEAB582-AA75-4D9C-A...",
-// @Description             "description": "Compra de equipos informáticos",
-// @Description             "retention_code": "22"
+// @Description             "type": 1,
+// @Description             "description": "Ajuste por intereses",
+// @Description             "quantity": 1,
+// @Description             "unit_measure": 59,
+// @Description             "unit_price": 1000.00,
+// @Description             "taxed_sale": 1000.00,
+// @Description             "exempt_sale": 0,
+// @Description             "non_subject_sale": 0,
+// @Description             "taxes": [
+// @Description                 "20"
+// @Description             ],
+// @Description             "related_doc" : "DE4BD411-DEBF-4EB8-B..."
 // @Description         }
 // @Description     ],
 // @Description     "receiver": {
-// @Description     "document_type": "36",
-// @Description     "document_number": "00000000000000",
-// @Description     "nrc": "000000",
-// @Description     "name": "EJEMPLO S.A de S.V",
-// @Description     "commercial_name": "EJEMPLO",
-// @Description     "activity_code": "00000",
-// @Description     "activity_description": "ACTIVIDADES JURÍDICAS Y CONTABLES",
-// @Description     "address": {
-// @Description       "department": "06",
-// @Description       "municipality": "20",
-// @Description       "complement": "Dirección de Prueba 1, N° 1234"
+// @Description         "nrc": "0000",
+// @Description         "nit": "00000000000000",
+// @Description         "name": "CLIENTE DE PRUEBA",
+// @Description         "commercial_name": "EJEMPLO S.A de S.V",
+// @Description         "activity_code": "47190",
+// @Description         "activity_description": "ACTIVIDADES JURÍDICAS Y CONTABLES",
+// @Description         "address": {
+// @Description             "department": "06",
+// @Description             "municipality": "22",
+// @Description             "complement": "Dirección de Prueba 1, N° 1234"
+// @Description         },
+// @Description         "phone": "21212828",
+// @Description         "email": "cliente@gmail.com"
 // @Description     },
-// @Description     "phone": "21212121",
-// @Description     "email": "cliente@gmail.com"
-// @Description   },
-// @Description    "extension": {
+// @Description     "summary": {
+// @Description         "operation_condition": 1,
+// @Description         "total_taxed": 1000.00,
+// @Description         "iva_retention": 10,
+// @Description         "sub_total_sales": 1000.00,
+// @Description         "sub_total": 1000.00,
+// @Description         "total_operation": 1120.00,
+// @Description         "taxes": [
+// @Description             {
+// @Description                 "code": "20",
+// @Description                 "description": "IVA 13%",
+// @Description                 "value": 130.00
+// @Description             }
+// @Description         ]
+// @Description     },
+// @Description     "extension": {
 // @Description         "delivery_name": "Juan Pérez",
 // @Description         "delivery_document": "06141809931020",
 // @Description         "receiver_name": "María López",
 // @Description         "receiver_document": "06142509882011",
 // @Description         "observation": "Entrega en oficina central"
 // @Description     },
+// @Description     "related_docs": [
+// @Description         {
+// @Description             "document_type": "03",
+// @Description             "generation_type": 2,
+// @Description             "document_number": "DE4BD411-DEBF-4..."
+// @Description         }
+// @Description     ],
+// @Description     "appendixes": null,
+// @Description     "third_party_sale": null,
+// @Description     "other_docs": null
 // @Description 
-// @Description     "appendixes": null
 // @Description }
+// @Description 
 // @Description ```
 // @Description 
 // @Description ## Ejemplo de Respuesta
@@ -753,131 +817,1548 @@ func (h *GenericCreatorDTEHandler) CreateCreditNote(w http.ResponseWriter, r *ht
 // @Description     "success": true,
 // @Description     "reception_stamp": "202534D1BECF3321453...",
 // @Description     "qr_link": "https://admin.factura.gob.sv/consultaPublica?ambiente=00&codGen=5367521F-DD80-4B6B-9...&fechaEmi=FECHA-DE-EMISION",
-// @Description     "data":{
-// @Description   "identificacion": {
-// @Description     "version": 1,
-// @Description     "ambiente": "00",
-// @Description     "tipoDte": "07",
-// @Description     "numeroControl": "DTE-07-C0020000-000000000000001",
-// @Description     "codigoGeneracion": "EB399033-E184-41D2-B01...",
-// @Description     "tipoModelo": 1,
-// @Description     "tipoOperacion": 1,
-// @Description     "tipoContingencia": null,
-// @Description     "motivoContin": null,
-// @Description     "fecEmi": "2025-04-16",
-// @Description     "horEmi": "20:40:56",
-// @Description     "tipoMoneda": "USD"
-// @Description   },
-// @Description   "resumen": {
-// @Description     "totalSujetoRetencion": 80097.35,
-// @Description     "totalIVAretenido": 800.97,
-// @Description     "totalIVAretenidoLetras": "OCHOCIENTOS 97/100"
-// @Description   },
-// @Description   "emisor": {
-// @Description     "nit": "00000000000000",
-// @Description     "nrc": "0000000",
-// @Description     "nombre": "EMPRESA DE PRUEBAS SA DE CV 2",
-// @Description     "codActividad": "00000",
-// @Description     "descActividad": "Venta al por mayor de otros productos",
-// @Description     "tipoEstablecimiento": "01",
-// @Description     "direccion": {
-// @Description       "departamento": "06",
-// @Description       "municipio": "20",
-// @Description       "complemento": "BOULEVARD SANTA ELENA SUR, SANTA TECLA"
-// @Description     },
-// @Description     "telefono": "21212828",
-// @Description     "correo": "facturacion@empresa.com.sv",
-// @Description     "nombreComercial": "JEMEPLO",
-// @Description     "codigoMH": null,
-// @Description     "codigo": "C002",
-// @Description     "puntoVentaMH": null,
-// @Description     "puntoVenta": null
-// @Description   },
-// @Description   "receptor": {
-// @Description     "nombre": "EJEMPLO S.A DE C.V",
-// @Description     "tipoDocumento": "36",
-// @Description     "numDocumento": "00000000000000",
-// @Description     "nrc": "000000",
-// @Description     "codActividad": "00000",
-// @Description     "descActividad": "ACTIVIDADES JURÍDICAS Y CONTABLES",
-// @Description     "direccion": {
-// @Description       "departamento": "06",
-// @Description       "municipio": "20",
-// @Description       "complemento": "Dirección de Prueba 1, N° 1234"
-// @Description     },
-// @Description     "telefono": "21212121",
-// @Description     "correo": "cliente@gmail.com",
-// @Description     "nombreComercial": "EJEMPLO"
-// @Description   },
-// @Description   "cuerpoDocumento": [
-// @Description     {
-// @Description       "numItem": 1,
-// @Description       "tipoDte": "03",
-// @Description       "tipoDoc": 2,
-// @Description       "numDocumento": "1EEAB582-AA75-4D9C-AFF...",
-// @Description       "fechaEmision": "2025-04-11",
-// @Description       "montoSujetoGrav": 80097.35,
-// @Description       "codigoRetencionMH": "22",
-// @Description       "ivaRetenido": 800.97,
-// @Description       "descripcion": "Compra de equipos informáticos"
-// @Description     }
-// @Description   ],
-// @Description   "extension": {
-// @Description     "nombEntrega": "Juan Pérez",
-// @Description     "docuEntrega": "06141809931020",
-// @Description     "nombRecibe": "María López",
-// @Description     "docuRecibe": "06142509882011",
-// @Description     "observaciones": "Entrega en oficina central"
-// @Description   },
-// @Description         "apendice": [
+// @Description     "data": {
+// @Description         "identificacion": {
+// @Description             "version": 3,
+// @Description             "ambiente": "00",
+// @Description             "tipoDte": "06",
+// @Description             "numeroControl": "DTE-06-C0020000-000000000000001",
+// @Description             "codigoGeneracion": "5367521F-DD80-4B6B-9...",
+// @Description             "tipoModelo": 1,
+// @Description             "tipoOperacion": 1,
+// @Description             "tipoContingencia": null,
+// @Description             "motivoContin": null,
+// @Description             "fecEmi": "2025-04-16",
+// @Description             "horEmi": "17:54:19",
+// @Description             "tipoMoneda": "USD"
+// @Description         },
+// @Description         "emisor": {
+// @Description             "nit": "00000000000000",
+// @Description             "nrc": "0000000",
+// @Description             "nombre": "EMPRESA DE PRUEBAS SA DE CV 2",
+// @Description             "codActividad": "00000",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "tipoEstablecimiento": "01",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "BOULEVARD SANTA ELENA SUR, SANTA TECLA"
+// @Description             },
+// @Description             "telefono": "21212828",
+// @Description             "correo": "facturacion@empresa.com.sv",
+// @Description             "nombreComercial": "EJEMPLO"
+// @Description         },
+// @Description         "receptor": {
+// @Description             "nombre": "CLIENTE DE PRUEBA",
+// @Description             "nrc": "0000",
+// @Description             "nit": "00000000000000",
+// @Description             "codActividad": "00000",
+// @Description             "descActividad": "ACTIVIDADES JURÍDICAS Y CONTABLES",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "22",
+// @Description                 "complemento": "Dirección de Prueba 1, N° 1234"
+// @Description             },
+// @Description             "telefono": "21212828",
+// @Description             "correo": "cliente@gmail.com",
+// @Description             "nombreComercial": "EJEMPLO S.A de S.V"
+// @Description         },
+// @Description         "cuerpoDocumento": [
 // @Description             {
-// @Description                 "campo": "Datos del documento",
-// @Description                 "etiqueta": "Sello de recepción",
-// @Description                 "valor": "202534D1BECF33214..."
+// @Description                 "numItem": 1,
+// @Description                 "tipoItem": 1,
+// @Description                 "numeroDocumento": "DE4BD411-DEBF-4EB8-B...",
+// @Description                 "codigo": null,
+// @Description                 "codTributo": null,
+// @Description                 "descripcion": "Ajuste por intereses",
+// @Description                 "cantidad": 1,
+// @Description                 "uniMedida": 59,
+// @Description                 "precioUni": 1000,
+// @Description                 "montoDescu": 0,
+// @Description                 "ventaNoSuj": 0,
+// @Description                 "ventaExenta": 0,
+// @Description                 "ventaGravada": 1000,
+// @Description                 "tributos": [
+// @Description                     "20"
+// @Description                 ]
 // @Description             }
-// @Description         ]
-// @Description   }
-// @Description }
-// @Description ```
-// @Description 
-// @Description Para ver ejemplos completos, consulta: /jsonExamples/
-// @Tags DTE
-// @Accept json
-// @Produce json
-// @Security BearerAuth
-// @Param Authorization header string true "Bearer token"
-// @Param retention body object true "Datos del comprobante de Retencion"
-// @Success 201 {object} map[string]interface{}
-// @Failure 400 {object} response.APIError
-// @Failure 401 {object} response.APIError
-// @Failure 500 {object} response.APIError
-// @Router /dte/retention [post]
-func (h *GenericCreatorDTEHandler) CreateRetention(w http.ResponseWriter, r *http.Request) {
-	h.HandleCreate(w, r)
-}
-
-// HandleCreate maneja la creaciÃ³n de cualquier tipo de documento
-func (h *GenericCreatorDTEHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
-	// 1. Determinar quÃ© tipo de documento se estÃ¡ creando basado en la ruta
-	path := r.URL.Path
-	config, err := h.getDocumentTypeFromPath(path)
-	if err != nil {
-		h.respWriter.Error(w, http.StatusNotFound, "Document type not supported", nil)
-		return
-	}
-
-	// 2. Crear una nueva instancia del tipo de solicitud
-	requestType := reflect.TypeOf(config.RequestType)
-	request := reflect.New(requestType.Elem()).Interface()
-
-	// 3. Decodificar el JSON en la estructura de solicitud
-	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
-		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
-		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
-		return
-	}
+// @Description         ],
+// @Description         "resumen": {
+// @Description             "totalNoSuj": 0,
+// @Description             "totalExenta": 0,
+// @Description             "totalGravada": 1000,
+// @Description             "subTotalVentas": 1000,
+// @Description             "descuNoSuj": 0,
+// @Description             "descuExenta": 0,
+// @Description             "descuGravada": 0,
+// @Description             "totalDescu": 0,
+// @Description             "tributos": [
+// @Description                 {
+// @Description                     "codigo": "20",
+// @Description                     "descripcion": "IVA 13%",
+// @Description                     "valor": 130
+// @Description                 }
+// @Description             ],
+// @Description             "subTotal": 1000,
+// @Description             "ivaRete1": 10,
+// @Description             "ivaPerci1": 0,
+// @Description             "reteRenta": 0,
+// @Description             "montoTotalOperacion": 1120,
+// @Description             "totalLetras": "UN MIL CIENTO VEINTE 00/100",
+// @Description             "condicionOperacion": 1,
+// @Description             "numPagoElectronico": null
+// @Description         },
+// @Description         "documentoRelacionado": [
+// @Description             {
+// @Description                 "tipoDocumento": "03",
+// @Description                 "tipoGeneracion": 2,
+// @Description                 "numeroDocumento": "DE4BD411-DEBF-4EB8-B...",
+// @Description                 "fechaEmision": "2025-04-16"
+// @Description             }
+// @Description         ],
+// @Description         "ventaTercero": null,
+// @Description         "extension": {
+// @Description             "nombEntrega": "Juan Pérez",
+// @Description             "docuEntrega": "06141809931020",
+// @Description             "nombRecibe": "María López",
+// @Description             "docuRecibe": "06142509882011",
+// @Description             "observaciones": "Entrega en oficina central"
+// @Description         },
+// @Description         "apendice": [
+// @Description             {
+// @Description                 "campo": "Datos del documento",
+// @Description                 "etiqueta": "Sello de recepción",
+// @Description                 "valor": "202534D1BECF33214..."
+// @Description             }
+// @Description         ]
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description Para ver ejemplos completos, consulta: /jsonExamples/
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param debitnote body object true "Datos de la nota de debito"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/debitnote [post]
+func (h *GenericCreatorDTEHandler) CreateDebitNote(w http.ResponseWriter, r *http.Request) {
+	h.HandleCreate(w, r)
+}
+
+// CreateFSE godoc
+// @Summary Crear Factura de Sujeto Excluido
+// @Description Este endpoint permite crear y emitir una Factura de Sujeto Excluido electrónica.
+// @Description 
+// @Description ## Ejemplo de Solicitud
+// @Description ```json
+// @Description {
+// @Description     "items": [
+// @Description         {
+// @Description             "type": 1,
+// @Description             "description": "Compra de granos básicos",
+// @Description             "quantity": 10,
+// @Description             "unit_measure": 59,
+// @Description             "unit_price": 25.00,
+// @Description             "discount": 0,
+// @Description             "code": "GRANO001",
+// @Description             "purchase": 250.00
+// @Description         },
+// @Description         {
+// @Description             "type": 2,
+// @Description             "description": "Servicio de transporte de mercadería",
+// @Description             "quantity": 1,
+// @Description             "unit_measure": 99,
+// @Description             "unit_price": 50.00,
+// @Description             "discount": 0,
+// @Description             "purchase": 50.00
+// @Description         }
+// @Description     ],
+// @Description     "excluded_subject": {
+// @Description         "document_type": "13",
+// @Description         "document_number": "00000000-0",
+// @Description         "name": "JUAN PÉREZ",
+// @Description         "activity_code": "01111",
+// @Description         "activity_description": "Cultivo de cereales",
+// @Description         "address": {
+// @Description             "department": "06",
+// @Description             "municipality": "20",
+// @Description             "complement": "Cantón El Rosario, Caserío Los Pinos"
+// @Description         },
+// @Description         "phone": "77777777",
+// @Description         "email": "juan.perez@gmail.com"
+// @Description     },
+// @Description     "summary": {
+// @Description         "total_purchase": 300.00,
+// @Description         "discount": 0,
+// @Description         "total_discount": 0,
+// @Description         "sub_total": 300.00,
+// @Description         "iva_retention": 0,
+// @Description         "income_retention": 30.00,
+// @Description         "total_to_pay": 270.00,
+// @Description         "operation_condition": 1,
+// @Description         "payment_types": [
+// @Description             {
+// @Description                 "code": "01",
+// @Description                 "amount": 270.00
+// @Description             }
+// @Description         ],
+// @Description         "observations": "Compra a productor local"
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description ## Ejemplo de Respuesta
+// @Description ```json
+// @Description {
+// @Description     "success": true,
+// @Description     "reception_stamp": "202534D1BECF3321453...",
+// @Description     "qr_link": "https://admin.factura.gob.sv/consultaPublica?ambiente=00&codGen=5367521F-DD80-4B6B-9...&fechaEmi=FECHA-DE-EMISION",
+// @Description     "data": {
+// @Description         "identificacion": {
+// @Description             "version": 1,
+// @Description             "ambiente": "00",
+// @Description             "tipoDte": "14",
+// @Description             "numeroControl": "DTE-14-C0020000-000000000000001",
+// @Description             "codigoGeneracion": "0B6A7F63-2D1C-4E0F-9A2B-...",
+// @Description             "tipoModelo": 1,
+// @Description             "tipoOperacion": 1,
+// @Description             "tipoContingencia": null,
+// @Description             "motivoContin": null,
+// @Description             "fecEmi": "2025-04-16",
+// @Description             "horEmi": "20:40:56",
+// @Description             "tipoMoneda": "USD"
+// @Description         },
+// @Description         "emisor": {
+// @Description             "nit": "00000000000000",
+// @Description             "nrc": "0000000",
+// @Description             "nombre": "EMPRESA DE PRUEBAS SA DE CV 2",
+// @Description             "codActividad": "00000",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "BOULEVARD SANTA ELENA SUR, SANTA TECLA"
+// @Description             },
+// @Description             "telefono": "21212828",
+// @Description             "codEstableMH": null,
+// @Description             "codEstable": "C002",
+// @Description             "codPuntoVentaMH": null,
+// @Description             "codPuntoVenta": null,
+// @Description             "correo": "facturacion@empresa.com.sv"
+// @Description         },
+// @Description         "sujetoExcluido": {
+// @Description             "tipoDocumento": "13",
+// @Description             "numDocumento": "00000000-0",
+// @Description             "nombre": "JUAN PÉREZ",
+// @Description             "codActividad": "01111",
+// @Description             "descActividad": "Cultivo de cereales",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "Cantón El Rosario, Caserío Los Pinos"
+// @Description             },
+// @Description             "telefono": "77777777",
+// @Description             "correo": "juan.perez@gmail.com"
+// @Description         },
+// @Description         "cuerpoDocumento": [
+// @Description             {
+// @Description                 "numItem": 1,
+// @Description                 "tipoItem": 1,
+// @Description                 "cantidad": 10,
+// @Description                 "codigo": "GRANO001",
+// @Description                 "uniMedida": 59,
+// @Description                 "descripcion": "Compra de granos básicos",
+// @Description                 "precioUni": 25,
+// @Description                 "montoDescu": 0,
+// @Description                 "compra": 250
+// @Description             },
+// @Description             {
+// @Description                 "numItem": 2,
+// @Description                 "tipoItem": 2,
+// @Description                 "cantidad": 1,
+// @Description                 "codigo": null,
+// @Description                 "uniMedida": 99,
+// @Description                 "descripcion": "Servicio de transporte de mercadería",
+// @Description                 "precioUni": 50,
+// @Description                 "montoDescu": 0,
+// @Description                 "compra": 50
+// @Description             }
+// @Description         ],
+// @Description         "resumen": {
+// @Description             "totalCompra": 300,
+// @Description             "descu": 0,
+// @Description             "totalDescu": 0,
+// @Description             "subTotal": 300,
+// @Description             "ivaRete1": 0,
+// @Description             "reteRenta": 30,
+// @Description             "totalPagar": 270,
+// @Description             "totalLetras": "DOSCIENTOS SETENTA 00/100",
+// @Description             "condicionOperacion": 1,
+// @Description             "pagos": [
+// @Description                 {
+// @Description                     "codigo": "01",
+// @Description                     "montoPago": 270,
+// @Description                     "referencia": null,
+// @Description                     "plazo": null,
+// @Description                     "periodo": null
+// @Description                 }
+// @Description             ],
+// @Description             "observaciones": "Compra a productor local"
+// @Description         },
+// @Description         "apendice": [
+// @Description             {
+// @Description                 "campo": "Datos del documento",
+// @Description                 "etiqueta": "Sello de recepción",
+// @Description                 "valor": "202534D1BECF33214..."
+// @Description             }
+// @Description         ]
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description Para ver ejemplos completos, consulta: /jsonExamples/
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param async query bool false "Emitir de forma asíncrona; si se omite se usa la configuración de la sucursal"
+// @Param fse body object true "Datos de la factura de sujeto excluido"
+// @Success 201 {object} map[string]interface{}
+// @Success 202 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/fse [post]
+func (h *GenericCreatorDTEHandler) CreateFSE(w http.ResponseWriter, r *http.Request) {
+	h.HandleCreate(w, r)
+}
+
+// CreateExportInvoice godoc
+// @Summary Crear Factura de Exportación
+// @Description Este endpoint permite crear y emitir una Factura de Exportación electrónica.
+// @Description 
+// @Description ## Ejemplo de Solicitud
+// @Description ```json
+// @Description {
+// @Description     "items": [
+// @Description         {
+// @Description             "type": 1,
+// @Description             "description": "Café oro lavado de altura, saco de 69 kg",
+// @Description             "quantity": 20,
+// @Description             "unit_measure": 59,
+// @Description             "unit_price": 250.00,
+// @Description             "discount": 0,
+// @Description             "code": "CAFE001",
+// @Description             "taxes": ["C3"],
+// @Description             "taxed_sale": 5000.00,
+// @Description             "non_taxed": 0
+// @Description         },
+// @Description         {
+// @Description             "type": 1,
+// @Description             "description": "Azúcar refinada, quintal",
+// @Description             "quantity": 40,
+// @Description             "unit_measure": 59,
+// @Description             "unit_price": 50.00,
+// @Description             "discount": 100.00,
+// @Description             "code": "AZU001",
+// @Description             "taxes": ["C3"],
+// @Description             "taxed_sale": 1900.00,
+// @Description             "non_taxed": 0
+// @Description         }
+// @Description     ],
+// @Description     "receiver": {
+// @Description         "name": "GLOBAL IMPORTS LLC",
+// @Description         "document_type": "37",
+// @Description         "document_number": "EIN-12-3456789",
+// @Description         "commercial_name": "GLOBAL IMPORTS",
+// @Description         "country_code": "9450",
+// @Description         "country_name": "ESTADOS UNIDOS",
+// @Description         "complement": "1200 Brickell Ave, Miami, FL 33131",
+// @Description         "person_type": 2,
+// @Description         "activity_description": "Importación y distribución de alimentos",
+// @Description         "phone": "13055550100",
+// @Description         "email": "compras@globalimports.com"
+// @Description     },
+// @Description     "export_data": {
+// @Description         "item_export_type": 1,
+// @Description         "fiscal_precinct": "02",
+// @Description         "regime": "EX-1.1000.000"
+// @Description     },
+// @Description     "summary": {
+// @Description         "total_taxed": 6900.00,
+// @Description         "discount": 0,
+// @Description         "discount_percentage": 0,
+// @Description         "total_discount": 100.00,
+// @Description         "insurance": 150.00,
+// @Description         "freight": 350.00,
+// @Description         "total_operation": 7400.00,
+// @Description         "total_non_taxed": 0,
+// @Description         "total_to_pay": 7400.00,
+// @Description         "operation_condition": 1,
+// @Description         "payment_types": [
+// @Description             {
+// @Description                 "code": "05",
+// @Description                 "amount": 7400.00
+// @Description             }
+// @Description         ],
+// @Description         "incoterm_code": "11",
+// @Description         "observations": "Embarque por Puerto de Acajutla"
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description ## Ejemplo de Respuesta
+// @Description ```json
+// @Description {
+// @Description     "success": true,
+// @Description     "reception_stamp": "2025A1F3C0B9E2D84E1...",
+// @Description     "qr_link": "https://admin.factura.gob.sv/consultaPublica?ambiente=00&codGen=7D3C1A2B-5E4F-4A6B-8...&fechaEmi=FECHA-DE-EMISION",
+// @Description     "data": {
+// @Description         "identificacion": {
+// @Description             "version": 1,
+// @Description             "ambiente": "00",
+// @Description             "tipoDte": "11",
+// @Description             "numeroControl": "DTE-11-C0020000-000000000000001",
+// @Description             "codigoGeneracion": "7D3C1A2B-5E4F-4A6B-8...",
+// @Description             "tipoModelo": 1,
+// @Description             "tipoOperacion": 1,
+// @Description             "tipoContingencia": null,
+// @Description             "motivoContigencia": null,
+// @Description             "fecEmi": "2025-04-20",
+// @Description             "horEmi": "10:15:32",
+// @Description             "tipoMoneda": "USD"
+// @Description         },
+// @Description         "emisor": {
+// @Description             "nit": "00000000000000",
+// @Description             "nrc": "0000000",
+// @Description             "nombre": "EMPRESA DE PRUEBAS SA DE CV 2",
+// @Description             "codActividad": "00000",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "nombreComercial": null,
+// @Description             "tipoEstablecimiento": "02",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "BOULEVARD SANTA ELENA SUR, SANTA TECLA"
+// @Description             },
+// @Description             "telefono": "21212828",
+// @Description             "correo": "facturacion@empresa.com.sv",
+// @Description             "codEstableMH": null,
+// @Description             "codEstable": "C002",
+// @Description             "codPuntoVentaMH": null,
+// @Description             "codPuntoVenta": null,
+// @Description             "tipoItemExpor": 1,
+// @Description             "recintoFiscal": "02",
+// @Description             "regimen": "EX-1.1000.000"
+// @Description         },
+// @Description         "receptor": {
+// @Description             "nombre": "GLOBAL IMPORTS LLC",
+// @Description             "tipoDocumento": "37",
+// @Description             "numDocumento": "EIN-12-3456789",
+// @Description             "nombreComercial": "GLOBAL IMPORTS",
+// @Description             "codPais": "9450",
+// @Description             "nombrePais": "ESTADOS UNIDOS",
+// @Description             "complemento": "1200 Brickell Ave, Miami, FL 33131",
+// @Description             "tipoPersona": 2,
+// @Description             "descActividad": "Importación y distribución de alimentos",
+// @Description             "telefono": "13055550100",
+// @Description             "correo": "compras@globalimports.com"
+// @Description         },
+// @Description         "otrosDocumentos": null,
+// @Description         "ventaTercero": null,
+// @Description         "cuerpoDocumento": [
+// @Description             {
+// @Description                 "numItem": 1,
+// @Description                 "cantidad": 20,
+// @Description                 "codigo": "CAFE001",
+// @Description                 "uniMedida": 59,
+// @Description                 "descripcion": "Café oro lavado de altura, saco de 69 kg",
+// @Description                 "precioUni": 250,
+// @Description                 "montoDescu": 0,
+// @Description                 "ventaGravada": 5000,
+// @Description                 "tributos": ["C3"],
+// @Description                 "noGravado": 0
+// @Description             },
+// @Description             {
+// @Description                 "numItem": 2,
+// @Description                 "cantidad": 40,
+// @Description                 "codigo": "AZU001",
+// @Description                 "uniMedida": 59,
+// @Description                 "descripcion": "Azúcar refinada, quintal",
+// @Description                 "precioUni": 50,
+// @Description                 "montoDescu": 100,
+// @Description                 "ventaGravada": 1900,
+// @Description                 "tributos": ["C3"],
+// @Description                 "noGravado": 0
+// @Description             }
+// @Description         ],
+// @Description         "resumen": {
+// @Description             "totalGravada": 6900,
+// @Description             "descuento": 0,
+// @Description             "porcentajeDescuento": 0,
+// @Description             "totalDescu": 100,
+// @Description             "seguro": 150,
+// @Description             "flete": 350,
+// @Description             "montoTotalOperacion": 7400,
+// @Description             "totalNoGravado": 0,
+// @Description             "totalPagar": 7400,
+// @Description             "totalLetras": "SIETE MIL CUATROCIENTOS 00/100",
+// @Description             "condicionOperacion": 1,
+// @Description             "pagos": [
+// @Description                 {
+// @Description                     "codigo": "05",
+// @Description                     "montoPago": 7400,
+// @Description                     "referencia": null,
+// @Description                     "plazo": null,
+// @Description                     "periodo": null
+// @Description                 }
+// @Description             ],
+// @Description             "codIncoterms": "11",
+// @Description             "descIncoterms": "CIF-Costo seguro y flete",
+// @Description             "numPagoElectronico": null,
+// @Description             "observaciones": "Embarque por Puerto de Acajutla"
+// @Description         },
+// @Description         "apendice": [
+// @Description             {
+// @Description                 "campo": "Datos del documento",
+// @Description                 "etiqueta": "Sello de recepción",
+// @Description                 "valor": "2025A1F3C0B9E2D84E1..."
+// @Description             }
+// @Description         ]
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description Para ver ejemplos completos, consulta: /jsonExamples/
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param async query bool false "Emitir de forma asíncrona; si se omite se usa la configuración de la sucursal"
+// @Param export body object true "Datos de la factura de exportación"
+// @Success 201 {object} map[string]interface{}
+// @Success 202 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/export [post]
+func (h *GenericCreatorDTEHandler) CreateExportInvoice(w http.ResponseWriter, r *http.Request) {
+	h.HandleCreate(w, r)
+}
+
+// CreateRemissionNote godoc
+// @Summary Crear Nota de Remisión
+// @Description Este endpoint permite crear y emitir una Nota de Remisión electrónica.
+// @Description 
+// @Description ## Ejemplo de Solicitud
+// @Description ```json
+// @Description {
+// @Description     "items": [
+// @Description         {
+// @Description             "type": 1,
+// @Description             "description": "Traslado de mercadería a sucursal",
+// @Description             "quantity": 10,
+// @Description             "unit_measure": 59,
+// @Description             "unit_price": 50.00,
+// @Description             "taxed_sale": 500.00,
+// @Description             "exempt_sale": 0,
+// @Description             "non_subject_sale": 0,
+// @Description             "taxes": [
+// @Description                 "20"
+// @Description             ]
+// @Description         }
+// @Description     ],
+// @Description     "receiver": {
+// @Description         "document_type": "36",
+// @Description         "document_number": "06142010901015",
+// @Description         "nrc": "1234567",
+// @Description         "name": "CLIENTE DE PRUEBA",
+// @Description         "commercial_name": "EJEMPLO S.A de S.V",
+// @Description         "activity_code": "47190",
+// @Description         "activity_description": "ACTIVIDADES JURÍDICAS Y CONTABLES",
+// @Description         "address": {
+// @Description             "department": "06",
+// @Description             "municipality": "22",
+// @Description             "complement": "Dirección de Prueba 1, N° 1234"
+// @Description         },
+// @Description         "phone": "21212828",
+// @Description         "email": "cliente@gmail.com"
+// @Description     },
+// @Description     "goods_title": "04",
+// @Description     "summary": {
+// @Description         "total_taxed": 500.00,
+// @Description         "sub_total_sales": 500.00,
+// @Description         "sub_total": 500.00,
+// @Description         "total_operation": 565.00,
+// @Description         "taxes": [
+// @Description             {
+// @Description                 "code": "20",
+// @Description                 "description": "IVA 13%",
+// @Description                 "value": 65.00
+// @Description             }
+// @Description         ]
+// @Description     },
+// @Description     "extension": {
+// @Description         "delivery_name": "Juan Pérez",
+// @Description         "delivery_document": "06141809931020",
+// @Description         "receiver_name": "María López",
+// @Description         "receiver_document": "06142509882011",
+// @Description         "observation": "Traslado en camión placa C123456"
+// @Description     },
+// @Description     "related_docs": null,
+// @Description     "appendixes": null,
+// @Description     "third_party_sale": null
+// @Description }
+// @Description ```
+// @Description 
+// @Description ## Ejemplo de Respuesta
+// @Description ```json
+// @Description {
+// @Description     "success": true,
+// @Description     "reception_stamp": "2025A1B2C3D4E5F6071...",
+// @Description     "qr_link": "https://admin.factura.gob.sv/consultaPublica?ambiente=00&codGen=5FF457A7-564A-45DE-8...&fechaEmi=FECHA-DE-EMISION",
+// @Description     "data": {
+// @Description         "identificacion": {
+// @Description             "version": 3,
+// @Description             "ambiente": "00",
+// @Description             "tipoDte": "04",
+// @Description             "numeroControl": "DTE-04-C0020000-000000000000001",
+// @Description             "codigoGeneracion": "5FF457A7-564A-45DE-8...",
+// @Description             "tipoModelo": 1,
+// @Description             "tipoOperacion": 1,
+// @Description             "tipoContingencia": null,
+// @Description             "motivoContin": null,
+// @Description             "fecEmi": "2025-04-16",
+// @Description             "horEmi": "17:54:19",
+// @Description             "tipoMoneda": "USD"
+// @Description         },
+// @Description         "documentoRelacionado": null,
+// @Description         "emisor": {
+// @Description             "nit": "00000000000000",
+// @Description             "nrc": "0000000",
+// @Description             "nombre": "EMPRESA DE PRUEBAS SA DE CV 2",
+// @Description             "codActividad": "00000",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "tipoEstablecimiento": "01",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "BOULEVARD SANTA ELENA SUR, SANTA TECLA"
+// @Description             },
+// @Description             "telefono": "21212828",
+// @Description             "correo": "facturacion@empresa.com.sv",
+// @Description             "nombreComercial": "EJEMPLO",
+// @Description             "codEstableMH": null,
+// @Description             "codEstable": "C002",
+// @Description             "codPuntoVentaMH": null,
+// @Description             "codPuntoVenta": null
+// @Description         },
+// @Description         "receptor": {
+// @Description             "nombre": "CLIENTE DE PRUEBA",
+// @Description             "tipoDocumento": "36",
+// @Description             "numDocumento": "06142010901015",
+// @Description             "nrc": "1234567",
+// @Description             "codActividad": "47190",
+// @Description             "descActividad": "ACTIVIDADES JURÍDICAS Y CONTABLES",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "22",
+// @Description                 "complemento": "Dirección de Prueba 1, N° 1234"
+// @Description             },
+// @Description             "telefono": "21212828",
+// @Description             "correo": "cliente@gmail.com",
+// @Description             "nombreComercial": "EJEMPLO S.A de S.V",
+// @Description             "bienTitulo": "04"
+// @Description         },
+// @Description         "ventaTercero": null,
+// @Description         "cuerpoDocumento": [
+// @Description             {
+// @Description                 "numItem": 1,
+// @Description                 "tipoItem": 1,
+// @Description                 "numeroDocumento": null,
+// @Description                 "codigo": null,
+// @Description                 "codTributo": null,
+// @Description                 "descripcion": "Traslado de mercadería a sucursal",
+// @Description                 "cantidad": 10,
+// @Description                 "uniMedida": 59,
+// @Description                 "precioUni": 50,
+// @Description                 "montoDescu": 0,
+// @Description                 "ventaNoSuj": 0,
+// @Description                 "ventaExenta": 0,
+// @Description                 "ventaGravada": 500,
+// @Description                 "tributos": [
+// @Description                     "20"
+// @Description                 ]
+// @Description             }
+// @Description         ],
+// @Description         "resumen": {
+// @Description             "totalNoSuj": 0,
+// @Description             "totalExenta": 0,
+// @Description             "totalGravada": 500,
+// @Description             "subTotalVentas": 500,
+// @Description             "descuNoSuj": 0,
+// @Description             "descuExenta": 0,
+// @Description             "descuGravada": 0,
+// @Description             "porcentajeDescuento": 0,
+// @Description             "totalDescu": 0,
+// @Description             "tributos": [
+// @Description                 {
+// @Description                     "codigo": "20",
+// @Description                     "descripcion": "IVA 13%",
+// @Description                     "valor": 65
+// @Description                 }
+// @Description             ],
+// @Description             "subTotal": 500,
+// @Description             "montoTotalOperacion": 565,
+// @Description             "totalLetras": "QUINIENTOS SESENTA Y CINCO 00/100"
+// @Description         },
+// @Description         "extension": {
+// @Description             "nombEntrega": "Juan Pérez",
+// @Description             "docuEntrega": "06141809931020",
+// @Description             "nombRecibe": "María López",
+// @Description             "docuRecibe": "06142509882011",
+// @Description             "observaciones": "Traslado en camión placa C123456"
+// @Description         },
+// @Description         "apendice": [
+// @Description             {
+// @Description                 "campo": "Datos del documento",
+// @Description                 "etiqueta": "Sello de recepción",
+// @Description                 "valor": "2025A1B2C3D4E5F6071..."
+// @Description             }
+// @Description         ]
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description Para ver ejemplos completos, consulta: /jsonExamples/
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param async query bool false "Emitir de forma asíncrona; si se omite se usa la configuración de la sucursal"
+// @Param remission body object true "Datos de la nota de remisión"
+// @Success 201 {object} map[string]interface{}
+// @Success 202 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/remission [post]
+func (h *GenericCreatorDTEHandler) CreateRemissionNote(w http.ResponseWriter, r *http.Request) {
+	h.HandleCreate(w, r)
+}
+
+// CreateLiquidation godoc
+// @Summary Crear Comprobante de Liquidación
+// @Description Este endpoint permite crear y emitir un Comprobante de Liquidación electrónico.
+// @Description 
+// @Description ## Ejemplo de Solicitud
+// @Description ```json
+// @Description {
+// @Description     "items": [
+// @Description         {
+// @Description             "dte_type": "03",
+// @Description             "generation_type": 2,
+// @Description             "document_number": "1EEAB582-AA75-4D9C-A...",
+// @Description             "non_subject_sale": 0,
+// @Description             "exempt_sale": 0,
+// @Description             "taxed_sale": 100,
+// @Description             "export_sale": 0,
+// @Description             "taxes": ["20"],
+// @Description             "iva_item": 13,
+// @Description             "observation": "Venta en consignación de la primera quincena"
+// @Description         },
+// @Description         {
+// @Description             "dte_type": "01",
+// @Description             "generation_type": 1,
+// @Description             "document_number": "0005678",
+// @Description             "generation_date": "2025-04-12",
+// @Description             "non_subject_sale": 0,
+// @Description             "exempt_sale": 50,
+// @Description             "taxed_sale": 0,
+// @Description             "export_sale": 0,
+// @Description             "iva_item": 0
+// @Description         }
+// @Description     ],
+// @Description     "receiver": {
+// @Description         "nit": "06142803901121",
+// @Description         "nrc": "2356789",
+// @Description         "name": "EJEMPLO S.A de S.V",
+// @Description         "commercial_name": "EJEMPLO",
+// @Description         "activity_code": "46900",
+// @Description         "activity_description": "Venta al por mayor de otros productos",
+// @Description         "address": {
+// @Description             "department": "06",
+// @Description             "municipality": "20",
+// @Description             "complement": "Dirección de Prueba 1, N° 1234"
+// @Description         },
+// @Description         "phone": "21212121",
+// @Description         "email": "cliente@gmail.com"
+// @Description     },
+// @Description     "summary": {
+// @Description         "total_non_subject": 0,
+// @Description         "total_exempt": 50,
+// @Description         "total_taxed": 100,
+// @Description         "total_export": 0,
+// @Description         "sub_total_sales": 150,
+// @Description         "taxes": [
+// @Description             {
+// @Description                 "code": "20",
+// @Description                 "description": "Impuesto al Valor Agregado 13%",
+// @Description                 "value": 13
+// @Description             }
+// @Description         ],
+// @Description         "total_operation": 163,
+// @Description         "iva_perception": 1,
+// @Description         "total": 164,
+// @Description         "operation_condition": 1
+// @Description     },
+// @Description     "extension": {
+// @Description         "delivery_name": "Juan Pérez",
+// @Description         "delivery_document": "06141809931020",
+// @Description         "receiver_name": "María López",
+// @Description         "receiver_document": "06142509882011",
+// @Description         "observation": "Liquidación de mercadería en consignación"
+// @Description     },
+// @Description     "appendixes": null
+// @Description }
+// @Description ```
+// @Description 
+// @Description ## Ejemplo de Respuesta
+// @Description ```json
+// @Description {
+// @Description     "success": true,
+// @Description     "reception_stamp": "2025A1B2C3D4E5F6071...",
+// @Description     "qr_link": "https://admin.factura.gob.sv/consultaPublica?ambiente=00&codGen=5FF457A7-564A-45DE-8...&fechaEmi=FECHA-DE-EMISION",
+// @Description     "data": {
+// @Description         "identificacion": {
+// @Description             "version": 1,
+// @Description             "ambiente": "00",
+// @Description             "tipoDte": "08",
+// @Description             "numeroControl": "DTE-08-C0020000-000000000000001",
+// @Description             "codigoGeneracion": "5FF457A7-564A-45DE-8...",
+// @Description             "tipoModelo": 1,
+// @Description             "tipoOperacion": 1,
+// @Description             "tipoContingencia": null,
+// @Description             "motivoContin": null,
+// @Description             "fecEmi": "2025-04-16",
+// @Description             "horEmi": "17:54:19",
+// @Description             "tipoMoneda": "USD"
+// @Description         },
+// @Description         "emisor": {
+// @Description             "nit": "00000000000000",
+// @Description             "nrc": "0000000",
+// @Description             "nombre": "EMPRESA DE PRUEBAS SA DE CV 2",
+// @Description             "codActividad": "00000",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "tipoEstablecimiento": "01",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "BOULEVARD SANTA ELENA SUR, SANTA TECLA"
+// @Description             },
+// @Description             "telefono": "21212828",
+// @Description             "correo": "facturacion@empresa.com.sv",
+// @Description             "nombreComercial": "EJEMPLO",
+// @Description             "codEstableMH": null,
+// @Description             "codEstable": "C002",
+// @Description             "codPuntoVentaMH": null,
+// @Description             "codPuntoVenta": null
+// @Description         },
+// @Description         "receptor": {
+// @Description             "nombre": "EJEMPLO S.A de S.V",
+// @Description             "nrc": "2356789",
+// @Description             "nit": "06142803901121",
+// @Description             "codActividad": "46900",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "Dirección de Prueba 1, N° 1234"
+// @Description             },
+// @Description             "telefono": "21212121",
+// @Description             "correo": "cliente@gmail.com",
+// @Description             "nombreComercial": "EJEMPLO"
+// @Description         },
+// @Description         "cuerpoDocumento": [
+// @Description             {
+// @Description                 "numItem": 1,
+// @Description                 "tipoDte": "03",
+// @Description                 "tipoGeneracion": 2,
+// @Description                 "numeroDocumento": "1EEAB582-AA75-4D9C-A...",
+// @Description                 "fechaGeneracion": "2025-04-10",
+// @Description                 "ventaNoSuj": 0,
+// @Description                 "ventaExenta": 0,
+// @Description                 "ventaGravada": 100,
+// @Description                 "exportaciones": 0,
+// @Description                 "tributos": [
+// @Description                     "20"
+// @Description                 ],
+// @Description                 "ivaItem": 13,
+// @Description                 "obsItem": "Venta en consignación de la primera quincena"
+// @Description             },
+// @Description             {
+// @Description                 "numItem": 2,
+// @Description                 "tipoDte": "01",
+// @Description                 "tipoGeneracion": 1,
+// @Description                 "numeroDocumento": "0005678",
+// @Description                 "fechaGeneracion": "2025-04-12",
+// @Description                 "ventaNoSuj": 0,
+// @Description                 "ventaExenta": 50,
+// @Description                 "ventaGravada": 0,
+// @Description                 "exportaciones": 0,
+// @Description                 "tributos": null,
+// @Description                 "ivaItem": 0,
+// @Description                 "obsItem": null
+// @Description             }
+// @Description         ],
+// @Description         "resumen": {
+// @Description             "totalNoSuj": 0,
+// @Description             "totalExenta": 50,
+// @Description             "totalGravada": 100,
+// @Description             "totalExportacion": 0,
+// @Description             "subTotalVentas": 150,
+// @Description             "tributos": [
+// @Description                 {
+// @Description                     "codigo": "20",
+// @Description                     "descripcion": "Impuesto al Valor Agregado 13%",
+// @Description                     "valor": 13
+// @Description                 }
+// @Description             ],
+// @Description             "montoTotalOperacion": 163,
+// @Description             "ivaPerci": 1,
+// @Description             "total": 164,
+// @Description             "totalLetras": "CIENTO SESENTA Y CUATRO 00/100",
+// @Description             "condicionOperacion": 1
+// @Description         },
+// @Description         "extension": {
+// @Description             "nombEntrega": "Juan Pérez",
+// @Description             "docuEntrega": "06141809931020",
+// @Description             "nombRecibe": "María López",
+// @Description             "docuRecibe": "06142509882011",
+// @Description             "observaciones": "Liquidación de mercadería en consignación"
+// @Description         },
+// @Description         "apendice": [
+// @Description             {
+// @Description                 "campo": "Datos del documento",
+// @Description                 "etiqueta": "Sello de recepción",
+// @Description                 "valor": "2025A1B2C3D4E5F6071..."
+// @Description             }
+// @Description         ]
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description Para ver ejemplos completos, consulta: /jsonExamples/
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param async query bool false "Emitir de forma asíncrona; si se omite se usa la configuración de la sucursal"
+// @Param liquidation body object true "Datos del comprobante de liquidación"
+// @Success 201 {object} map[string]interface{}
+// @Success 202 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/liquidation [post]
+func (h *GenericCreatorDTEHandler) CreateLiquidation(w http.ResponseWriter, r *http.Request) {
+	h.HandleCreate(w, r)
+}
+
+// CreateAccountingLiquidation godoc
+// @Summary Crear Documento Contable de Liquidación
+// @Description Este endpoint permite crear y emitir un Documento Contable de Liquidación electrónico.
+// @Description 
+// @Description ## Ejemplo de Solicitud
+// @Description ```json
+// @Description {
+// @Description     "receiver": {
+// @Description         "nit": "06142803901121",
+// @Description         "nrc": "2356789",
+// @Description         "name": "EJEMPLO S.A de S.V",
+// @Description         "commercial_name": "EJEMPLO",
+// @Description         "activity_code": "46900",
+// @Description         "activity_description": "Venta al por mayor de otros productos",
+// @Description         "address": {
+// @Description             "department": "06",
+// @Description             "municipality": "20",
+// @Description             "complement": "Dirección de Prueba 1, N° 1234"
+// @Description         },
+// @Description         "phone": "21212121",
+// @Description         "email": "cliente@gmail.com"
+// @Description     },
+// @Description     "body": {
+// @Description         "period_start": "2025-04-01",
+// @Description         "period_end": "2025-04-15",
+// @Description         "liquidation_code": "LIQ-2025-0001",
+// @Description         "document_count": 12,
+// @Description         "operations_value": 1500,
+// @Description         "amount_without_perception": 500,
+// @Description         "without_perception_description": "Ventas a consumidor final",
+// @Description         "sub_total": 1000,
+// @Description         "iva": 130,
+// @Description         "amount_subject_to_perception": 1000,
+// @Description         "iva_perceived": 10,
+// @Description         "commission": 100,
+// @Description         "commission_percentage": 10,
+// @Description         "commission_iva": 13,
+// @Description         "net_amount_to_pay": 1007,
+// @Description         "observations": "Liquidación de la primera quincena de abril"
+// @Description     },
+// @Description     "extension": {
+// @Description         "delivery_name": "Juan Pérez",
+// @Description         "delivery_document": "06141809931020",
+// @Description         "employee_code": "EMP-001"
+// @Description     },
+// @Description     "appendixes": null
+// @Description }
+// @Description ```
+// @Description 
+// @Description ## Ejemplo de Respuesta
+// @Description ```json
+// @Description {
+// @Description     "success": true,
+// @Description     "reception_stamp": "2025A1B2C3D4E5F6071...",
+// @Description     "qr_link": "https://admin.factura.gob.sv/consultaPublica?ambiente=00&codGen=5FF457A7-564A-45DE-8...&fechaEmi=FECHA-DE-EMISION",
+// @Description     "data": {
+// @Description         "identificacion": {
+// @Description             "version": 1,
+// @Description             "ambiente": "00",
+// @Description             "tipoDte": "09",
+// @Description             "numeroControl": "DTE-09-C0020000-000000000000001",
+// @Description             "codigoGeneracion": "5FF457A7-564A-45DE-8...",
+// @Description             "tipoModelo": 1,
+// @Description             "tipoOperacion": 1,
+// @Description             "tipoContingencia": null,
+// @Description             "motivoContin": null,
+// @Description             "fecEmi": "2025-04-16",
+// @Description             "horEmi": "17:54:19",
+// @Description             "tipoMoneda": "USD"
+// @Description         },
+// @Description         "emisor": {
+// @Description             "nit": "00000000000000",
+// @Description             "nrc": "0000000",
+// @Description             "nombre": "EMPRESA DE PRUEBAS SA DE CV 2",
+// @Description             "codActividad": "00000",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "tipoEstablecimiento": "01",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "BOULEVARD SANTA ELENA SUR, SANTA TECLA"
+// @Description             },
+// @Description             "telefono": "21212828",
+// @Description             "correo": "facturacion@empresa.com.sv",
+// @Description             "nombreComercial": "EJEMPLO",
+// @Description             "codEstableMH": null,
+// @Description             "codEstable": "C002",
+// @Description             "codPuntoVentaMH": null,
+// @Description             "codPuntoVenta": null
+// @Description         },
+// @Description         "receptor": {
+// @Description             "nombre": "EJEMPLO S.A de S.V",
+// @Description             "nrc": "2356789",
+// @Description             "nit": "06142803901121",
+// @Description             "codActividad": "46900",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "Dirección de Prueba 1, N° 1234"
+// @Description             },
+// @Description             "telefono": "21212121",
+// @Description             "correo": "cliente@gmail.com",
+// @Description             "nombreComercial": "EJEMPLO"
+// @Description         },
+// @Description         "cuerpoDocumento": {
+// @Description             "periodoLiquidacionFechaInicio": "2025-04-01",
+// @Description             "periodoLiquidacionFechaFin": "2025-04-15",
+// @Description             "codLiquidacion": "LIQ-2025-0001",
+// @Description             "cantidadDoc": 12,
+// @Description             "valorOperaciones": 1500,
+// @Description             "montoSinPercepcion": 500,
+// @Description             "descripSinPercepcion": "Ventas a consumidor final",
+// @Description             "subTotal": 1000,
+// @Description             "iva": 130,
+// @Description             "montoSujetoPercepcion": 1000,
+// @Description             "ivaPercibido": 10,
+// @Description             "comision": 100,
+// @Description             "porcentComision": "10",
+// @Description             "ivaComision": 13,
+// @Description             "liquidoApagar": 1007,
+// @Description             "totalLetras": "UN MIL SIETE 00/100",
+// @Description             "observaciones": "Liquidación de la primera quincena de abril"
+// @Description         },
+// @Description         "extension": {
+// @Description             "nombEntrega": "Juan Pérez",
+// @Description             "docuEntrega": "06141809931020",
+// @Description             "codEmpleado": "EMP-001"
+// @Description         },
+// @Description         "apendice": [
+// @Description             {
+// @Description                 "campo": "Datos del documento",
+// @Description                 "etiqueta": "Sello de recepción",
+// @Description                 "valor": "2025A1B2C3D4E5F6071..."
+// @Description             }
+// @Description         ]
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description Para ver ejemplos completos, consulta: /jsonExamples/
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param async query bool false "Emitir de forma asíncrona; si se omite se usa la configuración de la sucursal"
+// @Param accounting_liquidation body object true "Datos del documento contable de liquidación"
+// @Success 201 {object} map[string]interface{}
+// @Success 202 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/accounting-liquidation [post]
+func (h *GenericCreatorDTEHandler) CreateAccountingLiquidation(w http.ResponseWriter, r *http.Request) {
+	h.HandleCreate(w, r)
+}
+
+// CreateDonation godoc
+// @Summary Crear Comprobante de Donación
+// @Description Este endpoint permite crear y emitir un Comprobante de Donación electrónico.
+// @Description 
+// @Description ## Ejemplo de Solicitud
+// @Description ```json
+// @Description {
+// @Description     "items": [
+// @Description         {
+// @Description             "type": 1,
+// @Description             "description": "Donación en efectivo para programa de becas",
+// @Description             "quantity": 1,
+// @Description             "unit_measure": 99,
+// @Description             "depreciation": 0,
+// @Description             "unit_value": 500.00,
+// @Description             "value": 500.00
+// @Description         },
+// @Description         {
+// @Description             "type": 2,
+// @Description             "description": "Computadoras portátiles usadas",
+// @Description             "quantity": 5,
+// @Description             "unit_measure": 59,
+// @Description             "code": "EQ-LAPTOP",
+// @Description             "depreciation": 250.00,
+// @Description             "unit_value": 300.00,
+// @Description             "value": 1250.00
+// @Description         }
+// @Description     ],
+// @Description     "donor": {
+// @Description         "document_type": "36",
+// @Description         "document_number": "06141234567890",
+// @Description         "nrc": "1234567",
+// @Description         "name": "DISTRIBUIDORA EL SOL SA DE CV",
+// @Description         "activity_code": "46900",
+// @Description         "activity_description": "Venta al por mayor de otros productos",
+// @Description         "address": {
+// @Description             "department": "06",
+// @Description             "municipality": "20",
+// @Description             "complement": "COLONIA ESCALÓN, SAN SALVADOR"
+// @Description         },
+// @Description         "phone": "22223333",
+// @Description         "email": "contabilidad@elsol.com.sv",
+// @Description         "domiciled_code": 1,
+// @Description         "country_code": "9300"
+// @Description     },
+// @Description     "other_docs": [
+// @Description         {
+// @Description             "document_code": 1,
+// @Description             "description": "Acuerdo de calificación como entidad de utilidad pública",
+// @Description             "detail": "Acuerdo ejecutivo No. 123 de fecha 10/01/2024"
+// @Description         }
+// @Description     ],
+// @Description     "summary": {
+// @Description         "total_value": 1750.00,
+// @Description         "payment_types": [
+// @Description             {
+// @Description                 "code": "01",
+// @Description                 "amount": 500.00
+// @Description             }
+// @Description         ]
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description ## Ejemplo de Respuesta
+// @Description ```json
+// @Description {
+// @Description     "success": true,
+// @Description     "reception_stamp": "202534D1BECF3321453...",
+// @Description     "qr_link": "https://admin.factura.gob.sv/consultaPublica?ambiente=00&codGen=7D1E3B2A-5C4F-4E8A-9B6D-...&fechaEmi=FECHA-DE-EMISION",
+// @Description     "data": {
+// @Description         "identificacion": {
+// @Description             "version": 1,
+// @Description             "ambiente": "00",
+// @Description             "tipoDte": "15",
+// @Description             "numeroControl": "DTE-15-C0020000-000000000000001",
+// @Description             "codigoGeneracion": "7D1E3B2A-5C4F-4E8A-9B6D-...",
+// @Description             "tipoModelo": 1,
+// @Description             "tipoOperacion": 1,
+// @Description             "tipoContingencia": null,
+// @Description             "motivoContin": null,
+// @Description             "fecEmi": "2025-04-16",
+// @Description             "horEmi": "20:40:56",
+// @Description             "tipoMoneda": "USD"
+// @Description         },
+// @Description         "donatario": {
+// @Description             "tipoDocumento": "36",
+// @Description             "numDocumento": "00000000000000",
+// @Description             "nrc": "0000000",
+// @Description             "nombre": "EMPRESA DE PRUEBAS SA DE CV 2",
+// @Description             "codActividad": "00000",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "nombreComercial": null,
+// @Description             "tipoEstablecimiento": "01",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "BOULEVARD SANTA ELENA SUR, SANTA TECLA"
+// @Description             },
+// @Description             "telefono": "21212828",
+// @Description             "correo": "facturacion@empresa.com.sv",
+// @Description             "codEstableMH": null,
+// @Description             "codEstable": "C002",
+// @Description             "codPuntoVentaMH": null,
+// @Description             "codPuntoVenta": null
+// @Description         },
+// @Description         "donante": {
+// @Description             "tipoDocumento": "36",
+// @Description             "numDocumento": "06141234567890",
+// @Description             "nrc": "1234567",
+// @Description             "nombre": "DISTRIBUIDORA EL SOL SA DE CV",
+// @Description             "codActividad": "46900",
+// @Description             "descActividad": "Venta al por mayor de otros productos",
+// @Description             "direccion": {
+// @Description                 "departamento": "06",
+// @Description                 "municipio": "20",
+// @Description                 "complemento": "COLONIA ESCALÓN, SAN SALVADOR"
+// @Description             },
+// @Description             "telefono": "22223333",
+// @Description             "correo": "contabilidad@elsol.com.sv",
+// @Description             "codDomiciliado": 1,
+// @Description             "codPais": "9300"
+// @Description         },
+// @Description         "otrosDocumentos": [
+// @Description             {
+// @Description                 "codDocAsociado": 1,
+// @Description                 "descDocumento": "Acuerdo de calificación como entidad de utilidad pública",
+// @Description                 "detalleDocumento": "Acuerdo ejecutivo No. 123 de fecha 10/01/2024"
+// @Description             }
+// @Description         ],
+// @Description         "cuerpoDocumento": [
+// @Description             {
+// @Description                 "numItem": 1,
+// @Description                 "tipoDonacion": 1,
+// @Description                 "cantidad": 1,
+// @Description                 "codigo": null,
+// @Description                 "uniMedida": 99,
+// @Description                 "descripcion": "Donación en efectivo para programa de becas",
+// @Description                 "depreciacion": 0,
+// @Description                 "valorUni": 500,
+// @Description                 "valor": 500
+// @Description             },
+// @Description             {
+// @Description                 "numItem": 2,
+// @Description                 "tipoDonacion": 2,
+// @Description                 "cantidad": 5,
+// @Description                 "codigo": "EQ-LAPTOP",
+// @Description                 "uniMedida": 59,
+// @Description                 "descripcion": "Computadoras portátiles usadas",
+// @Description                 "depreciacion": 250,
+// @Description                 "valorUni": 300,
+// @Description                 "valor": 1250
+// @Description             }
+// @Description         ],
+// @Description         "resumen": {
+// @Description             "valorTotal": 1750,
+// @Description             "totalLetras": "UN MIL SETECIENTOS CINCUENTA 00/100",
+// @Description             "pagos": [
+// @Description                 {
+// @Description                     "codigo": "01",
+// @Description                     "montoPago": 500,
+// @Description                     "referencia": null
+// @Description                 }
+// @Description             ]
+// @Description         },
+// @Description         "apendice": [
+// @Description             {
+// @Description                 "campo": "Datos del documento",
+// @Description                 "etiqueta": "Sello de recepción",
+// @Description                 "valor": "202534D1BECF3321453..."
+// @Description             }
+// @Description         ]
+// @Description     }
+// @Description }
+// @Description ```
+// @Description 
+// @Description Para ver ejemplos completos, consulta: /jsonExamples/
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param async query bool false "Emitir de forma asíncrona; si se omite se usa la configuración de la sucursal"
+// @Param donation body object true "Datos del comprobante de donación"
+// @Success 201 {object} map[string]interface{}
+// @Success 202 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/donation [post]
+func (h *GenericCreatorDTEHandler) CreateDonation(w http.ResponseWriter, r *http.Request) {
+	h.HandleCreate(w, r)
+}
+
+// CreateRetention godoc
+// @Summary Crear Comprobante de Retencion
+// @Description // @Description Este endpoint permite crear y emitir un Comprobante de Retención electrónico.
+// @Description 
+// @Description ## Ejemplo de Solicitud
+// @Description ```json
+// @Description {
+// @Description     "items": [
+// @Description         {
+// @Description             "type": 2,
+// @Description             "document_number": "1EEAB582-AA75-4D9C-A...",
+// @Description             "description": "Compra de equipos informáticos",
+// @Description             "retention_code": "22"
+// @Description         }
+// @Description     ],
+// @Description     "receiver": {
+// @Description     "document_type": "36",
+// @Description     "document_number": "00000000000000",
+// @Description     "nrc": "000000",
+// @Description     "name": "EJEMPLO S.A de S.V",
+// @Description     "commercial_name": "EJEMPLO",
+// @Description     "activity_code": "00000",
+// @Description     "activity_description": "ACTIVIDADES JURÍDICAS Y CONTABLES",
+// @Description     "address": {
+// @Description       "department": "06",
+// @Description       "municipality": "20",
+// @Description       "complement": "Dirección de Prueba 1, N° 1234"
+// @Description     },
+// @Description     "phone": "21212121",
+// @Description     "email": "cliente@gmail.com"
+// @Description   },
+// @Description    "extension": {
+// @Description         "delivery_name": "Juan Pérez",
+// @Description         "delivery_document": "06141809931020",
+// @Description         "receiver_name": "María López",
+// @Description         "receiver_document": "06142509882011",
+// @Description         "observation": "Entrega en oficina central"
+// @Description     },
+// @Description 
+// @Description     "appendixes": null
+// @Description }
+// @Description ```
+// @Description 
+// @Description ## Ejemplo de Respuesta
+// @Description ```json
+// @Description {
+// @Description     "success": true,
+// @Description     "reception_stamp": "202534D1BECF3321453...",
+// @Description     "qr_link": "https://admin.factura.gob.sv/consultaPublica?ambiente=00&codGen=5367521F-DD80-4B6B-9...&fechaEmi=FECHA-DE-EMISION",
+// @Description     "data":{
+// @Description   "identificacion": {
+// @Description     "version": 1,
+// @Description     "ambiente": "00",
+// @Description     "tipoDte": "07",
+// @Description     "numeroControl": "DTE-07-C0020000-000000000000001",
+// @Description     "codigoGeneracion": "EB399033-E184-41D2-B01...",
+// @Description     "tipoModelo": 1,
+// @Description     "tipoOperacion": 1,
+// @Description     "tipoContingencia": null,
+// @Description     "motivoContin": null,
+// @Description     "fecEmi": "2025-04-16",
+// @Description     "horEmi": "20:40:56",
+// @Description     "tipoMoneda": "USD"
+// @Description   },
+// @Description   "resumen": {
+// @Description     "totalSujetoRetencion": 80097.35,
+// @Description     "totalIVAretenido": 800.97,
+// @Description     "totalIVAretenidoLetras": "OCHOCIENTOS 97/100"
+// @Description   },
+// @Description   "emisor": {
+// @Description     "nit": "00000000000000",
+// @Description     "nrc": "0000000",
+// @Description     "nombre": "EMPRESA DE PRUEBAS SA DE CV 2",
+// @Description     "codActividad": "00000",
+// @Description     "descActividad": "Venta al por mayor de otros productos",
+// @Description     "tipoEstablecimiento": "01",
+// @Description     "direccion": {
+// @Description       "departamento": "06",
+// @Description       "municipio": "20",
+// @Description       "complemento": "BOULEVARD SANTA ELENA SUR, SANTA TECLA"
+// @Description     },
+// @Description     "telefono": "21212828",
+// @Description     "correo": "facturacion@empresa.com.sv",
+// @Description     "nombreComercial": "JEMEPLO",
+// @Description     "codigoMH": null,
+// @Description     "codigo": "C002",
+// @Description     "puntoVentaMH": null,
+// @Description     "puntoVenta": null
+// @Description   },
+// @Description   "receptor": {
+// @Description     "nombre": "EJEMPLO S.A DE C.V",
+// @Description     "tipoDocumento": "36",
+// @Description     "numDocumento": "00000000000000",
+// @Description     "nrc": "000000",
+// @Description     "codActividad": "00000",
+// @Description     "descActividad": "ACTIVIDADES JURÍDICAS Y CONTABLES",
+// @Description     "direccion": {
+// @Description       "departamento": "06",
+// @Description       "municipio": "20",
+// @Description       "complemento": "Dirección de Prueba 1, N° 1234"
+// @Description     },
+// @Description     "telefono": "21212121",
+// @Description     "correo": "cliente@gmail.com",
+// @Description     "nombreComercial": "EJEMPLO"
+// @Description   },
+// @Description   "cuerpoDocumento": [
+// @Description     {
+// @Description       "numItem": 1,
+// @Description       "tipoDte": "03",
+// @Description       "tipoDoc": 2,
+// @Description       "numDocumento": "1EEAB582-AA75-4D9C-AFF...",
+// @Description       "fechaEmision": "2025-04-11",
+// @Description       "montoSujetoGrav": 80097.35,
+// @Description       "codigoRetencionMH": "22",
+// @Description       "ivaRetenido": 800.97,
+// @Description       "descripcion": "Compra de equipos informáticos"
+// @Description     }
+// @Description   ],
+// @Description   "extension": {
+// @Description     "nombEntrega": "Juan Pérez",
+// @Description     "docuEntrega": "06141809931020",
+// @Description     "nombRecibe": "María López",
+// @Description     "docuRecibe": "06142509882011",
+// @Description     "observaciones": "Entrega en oficina central"
+// @Description   },
+// @Description         "apendice": [
+// @Description             {
+// @Description                 "campo": "Datos del documento",
+// @Description                 "etiqueta": "Sello de recepción",
+// @Description                 "valor": "202534D1BECF33214..."
+// @Description             }
+// @Description         ]
+// @Description   }
+// @Description }
+// @Description ```
+// @Description 
+// @Description Para ver ejemplos completos, consulta: /jsonExamples/
+// @Tags DTE
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "Llave para reintentar la solicitud sin emitir un documento duplicado"
+// @Param async query bool false "Emitir de forma asíncrona; si se omite se usa la configuración de la sucursal"
+// @Param retention body object true "Datos del comprobante de Retencion"
+// @Success 201 {object} map[string]interface{}
+// @Success 202 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 422 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /dte/retention [post]
+func (h *GenericCreatorDTEHandler) CreateRetention(w http.ResponseWriter, r *http.Request) {
+	h.HandleCreate(w, r)
+}
+
+// HandleCreate maneja la creaciÃ³n de cualquier tipo de documento
+func (h *GenericCreatorDTEHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	// 1. Determinar quÃ© tipo de documento se estÃ¡ creando basado en la ruta
+	path := r.URL.Path
+	config, err := h.getDocumentTypeFromPath(path)
+	if err != nil {
+		h.respWriter.Error(w, http.StatusNotFound, "Document type not supported", nil)
+		return
+	}
+
+	// 2. Leer el cuerpo de la solicitud
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		logs.Error("Failed to read request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 3. Si la solicitud incluye un Idempotency-Key, procesarla una sola vez
+	if key := r.Header.Get(IdempotencyKeyHeader); key != "" && h.idempotency != nil {
+		h.handleIdempotentCreate(w, r, key, body, config)
+		return
+	}
+
+	h.create(w, r, body, config)
+}
+
+// create decodifica la solicitud y emite el documento
+func (h *GenericCreatorDTEHandler) create(w http.ResponseWriter, r *http.Request, body []byte, config helpers.DocumentConfig) {
+	// 1. Crear una nueva instancia del tipo de solicitud
+	requestType := reflect.TypeOf(config.RequestType)
+	request := reflect.New(requestType.Elem()).Interface()
+
+	// 2. Decodificar el JSON en la estructura de solicitud
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(request); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 3. Si la emisión es asíncrona, almacenar el documento y dejar su transmisión a la cola de emisión
+	if config.UseCase.IsAsync(r.Context(), r.URL.Query().Get("async")) {
+		result, err := config.UseCase.CreateAsync(r.Context(), request)
+		if err != nil {
+			h.respWriter.HandleError(w, err)
+			return
+		}
+
+		w.Header().Set("Location", result.StatusURL)
+		h.respWriter.Success(w, http.StatusAccepted, result, nil)
+		return
+	}
 
-	// 4. Invocar el caso de uso genÃ©rico
+	// 4. Invocar el caso de uso genérico
 	resp, options, err := config.UseCase.Create(r.Context(), request)
 	if err != nil {
 		logs.Warn("Error processing document because", map[string]interface{}{"error": err.Error()})
@@ -896,10 +2377,77 @@ func (h *GenericCreatorDTEHandler) HandleCreate(w http.ResponseWriter, r *http.R
 		}
 	}
 
-	// 6. Responder con Ã©xito
+	// 6. Responder con éxito
 	h.respWriter.Success(w, http.StatusCreated, resp, options)
 }
 
+// handleIdempotentCreate emite el documento una sola vez por llave y sucursal. Los reintentos con el mismo contenido
+// reciben la respuesta original y los reintentos con un contenido distinto se rechazan.
+func (h *GenericCreatorDTEHandler) handleIdempotentCreate(w http.ResponseWriter, r *http.Request, key string, body []byte, config helpers.DocumentConfig) {
+	claims := r.Context().Value("claims").(*models.AuthClaims)
+	requestHash := idempotency.HashRequest(r.Method, r.URL.Path, body)
+
+	// 1. Reservar la llave u obtener la respuesta de la solicitud original
+	record, err := h.idempotency.Begin(r.Context(), claims.BranchID, key, requestHash)
+	if err != nil {
+		h.handleIdempotencyError(w, err)
+		return
+	}
+
+	if record != nil {
+		logs.Info("Replaying response for idempotency key", map[string]interface{}{
+			"branchID": claims.BranchID,
+			"key":      key,
+		})
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(record.StatusCode)
+		w.Write(record.Body)
+		return
+	}
+
+	// 2. Emitir el documento capturando la respuesta y si se transmitió a Hacienda
+	recorder := helpers.NewResponseRecorder()
+	trackedCtx, tracker := idempotency.WithTransmissionTracker(r.Context())
+	h.create(recorder, r.WithContext(trackedCtx), body, config)
+
+	// 3. Almacenar la respuesta final aunque el cliente haya abandonado la solicitud. Los errores del servidor
+	// liberan la llave para permitir el reintento solo si no se transmitió el documento, de lo contrario
+	// Hacienda pudo haberlo recibido y los reintentos reciben el error original
+	ctx := context.WithoutCancel(r.Context())
+	if recorder.Status() >= http.StatusInternalServerError && !tracker.Transmitted() {
+		err = h.idempotency.Release(ctx, claims.BranchID, key)
+	} else {
+		err = h.idempotency.Complete(ctx, claims.BranchID, key, requestHash, recorder.Status(), recorder.Body())
+	}
+	if err != nil {
+		logs.Error("Failed to store idempotency key result", map[string]interface{}{
+			"branchID": claims.BranchID,
+			"key":      key,
+			"error":    err.Error(),
+		})
+	}
+
+	recorder.FlushTo(w)
+}
+
+// handleIdempotencyError responde con 422 si la llave se usó con otro contenido y con 409 si la solicitud original sigue en proceso
+func (h *GenericCreatorDTEHandler) handleIdempotencyError(w http.ResponseWriter, err error) {
+	var svcErr *shared_error.ServiceError
+	if errors.As(err, &svcErr) {
+		switch svcErr.GetCode() {
+		case "IdempotencyKeyMismatch":
+			h.respWriter.Error(w, http.StatusUnprocessableEntity, svcErr.Message, nil)
+			return
+		case "IdempotencyKeyInProgress":
+			h.respWriter.Error(w, http.StatusConflict, svcErr.Message, nil)
+			return
+		}
+	}
+
+	h.respWriter.HandleError(w, err)
+}
+
 // handleErrorForContingency maneja el error en caso de que se aplique una contingencia
 func (h *GenericCreatorDTEHandler) handleErrorForContingency(ctx context.Context, dte interface{}, dteType string, options *response.SuccessOptions, err error, w http.ResponseWriter) error {
 	// 1. Verificar si aplica a contingencia
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/jobs"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type JobHandler struct {
+	jobUseCase *jobs.JobUseCase
+	respWriter *response.ResponseWriter
+}
+
+func NewJobHandler(jobUseCase *jobs.JobUseCase) *JobHandler {
+	return &JobHandler{
+		jobUseCase: jobUseCase,
+		respWriter: response.NewResponseWriter(),
+	}
+}
+
+// Status maneja la solicitud HTTP para consultar el estado de los jobs programados
+// Status godoc
+// @Summary Estado de los jobs
+// @Description Obtiene la programación, la próxima ejecución y el resultado y la duración de la última ejecución de cada job en esta instancia
+// @Tags Jobs
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} response.APIError
+// @Router /jobs [get]
+func (h *JobHandler) Status(w http.ResponseWriter, r *http.Request) {
+	h.respWriter.Success(w, http.StatusOK, h.jobUseCase.Status(r.Context()), nil)
+}
+
+// GetSchedule maneja la solicitud HTTP para consultar la programación de retransmisión del usuario
+// GetSchedule godoc
+// @Summary Obtener programación de retransmisión
+// @Description Obtiene la expresión cron, las ventanas horarias, el tamaño de lote y el tiempo máximo de ejecución con los que se retransmiten los documentos en contingencia del NIT autenticado
+// @Tags Jobs
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /jobs/retransmission/schedule [get]
+func (h *JobHandler) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	schedule, err := h.jobUseCase.GetSchedule(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, schedule, nil)
+}
+
+// UpdateSchedule maneja la solicitud HTTP para cambiar la programación de retransmisión del usuario
+// UpdateSchedule godoc
+// @Summary Actualizar programación de retransmisión
+// @Description Guarda una programación propia para la retransmisión de los documentos en contingencia del NIT autenticado y reprograma su job; los campos omitidos conservan su valor vigente
+// @Tags Jobs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param schedule body structs.RetransmissionScheduleRequest true "Cambios de la programación"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /jobs/retransmission/schedule [put]
+func (h *JobHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar la solicitud
+	var req structs.RetransmissionScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Guardar la programación
+	schedule, err := h.jobUseCase.UpdateSchedule(r.Context(), &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, schedule, nil)
+}
+
+// ResetSchedule maneja la solicitud HTTP para volver a la programación de retransmisión predeterminada
+// ResetSchedule godoc
+// @Summary Restablecer programación de retransmisión
+// @Description Elimina la programación propia del NIT autenticado, sus documentos en contingencia vuelven a retransmitirse con la programación predeterminada del entorno
+// @Tags Jobs
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /jobs/retransmission/schedule [delete]
+func (h *JobHandler) ResetSchedule(w http.ResponseWriter, r *http.Request) {
+	schedule, err := h.jobUseCase.ResetSchedule(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, schedule, nil)
+}
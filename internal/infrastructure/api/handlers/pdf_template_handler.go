@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/pdf_template"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type PDFTemplateHandler struct {
+	templateUseCase *pdf_template.PDFTemplateUseCase
+	respWriter      *response.ResponseWriter
+}
+
+func NewPDFTemplateHandler(templateUseCase *pdf_template.PDFTemplateUseCase) *PDFTemplateHandler {
+	return &PDFTemplateHandler{
+		templateUseCase: templateUseCase,
+		respWriter:      response.NewResponseWriter(),
+	}
+}
+
+// Get maneja la solicitud HTTP para obtener la plantilla de la versión legible del usuario autenticado
+// Get godoc
+// @Summary Obtener plantilla de la versión legible
+// @Description Obtiene el pie de página configurado e indica si existe un logo para la versión legible (PDF) de los DTE
+// @Tags PDF
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} models.PDFTemplate
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /pdf/template [get]
+func (h *PDFTemplateHandler) Get(w http.ResponseWriter, r *http.Request) {
+	tpl, err := h.templateUseCase.Get(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, tpl, nil)
+}
+
+// Save maneja la solicitud HTTP para configurar la plantilla de la versión legible del usuario autenticado
+// Save godoc
+// @Summary Configurar plantilla de la versión legible
+// @Description Reemplaza el logo (PNG o JPEG en base64) y la plantilla del pie de página de la versión legible (PDF) de los DTE
+// @Tags PDF
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param template body structs.SavePDFTemplateRequest true "Plantilla de la versión legible"
+// @Success 200 {object} models.PDFTemplate
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /pdf/template [put]
+func (h *PDFTemplateHandler) Save(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar la solicitud
+	var req structs.SavePDFTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Almacenar la plantilla
+	tpl, err := h.templateUseCase.Save(r.Context(), &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, tpl, nil)
+}
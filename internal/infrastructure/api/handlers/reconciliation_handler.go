@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/reconciliation"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+type ReconciliationHandler struct {
+	reconciliationUseCase *reconciliation.ReconciliationUseCase
+	respWriter            *response.ResponseWriter
+}
+
+func NewReconciliationHandler(reconciliationUseCase *reconciliation.ReconciliationUseCase) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		reconciliationUseCase: reconciliationUseCase,
+		respWriter:            response.NewResponseWriter(),
+	}
+}
+
+// ListDiscrepancies maneja la solicitud HTTP para consultar el reporte de discrepancias de la conciliación con Hacienda
+// ListDiscrepancies godoc
+// @Summary Listar discrepancias de conciliación
+// @Description Obtiene lista paginada de las diferencias encontradas entre el estado local de los documentos y su estado en Hacienda, junto a la acción con la que se repararon
+// @Tags Reconciliation
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param branch_id query int false "ID de la sucursal"
+// @Param source query string false "Origen del documento" Enums(PENDING_DOCUMENT,FAILED_SEQUENCE,OUTBOX_INTENT)
+// @Param action query string false "Acción aplicada" Enums(MARKED_RECEIVED,MARKED_REJECTED,RECOVERED,RESUMED)
+// @Param page query int false "Número de página" default(1)
+// @Param page_size query int false "Elementos por página (máximo 100)" default(20)
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /reconciliation/discrepancies [get]
+func (h *ReconciliationHandler) ListDiscrepancies(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	result, err := h.reconciliationUseCase.ListDiscrepancies(r.Context(), &structs.ReconciliationDiscrepancyRequest{
+		BranchID: query.Get("branch_id"),
+		Source:   query.Get("source"),
+		Action:   query.Get("action"),
+		Page:     query.Get("page"),
+		PageSize: query.Get("page_size"),
+	})
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, result, nil)
+}
@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/webhook"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/helpers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type WebhookHandler struct {
+	webhookUseCase *webhook.WebhookUseCase
+	respWriter     *response.ResponseWriter
+}
+
+func NewWebhookHandler(webhookUseCase *webhook.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+		respWriter:     response.NewResponseWriter(),
+	}
+}
+
+// Create maneja la solicitud HTTP para registrar una suscripción de webhooks
+// Create godoc
+// @Summary Registrar webhook
+// @Description Registra un endpoint que recibe los eventos seleccionados de los DTE del usuario. El secreto de firma solo se muestra en esta respuesta.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param webhook body structs.WebhookSubscriptionRequest true "Suscripción"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /webhooks [post]
+func (h *WebhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	// 1. Decodificar la solicitud
+	var req structs.WebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Registrar la suscripción
+	subscription, err := h.webhookUseCase.Create(r.Context(), &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusCreated, subscription, nil)
+}
+
+// List maneja la solicitud HTTP para listar las suscripciones de webhooks del usuario
+// List godoc
+// @Summary Listar webhooks
+// @Description Obtiene las suscripciones registradas sin sus secretos de firma
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {array} map[string]interface{}
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /webhooks [get]
+func (h *WebhookHandler) List(w http.ResponseWriter, r *http.Request) {
+	subscriptions, err := h.webhookUseCase.List(r.Context())
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, subscriptions, nil)
+}
+
+// Update maneja la solicitud HTTP para modificar una suscripción de webhooks
+// Update godoc
+// @Summary Modificar webhook
+// @Description Modifica la URL, los eventos, el secreto o el estado de una suscripción; los campos omitidos conservan su valor
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "ID de la suscripción"
+// @Param webhook body structs.WebhookSubscriptionRequest true "Cambios de la suscripción"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) Update(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el ID de la suscripción y decodificar la solicitud
+	id := helpers.GetRequestVar(r, "id")
+
+	var req structs.WebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logs.Error("Failed to decode request body", map[string]interface{}{"error": err.Error()})
+		h.respWriter.Error(w, http.StatusBadRequest, "Invalid request format", nil)
+		return
+	}
+
+	// 2. Modificar la suscripción
+	subscription, err := h.webhookUseCase.Update(r.Context(), id, &req)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, subscription, nil)
+}
+
+// Delete maneja la solicitud HTTP para eliminar una suscripción de webhooks
+// Delete godoc
+// @Summary Eliminar webhook
+// @Description Elimina una suscripción; su registro de entregas se conserva
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "ID de la suscripción"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el ID de la suscripción
+	id := helpers.GetRequestVar(r, "id")
+
+	// 2. Eliminar la suscripción
+	if err := h.webhookUseCase.Delete(r.Context(), id); err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, map[string]interface{}{"id": id, "deleted": true}, nil)
+}
+
+// ListDeliveries maneja la solicitud HTTP para consultar el registro de entregas de webhooks
+// ListDeliveries godoc
+// @Summary Listar entregas de webhooks
+// @Description Obtiene las entregas de las suscripciones del usuario, de la más reciente a la más antigua
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param subscription_id query int false "ID de la suscripción"
+// @Param status query string false "Estado de la entrega (PENDING, DELIVERED, FAILED)"
+// @Param event query string false "Tipo de evento"
+// @Param limit query int false "Cantidad máxima de entregas (por defecto 50, máximo 200)"
+// @Success 200 {array} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /webhooks/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	deliveries, err := h.webhookUseCase.ListDeliveries(r.Context(), query.Get("subscription_id"), query.Get("status"),
+		query.Get("event"), query.Get("limit"))
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, deliveries, nil)
+}
+
+// Replay maneja la solicitud HTTP para reenviar una entrega de webhook
+// Replay godoc
+// @Summary Reenviar entrega de webhook
+// @Description Registra una nueva entrega del mismo evento hacia la suscripción original y la envía de inmediato
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "ID de la entrega"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.APIError
+// @Failure 401 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /webhooks/deliveries/{id}/replay [post]
+func (h *WebhookHandler) Replay(w http.ResponseWriter, r *http.Request) {
+	// 1. Obtener el ID de la entrega
+	id := helpers.GetRequestVar(r, "id")
+
+	// 2. Reenviar la entrega
+	delivery, err := h.webhookUseCase.Replay(r.Context(), id)
+	if err != nil {
+		h.respWriter.HandleError(w, err)
+		return
+	}
+
+	h.respWriter.Success(w, http.StatusOK, delivery, nil)
+}
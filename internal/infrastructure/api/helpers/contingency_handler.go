@@ -12,6 +12,7 @@ import (
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/transmitter/hacienda_error"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
@@ -19,6 +20,7 @@ import (
 
 type ContingencyHandler struct {
 	contingencyService contingency.ContingencyManager
+	circuit            ports.CircuitManager
 }
 
 type ContingencyResult struct {
@@ -73,6 +75,29 @@ func (ch *ContingencyHandler) HandleContingency(ctx context.Context,
 	return &result.ContingencyType, &result.ContingencyReason
 }
 
+// WithCircuit configura el circuit breaker de Hacienda. Mientras está abierto, los errores de transmisión se
+// clasifican directamente como no disponibilidad del MH
+func (ch *ContingencyHandler) WithCircuit(circuit ports.CircuitManager) *ContingencyHandler {
+	ch.circuit = circuit
+	return ch
+}
+
+// Classify determina si el error de transmisión amerita contingencia sin almacenar el documento, retorna nil si no aplica
+func (ch *ContingencyHandler) Classify(err error) (*int8, *string) {
+	if !ch.shouldHandleAsContingency(err) {
+		return nil, nil
+	}
+
+	result := ch.classifyError(err)
+	return &result.ContingencyType, &result.ContingencyReason
+}
+
+// IsRejected indica si el error corresponde a un documento rechazado por Hacienda
+func (ch *ContingencyHandler) IsRejected(err error) bool {
+	var haciendaErr *hacienda_error.HaciendaResponseError
+	return errors.As(err, &haciendaErr) && haciendaErr.Status == "RECHAZADO"
+}
+
 func (ch *ContingencyHandler) shouldHandleAsContingency(err error) bool {
 	// No es contingencia si es error de validación
 	var validationErr *dte_errors.ValidationError
@@ -88,6 +113,12 @@ func (ch *ContingencyHandler) shouldHandleAsContingency(err error) bool {
 		}
 	}
 
+	// Es contingencia si Hacienda o el firmador no están disponibles
+	var unavailableErr *dte_errors.ServiceUnavailableError
+	if errors.As(err, &unavailableErr) {
+		return true
+	}
+
 	// No es contingencia si es error general de servicio
 	var generalErr *shared_error.ServiceError
 	if errors.As(err, &generalErr) {
@@ -136,6 +167,15 @@ func (ch *ContingencyHandler) storeForContingency(
 }
 
 func (ch *ContingencyHandler) classifyError(err error) ContingencyResult {
+	// Circuit breaker abierto, el servicio ya se sabe no disponible
+	var unavailableErr *dte_errors.ServiceUnavailableError
+	if errors.As(err, &unavailableErr) && unavailableErr.CircuitOpen {
+		return ch.classifyCircuitOpen(unavailableErr.Component)
+	}
+	if ch.circuit != nil && ch.circuit.GetState() == constants.StateOpen {
+		return ch.classifyCircuitOpen(constants.CircuitHaciendaReception)
+	}
+
 	// Errores de Hacienda
 	var haciendaErr *hacienda_error.HaciendaResponseError
 	if errors.As(err, &haciendaErr) {
@@ -163,6 +203,21 @@ func (ch *ContingencyHandler) classifyError(err error) ContingencyResult {
 	return ch.defaultErrorClassification(err)
 }
 
+// classifyCircuitOpen clasifica una solicitud que no se envió porque el circuit breaker del componente está abierto.
+// Los componentes de Hacienda corresponden a no disponibilidad del MH y el firmador a una falla del sistema del emisor
+func (ch *ContingencyHandler) classifyCircuitOpen(component string) ContingencyResult {
+	contingencyType := int8(constants.NoDisponibilidadMH)
+	if component == constants.CircuitSigner {
+		contingencyType = constants.FallaConexionSistema
+	}
+
+	return ContingencyResult{
+		ContingencyType:   contingencyType,
+		ContingencyReason: constants.GetContingencyReason(contingencyType),
+		ShouldRetry:       true,
+	}
+}
+
 func (ch *ContingencyHandler) classifyHaciendaError(err *hacienda_error.HaciendaResponseError) ContingencyResult {
 	classification := ch.getHaciendaErrorClassification(err)
 
@@ -0,0 +1,51 @@
+package helpers
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// ResponseRecorder captura la respuesta de un handler para poder almacenarla antes de enviarla al cliente
+type ResponseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func NewResponseRecorder() *ResponseRecorder {
+	return &ResponseRecorder{
+		header: make(http.Header),
+		status: http.StatusOK,
+	}
+}
+
+func (r *ResponseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *ResponseRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *ResponseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+// Status obtiene el código de estado capturado
+func (r *ResponseRecorder) Status() int {
+	return r.status
+}
+
+// Body obtiene el cuerpo capturado
+func (r *ResponseRecorder) Body() []byte {
+	return r.body.Bytes()
+}
+
+// FlushTo envía la respuesta capturada al cliente
+func (r *ResponseRecorder) FlushTo(w http.ResponseWriter) {
+	for key, values := range r.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(r.status)
+	w.Write(r.body.Bytes())
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/response"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+type AuthorizationMiddleware struct {
+	respWriter *response.ResponseWriter
+}
+
+// NewAuthorizationMiddleware crea una nueva instancia de AuthorizationMiddleware. Se usa en las rutas protegidas
+// después de AuthMiddleware, que agrega los claims del token al contexto.
+func NewAuthorizationMiddleware() *AuthorizationMiddleware {
+	return &AuthorizationMiddleware{
+		respWriter: response.NewResponseWriter(),
+	}
+}
+
+// Require retorna un handler que solo ejecuta next si el token de la solicitud autoriza el scope indicado
+func (m *AuthorizationMiddleware) Require(scope string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := r.Context().Value("claims").(*models.AuthClaims)
+		if !ok || claims == nil {
+			m.respWriter.Error(w, http.StatusUnauthorized, "Authorization header required", nil)
+			return
+		}
+
+		if !claims.HasScope(scope) {
+			logs.Warn("Insufficient scope", map[string]interface{}{
+				"branchID": claims.BranchID,
+				"role":     claims.Role,
+				"scope":    scope,
+				"path":     r.URL.Path,
+				"method":   r.Method,
+			})
+			m.respWriter.Error(w, http.StatusForbidden, "Insufficient scope", []string{"required scope: " + scope})
+			return
+		}
+
+		next(w, r)
+	}
+}
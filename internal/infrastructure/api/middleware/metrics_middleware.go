@@ -24,13 +24,24 @@ var (
 	uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
 
 	endpointMappings = map[string]string{
-		"GET:/api/v1/dte":               "dte",
-		"GET:/api/v1/dte/{id}":          "dte/{id}",
-		"POST:/api/v1/dte/invoices":     "invoices",
-		"POST:/api/v1/dte/ccf":          "ccf",
-		"POST:/api/v1/dte/invalidation": "invalidation",
-		"POST:/api/v1/dte/retention":    "retention",
-		"POST:/api/v1/dte/creditnote":   "creditnote",
+		"GET:/api/v1/dte":                         "dte",
+		"GET:/api/v1/dte/{id}":                    "dte/{id}",
+		"GET:/api/v1/dte/{id}/pdf":                "dte/{id}/pdf",
+		"GET:/api/v1/dte/{id}/status":             "dte/{id}/status",
+		"POST:/api/v1/dte/{id}/resend":            "dte/{id}/resend",
+		"POST:/api/v1/dte/invoices":               "invoices",
+		"POST:/api/v1/dte/ccf":                    "ccf",
+		"POST:/api/v1/dte/invalidation":           "invalidation",
+		"POST:/api/v1/dte/verify":                 "verify",
+		"POST:/api/v1/dte/retention":              "retention",
+		"POST:/api/v1/dte/creditnote":             "creditnote",
+		"POST:/api/v1/dte/debitnote":              "debitnote",
+		"POST:/api/v1/dte/fse":                    "fse",
+		"POST:/api/v1/dte/export":                 "export",
+		"POST:/api/v1/dte/remission":              "remission",
+		"POST:/api/v1/dte/liquidation":            "liquidation",
+		"POST:/api/v1/dte/accounting-liquidation": "accounting-liquidation",
+		"POST:/api/v1/dte/donation":               "donation",
 	}
 )
 
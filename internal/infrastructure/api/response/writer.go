@@ -215,6 +215,10 @@ func deriveErrorCode(status int) string {
 		return "NOT_FOUND"
 	case http.StatusMethodNotAllowed:
 		return "METHOD_NOT_ALLOWED"
+	case http.StatusConflict:
+		return "CONFLICT"
+	case http.StatusUnprocessableEntity:
+		return "UNPROCESSABLE_ENTITY"
 	case http.StatusInternalServerError:
 		return "INTERNAL_SERVER_ERROR"
 	case http.StatusRequestTimeout:
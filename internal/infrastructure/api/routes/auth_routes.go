@@ -1,7 +1,9 @@
 package routes
 
 import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
 )
 
@@ -9,3 +11,12 @@ func RegisterPublicAuthRoutes(r *mux.Router, h *handlers.AuthHandler) {
 	r.HandleFunc("/auth/register", h.Register).Methods("POST")
 	r.HandleFunc("/auth/login", h.Login).Methods("POST")
 }
+
+func RegisterBranchRoutes(r *mux.Router, h *handlers.BranchHandler, authz *middleware.AuthorizationMiddleware) {
+	r.HandleFunc("/auth/branches", authz.Require(user.ScopeAdminBranches, h.List)).Methods("GET")
+	r.HandleFunc("/auth/branches", authz.Require(user.ScopeAdminBranches, h.Create)).Methods("POST")
+	r.HandleFunc("/auth/branches/{id}", authz.Require(user.ScopeAdminBranches, h.Update)).Methods("PUT")
+	r.HandleFunc("/auth/branches/{id}/deactivate", authz.Require(user.ScopeAdminBranches, h.Deactivate)).Methods("POST")
+	r.HandleFunc("/auth/branches/{id}/reactivate", authz.Require(user.ScopeAdminBranches, h.Reactivate)).Methods("POST")
+	r.HandleFunc("/auth/branches/{id}/rotate-credentials", authz.Require(user.ScopeAdminBranches, h.RotateCredentials)).Methods("POST")
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+)
+
+func RegisterCertificateRoutes(r *mux.Router, h *handlers.CertificateHandler, authz *middleware.AuthorizationMiddleware) {
+	r.HandleFunc("/certificates", authz.Require(user.ScopeAdminSettings, h.Upload)).Methods(http.MethodPost)
+	r.HandleFunc("/certificates", authz.Require(user.ScopeAdminSettings, h.List)).Methods(http.MethodGet)
+	r.HandleFunc("/certificates/rotate", authz.Require(user.ScopeAdminSettings, h.Rotate)).Methods(http.MethodPost)
+	r.HandleFunc("/certificates/{id}", authz.Require(user.ScopeAdminSettings, h.Deactivate)).Methods(http.MethodDelete)
+}
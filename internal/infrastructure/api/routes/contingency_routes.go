@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+)
+
+func RegisterContingencyRoutes(r *mux.Router, h *handlers.ContingencyHandler, authz *middleware.AuthorizationMiddleware) {
+	r.HandleFunc("/contingency/documents", authz.Require(user.ScopeDTERead, h.List)).Methods(http.MethodGet)
+	r.HandleFunc("/contingency/documents/{id}", authz.Require(user.ScopeDTERead, h.Get)).Methods(http.MethodGet)
+	r.HandleFunc("/contingency/documents/{id}/classification", authz.Require(user.ScopeDTECreate, h.Reclassify)).Methods(http.MethodPut)
+	r.HandleFunc("/contingency/documents/{id}/cancel", authz.Require(user.ScopeDTECreate, h.Cancel)).Methods(http.MethodPost)
+	r.HandleFunc("/contingency/retransmit", authz.Require(user.ScopeDTECreate, h.Retransmit)).Methods(http.MethodPost)
+}
@@ -1,20 +1,33 @@
 package routes
 
 import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
 	"net/http"
 )
 
-func RegisterDTERoutes(r *mux.Router, h *handlers.DTEHandler) {
+func RegisterDTERoutes(r *mux.Router, h *handlers.DTEHandler, authz *middleware.AuthorizationMiddleware) {
 	// Rutas específicas para creación de DTEs (para Swagger)
-	r.HandleFunc("/dte/invoices", h.GenericHandler.CreateInvoice).Methods(http.MethodPost)
-	r.HandleFunc("/dte/ccf", h.GenericHandler.CreateCCF).Methods(http.MethodPost)
-	r.HandleFunc("/dte/creditnote", h.GenericHandler.CreateCreditNote).Methods(http.MethodPost)
-	r.HandleFunc("/dte/retention", h.GenericHandler.CreateRetention).Methods(http.MethodPost)
+	r.HandleFunc("/dte/invoices", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateInvoice)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/ccf", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateCCF)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/creditnote", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateCreditNote)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/debitnote", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateDebitNote)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/fse", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateFSE)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/export", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateExportInvoice)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/remission", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateRemissionNote)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/liquidation", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateLiquidation)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/accounting-liquidation", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateAccountingLiquidation)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/donation", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateDonation)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/retention", authz.Require(user.ScopeDTECreate, h.GenericHandler.CreateRetention)).Methods(http.MethodPost)
 	
 	// Rutas de consulta de DTE e Invalidación
-	r.HandleFunc("/dte/invalidation", h.InvalidateDocument).Methods(http.MethodPost)
-	r.HandleFunc("/dte/{id}", h.GetByGenerationCode).Methods(http.MethodGet)
-	r.HandleFunc("/dte", h.GetAll).Methods(http.MethodGet)
+	r.HandleFunc("/dte/invalidation", authz.Require(user.ScopeDTEInvalidate, h.InvalidateDocument)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/verify", authz.Require(user.ScopeDTERead, h.VerifyDocument)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/{id}/pdf", authz.Require(user.ScopeDTERead, h.GetPDF)).Methods(http.MethodGet)
+	r.HandleFunc("/dte/{id}/status", authz.Require(user.ScopeDTERead, h.GetStatus)).Methods(http.MethodGet)
+	r.HandleFunc("/dte/{id}/resend", authz.Require(user.ScopeDTECreate, h.Resend)).Methods(http.MethodPost)
+	r.HandleFunc("/dte/{id}", authz.Require(user.ScopeDTERead, h.GetByGenerationCode)).Methods(http.MethodGet)
+	r.HandleFunc("/dte", authz.Require(user.ScopeDTERead, h.GetAll)).Methods(http.MethodGet)
 }
\ No newline at end of file
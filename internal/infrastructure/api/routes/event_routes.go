@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+)
+
+func RegisterEventRoutes(r *mux.Router, h *handlers.EventHandler, authz *middleware.AuthorizationMiddleware) {
+	r.HandleFunc("/events", authz.Require(user.ScopeDTERead, h.List)).Methods(http.MethodGet)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+)
+
+func RegisterJobRoutes(r *mux.Router, h *handlers.JobHandler, authz *middleware.AuthorizationMiddleware) {
+	r.HandleFunc("/jobs", authz.Require(user.ScopeMetricsRead, h.Status)).Methods(http.MethodGet)
+	r.HandleFunc("/jobs/retransmission/schedule", authz.Require(user.ScopeMetricsRead, h.GetSchedule)).Methods(http.MethodGet)
+	r.HandleFunc("/jobs/retransmission/schedule", authz.Require(user.ScopeAdminSettings, h.UpdateSchedule)).Methods(http.MethodPut)
+	r.HandleFunc("/jobs/retransmission/schedule", authz.Require(user.ScopeAdminSettings, h.ResetSchedule)).Methods(http.MethodDelete)
+}
@@ -1,10 +1,12 @@
 package routes
 
 import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
 	"github.com/gorilla/mux"
 )
 
-func RegisterMetricsRoutes(router *mux.Router, handler *handlers.MetricsHandler) {
-	router.HandleFunc("/metrics", handler.GetEndpointMetrics).Methods("GET")
+func RegisterMetricsRoutes(router *mux.Router, handler *handlers.MetricsHandler, authz *middleware.AuthorizationMiddleware) {
+	router.HandleFunc("/metrics", authz.Require(user.ScopeMetricsRead, handler.GetEndpointMetrics)).Methods("GET")
 }
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+)
+
+func RegisterPDFTemplateRoutes(r *mux.Router, h *handlers.PDFTemplateHandler, authz *middleware.AuthorizationMiddleware) {
+	r.HandleFunc("/pdf/template", authz.Require(user.ScopeAdminSettings, h.Get)).Methods(http.MethodGet)
+	r.HandleFunc("/pdf/template", authz.Require(user.ScopeAdminSettings, h.Save)).Methods(http.MethodPut)
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/handlers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
+	"github.com/gorilla/mux"
+)
+
+func RegisterWebhookRoutes(r *mux.Router, h *handlers.WebhookHandler, authz *middleware.AuthorizationMiddleware) {
+	r.HandleFunc("/webhooks", authz.Require(user.ScopeAdminSettings, h.Create)).Methods(http.MethodPost)
+	r.HandleFunc("/webhooks", authz.Require(user.ScopeAdminSettings, h.List)).Methods(http.MethodGet)
+	r.HandleFunc("/webhooks/deliveries", authz.Require(user.ScopeAdminSettings, h.ListDeliveries)).Methods(http.MethodGet)
+	r.HandleFunc("/webhooks/deliveries/{id}/replay", authz.Require(user.ScopeAdminSettings, h.Replay)).Methods(http.MethodPost)
+	r.HandleFunc("/webhooks/{id}", authz.Require(user.ScopeAdminSettings, h.Update)).Methods(http.MethodPut)
+	r.HandleFunc("/webhooks/{id}", authz.Require(user.ScopeAdminSettings, h.Delete)).Methods(http.MethodDelete)
+}
@@ -63,8 +63,18 @@ func (s *Server) ConfigureRoutes() {
 }
 
 func (s *Server) configureProtectedRoutes(protected *mux.Router) {
-	routes.RegisterDTERoutes(protected, s.container.Handlers().DTEHandler())
-	routes.RegisterMetricsRoutes(protected, s.container.Handlers().MetricsHandler())
+	authz := s.container.Middleware().AuthorizationMiddleware()
+
+	routes.RegisterDTERoutes(protected, s.container.Handlers().DTEHandler(), authz)
+	routes.RegisterMetricsRoutes(protected, s.container.Handlers().MetricsHandler(), authz)
+	routes.RegisterCertificateRoutes(protected, s.container.Handlers().CertificateHandler(), authz)
+	routes.RegisterWebhookRoutes(protected, s.container.Handlers().WebhookHandler(), authz)
+	routes.RegisterEventRoutes(protected, s.container.Handlers().EventHandler(), authz)
+	routes.RegisterReconciliationRoutes(protected, s.container.Handlers().ReconciliationHandler(), authz)
+	routes.RegisterContingencyRoutes(protected, s.container.Handlers().ContingencyQueueHandler(), authz)
+	routes.RegisterJobRoutes(protected, s.container.Handlers().JobHandler(), authz)
+	routes.RegisterPDFTemplateRoutes(protected, s.container.Handlers().PDFTemplateHandler(), authz)
+	routes.RegisterBranchRoutes(protected, s.container.Handlers().BranchHandler(), authz)
 }
 
 func (s *Server) configureGlobalOptions() {
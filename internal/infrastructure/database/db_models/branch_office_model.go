@@ -1,5 +1,7 @@
 package db_models
 
+import "time"
+
 // BranchOffice representa la estructura de la tabla branch_offices en la base de datos
 // El campo EstablishmentType es un campo de 2 caracteres que representa el tipo de establecimiento exigido por la Hacienda
 // el campo hace referencia a si lugar es una sucursal, casa matriz, etc.
@@ -24,6 +26,17 @@ type BranchOffice struct {
 	POSCode             *string `gorm:"column:pos_code;type:varchar(15)"`
 	POSCodeMH           *string `gorm:"column:pos_code_mh;type:varchar(4)"`
 	IsActive            bool    `gorm:"column:is_active;type:tinyint(1);not null;index:idx_branch_offices_active"`
+	AsyncEmission       bool    `gorm:"column:async_emission;type:tinyint(1);not null;default:0"`
+
+	// Permisos de las credenciales de la sucursal: su rol y los scopes adicionales al rol separados por comas
+	Role   string `gorm:"column:role;type:varchar(20);not null;default:''"`
+	Scopes string `gorm:"column:scopes;type:varchar(255);not null;default:''"`
+
+	// Credenciales anteriores a la última rotación, válidas hasta PreviousExpiresAt. Los API secrets se almacenan
+	// como hash SHA-256.
+	PreviousAPIKey    *string    `gorm:"column:previous_api_key;type:varchar(255);index:idx_branch_offices_previous_key"`
+	PreviousAPISecret *string    `gorm:"column:previous_api_secret;type:varchar(255)"`
+	PreviousExpiresAt *time.Time `gorm:"column:previous_expires_at;type:timestamp;null"`
 
 	// Relaciones
 	User    *User    `gorm:"foreignKey:UserID;references:ID"`
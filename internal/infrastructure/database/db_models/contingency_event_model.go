@@ -0,0 +1,27 @@
+package db_models
+
+import "time"
+
+// ContingencyEvent representa un evento de contingencia enviado a Hacienda para informar los documentos de una
+// sucursal. Los documentos informados lo referencian en contingency_documents.contingency_event_id, SignedDocument
+// almacena el evento firmado y Observations las observaciones de Hacienda en formato JSON.
+type ContingencyEvent struct {
+	ID              string    `gorm:"column:id;type:varchar(36);primaryKey;not null"`
+	BranchID        uint      `gorm:"column:branch_id;type:uint;not null;index:idx_contingency_event_branch"`
+	NIT             string    `gorm:"column:nit;type:varchar(14);not null"`
+	ContingencyType int8      `gorm:"column:contingency_type;type:tinyint;not null"`
+	Reason          string    `gorm:"column:reason;type:varchar(150);not null"`
+	SignedDocument  string    `gorm:"column:signed_document;type:text;not null"`
+	Status          string    `gorm:"column:status;type:varchar(15);not null;index"`
+	ReceptionStamp  *string   `gorm:"column:reception_stamp;type:varchar(100);null"`
+	Message         string    `gorm:"column:message;type:text"`
+	Observations    *string   `gorm:"column:observations;type:text;null"`
+	CreatedAt       time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;index:idx_contingency_event_branch"`
+
+	// Relaciones
+	Branch *BranchOffice `gorm:"foreignKey:BranchID;references:ID"`
+}
+
+func (ContingencyEvent) TableName() string {
+	return "contingency_events"
+}
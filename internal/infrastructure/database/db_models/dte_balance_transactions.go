@@ -12,9 +12,9 @@ import (
 // en esta tabla se registran las transacciones de Notas de Crédito y Débito que afectan el saldo de un DTE.
 type DTEBalanceTransaction struct {
 	ID                   uint      `gorm:"primaryKey;autoIncrement:true;not null;index:idx_dte_balance_transaction"`
-	BalanceControlID     uint      `gorm:"column:balance_control_id;type:int;not null;index:idx_dte_balance_control"`
-	AdjustmentDocumentID string    `gorm:"column:adjustment_document_id;type:varchar(36);not null;index:idx_dte_adjustment_document"`
-	TransactionType      string    `gorm:"column:transaction_type;type:varchar(20);not null;index:idx_dte_transaction_type"`
+	BalanceControlID     uint      `gorm:"column:balance_control_id;type:int;not null;index:idx_dte_balance_control;uniqueIndex:idx_dte_balance_transaction_unique,priority:1"`
+	AdjustmentDocumentID string    `gorm:"column:adjustment_document_id;type:varchar(36);not null;index:idx_dte_adjustment_document;uniqueIndex:idx_dte_balance_transaction_unique,priority:2"`
+	TransactionType      string    `gorm:"column:transaction_type;type:varchar(20);not null;index:idx_dte_transaction_type;uniqueIndex:idx_dte_balance_transaction_unique,priority:3"`
 	TaxedAmount          float64   `gorm:"column:taxed_amount;type:decimal(18,2);not null"`
 	ExemptAmount         float64   `gorm:"column:exempt_amount;type:decimal(18,2);not null"`
 	NotSubjectAmount     float64   `gorm:"column:not_subject_amount;type:decimal(18,2);not null"`
@@ -0,0 +1,33 @@
+package db_models
+
+import "time"
+
+// DTEOutboxEntry representa el outbox de los documentos emitidos de forma síncrona.
+// El modelo de Hacienda se registra antes de transmitirlo y el sello y la respuesta de Hacienda se registran antes de
+// almacenar el documento, de modo que un documento recibido por Hacienda cuyo almacenamiento u operaciones adicionales
+// fallaron se complete en un reintento. Attempts, LastError y NextAttemptAt permiten reprogramar los reintentos y
+// LockedUntil reserva el documento para que lo complete una sola solicitud o ejecución del job.
+type DTEOutboxEntry struct {
+	ID             uint       `gorm:"column:id;type:uint;primaryKey;autoIncrement;not null"`
+	BranchID       uint       `gorm:"column:branch_id;type:uint;not null;index:idx_outbox_branch"`
+	GenerationCode string     `gorm:"column:generation_code;type:varchar(36);not null;uniqueIndex"`
+	ControlNumber  string     `gorm:"column:control_number;type:varchar(31);not null"`
+	DTEType        string     `gorm:"column:dte_type;type:varchar(2);not null"`
+	Status         string     `gorm:"column:status;type:varchar(15);not null;index:idx_outbox_due,priority:1"`
+	Document       string     `gorm:"column:document;type:json;not null"`
+	ReceptionStamp *string    `gorm:"column:reception_stamp;type:varchar(100)"`
+	MHResponse     string     `gorm:"column:mh_response;type:text"`
+	Attempts       int        `gorm:"column:attempts;type:int;not null;default:0"`
+	LastError      string     `gorm:"column:last_error;type:text"`
+	NextAttemptAt  *time.Time `gorm:"column:next_attempt_at;type:timestamp;null;index:idx_outbox_due,priority:2"`
+	LockedUntil    *time.Time `gorm:"column:locked_until;type:timestamp;null"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP;index"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Relaciones
+	Branch *BranchOffice `gorm:"foreignKey:BranchID;references:ID"`
+}
+
+func (DTEOutboxEntry) TableName() string {
+	return "dte_outbox"
+}
@@ -0,0 +1,29 @@
+package db_models
+
+import "time"
+
+// EmissionJob representa la cola de transmisión de los documentos emitidos de forma asíncrona.
+// El documento se almacena como pendiente al emitirse y un worker lo transmite a Hacienda; Attempts, LastError y
+// NextAttemptAt permiten reintentar las transmisiones fallidas y LockedUntil evita que dos workers procesen el mismo
+// documento.
+type EmissionJob struct {
+	ID            uint       `gorm:"column:id;type:uint;primaryKey;autoIncrement;not null"`
+	BranchID      uint       `gorm:"column:branch_id;type:uint;not null;index:idx_emission_branch"`
+	DocumentID    string     `gorm:"column:document_id;type:varchar(36);not null;uniqueIndex"`
+	DTEType       string     `gorm:"column:dte_type;type:varchar(2);not null"`
+	Status        string     `gorm:"column:status;type:varchar(15);not null;index:idx_emission_ready,priority:1"`
+	Attempts      int        `gorm:"column:attempts;type:int;not null;default:0"`
+	LastError     string     `gorm:"column:last_error;type:text"`
+	NextAttemptAt *time.Time `gorm:"column:next_attempt_at;type:timestamp;null;index:idx_emission_ready,priority:2"`
+	LockedUntil   *time.Time `gorm:"column:locked_until;type:timestamp;null"`
+	CreatedAt     time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Relaciones
+	Document *DTEDetails   `gorm:"foreignKey:DocumentID;references:ID"`
+	Branch   *BranchOffice `gorm:"foreignKey:BranchID;references:ID"`
+}
+
+func (EmissionJob) TableName() string {
+	return "emission_jobs"
+}
@@ -0,0 +1,22 @@
+package db_models
+
+import "time"
+
+// PDFTemplate representa la tabla de plantillas de la versión legible de los DTE de cada usuario.
+// El logo se almacena codificado en base64 para mantener la compatibilidad entre los motores soportados.
+type PDFTemplate struct {
+	ID           uint      `gorm:"column:id;type:uint;primaryKey;autoIncrement;not null"`
+	UserID       uint      `gorm:"column:user_id;type:uint;not null;uniqueIndex:idx_pdf_template_user"`
+	Logo         string    `gorm:"column:logo;type:mediumtext"`
+	LogoMimeType string    `gorm:"column:logo_mime_type;type:varchar(20)"`
+	Footer       string    `gorm:"column:footer;type:varchar(1000)"`
+	CreatedAt    time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Relaciones
+	User *User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (PDFTemplate) TableName() string {
+	return "pdf_templates"
+}
@@ -0,0 +1,29 @@
+package db_models
+
+import "time"
+
+// ReconciliationDiscrepancy representa una diferencia entre el estado local de un documento y su estado en Hacienda
+// encontrada por el job de conciliación, junto a la acción con la que se reparó
+type ReconciliationDiscrepancy struct {
+	ID             uint      `gorm:"column:id;type:uint;primaryKey;autoIncrement;not null"`
+	UserID         uint      `gorm:"column:user_id;type:uint;not null;index:idx_discrepancy_user"`
+	BranchID       uint      `gorm:"column:branch_id;type:uint;not null;index:idx_discrepancy_branch"`
+	GenerationCode string    `gorm:"column:generation_code;type:varchar(36);not null;index"`
+	ControlNumber  string    `gorm:"column:control_number;type:varchar(31)"`
+	DTEType        string    `gorm:"column:dte_type;type:varchar(2)"`
+	Source         string    `gorm:"column:source;type:varchar(20);not null"`
+	LocalStatus    string    `gorm:"column:local_status;type:varchar(15);not null"`
+	MHStatus       string    `gorm:"column:mh_status;type:varchar(15);not null"`
+	ReceptionStamp *string   `gorm:"column:reception_stamp;type:varchar(40)"`
+	Action         string    `gorm:"column:action;type:varchar(20);not null;index"`
+	Detail         string    `gorm:"column:detail;type:text"`
+	DetectedAt     time.Time `gorm:"column:detected_at;type:timestamp;not null;index"`
+
+	// Relaciones
+	User   *User         `gorm:"foreignKey:UserID;references:ID"`
+	Branch *BranchOffice `gorm:"foreignKey:BranchID;references:ID"`
+}
+
+func (ReconciliationDiscrepancy) TableName() string {
+	return "reconciliation_discrepancies"
+}
@@ -0,0 +1,23 @@
+package db_models
+
+import "time"
+
+// RetransmissionSchedule representa la programación propia de un usuario para la retransmisión de sus documentos en
+// contingencia. Los usuarios sin registro utilizan la programación predeterminada del entorno.
+type RetransmissionSchedule struct {
+	UserID              uint      `gorm:"column:user_id;type:uint;primaryKey;not null"`
+	NIT                 string    `gorm:"column:nit;type:varchar(14);not null;uniqueIndex:idx_retransmission_schedule_nit"`
+	Cron                string    `gorm:"column:cron;type:varchar(100);not null"`
+	Windows             string    `gorm:"column:windows;type:varchar(255);not null"`
+	BatchSize           int       `gorm:"column:batch_size;type:int;not null"`
+	MaxExecutionMinutes int       `gorm:"column:max_execution_minutes;type:int;not null"`
+	CreatedAt           time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt           time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Relaciones
+	User *User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (RetransmissionSchedule) TableName() string {
+	return "retransmission_schedules"
+}
@@ -0,0 +1,30 @@
+package db_models
+
+import "time"
+
+// SigningCertificate representa la tabla de certificados de firma emitidos por el Ministerio de Hacienda para cada NIT.
+// El campo EncryptedContent almacena el archivo del certificado (incluida la llave privada PKCS#8) encriptado con la
+// llave SIGNER_CERTIFICATES_KEY, por lo que nunca se guarda en texto plano.
+//
+// Un usuario solo puede tener un certificado activo a la vez; al renovar un certificado los anteriores se desactivan.
+// El campo ExpiryNotifiedAt indica si ya se generó el evento de vencimiento próximo del certificado.
+type SigningCertificate struct {
+	ID               uint       `gorm:"column:id;type:uint;primaryKey;autoIncrement;not null"`
+	UserID           uint       `gorm:"column:user_id;type:uint;not null;index:idx_certificate_user"`
+	NIT              string     `gorm:"column:nit;type:varchar(17);not null;index:idx_certificate_nit"`
+	Fingerprint      string     `gorm:"column:fingerprint;type:varchar(64);not null"`
+	EncryptedContent string     `gorm:"column:encrypted_content;type:text;not null"`
+	ExpiresAt        time.Time  `gorm:"column:expires_at;type:timestamp;not null;index:idx_certificate_expires"`
+	IsActive         bool       `gorm:"column:is_active;type:tinyint(1);not null;index:idx_certificate_active"`
+	ExpiryNotifiedAt *time.Time `gorm:"column:expiry_notified_at;type:timestamp"`
+	DeactivatedAt    *time.Time `gorm:"column:deactivated_at;type:timestamp"`
+	CreatedAt        time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Relaciones
+	User *User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (SigningCertificate) TableName() string {
+	return "signing_certificates"
+}
@@ -4,14 +4,21 @@ import "time"
 
 // UserNotification representa la tabla que almacenará la información de las notificaciones que se enviarán a los usuarios.
 // Esta tabla almacenará la información de las notificaciones que se enviarán a los usuarios.
+// Recipient, ReferenceID, Attempts, LastError y NextAttemptAt permiten reintentar las entregas fallidas, por ejemplo
+// el envío de un DTE por correo a su receptor, donde ReferenceID es el código de generación del documento.
 type UserNotification struct {
-	ID               uint      `gorm:"column:id;type:uint;primaryKey;autoIncrement;not null"`
-	UserID           uint      `gorm:"column:user_id;type:uint;not null;index:idx_notification_user"`
-	EventID          uint      `gorm:"column:event_id;type:uint;not null;index:idx_notification_event"`
-	NotificationType string    `gorm:"column:notification_type;type:varchar(15);not null;index"`
-	Message          string    `gorm:"column:message;type:text;not null"`
-	DeliveryStatus   string    `gorm:"column:delivery_status;type:varchar(15);not null;index"`
-	DeliveryAt       time.Time `gorm:"column:delivery_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	ID               uint       `gorm:"column:id;type:uint;primaryKey;autoIncrement;not null"`
+	UserID           uint       `gorm:"column:user_id;type:uint;not null;index:idx_notification_user"`
+	EventID          uint       `gorm:"column:event_id;type:uint;not null;index:idx_notification_event"`
+	NotificationType string     `gorm:"column:notification_type;type:varchar(15);not null;index"`
+	Message          string     `gorm:"column:message;type:text;not null"`
+	DeliveryStatus   string     `gorm:"column:delivery_status;type:varchar(15);not null;index;index:idx_notification_pending,priority:1"`
+	DeliveryAt       time.Time  `gorm:"column:delivery_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	Recipient        string     `gorm:"column:recipient;type:varchar(255)"`
+	ReferenceID      string     `gorm:"column:reference_id;type:varchar(36);index:idx_notification_reference"`
+	Attempts         int        `gorm:"column:attempts;type:int;not null;default:0"`
+	LastError        string     `gorm:"column:last_error;type:text"`
+	NextAttemptAt    *time.Time `gorm:"column:next_attempt_at;type:timestamp;null;index:idx_notification_pending,priority:2"`
 
 	// Relaciones
 	User  *User        `gorm:"foreignKey:UserID;references:ID"`
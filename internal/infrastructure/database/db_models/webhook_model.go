@@ -0,0 +1,57 @@
+package db_models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WebhookSubscription representa un endpoint de un usuario que recibe los eventos del ciclo de vida de sus DTE.
+// Events almacena los eventos suscritos separados por comas y Secret el secreto de firma cifrado. Las suscripciones
+// eliminadas se conservan para mantener el registro de sus entregas.
+type WebhookSubscription struct {
+	ID        uint           `gorm:"column:id;type:uint;primaryKey;autoIncrement;not null"`
+	UserID    uint           `gorm:"column:user_id;type:uint;not null;index:idx_webhook_user"`
+	URL       string         `gorm:"column:url;type:varchar(500);not null"`
+	Events    string         `gorm:"column:events;type:varchar(500);not null"`
+	Secret    string         `gorm:"column:secret;type:text;not null"`
+	IsActive  bool           `gorm:"column:is_active;type:boolean;not null;default:true"`
+	CreatedAt time.Time      `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;null;index"`
+
+	// Relaciones
+	User *User `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookDelivery representa el registro de entrega de un evento a una suscripción.
+// Attempts, LastError y NextAttemptAt permiten reintentar las entregas fallidas y LockedUntil evita que el envío
+// inmediato y el job de reintentos envíen la misma entrega. ReplayOf referencia la entrega original de un reenvío.
+type WebhookDelivery struct {
+	ID             uint       `gorm:"column:id;type:uint;primaryKey;autoIncrement;not null"`
+	SubscriptionID uint       `gorm:"column:subscription_id;type:uint;not null;index:idx_webhook_delivery_subscription"`
+	EventID        string     `gorm:"column:event_id;type:varchar(36);not null;index:idx_webhook_delivery_event"`
+	EventType      string     `gorm:"column:event_type;type:varchar(50);not null"`
+	Payload        string     `gorm:"column:payload;type:text;not null"`
+	Status         string     `gorm:"column:status;type:varchar(15);not null;index:idx_webhook_delivery_pending,priority:1"`
+	Attempts       int        `gorm:"column:attempts;type:int;not null;default:0"`
+	ResponseStatus *int       `gorm:"column:response_status;type:int;null"`
+	LastError      string     `gorm:"column:last_error;type:text"`
+	NextAttemptAt  *time.Time `gorm:"column:next_attempt_at;type:timestamp;null;index:idx_webhook_delivery_pending,priority:2"`
+	LockedUntil    *time.Time `gorm:"column:locked_until;type:timestamp;null"`
+	DeliveredAt    *time.Time `gorm:"column:delivered_at;type:timestamp;null"`
+	ReplayOf       *uint      `gorm:"column:replay_of;type:uint;null"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP"`
+
+	// Relaciones
+	Subscription *WebhookSubscription `gorm:"foreignKey:SubscriptionID;references:ID"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
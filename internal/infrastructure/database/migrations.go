@@ -5,6 +5,11 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 
+	"github.com/MarlonG1/api-facturacion-sv/config"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/crypt"
 	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/database/db_models"
 	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
 )
@@ -17,19 +22,36 @@ var modelsToMigrate = []schema.Tabler{
 	&db_models.DTEDetails{},
 	&db_models.DTEDocument{},
 	&db_models.ContingencyDocument{},
+	&db_models.ContingencyEvent{},
 	&db_models.ControlNumberSequence{},
 	&db_models.FailedSequenceNumber{},
+	&db_models.ReconciliationDiscrepancy{},
 	&db_models.DomainEvent{},
 	&db_models.UserNotification{},
 	&db_models.NotifiableUser{},
 	&db_models.DTEBalanceControl{},
 	&db_models.DTEBalanceTransaction{},
+	&db_models.SigningCertificate{},
+	&db_models.PDFTemplate{},
+	&db_models.EmissionJob{},
+	&db_models.DTEOutboxEntry{},
+	&db_models.WebhookSubscription{},
+	&db_models.WebhookDelivery{},
+	&db_models.RetransmissionSchedule{},
 }
 
 // RunMigrations ejecuta todas las migraciones de la base de datos
 func RunMigrations(db *gorm.DB) error {
 	logs.Info("Starting database migrations")
 
+	// Las transacciones de saldo duplicadas se unifican antes de migrar, de lo contrario no se puede crear su índice único
+	if err := mergeDuplicatedBalanceTransactions(db); err != nil {
+		logs.Error("Failed to merge the duplicated balance transactions", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
 	for i, model := range modelsToMigrate {
 		tn := model.TableName()
 		logs.Info(fmt.Sprintf("Starting model migration #%d: %s", i+1, tn))
@@ -46,6 +68,189 @@ func RunMigrations(db *gorm.DB) error {
 		logs.Info(fmt.Sprintf("Successfully migrated model %s", tn))
 	}
 
+	if err := hashPlainAPISecrets(db); err != nil {
+		logs.Error("Failed to hash the API secrets of the branch offices", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if err := assignDefaultRoles(db); err != nil {
+		logs.Error("Failed to assign the default roles of the branch offices", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if err := dropEmissionJobToken(db); err != nil {
+		logs.Error("Failed to drop the token of the emission jobs", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if err := dropContingencyEventDocuments(db); err != nil {
+		logs.Error("Failed to drop the documents of the contingency events", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if err := reencryptWebhookSecrets(db, crypt.NewCryptService(), config.Server.JWTSecret, config.Webhook.SecretsKey); err != nil {
+		logs.Error("Failed to re-encrypt the signing secrets of the webhook subscriptions", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
 	logs.Info("All migrations completed successfully")
 	return nil
 }
+
+// hashPlainAPISecrets reemplaza los API secrets de las sucursales almacenados en texto plano por su hash
+func hashPlainAPISecrets(db *gorm.DB) error {
+	var branches []db_models.BranchOffice
+	if err := db.Select("id", "api_secret").Where("api_secret NOT LIKE ?", crypt.HashedSecretPrefix+"%").Find(&branches).Error; err != nil {
+		return err
+	}
+
+	for _, branch := range branches {
+		if err := db.Model(&db_models.BranchOffice{}).Where("id = ?", branch.ID).
+			Update("api_secret", crypt.HashSecret(branch.APISecret)).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(branches) > 0 {
+		logs.Info(fmt.Sprintf("Hashed the API secrets of %d branch offices", len(branches)))
+	}
+
+	return nil
+}
+
+// assignDefaultRoles asigna el rol predeterminado a las sucursales registradas antes de que sus credenciales tuvieran
+// rol: administrador a la casa matriz y operador al resto
+func assignDefaultRoles(db *gorm.DB) error {
+	result := db.Model(&db_models.BranchOffice{}).
+		Where("role = '' AND establishment_type = ?", constants.CasaMatriz).
+		Update("role", user.RoleAdmin)
+	if result.Error != nil {
+		return result.Error
+	}
+	admins := result.RowsAffected
+
+	result = db.Model(&db_models.BranchOffice{}).Where("role = ''").Update("role", user.RoleOperator)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if admins+result.RowsAffected > 0 {
+		logs.Info(fmt.Sprintf("Assigned the default role to %d branch offices", admins+result.RowsAffected))
+	}
+
+	return nil
+}
+
+// dropEmissionJobToken elimina el token de la solicitud que los jobs de emisión almacenaban, el worker ahora se
+// autentica con el token de sistema de la sucursal
+func dropEmissionJobToken(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&db_models.EmissionJob{}, "token") {
+		return nil
+	}
+
+	return db.Migrator().DropColumn(&db_models.EmissionJob{}, "token")
+}
+
+// dropContingencyEventDocuments elimina la lista de documentos separados por comas que almacenaban los eventos de
+// contingencia, los documentos ahora referencian su evento en contingency_event_id
+func dropContingencyEventDocuments(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&db_models.ContingencyEvent{}, "documents") {
+		return nil
+	}
+
+	return db.Migrator().DropColumn(&db_models.ContingencyEvent{}, "documents")
+}
+
+// reencryptWebhookSecrets cifra con WEBHOOK_SECRETS_KEY los secretos de firma de las suscripciones que se cifraron con
+// JWT_SECRET antes de que los webhooks tuvieran su propia llave. Los secretos que ya se descifran con la nueva llave no
+// se modifican.
+func reencryptWebhookSecrets(db *gorm.DB, cryptManager ports.CryptManager, previousKey, key string) error {
+	var subscriptions []db_models.WebhookSubscription
+	if err := db.Unscoped().Select("id", "secret").Find(&subscriptions).Error; err != nil {
+		return err
+	}
+
+	reencrypted := 0
+	for _, subscription := range subscriptions {
+		if _, err := cryptManager.Decrypt(key, subscription.Secret); err == nil {
+			continue
+		}
+
+		secret, err := cryptManager.Decrypt(previousKey, subscription.Secret)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt the secret of webhook subscription %d: %w", subscription.ID, err)
+		}
+
+		encrypted, err := cryptManager.Encrypt(key, secret)
+		if err != nil {
+			return err
+		}
+
+		if err = db.Unscoped().Model(&db_models.WebhookSubscription{}).Where("id = ?", subscription.ID).
+			UpdateColumn("secret", encrypted).Error; err != nil {
+			return err
+		}
+		reencrypted++
+	}
+
+	if reencrypted > 0 {
+		logs.Info(fmt.Sprintf("Re-encrypted the signing secrets of %d webhook subscriptions", reencrypted))
+	}
+
+	return nil
+}
+
+// mergeDuplicatedBalanceTransactions unifica en una sola las transacciones de saldo que un documento de ajuste registró
+// varias veces con el mismo tipo sobre un documento, como las que la invalidación generaba por cada ítem. Los montos se
+// suman en la primera transacción y el resto se elimina, el saldo del documento ya los refleja y no se modifica
+func mergeDuplicatedBalanceTransactions(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&db_models.DTEBalanceTransaction{}) {
+		return nil
+	}
+
+	var duplicates []db_models.DTEBalanceTransaction
+	if err := db.Model(&db_models.DTEBalanceTransaction{}).
+		Select("MIN(id) AS id, balance_control_id, adjustment_document_id, transaction_type, " +
+			"SUM(taxed_amount) AS taxed_amount, SUM(exempt_amount) AS exempt_amount, SUM(not_subject_amount) AS not_subject_amount").
+		Group("balance_control_id, adjustment_document_id, transaction_type").
+		Having("COUNT(*) > 1").
+		Scan(&duplicates).Error; err != nil {
+		return err
+	}
+
+	for _, duplicate := range duplicates {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Model(&db_models.DTEBalanceTransaction{}).Where("id = ?", duplicate.ID).
+				UpdateColumns(map[string]interface{}{
+					"taxed_amount":       duplicate.TaxedAmount,
+					"exempt_amount":      duplicate.ExemptAmount,
+					"not_subject_amount": duplicate.NotSubjectAmount,
+				}).Error; err != nil {
+				return err
+			}
+
+			return tx.Where("balance_control_id = ? AND adjustment_document_id = ? AND transaction_type = ? AND id <> ?",
+				duplicate.BalanceControlID, duplicate.AdjustmentDocumentID, duplicate.TransactionType, duplicate.ID).
+				Delete(&db_models.DTEBalanceTransaction{}).Error
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(duplicates) > 0 {
+		logs.Info(fmt.Sprintf("Merged the duplicated balance transactions of %d adjustment documents", len(duplicates)))
+	}
+
+	return nil
+}
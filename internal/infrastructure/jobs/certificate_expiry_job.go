@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type CertificateExpiryJob struct {
+	CertificateService certificate.CertificateManager
+	IsRunning          atomic.Bool
+	MaxExecutionTime   time.Duration
+}
+
+func NewCertificateExpiryJob(certificateService certificate.CertificateManager) *CertificateExpiryJob {
+	return &CertificateExpiryJob{
+		CertificateService: certificateService,
+		MaxExecutionTime:   5 * time.Minute,
+	}
+}
+
+// Execute genera los eventos de vencimiento de los certificados de firma próximos a vencer.
+func (j *CertificateExpiryJob) Execute(ctx context.Context) error {
+	// Evitar ejecuciones concurrentes
+	if !j.IsRunning.CompareAndSwap(false, true) {
+		logs.Warn("Certificate expiry job already running, skipping execution")
+		return ErrJobAlreadyRunning
+	}
+	defer j.IsRunning.Store(false)
+
+	ctx, cancel := context.WithTimeout(ctx, j.MaxExecutionTime)
+	defer cancel()
+
+	notified, err := j.CertificateService.NotifyExpiringCertificates(ctx)
+	if err != nil {
+		logs.Error("Certificate expiry job failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	logs.Info("Certificate expiry job completed successfully", map[string]interface{}{
+		"notified":  notified,
+		"timestamp": utils.TimeNow().Format(time.RFC3339),
+	})
+
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"github.com/MarlonG1/api-facturacion-sv/config/drivers"
 	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	domainJobs "github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs"
 	"sync/atomic"
 	"time"
 
@@ -15,32 +17,51 @@ import (
 type RetransmissionJob struct {
 	connection         *drivers.DbConnection
 	ContingencyService contingency.ContingencyManager
+	Schedule           models.RetransmissionSchedule
+	Windows            []models.TimeWindow
+	ExcludedNITs       func() []string
 	IsRunning          atomic.Bool
 	MaxExecutionTime   time.Duration
 }
 
-func NewRetransmissionJob(contingencyService contingency.ContingencyManager, connection *drivers.DbConnection) *RetransmissionJob {
+// NewRetransmissionJob crea el job de retransmisión de una programación. Si la programación no tiene NIT el job
+// retransmite los documentos de todos los sistemas, excepto los que ExcludedNITs indique.
+func NewRetransmissionJob(contingencyService contingency.ContingencyManager, connection *drivers.DbConnection, schedule models.RetransmissionSchedule, excludedNITs func() []string) (*RetransmissionJob, error) {
+	windows, err := models.ParseTimeWindows(schedule.Windows)
+	if err != nil {
+		return nil, err
+	}
+
 	return &RetransmissionJob{
 		connection:         connection,
 		ContingencyService: contingencyService,
-		MaxExecutionTime:   10 * time.Minute,
-	}
+		Schedule:           schedule,
+		Windows:            windows,
+		ExcludedNITs:       excludedNITs,
+		MaxExecutionTime:   schedule.MaxExecutionTime(),
+	}, nil
 }
 
-// Execute ejecuta el trabajo de retransmisión de documentos en contingencia.
-func (j *RetransmissionJob) Execute() {
+// Execute ejecuta el trabajo de retransmisión de documentos en contingencia dentro de sus ventanas horarias.
+func (j *RetransmissionJob) Execute(ctx context.Context) error {
 	// Evitar ejecuciones concurrentes
 	if !j.IsRunning.CompareAndSwap(false, true) {
 		logs.Warn("Job already running, skipping execution")
-		return
+		return ErrJobAlreadyRunning
 	}
 	defer j.IsRunning.Store(false)
 
-	ctx, cancel := context.WithTimeout(context.Background(), j.MaxExecutionTime)
+	// Respetar las ventanas horarias de la programación
+	if !models.InWindows(j.Windows, utils.TimeNow()) {
+		return ErrOutsideWindow
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, j.MaxExecutionTime)
 	defer cancel()
 
 	logs.Info("Starting retransmission job", map[string]interface{}{
 		"MaxExecutionTime": j.MaxExecutionTime,
+		"nit":              j.Schedule.NIT,
 		"timestamp":        utils.TimeNow().Format(time.RFC3339),
 	})
 
@@ -49,21 +70,38 @@ func (j *RetransmissionJob) Execute() {
 		logs.Error("Error connecting to database", map[string]interface{}{
 			"error": err.Error(),
 		})
-		return
+		return err
 	}
 	sqlDb.Ping()
 
-	if err := j.ContingencyService.RetransmitPendingDocuments(ctx); err != nil {
+	if j.Schedule.NIT == "" {
+		var excluded []string
+		if j.ExcludedNITs != nil {
+			excluded = j.ExcludedNITs()
+		}
+		err = j.ContingencyService.RetransmitPendingDocuments(ctx, j.Schedule.BatchSize, excluded)
+	} else {
+		err = j.ContingencyService.RetransmitTenantDocuments(ctx, j.Schedule.NIT, j.Schedule.BatchSize)
+	}
+
+	if err != nil {
 		j.handleExecutionError(err)
-		return
+		return err
 	}
 
 	logs.Info("Retransmission job completed successfully", map[string]interface{}{
+		"nit":       j.Schedule.NIT,
 		"timestamp": utils.TimeNow().Format(time.RFC3339),
 	})
+
+	return nil
 }
 
 func (j *RetransmissionJob) handleExecutionError(err error) {
+	if errors.Is(err, domainJobs.ErrJobSkipped) {
+		return
+	}
+
 	if errors.Is(err, context.DeadlineExceeded) {
 		logs.Error("Job execution timed out", map[string]interface{}{
 			"MaxExecutionTime": j.MaxExecutionTime,
@@ -0,0 +1,99 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+)
+
+// EmissionPollInterval es la espera de un worker cuando la cola de emisión está vacía
+const EmissionPollInterval = 2 * time.Second
+
+// EmissionWorkerPool transmite en segundo plano los documentos emitidos de forma asíncrona
+type EmissionWorkerPool struct {
+	Processor    ports.DTEEmissionProcessor
+	Workers      int
+	PollInterval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewEmissionWorkerPool(processor ports.DTEEmissionProcessor, workers int) *EmissionWorkerPool {
+	return &EmissionWorkerPool{
+		Processor:    processor,
+		Workers:      workers,
+		PollInterval: EmissionPollInterval,
+	}
+}
+
+// Start inicia los workers, cada uno procesa la cola hasta vaciarla y espera el intervalo de consulta
+func (p *EmissionWorkerPool) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	for i := 0; i < p.Workers; i++ {
+		p.wg.Add(1)
+		go p.run(ctx, i+1)
+	}
+
+	logs.Info("Emission workers started", map[string]interface{}{
+		"workers": p.Workers,
+	})
+}
+
+// Stop detiene los workers y espera a que terminen el documento que están transmitiendo, o hasta que el contexto expire
+func (p *EmissionWorkerPool) Stop(ctx context.Context) {
+	if p.cancel == nil {
+		return
+	}
+	p.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		logs.Info("Emission workers stopped")
+	case <-ctx.Done():
+		logs.Warn("Emission workers did not stop in time, pending jobs will be retaken after their lock expires")
+	}
+}
+
+func (p *EmissionWorkerPool) run(ctx context.Context, worker int) {
+	defer p.wg.Done()
+
+	for {
+		// 1. Transmitir el siguiente documento sin interrumpirlo si se solicita el apagado
+		processed, err := p.Processor.ProcessNext(context.WithoutCancel(ctx))
+		if err != nil {
+			logs.Error("Emission worker failed to process job", map[string]interface{}{
+				"worker": worker,
+				"error":  err.Error(),
+			})
+		}
+
+		// 2. Continuar de inmediato mientras haya documentos en cola
+		if processed && err == nil {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				continue
+			}
+		}
+
+		// 3. Esperar el intervalo de consulta
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.PollInterval):
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	domainJobs "github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// DefaultJobLeaseTTL es la duración del bloqueo distribuido de un job. La réplica que lo tiene lo renueva cada tercio de
+// este tiempo, y si se detiene a mitad de una ejecución otra réplica puede tomarlo cuando expira.
+const DefaultJobLeaseTTL = 30 * time.Second
+
+// jobLockTimeout es el tiempo máximo de las operaciones sobre los bloqueos
+const jobLockTimeout = 5 * time.Second
+
+var (
+	// ErrJobAlreadyRunning indica que la ejecución anterior del job aún no termina
+	ErrJobAlreadyRunning = fmt.Errorf("%w: previous execution still running", domainJobs.ErrJobSkipped)
+	// ErrOutsideWindow indica que el job se disparó fuera de sus ventanas horarias
+	ErrOutsideWindow = fmt.Errorf("%w: outside of the allowed time windows", domainJobs.ErrJobSkipped)
+	// ErrJobLockedByOtherInstance indica que otra réplica tiene el bloqueo del job
+	ErrJobLockedByOtherInstance = fmt.Errorf("%w: locked by another instance", domainJobs.ErrJobSkipped)
+	// ErrJobLeaseLost indica que la réplica perdió el bloqueo del job durante la ejecución
+	ErrJobLeaseLost = errors.New("job lock lease lost")
+)
+
+// JobMonitor mantiene en memoria el estado de los jobs programados en esta instancia y ejecuta cada job bajo su
+// bloqueo distribuido. Sin bloqueo los jobs solo se protegen dentro de esta réplica.
+type JobMonitor struct {
+	mu       sync.RWMutex
+	statuses map[string]*models.JobStatus
+	nextRuns map[string]func() time.Time
+
+	locker   domainJobs.JobLocker
+	leaseTTL time.Duration
+}
+
+func NewJobMonitor(locker domainJobs.JobLocker, leaseTTL time.Duration) domainJobs.JobMonitor {
+	return &JobMonitor{
+		statuses: make(map[string]*models.JobStatus),
+		nextRuns: make(map[string]func() time.Time),
+		locker:   locker,
+		leaseTTL: leaseTTL,
+	}
+}
+
+// Register registra un job o actualiza su programación conservando el historial de ejecuciones
+func (m *JobMonitor) Register(name, schedule string, nextRun func() time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status, ok := m.statuses[name]
+	if !ok {
+		status = &models.JobStatus{Name: name}
+		m.statuses[name] = status
+	}
+	status.Schedule = schedule
+	m.nextRuns[name] = nextRun
+}
+
+// Unregister elimina un job que dejó de estar programado
+func (m *JobMonitor) Unregister(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.statuses, name)
+	delete(m.nextRuns, name)
+}
+
+// Track retorna una función que ejecuta el job bajo su bloqueo y registra su resultado. Los errores que envuelven
+// ErrJobSkipped se registran como ejecuciones omitidas y no cuentan como fallos.
+func (m *JobMonitor) Track(name string, run func(ctx context.Context) error) func() {
+	return func() {
+		startedAt := utils.TimeNow()
+		m.update(name, func(status *models.JobStatus) {
+			status.Running = true
+		})
+
+		err := m.runWithLease(name, run)
+		duration := utils.TimeNow().Sub(startedAt)
+
+		m.update(name, func(status *models.JobStatus) {
+			status.Running = false
+			status.LastRun = &startedAt
+			status.LastDuration = duration.Round(time.Millisecond).String()
+			status.LastError = ""
+			status.Runs++
+
+			switch {
+			case err == nil:
+				status.LastOutcome = models.JobOutcomeSuccess
+			case errors.Is(err, domainJobs.ErrJobSkipped):
+				status.LastOutcome = models.JobOutcomeSkipped
+				status.LastError = err.Error()
+			default:
+				status.LastOutcome = models.JobOutcomeFailed
+				status.LastError = err.Error()
+				status.Failures++
+			}
+		})
+	}
+}
+
+// List obtiene una copia del estado de los jobs registrados ordenados por nombre, con el dueño de su bloqueo
+func (m *JobMonitor) List(ctx context.Context) *models.JobsStatus {
+	m.mu.RLock()
+	statuses := make([]models.JobStatus, 0, len(m.statuses))
+	for name, status := range m.statuses {
+		copied := *status
+		if nextRun := m.nextRuns[name]; nextRun != nil {
+			if next := nextRun(); !next.IsZero() {
+				copied.NextRun = &next
+			}
+		}
+		statuses = append(statuses, copied)
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	result := &models.JobsStatus{Jobs: statuses}
+	if m.locker == nil {
+		return result
+	}
+
+	// Consultar los bloqueos fuera del mutex para no detener las ejecuciones mientras responde Redis
+	result.Instance = m.locker.Instance()
+	for i := range statuses {
+		owner, ttl, err := m.locker.Owner(ctx, statuses[i].Name)
+		if err != nil {
+			logs.Warn("Failed to get job lock owner", map[string]interface{}{
+				"job":   statuses[i].Name,
+				"error": err.Error(),
+			})
+			continue
+		}
+		if owner != "" {
+			statuses[i].LockOwner = owner
+			statuses[i].LockTTL = ttl.Round(time.Second).String()
+		}
+	}
+
+	return result
+}
+
+// runWithLease adquiere el bloqueo del job, lo renueva mientras se ejecuta y lo libera al terminar. Si la réplica no
+// logra renovarlo antes de que expire se cancela el contexto de la ejecución, ya que otra réplica puede tomarlo.
+func (m *JobMonitor) runWithLease(name string, run func(ctx context.Context) error) error {
+	if m.locker == nil {
+		return run(context.Background())
+	}
+
+	// 1. Adquirir el bloqueo, si otra réplica lo tiene se omite la ejecución
+	lockCtx, lockCancel := context.WithTimeout(context.Background(), jobLockTimeout)
+	acquired, err := m.locker.Acquire(lockCtx, name, m.leaseTTL)
+	lockCancel()
+	if err != nil {
+		return fmt.Errorf("failed to acquire job lock: %w", err)
+	}
+	if !acquired {
+		return m.lockedError(name)
+	}
+
+	// 2. Renovar el bloqueo mientras el job se ejecuta
+	ctx, cancel := context.WithCancelCause(context.Background())
+	renewed := make(chan struct{})
+	stop := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		m.renewLease(ctx, cancel, name, stop)
+	}()
+
+	// 3. Ejecutar el job y liberar el bloqueo
+	err = run(ctx)
+	close(stop)
+	<-renewed
+	cancel(nil)
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), jobLockTimeout)
+	if releaseErr := m.locker.Release(releaseCtx, name); releaseErr != nil {
+		logs.Warn("Failed to release job lock", map[string]interface{}{
+			"job":   name,
+			"error": releaseErr.Error(),
+		})
+	}
+	releaseCancel()
+
+	if err != nil && errors.Is(context.Cause(ctx), ErrJobLeaseLost) {
+		return fmt.Errorf("%w: %w", ErrJobLeaseLost, err)
+	}
+
+	return err
+}
+
+// renewLease renueva el bloqueo cada tercio de su duración hasta que termine la ejecución. Cancela la ejecución si
+// otra réplica tomó el bloqueo o si no se pudo renovar antes de que expirara.
+func (m *JobMonitor) renewLease(ctx context.Context, cancel context.CancelCauseFunc, name string, stop <-chan struct{}) {
+	ticker := time.NewTicker(m.leaseTTL / 3)
+	defer ticker.Stop()
+
+	lastRenewal := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			renewCtx, renewCancel := context.WithTimeout(ctx, jobLockTimeout)
+			renewed, err := m.locker.Renew(renewCtx, name, m.leaseTTL)
+			renewCancel()
+
+			switch {
+			case err == nil && renewed:
+				lastRenewal = time.Now()
+			case err == nil:
+				logs.Error("Job lock taken by another instance, cancelling execution", map[string]interface{}{
+					"job":      name,
+					"instance": m.locker.Instance(),
+				})
+				cancel(ErrJobLeaseLost)
+				return
+			case time.Since(lastRenewal) >= m.leaseTTL:
+				logs.Error("Job lock lease expired, cancelling execution", map[string]interface{}{
+					"job":   name,
+					"error": err.Error(),
+				})
+				cancel(ErrJobLeaseLost)
+				return
+			default:
+				logs.Warn("Failed to renew job lock, retrying", map[string]interface{}{
+					"job":   name,
+					"error": err.Error(),
+				})
+			}
+		}
+	}
+}
+
+// lockedError obtiene el error de una ejecución omitida indicando la réplica que tiene el bloqueo
+func (m *JobMonitor) lockedError(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), jobLockTimeout)
+	defer cancel()
+
+	owner, _, err := m.locker.Owner(ctx, name)
+	if err != nil || owner == "" {
+		return ErrJobLockedByOtherInstance
+	}
+
+	return fmt.Errorf("%w (%s)", ErrJobLockedByOtherInstance, owner)
+}
+
+// update aplica un cambio al estado de un job, registrándolo si aún no existe
+func (m *JobMonitor) update(name string, apply func(status *models.JobStatus)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status, ok := m.statuses[name]
+	if !ok {
+		status = &models.JobStatus{Name: name}
+		m.statuses[name] = status
+	}
+	apply(status)
+}
@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type MailDeliveryJob struct {
+	Notifiers        []ports.NotificationRetrier
+	IsRunning        atomic.Bool
+	MaxExecutionTime time.Duration
+}
+
+func NewMailDeliveryJob(notifiers ...ports.NotificationRetrier) *MailDeliveryJob {
+	return &MailDeliveryJob{
+		Notifiers:        notifiers,
+		MaxExecutionTime: 10 * time.Minute,
+	}
+}
+
+// Execute reintenta los envíos por correo cuyo intento anterior falló, tanto de los DTE a sus receptores como de
+// las notificaciones de eventos de dominio.
+func (j *MailDeliveryJob) Execute(ctx context.Context) error {
+	// Evitar ejecuciones concurrentes
+	if !j.IsRunning.CompareAndSwap(false, true) {
+		logs.Warn("Mail delivery job already running, skipping execution")
+		return ErrJobAlreadyRunning
+	}
+	defer j.IsRunning.Store(false)
+
+	ctx, cancel := context.WithTimeout(ctx, j.MaxExecutionTime)
+	defer cancel()
+
+	sent := 0
+	var errs []error
+	for _, notifier := range j.Notifiers {
+		delivered, err := notifier.RetryPending(ctx)
+		if err != nil {
+			logs.Error("Mail delivery job failed", map[string]interface{}{
+				"error": err.Error(),
+			})
+			errs = append(errs, err)
+			continue
+		}
+		sent += delivered
+	}
+
+	if sent > 0 {
+		logs.Info("Mail delivery job completed successfully", map[string]interface{}{
+			"sent":      sent,
+			"timestamp": utils.TimeNow().Format(time.RFC3339),
+		})
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type OutboxJob struct {
+	Processor        ports.DTEOutboxProcessor
+	IsRunning        atomic.Bool
+	MaxExecutionTime time.Duration
+}
+
+func NewOutboxJob(processor ports.DTEOutboxProcessor) *OutboxJob {
+	return &OutboxJob{
+		Processor:        processor,
+		MaxExecutionTime: 10 * time.Minute,
+	}
+}
+
+// Execute completa los documentos transmitidos del outbox cuyo almacenamiento u operaciones adicionales fallaron y
+// cuyo próximo intento ya se cumplió.
+func (j *OutboxJob) Execute(ctx context.Context) error {
+	// Evitar ejecuciones concurrentes
+	if !j.IsRunning.CompareAndSwap(false, true) {
+		logs.Warn("Outbox job already running, skipping execution")
+		return ErrJobAlreadyRunning
+	}
+	defer j.IsRunning.Store(false)
+
+	ctx, cancel := context.WithTimeout(ctx, j.MaxExecutionTime)
+	defer cancel()
+
+	completed, err := j.Processor.ProcessPending(ctx)
+	if err != nil {
+		logs.Error("Outbox job failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if completed > 0 {
+		logs.Info("Outbox job completed successfully", map[string]interface{}{
+			"completed": completed,
+			"timestamp": utils.TimeNow().Format(time.RFC3339),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type ReconciliationJob struct {
+	ReconciliationManager reconciliation.ReconciliationManager
+	IsRunning             atomic.Bool
+	MaxExecutionTime      time.Duration
+}
+
+func NewReconciliationJob(reconciliationManager reconciliation.ReconciliationManager) *ReconciliationJob {
+	return &ReconciliationJob{
+		ReconciliationManager: reconciliationManager,
+		MaxExecutionTime:      10 * time.Minute,
+	}
+}
+
+// Execute compara el estado local de los documentos pendientes y de los números de secuencia fallidos con su estado
+// en Hacienda, reparando las discrepancias encontradas.
+func (j *ReconciliationJob) Execute(ctx context.Context) error {
+	// Evitar ejecuciones concurrentes
+	if !j.IsRunning.CompareAndSwap(false, true) {
+		logs.Warn("Reconciliation job already running, skipping execution")
+		return ErrJobAlreadyRunning
+	}
+	defer j.IsRunning.Store(false)
+
+	ctx, cancel := context.WithTimeout(ctx, j.MaxExecutionTime)
+	defer cancel()
+
+	result, err := j.ReconciliationManager.Reconcile(ctx)
+	if err != nil {
+		logs.Error("Reconciliation job failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	logs.Info("Reconciliation job completed successfully", map[string]interface{}{
+		"checked":       result.Checked,
+		"discrepancies": result.Discrepancies,
+		"timestamp":     utils.TimeNow().Format(time.RFC3339),
+	})
+
+	return nil
+}
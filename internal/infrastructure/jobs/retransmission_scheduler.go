@@ -0,0 +1,190 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-co-op/gocron"
+
+	"github.com/MarlonG1/api-facturacion-sv/config/drivers"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	domainJobs "github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+const defaultScheduleTag = "retransmission:default"
+
+// RetransmissionScheduleSyncInterval es el intervalo en minutos en el que cada réplica vuelve a cargar las
+// programaciones propias de los usuarios, para aplicar los cambios recibidos por otra réplica
+const RetransmissionScheduleSyncInterval = 1
+
+// ScheduleLoader obtiene las programaciones propias de los usuarios
+type ScheduleLoader func(ctx context.Context) ([]models.RetransmissionSchedule, error)
+
+// RetransmissionScheduler programa el job de retransmisión predeterminado y los jobs propios de los usuarios que
+// definieron su programación. Los documentos de esos usuarios se excluyen del job predeterminado.
+type RetransmissionScheduler struct {
+	scheduler          *gocron.Scheduler
+	contingencyService contingency.ContingencyManager
+	connection         *drivers.DbConnection
+	monitor            domainJobs.JobMonitor
+
+	mu      sync.RWMutex
+	tenants map[string]models.RetransmissionSchedule
+}
+
+func NewRetransmissionScheduler(contingencyService contingency.ContingencyManager, connection *drivers.DbConnection, monitor domainJobs.JobMonitor) *RetransmissionScheduler {
+	return &RetransmissionScheduler{
+		scheduler:          gocron.NewScheduler(utils.TimeLocation()),
+		contingencyService: contingencyService,
+		connection:         connection,
+		monitor:            monitor,
+		tenants:            make(map[string]models.RetransmissionSchedule),
+	}
+}
+
+// Start programa el job predeterminado y los jobs de los usuarios con programación propia e inicia el programador.
+// Las programaciones propias se sincronizan periódicamente con las almacenadas.
+func (s *RetransmissionScheduler) Start(defaults *models.RetransmissionSchedule, load ScheduleLoader) error {
+	if err := s.schedule(defaults, contingency.RetransmissionJobName, defaultScheduleTag); err != nil {
+		return err
+	}
+
+	if err := s.Sync(context.Background(), load); err != nil {
+		return err
+	}
+
+	_, err := s.scheduler.Every(RetransmissionScheduleSyncInterval).Minutes().WaitForSchedule().Do(func() {
+		if err := s.Sync(context.Background(), load); err != nil {
+			logs.Error("Failed to sync retransmission schedules", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to schedule retransmission schedule sync: %w", err)
+	}
+
+	s.scheduler.StartAsync()
+	return nil
+}
+
+// Sync aplica las programaciones propias que cambiaron y elimina los jobs de las que ya no existen
+func (s *RetransmissionScheduler) Sync(ctx context.Context, load ScheduleLoader) error {
+	schedules, err := load(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored := make(map[string]bool, len(schedules))
+	for i := range schedules {
+		stored[schedules[i].NIT] = true
+
+		s.mu.RLock()
+		current, ok := s.tenants[schedules[i].NIT]
+		s.mu.RUnlock()
+		if ok && sameSchedule(&current, &schedules[i]) {
+			continue
+		}
+
+		if err = s.Apply(&schedules[i]); err != nil {
+			// Una programación inválida de un usuario no debe detener a las demás, sus documentos siguen en el job
+			// predeterminado
+			logs.Error("Failed to schedule tenant retransmission job", map[string]interface{}{
+				"nit":   schedules[i].NIT,
+				"error": err.Error(),
+			})
+		}
+	}
+
+	for _, nit := range s.excludedNITs() {
+		if !stored[nit] {
+			s.Remove(nit)
+		}
+	}
+
+	return nil
+}
+
+// ValidateCron verifica que la expresión cron pueda programarse
+func (s *RetransmissionScheduler) ValidateCron(expression string) error {
+	_, err := gocron.NewScheduler(utils.TimeLocation()).Cron(expression).Do(func() {})
+	return err
+}
+
+// Apply programa o reprograma el job propio de un NIT
+func (s *RetransmissionScheduler) Apply(schedule *models.RetransmissionSchedule) error {
+	tag := tenantTag(schedule.NIT)
+	_ = s.scheduler.RemoveByTag(tag)
+
+	if err := s.schedule(schedule, contingency.TenantJobName(schedule.NIT), tag); err != nil {
+		s.Remove(schedule.NIT)
+		return err
+	}
+
+	s.mu.Lock()
+	s.tenants[schedule.NIT] = *schedule
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Remove elimina el job propio de un NIT
+func (s *RetransmissionScheduler) Remove(nit string) {
+	_ = s.scheduler.RemoveByTag(tenantTag(nit))
+	s.monitor.Unregister(contingency.TenantJobName(nit))
+
+	s.mu.Lock()
+	delete(s.tenants, nit)
+	s.mu.Unlock()
+}
+
+// excludedNITs obtiene los NIT con job propio, excluidos del job predeterminado
+func (s *RetransmissionScheduler) excludedNITs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	nits := make([]string, 0, len(s.tenants))
+	for nit := range s.tenants {
+		nits = append(nits, nit)
+	}
+	sort.Strings(nits)
+
+	return nits
+}
+
+// schedule crea el job de una programación y lo registra en el monitor
+func (s *RetransmissionScheduler) schedule(schedule *models.RetransmissionSchedule, name, tag string) error {
+	job, err := NewRetransmissionJob(s.contingencyService, s.connection, *schedule, s.excludedNITs)
+	if err != nil {
+		return fmt.Errorf("invalid retransmission windows %q: %w", schedule.Windows, err)
+	}
+
+	scheduled, err := s.scheduler.Cron(schedule.Cron).Tag(tag).Do(s.monitor.Track(name, job.Execute))
+	if err != nil {
+		return fmt.Errorf("failed to schedule retransmission job %q: %w", schedule.Cron, err)
+	}
+
+	s.monitor.Register(name, DescribeSchedule(schedule), scheduled.NextRun)
+	return nil
+}
+
+// DescribeSchedule obtiene la descripción de una programación mostrada en el estado de los jobs
+func DescribeSchedule(schedule *models.RetransmissionSchedule) string {
+	return fmt.Sprintf("cron %s, windows %s, batch size %d, max execution %dm",
+		schedule.Cron, schedule.Windows, schedule.BatchSize, schedule.MaxExecutionMinutes)
+}
+
+// sameSchedule indica si dos programaciones generan el mismo job
+func sameSchedule(a, b *models.RetransmissionSchedule) bool {
+	return a.Cron == b.Cron && a.Windows == b.Windows && a.BatchSize == b.BatchSize &&
+		a.MaxExecutionMinutes == b.MaxExecutionMinutes
+}
+
+func tenantTag(nit string) string {
+	return "retransmission:" + nit
+}
@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/logs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+type WebhookDeliveryJob struct {
+	WebhookManager   webhook.WebhookManager
+	IsRunning        atomic.Bool
+	MaxExecutionTime time.Duration
+}
+
+func NewWebhookDeliveryJob(webhookManager webhook.WebhookManager) *WebhookDeliveryJob {
+	return &WebhookDeliveryJob{
+		WebhookManager:   webhookManager,
+		MaxExecutionTime: 10 * time.Minute,
+	}
+}
+
+// Execute reintenta las entregas de webhooks pendientes cuyo próximo intento ya se cumplió.
+func (j *WebhookDeliveryJob) Execute(ctx context.Context) error {
+	// Evitar ejecuciones concurrentes
+	if !j.IsRunning.CompareAndSwap(false, true) {
+		logs.Warn("Webhook delivery job already running, skipping execution")
+		return ErrJobAlreadyRunning
+	}
+	defer j.IsRunning.Store(false)
+
+	ctx, cancel := context.WithTimeout(ctx, j.MaxExecutionTime)
+	defer cancel()
+
+	delivered, err := j.WebhookManager.DeliverPending(ctx)
+	if err != nil {
+		logs.Error("Webhook delivery job failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return err
+	}
+
+	if delivered > 0 {
+		logs.Info("Webhook delivery job completed successfully", map[string]interface{}{
+			"delivered": delivered,
+			"timestamp": utils.TimeNow().Format(time.RFC3339),
+		})
+	}
+
+	return nil
+}
@@ -62,6 +62,111 @@ func (f *MapperFactory) CreateCreditNoteMapperAdapter() DTEMapper {
 	}
 }
 
+// CreateDebitNoteMapperAdapter crea un adaptador para el mapper de Notas de Débito
+func (f *MapperFactory) CreateDebitNoteMapperAdapter() DTEMapper {
+	debitNoteMapper := request_mapper.NewDebitNoteMapper()
+
+	return &MapperAdapter{
+		MapFunc: func(req interface{}, issuer *dte.IssuerDTE, params ...interface{}) (interface{}, error) {
+			debitNoteReq, ok := req.(*structs.CreateDebitNoteRequest)
+			if !ok {
+				return nil, fmt.Errorf("invalid request type, expected *structs.CreateDebitNoteRequest")
+			}
+			return debitNoteMapper.MapToDebitNoteData(debitNoteReq, issuer)
+		},
+	}
+}
+
+// CreateFSEMapperAdapter crea un adaptador para el mapper de Facturas Sujeto Excluido
+func (f *MapperFactory) CreateFSEMapperAdapter() DTEMapper {
+	fseMapper := request_mapper.NewFSEMapper()
+
+	return &MapperAdapter{
+		MapFunc: func(req interface{}, issuer *dte.IssuerDTE, params ...interface{}) (interface{}, error) {
+			fseReq, ok := req.(*structs.CreateFSERequest)
+			if !ok {
+				return nil, fmt.Errorf("invalid request type, expected *structs.CreateFSERequest")
+			}
+			return fseMapper.MapToFSEData(fseReq, issuer)
+		},
+	}
+}
+
+// CreateExportInvoiceMapperAdapter crea un adaptador para el mapper de Facturas de Exportación
+func (f *MapperFactory) CreateExportInvoiceMapperAdapter() DTEMapper {
+	exportInvoiceMapper := request_mapper.NewExportInvoiceMapper()
+
+	return &MapperAdapter{
+		MapFunc: func(req interface{}, issuer *dte.IssuerDTE, params ...interface{}) (interface{}, error) {
+			exportReq, ok := req.(*structs.CreateExportInvoiceRequest)
+			if !ok {
+				return nil, fmt.Errorf("invalid request type, expected *structs.CreateExportInvoiceRequest")
+			}
+			return exportInvoiceMapper.MapToExportInvoiceData(exportReq, issuer)
+		},
+	}
+}
+
+// CreateRemissionNoteMapperAdapter crea un adaptador para el mapper de Notas de Remisión
+func (f *MapperFactory) CreateRemissionNoteMapperAdapter() DTEMapper {
+	remissionNoteMapper := request_mapper.NewRemissionNoteMapper()
+
+	return &MapperAdapter{
+		MapFunc: func(req interface{}, issuer *dte.IssuerDTE, params ...interface{}) (interface{}, error) {
+			remissionReq, ok := req.(*structs.CreateRemissionNoteRequest)
+			if !ok {
+				return nil, fmt.Errorf("invalid request type, expected *structs.CreateRemissionNoteRequest")
+			}
+			return remissionNoteMapper.MapToRemissionNoteData(remissionReq, issuer)
+		},
+	}
+}
+
+// CreateLiquidationMapperAdapter crea un adaptador para el mapper de Comprobantes de Liquidación
+func (f *MapperFactory) CreateLiquidationMapperAdapter() DTEMapper {
+	liquidationMapper := request_mapper.NewLiquidationMapper()
+
+	return &MapperAdapter{
+		MapFunc: func(req interface{}, issuer *dte.IssuerDTE, params ...interface{}) (interface{}, error) {
+			liquidationReq, ok := req.(*structs.CreateLiquidationRequest)
+			if !ok {
+				return nil, fmt.Errorf("invalid request type, expected *structs.CreateLiquidationRequest")
+			}
+			return liquidationMapper.MapToLiquidationData(liquidationReq, issuer)
+		},
+	}
+}
+
+// CreateAccountingLiquidationMapperAdapter crea un adaptador para el mapper de Documentos Contables de Liquidación
+func (f *MapperFactory) CreateAccountingLiquidationMapperAdapter() DTEMapper {
+	accountingLiquidationMapper := request_mapper.NewAccountingLiquidationMapper()
+
+	return &MapperAdapter{
+		MapFunc: func(req interface{}, issuer *dte.IssuerDTE, params ...interface{}) (interface{}, error) {
+			accountingLiquidationReq, ok := req.(*structs.CreateAccountingLiquidationRequest)
+			if !ok {
+				return nil, fmt.Errorf("invalid request type, expected *structs.CreateAccountingLiquidationRequest")
+			}
+			return accountingLiquidationMapper.MapToAccountingLiquidationData(accountingLiquidationReq, issuer)
+		},
+	}
+}
+
+// CreateDonationMapperAdapter crea un adaptador para el mapper de Comprobantes de Donación
+func (f *MapperFactory) CreateDonationMapperAdapter() DTEMapper {
+	donationMapper := request_mapper.NewDonationMapper()
+
+	return &MapperAdapter{
+		MapFunc: func(req interface{}, issuer *dte.IssuerDTE, params ...interface{}) (interface{}, error) {
+			donationReq, ok := req.(*structs.CreateDonationRequest)
+			if !ok {
+				return nil, fmt.Errorf("invalid request type, expected *structs.CreateDonationRequest")
+			}
+			return donationMapper.MapToDonationData(donationReq, issuer)
+		},
+	}
+}
+
 // CreateRetentionMapperAdapter crea un adaptador para el mapper de Retenciones
 func (f *MapperFactory) CreateRetentionMapperAdapter() DTEMapper {
 	retentionMapper := request_mapper.NewRetentionMapper()
@@ -116,6 +221,55 @@ func (f *MapperFactory) GetCreditNoteResponseMapper() ResponseMapperFunc {
 	}
 }
 
+// GetDebitNoteResponseMapper devuelve la función de mapeo para respuestas de Notas de Débito
+func (f *MapperFactory) GetDebitNoteResponseMapper() ResponseMapperFunc {
+	return func(domain interface{}) interface{} {
+		return response_mapper.ToMHDebitNote(domain)
+	}
+}
+
+// GetFSEResponseMapper devuelve la función de mapeo para respuestas de Facturas Sujeto Excluido
+func (f *MapperFactory) GetFSEResponseMapper() ResponseMapperFunc {
+	return func(domain interface{}) interface{} {
+		return response_mapper.ToMHFSE(domain)
+	}
+}
+
+// GetExportInvoiceResponseMapper devuelve la función de mapeo para respuestas de Facturas de Exportación
+func (f *MapperFactory) GetExportInvoiceResponseMapper() ResponseMapperFunc {
+	return func(domain interface{}) interface{} {
+		return response_mapper.ToMHExportInvoice(domain)
+	}
+}
+
+// GetRemissionNoteResponseMapper devuelve la función de mapeo para respuestas de Notas de Remisión
+func (f *MapperFactory) GetRemissionNoteResponseMapper() ResponseMapperFunc {
+	return func(domain interface{}) interface{} {
+		return response_mapper.ToMHRemissionNote(domain)
+	}
+}
+
+// GetLiquidationResponseMapper devuelve la función de mapeo para respuestas de Comprobantes de Liquidación
+func (f *MapperFactory) GetLiquidationResponseMapper() ResponseMapperFunc {
+	return func(domain interface{}) interface{} {
+		return response_mapper.ToMHLiquidation(domain)
+	}
+}
+
+// GetAccountingLiquidationResponseMapper devuelve la función de mapeo para respuestas de Documentos Contables de Liquidación
+func (f *MapperFactory) GetAccountingLiquidationResponseMapper() ResponseMapperFunc {
+	return func(domain interface{}) interface{} {
+		return response_mapper.ToMHAccountingLiquidation(domain)
+	}
+}
+
+// GetDonationResponseMapper devuelve la función de mapeo para respuestas de Comprobantes de Donación
+func (f *MapperFactory) GetDonationResponseMapper() ResponseMapperFunc {
+	return func(domain interface{}) interface{} {
+		return response_mapper.ToMHDonation(domain)
+	}
+}
+
 // GetRetentionResponseMapper devuelve la función de mapeo para respuestas de Retenciones
 func (f *MapperFactory) GetRetentionResponseMapper() ResponseMapperFunc {
 	return func(domain interface{}) interface{} {
@@ -0,0 +1,101 @@
+package accounting_liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/temporal"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapAccountingLiquidationRequestBody mapea el cuerpo de un Documento Contable de Liquidación -> Origen: Request
+func MapAccountingLiquidationRequestBody(req *structs.AccountingLiquidationBodyRequest) (*accounting_liquidation_models.AccountingLiquidationBody, error) {
+	if req == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Body")
+	}
+
+	periodStart, err := temporal.NewEmissionDateFromString(req.PeriodStart)
+	if err != nil {
+		return nil, err
+	}
+
+	periodEnd, err := temporal.NewEmissionDateFromString(req.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	operationsValue, err := financial.NewAmountForTotal(req.OperationsValue)
+	if err != nil {
+		return nil, err
+	}
+
+	amountWithoutPerception, err := financial.NewAmountForTotal(req.AmountWithoutPerception)
+	if err != nil {
+		return nil, err
+	}
+
+	subTotal, err := financial.NewAmountForTotal(req.SubTotal)
+	if err != nil {
+		return nil, err
+	}
+
+	iva, err := financial.NewAmountForTotal(req.IVA)
+	if err != nil {
+		return nil, err
+	}
+
+	amountSubjectToPerception, err := financial.NewAmountForTotal(req.AmountSubjectToPerception)
+	if err != nil {
+		return nil, err
+	}
+
+	ivaPerceived, err := financial.NewAmountForTotal(req.IVAPerceived)
+	if err != nil {
+		return nil, err
+	}
+
+	commission, err := financial.NewAmountForTotal(req.Commission)
+	if err != nil {
+		return nil, err
+	}
+
+	commissionPercentage, err := financial.NewAmount(req.CommissionPercentage)
+	if err != nil {
+		return nil, err
+	}
+
+	commissionIVA, err := financial.NewAmountForTotal(req.CommissionIVA)
+	if err != nil {
+		return nil, err
+	}
+
+	netAmountToPay, err := financial.NewAmountForTotal(req.NetAmountToPay)
+	if err != nil {
+		return nil, err
+	}
+
+	body := &accounting_liquidation_models.AccountingLiquidationBody{
+		PeriodStart:                  *periodStart,
+		PeriodEnd:                    *periodEnd,
+		LiquidationCode:              req.LiquidationCode,
+		DocumentCount:                req.DocumentCount,
+		OperationsValue:              *operationsValue,
+		AmountWithoutPerception:      *amountWithoutPerception,
+		WithoutPerceptionDescription: req.WithoutPerceptionDescription,
+		SubTotal:                     *subTotal,
+		IVA:                          *iva,
+		AmountSubjectToPerception:    *amountSubjectToPerception,
+		IVAPerceived:                 *ivaPerceived,
+		Commission:                   *commission,
+		CommissionPercentage:         *commissionPercentage,
+		CommissionIVA:                *commissionIVA,
+		NetAmountToPay:               *netAmountToPay,
+		Observations:                 req.Observations,
+	}
+
+	if req.TotalInWords != nil {
+		body.TotalInWords = *req.TotalInWords
+	}
+
+	return body, nil
+}
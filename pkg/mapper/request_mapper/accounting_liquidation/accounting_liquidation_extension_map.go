@@ -0,0 +1,46 @@
+package accounting_liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapAccountingLiquidationRequestExtension mapea la extensión de un Documento Contable de Liquidación -> Origen: Request
+func MapAccountingLiquidationRequestExtension(extension *structs.AccountingLiquidationExtensionRequest) (*accounting_liquidation_models.AccountingLiquidationExtension, error) {
+	if extension == nil {
+		return nil, nil
+	}
+
+	if extension.DeliveryName == "" {
+		return nil, dte_errors.NewValidationError("RequiredField", "Extension->DeliveryName")
+	}
+
+	if extension.DeliveryDocument == "" {
+		return nil, dte_errors.NewValidationError("RequiredField", "Extension->DeliveryDocument")
+	}
+
+	deliveryName, err := document.NewDeliveryName(extension.DeliveryName)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryDocument, err := document.NewDeliveryDocument(extension.DeliveryDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	if extension.EmployeeCode != nil {
+		value := *extension.EmployeeCode
+		if len(value) < 1 || len(value) > 10 {
+			return nil, dte_errors.NewValidationError("InvalidLength", "Extension->EmployeeCode", "1-10", value)
+		}
+	}
+
+	return &accounting_liquidation_models.AccountingLiquidationExtension{
+		DeliveryName:     *deliveryName,
+		DeliveryDocument: *deliveryDocument,
+		EmployeeCode:     extension.EmployeeCode,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package request_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/accounting_liquidation"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/liquidation"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type AccountingLiquidationMapper struct{}
+
+func NewAccountingLiquidationMapper() *AccountingLiquidationMapper {
+	return &AccountingLiquidationMapper{}
+}
+
+// MapToAccountingLiquidationData convierte una solicitud de Documento Contable de Liquidación a datos de modelo de dominio.
+func (m *AccountingLiquidationMapper) MapToAccountingLiquidationData(req *structs.CreateAccountingLiquidationRequest, client *dte.IssuerDTE) (*accounting_liquidation_models.InputAccountingLiquidationData, error) {
+	if req == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Request")
+	}
+	if req.Body == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Request->Body")
+	}
+	if req.Receiver == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Request->Receiver")
+	}
+
+	body, err := accounting_liquidation.MapAccountingLiquidationRequestBody(req.Body)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("AccountingLiquidationMapper", "MapToAccountingLiquidationData", err, "ErrorMapping", "AccountingLiquidation->Body")
+	}
+
+	// El receptor se identifica por NIT y NRC al igual que en el Comprobante de Liquidación
+	receiver, err := liquidation.MapLiquidationRequestReceiver(req.Receiver)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("AccountingLiquidationMapper", "MapToAccountingLiquidationData", err, "ErrorMapping", "AccountingLiquidation->Receiver")
+	}
+
+	identification, err := common.MapCommonRequestIdentification(constants.ModeloFacturacionPrevio, 1, constants.DocContableLiquidacionElectronico)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("AccountingLiquidationMapper", "MapToAccountingLiquidationData", err, "ErrorMapping", "AccountingLiquidation->Identification")
+	}
+
+	issuer, err := common.MapCommonIssuer(client)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("AccountingLiquidationMapper", "MapToAccountingLiquidationData", err, "ErrorMapping", "AccountingLiquidation->Issuer")
+	}
+
+	extension, err := accounting_liquidation.MapAccountingLiquidationRequestExtension(req.Extension)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("AccountingLiquidationMapper", "MapToAccountingLiquidationData", err, "ErrorMapping", "AccountingLiquidation->Extension")
+	}
+
+	result := &accounting_liquidation_models.InputAccountingLiquidationData{
+		InputDataCommon: &models.InputDataCommon{
+			Issuer:         issuer,
+			Identification: identification,
+			Receiver:       receiver,
+		},
+		Body:                 body,
+		LiquidationExtension: extension,
+	}
+
+	if req.Appendixes != nil {
+		appendixes, err := common.MapCommonRequestAppendix(req.Appendixes)
+		if err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("MapAppendixes", "MapToAccountingLiquidationData", err, "ErrorMapping", "AccountingLiquidation->Appendixes")
+		}
+		result.Appendixes = appendixes
+	}
+
+	return result, nil
+}
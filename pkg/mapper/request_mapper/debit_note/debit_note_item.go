@@ -0,0 +1,64 @@
+package debit_note
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/debit_note/debit_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+func MapDebitNoteItems(item []structs.DebitNoteItemRequest) ([]debit_note_models.DebitNoteItem, error) {
+	result := make([]debit_note_models.DebitNoteItem, len(item))
+
+	for i, noteItem := range item {
+		itemMapped, err := MapDebitNoteRequestItem(noteItem, i)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *itemMapped
+	}
+
+	return result, nil
+}
+
+// MapDebitNoteRequestItem mapea un item de Nota de Débito -> Origen: Request
+func MapDebitNoteRequestItem(item structs.DebitNoteItemRequest, index int) (*debit_note_models.DebitNoteItem, error) {
+	baseItem, err := common.MapCommonRequestItem(structs.ItemRequest{
+		Type:        item.Type,
+		Quantity:    item.Quantity,
+		UnitMeasure: item.UnitMeasure,
+		UnitPrice:   item.UnitPrice,
+		Discount:    item.Discount,
+		Code:        item.Code,
+		Taxes:       item.Taxes,
+		TaxCode:     item.TaxCode,
+		Description: item.Description,
+		RelatedDoc:  item.RelatedDoc,
+	}, index)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nonSubjectSale, err := financial.NewAmount(item.NonSubjectSale)
+	if err != nil {
+		return nil, err
+	}
+
+	exemptSale, err := financial.NewAmount(item.ExemptSale)
+	if err != nil {
+		return nil, err
+	}
+
+	taxedSale, err := financial.NewAmount(item.TaxedSale)
+	if err != nil {
+		return nil, err
+	}
+
+	return &debit_note_models.DebitNoteItem{
+		Item:           baseItem,
+		NonSubjectSale: *nonSubjectSale,
+		ExemptSale:     *exemptSale,
+		TaxedSale:      *taxedSale,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package request_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/debit_note/debit_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/debit_note"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type DebitNoteMapper struct{}
+
+func NewDebitNoteMapper() *DebitNoteMapper {
+	return &DebitNoteMapper{}
+}
+
+// MapToDebitNoteData convierte una solicitud de Nota de Débito a datos de modelo de dominio.
+func (m *DebitNoteMapper) MapToDebitNoteData(req *structs.CreateDebitNoteRequest, client *dte.IssuerDTE) (*debit_note_models.DebitNoteInput, error) {
+	if err := validateDebitNoteRequest(req); err != nil {
+		return nil, err
+	}
+
+	items, err := debit_note.MapDebitNoteItems(req.Items)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DebitNoteMapper", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->Items")
+	}
+
+	receiver, err := debit_note.MapDebitNoteRequestReceiver(req.Receiver)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DebitNoteMapper", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->Receiver")
+	}
+
+	identification, err := common.MapCommonRequestIdentification(constants.ModeloFacturacionPrevio, 3, constants.NotaDebitoElectronica)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DebitNoteMapper", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->Identification")
+	}
+
+	summary, err := debit_note.MapDebitNoteRequestSummary(req.Summary)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DebitNoteMapper", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->Summary")
+	}
+
+	issuer, err := common.MapCommonIssuer(client)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DebitNoteMapper", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->Issuer")
+	}
+
+	// En notas de crédito, los documentos relacionados son obligatorios y ya validados
+	relatedDocs, err := common.MapCommonRequestRelatedDocuments(req.RelatedDocs)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestRelatedDocuments", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->RelatedDocs")
+	}
+
+	result := &debit_note_models.DebitNoteInput{
+		InputDataCommon: &models.InputDataCommon{
+			Issuer:         issuer,
+			Identification: identification,
+			Receiver:       receiver,
+			RelatedDocs:    relatedDocs,
+		},
+		Items:        items,
+		DebitSummary: summary,
+	}
+
+	if err = mapDebitNoteOptionalFields(req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// validateDebitNoteRequest valida que la solicitud de Nota de Débito sea correcta.
+func validateDebitNoteRequest(req *structs.CreateDebitNoteRequest) error {
+	if req == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request")
+	}
+	if req.Items == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Items")
+	}
+	if req.Summary == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Summary")
+	}
+	if req.Receiver == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Receiver")
+	}
+	// Para notas de crédito, los documentos relacionados son obligatorios
+	if req.RelatedDocs == nil || len(req.RelatedDocs) == 0 {
+		return dte_errors.NewValidationError("RequiredField", "Request->RelatedDocs")
+	}
+
+	for _, doc := range req.RelatedDocs {
+		if doc.DocumentType == "" {
+			return dte_errors.NewValidationError("RequiredField", "Request->RelatedDocs->DocumentType")
+		}
+		if doc.DocumentNumber == "" {
+			return dte_errors.NewValidationError("RequiredField", "Request->RelatedDocs->DocumentNumber")
+		}
+
+		if doc.GenerationType == 0 {
+			return dte_errors.NewValidationError("RequiredField", "Request->RelatedDocs->GenerationType")
+		}
+
+		if doc.GenerationType == constants.PhysicalDocument && doc.EmissionDate == "" {
+			return dte_errors.NewValidationError("InvalidEmissionDateForPhysicalDocument", doc.EmissionDate)
+		}
+	}
+
+	return nil
+}
+
+// mapDebitNoteOptionalFields mapea los campos opcionales de la solicitud de Nota de Débito.
+func mapDebitNoteOptionalFields(req *structs.CreateDebitNoteRequest, result *debit_note_models.DebitNoteInput) error {
+	if req.ThirdPartySale != nil {
+		thirdPartySale, err := common.MapCommonRequestThirdPartySale(req.ThirdPartySale)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestThirdPartySale", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->ThirdPartySales")
+		}
+		result.ThirdPartySale = thirdPartySale
+	}
+
+	if req.Extension != nil {
+		extension, err := common.MapCommonRequestExtension(req.Extension)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestExtension", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->Extension")
+		}
+		result.Extension = extension
+	}
+
+	if req.Payments != nil {
+		payments, err := common.MapCommonRequestPaymentsType(req.Payments)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestPaymentsType", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->PaymentTypes")
+		}
+		result.DebitSummary.PaymentTypes = payments
+	}
+
+	if req.OtherDocs != nil {
+		otherDocs, err := common.MapCommonRequestOtherDocuments(req.OtherDocs)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestOtherDocuments", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->OtherDocs")
+		}
+		result.OtherDocs = otherDocs
+	}
+
+	if req.Appendixes != nil {
+		appendixes, err := common.MapCommonRequestAppendix(req.Appendixes)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapAppendixes", "MapToDebitNoteData", err, "ErrorMapping", "DebitNote->Appendixes")
+		}
+		result.Appendixes = appendixes
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package donation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/base"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/identification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/location"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapDonationRequestDonor mapea el donante de un Comprobante de Donación -> Origen: Request
+func MapDonationRequestDonor(donor *structs.DonorRequest) (*donation_models.Donor, error) {
+	var err error
+	if donor == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Donor")
+	}
+
+	if err = validateDonorFields(donor); err != nil {
+		return nil, err
+	}
+
+	docType, err := document.NewDTETypeForReceiver(*donor.DocumentType)
+	if err != nil {
+		return nil, err
+	}
+
+	docNumber, err := identification.NewDocumentNumber(*donor.DocumentNumber, *donor.DocumentType)
+	if err != nil {
+		return nil, err
+	}
+
+	countryCode, err := location.NewCountryCode(*donor.CountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &donation_models.Donor{
+		DocumentType:        docType,
+		DocumentNumber:      docNumber,
+		Name:                *donor.Name,
+		ActivityDescription: donor.ActivityDesc,
+		DomiciledCode:       *donor.DomiciledCode,
+		CountryCode:         countryCode,
+	}
+
+	if donor.NRC != nil {
+		result.NRC, err = identification.NewNRC(*donor.NRC)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if donor.ActivityCode != nil {
+		result.ActivityCode, err = identification.NewActivityCode(*donor.ActivityCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if donor.Address != nil {
+		result.Address, err = common.MapCommonRequestAddress(*donor.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if donor.Phone != nil {
+		result.Phone, err = base.NewPhone(*donor.Phone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if donor.Email != nil {
+		result.Email, err = base.NewEmail(*donor.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func validateDonorFields(donor *structs.DonorRequest) error {
+	if donor.Name == nil {
+		return dte_errors.NewValidationError("RequiredField", "Donor->Name")
+	}
+
+	if donor.DocumentType == nil {
+		return dte_errors.NewValidationError("RequiredField", "Donor->DocumentType")
+	}
+
+	if donor.DocumentNumber == nil {
+		return dte_errors.NewValidationError("RequiredField", "Donor->DocumentNumber")
+	}
+
+	if donor.CountryCode == nil {
+		return dte_errors.NewValidationError("RequiredField", "Donor->CountryCode")
+	}
+
+	if donor.DomiciledCode == nil {
+		return dte_errors.NewValidationError("RequiredField", "Donor->DomiciledCode")
+	}
+
+	if !isAllowedDomiciledCode(*donor.DomiciledCode) {
+		return dte_errors.NewValidationError("InvalidDomiciledCode", *donor.DomiciledCode)
+	}
+
+	return nil
+}
+
+// isAllowedDomiciledCode valida que el código de domicilio sea 1 (Domiciliado) o 2 (No domiciliado)
+func isAllowedDomiciledCode(code int) bool {
+	for _, allowed := range constants.AllowedDomiciledCodes {
+		if code == allowed {
+			return true
+		}
+	}
+	return false
+}
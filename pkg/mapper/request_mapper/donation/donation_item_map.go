@@ -0,0 +1,80 @@
+package donation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/item"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+func MapDonationItems(items []structs.DonationItemRequest) ([]donation_models.DonationItem, error) {
+	result := make([]donation_models.DonationItem, len(items))
+
+	for i, donationItem := range items {
+		itemMapped, err := MapDonationRequestItem(donationItem, i)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *itemMapped
+	}
+
+	return result, nil
+}
+
+// MapDonationRequestItem mapea un item de Comprobante de Donación -> Origen: Request
+func MapDonationRequestItem(donationItem structs.DonationItemRequest, index int) (*donation_models.DonationItem, error) {
+	number, err := item.NewItemNumber(index + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	donationType, err := item.NewDonationType(donationItem.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := item.NewQuantity(donationItem.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	unitMeasure, err := item.NewUnitMeasure(donationItem.UnitMeasure)
+	if err != nil {
+		return nil, err
+	}
+
+	depreciation, err := financial.NewAmount(donationItem.Depreciation)
+	if err != nil {
+		return nil, err
+	}
+
+	unitValue, err := financial.NewAmount(donationItem.UnitValue)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := financial.NewAmount(donationItem.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &donation_models.DonationItem{
+		Number:       *number,
+		Type:         *donationType,
+		Quantity:     *quantity,
+		UnitMeasure:  *unitMeasure,
+		Description:  donationItem.Description,
+		Depreciation: *depreciation,
+		UnitValue:    *unitValue,
+		Value:        *value,
+	}
+
+	if donationItem.Code != nil {
+		result.Code, err = item.NewItemCode(*donationItem.Code)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
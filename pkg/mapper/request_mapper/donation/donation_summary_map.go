@@ -0,0 +1,42 @@
+package donation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// MapDonationRequestSummary mapea un resumen de Comprobante de Donación a un modelo de resumen -> Origen: Request
+func MapDonationRequestSummary(summary *structs.DonationSummaryRequest) (*donation_models.DonationSummary, error) {
+	if summary == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "DonationSummary")
+	}
+
+	if summary.TotalInWords == nil {
+		inLetters := utils.InLetters(summary.TotalValue)
+		summary.TotalInWords = &inLetters
+	}
+
+	totalValue, err := financial.NewAmountForTotal(summary.TotalValue)
+	if err != nil {
+		return nil, err
+	}
+
+	var paymentTypes []interfaces.PaymentType
+	if len(summary.PaymentTypes) > 0 {
+		paymentTypes, err = common.MapCommonRequestPaymentsType(summary.PaymentTypes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &donation_models.DonationSummary{
+		TotalValue:   *totalValue,
+		TotalInWords: *summary.TotalInWords,
+		PaymentTypes: paymentTypes,
+	}, nil
+}
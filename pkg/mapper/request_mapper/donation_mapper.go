@@ -0,0 +1,103 @@
+package request_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/donation"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type DonationMapper struct{}
+
+func NewDonationMapper() *DonationMapper {
+	return &DonationMapper{}
+}
+
+// MapToDonationData convierte una solicitud de Comprobante de Donación a datos de modelo de dominio.
+// El cliente autenticado actúa como donatario del comprobante.
+func (m *DonationMapper) MapToDonationData(req *structs.CreateDonationRequest, client *dte.IssuerDTE) (*donation_models.InputDonationData, error) {
+	if err := validateDonationRequest(req); err != nil {
+		return nil, err
+	}
+
+	issuer, err := common.MapCommonIssuer(client)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DonationMapper", "MapToDonationData", err, "ErrorMapping", "Donation->Donee")
+	}
+
+	identification, err := common.MapCommonRequestIdentification(constants.ModeloFacturacionPrevio, 1, constants.ComprobanteDonacionElectronico)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DonationMapper", "MapToDonationData", err, "ErrorMapping", "Donation->Identification")
+	}
+
+	donor, err := donation.MapDonationRequestDonor(req.Donor)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DonationMapper", "MapToDonationData", err, "ErrorMapping", "Donation->Donor")
+	}
+
+	otherDocs, err := common.MapCommonRequestOtherDocuments(req.OtherDocs)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DonationMapper", "MapToDonationData", err, "ErrorMapping", "Donation->OtherDocs")
+	}
+
+	items, err := donation.MapDonationItems(req.Items)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DonationMapper", "MapToDonationData", err, "ErrorMapping", "Donation->Items")
+	}
+
+	summary, err := donation.MapDonationRequestSummary(req.Summary)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("DonationMapper", "MapToDonationData", err, "ErrorMapping", "Donation->Summary")
+	}
+
+	result := &donation_models.InputDonationData{
+		InputDataCommon: &models.InputDataCommon{
+			Issuer:         issuer,
+			Identification: identification,
+			OtherDocs:      otherDocs,
+		},
+		Donor:           donor,
+		DonationItems:   items,
+		DonationSummary: summary,
+	}
+
+	if req.Appendixes != nil {
+		appendixes, err := common.MapCommonRequestAppendix(req.Appendixes)
+		if err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("MapAppendixes", "MapToDonationData", err, "ErrorMapping", "Donation->Appendixes")
+		}
+		result.Appendixes = appendixes
+	}
+
+	return result, nil
+}
+
+// validateDonationRequest valida que la solicitud de Comprobante de Donación sea correcta.
+func validateDonationRequest(req *structs.CreateDonationRequest) error {
+	if req == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request")
+	}
+
+	if len(req.Items) == 0 {
+		return dte_errors.NewValidationError("RequiredField", "Request->Items")
+	}
+
+	if req.Donor == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Donor")
+	}
+
+	if len(req.OtherDocs) == 0 {
+		return dte_errors.NewValidationError("RequiredField", "Request->OtherDocs")
+	}
+
+	if req.Summary == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Summary")
+	}
+
+	return nil
+}
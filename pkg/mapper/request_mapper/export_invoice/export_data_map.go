@@ -0,0 +1,42 @@
+package export_invoice
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/item"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapExportRequestData mapea los datos de exportación del emisor -> Origen: Request
+func MapExportRequestData(data *structs.ExportDataRequest) (*export_invoice_models.ExportData, error) {
+	var err error
+	if data == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "ExportData")
+	}
+
+	itemExportType, err := item.NewItemExportType(data.ItemExportType)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &export_invoice_models.ExportData{
+		ItemExportType: itemExportType,
+	}
+
+	if data.FiscalPrecinct != nil {
+		result.FiscalPrecinct, err = document.NewFiscalPrecinct(*data.FiscalPrecinct)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if data.Regime != nil {
+		result.Regime, err = document.NewRegime(*data.Regime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package export_invoice
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+func MapExportItems(items []structs.ExportItemRequest) ([]export_invoice_models.ExportItem, error) {
+	result := make([]export_invoice_models.ExportItem, len(items))
+
+	for i, exportItem := range items {
+		itemMapped, err := MapExportRequestItem(exportItem, i)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *itemMapped
+	}
+
+	return result, nil
+}
+
+// MapExportRequestItem mapea un item de Factura de Exportación -> Origen: Request
+func MapExportRequestItem(item structs.ExportItemRequest, index int) (*export_invoice_models.ExportItem, error) {
+	baseItem, err := common.MapCommonRequestItem(structs.ItemRequest{
+		Type:        item.Type,
+		Quantity:    item.Quantity,
+		UnitMeasure: item.UnitMeasure,
+		UnitPrice:   item.UnitPrice,
+		Discount:    item.Discount,
+		Code:        item.Code,
+		Taxes:       item.Taxes,
+		Description: item.Description,
+	}, index)
+
+	if err != nil {
+		return nil, err
+	}
+
+	taxedSale, err := financial.NewAmount(item.TaxedSale)
+	if err != nil {
+		return nil, err
+	}
+
+	nonTaxed, err := financial.NewAmount(item.NonTaxed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &export_invoice_models.ExportItem{
+		Item:      baseItem,
+		TaxedSale: *taxedSale,
+		NonTaxed:  *nonTaxed,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package export_invoice
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/base"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/identification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/location"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapExportRequestReceiver mapea el receptor extranjero de una Factura de Exportación -> Origen: Request
+func MapExportRequestReceiver(receiver *structs.ForeignReceiverRequest) (*export_invoice_models.ForeignReceiver, error) {
+	var err error
+	if receiver == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Receiver")
+	}
+
+	if err = validateForeignReceiverFields(receiver); err != nil {
+		return nil, err
+	}
+
+	countryCode, err := location.NewCountryCode(*receiver.CountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	personType, err := identification.NewPersonType(*receiver.PersonType)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &export_invoice_models.ForeignReceiver{
+		Name:                *receiver.Name,
+		CommercialName:      receiver.CommercialName,
+		CountryCode:         countryCode,
+		CountryName:         *receiver.CountryName,
+		Complement:          *receiver.Complement,
+		PersonType:          personType,
+		ActivityDescription: receiver.ActivityDesc,
+	}
+
+	// El documento de identificación es opcional, pero si se envía el tipo debe enviarse el número
+	if receiver.DocumentType != nil {
+		if receiver.DocumentNumber == nil {
+			return nil, dte_errors.NewValidationError("RequiredField", "Receiver->DocumentNumber")
+		}
+
+		result.DocumentType, err = document.NewDTETypeForReceiver(*receiver.DocumentType)
+		if err != nil {
+			return nil, err
+		}
+
+		result.DocumentNumber, err = identification.NewDocumentNumber(*receiver.DocumentNumber, *receiver.DocumentType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if receiver.Phone != nil {
+		result.Phone, err = base.NewPhone(*receiver.Phone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if receiver.Email != nil {
+		result.Email, err = base.NewEmail(*receiver.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func validateForeignReceiverFields(receiver *structs.ForeignReceiverRequest) error {
+	if receiver.Name == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->Name")
+	}
+
+	if receiver.CountryCode == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->CountryCode")
+	}
+
+	if receiver.CountryName == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->CountryName")
+	}
+
+	if receiver.Complement == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->Complement")
+	}
+
+	if receiver.PersonType == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->PersonType")
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package export_invoice
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// MapExportRequestSummary mapea un resumen de Factura de Exportación a un modelo de resumen -> Origen: Request
+func MapExportRequestSummary(summary *structs.ExportSummaryRequest) (*export_invoice_models.ExportSummary, error) {
+	if summary == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "ExportSummary")
+	}
+
+	if summary.TotalInWords == nil {
+		inLetters := utils.InLetters(summary.TotalToPay)
+		summary.TotalInWords = &inLetters
+	}
+
+	totalTaxed, err := financial.NewAmountForTotal(summary.TotalTaxed)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := financial.NewAmountForTotal(summary.Discount)
+	if err != nil {
+		return nil, err
+	}
+
+	totalDiscount, err := financial.NewAmountForTotal(summary.TotalDiscount)
+	if err != nil {
+		return nil, err
+	}
+
+	insurance, err := financial.NewAmountForTotal(summary.Insurance)
+	if err != nil {
+		return nil, err
+	}
+
+	freight, err := financial.NewAmountForTotal(summary.Freight)
+	if err != nil {
+		return nil, err
+	}
+
+	totalOperation, err := financial.NewAmountForTotal(summary.TotalOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	totalNonTaxed, err := financial.NewAmountForTotal(summary.TotalNonTaxed)
+	if err != nil {
+		return nil, err
+	}
+
+	totalToPay, err := financial.NewAmountForTotal(summary.TotalToPay)
+	if err != nil {
+		return nil, err
+	}
+
+	discountPercentage, err := financial.NewDiscount(summary.DiscountPercentage)
+	if err != nil {
+		return nil, err
+	}
+
+	operationCondition, err := financial.NewPaymentCondition(summary.OperationCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	var paymentTypes []interfaces.PaymentType
+	if len(summary.PaymentTypes) > 0 {
+		paymentTypes, err = common.MapCommonRequestPaymentsType(summary.PaymentTypes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var incoterm *document.Incoterm
+	if summary.IncotermCode != nil {
+		incoterm, err = document.NewIncoterm(*summary.IncotermCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &export_invoice_models.ExportSummary{
+		TotalTaxed:              *totalTaxed,
+		Discount:                *discount,
+		TotalDiscount:           *totalDiscount,
+		Insurance:               *insurance,
+		Freight:                 *freight,
+		TotalOperation:          *totalOperation,
+		TotalNonTaxed:           *totalNonTaxed,
+		TotalToPay:              *totalToPay,
+		DiscountPercentage:      *discountPercentage,
+		TotalInWords:            *summary.TotalInWords,
+		OperationCondition:      *operationCondition,
+		PaymentTypes:            paymentTypes,
+		Incoterm:                incoterm,
+		ElectronicPaymentNumber: summary.ElectronicPaymentNumber,
+		Observations:            summary.Observations,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package request_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/export_invoice"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type ExportInvoiceMapper struct{}
+
+func NewExportInvoiceMapper() *ExportInvoiceMapper {
+	return &ExportInvoiceMapper{}
+}
+
+// MapToExportInvoiceData convierte una solicitud de Factura de Exportación a datos de modelo de dominio.
+func (m *ExportInvoiceMapper) MapToExportInvoiceData(req *structs.CreateExportInvoiceRequest, client *dte.IssuerDTE) (*export_invoice_models.ExportInvoiceInput, error) {
+	if err := validateExportInvoiceRequest(req); err != nil {
+		return nil, err
+	}
+
+	issuer, err := common.MapCommonIssuer(client)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ExportInvoiceMapper", "MapToExportInvoiceData", err, "ErrorMapping", "ExportInvoice->Issuer")
+	}
+
+	identification, err := common.MapCommonRequestIdentification(constants.ModeloFacturacionPrevio, 1, constants.FacturaExportacionElectronica)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ExportInvoiceMapper", "MapToExportInvoiceData", err, "ErrorMapping", "ExportInvoice->Identification")
+	}
+
+	receiver, err := export_invoice.MapExportRequestReceiver(req.Receiver)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ExportInvoiceMapper", "MapToExportInvoiceData", err, "ErrorMapping", "ExportInvoice->Receiver")
+	}
+
+	exportData, err := export_invoice.MapExportRequestData(req.ExportData)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ExportInvoiceMapper", "MapToExportInvoiceData", err, "ErrorMapping", "ExportInvoice->ExportData")
+	}
+
+	items, err := export_invoice.MapExportItems(req.Items)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ExportInvoiceMapper", "MapToExportInvoiceData", err, "ErrorMapping", "ExportInvoice->Items")
+	}
+
+	summary, err := export_invoice.MapExportRequestSummary(req.Summary)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("ExportInvoiceMapper", "MapToExportInvoiceData", err, "ErrorMapping", "ExportInvoice->Summary")
+	}
+
+	result := &export_invoice_models.ExportInvoiceInput{
+		InputDataCommon: &models.InputDataCommon{
+			Issuer:         issuer,
+			Identification: identification,
+		},
+		ForeignReceiver: receiver,
+		ExportData:      exportData,
+		ExportItems:     items,
+		ExportSummary:   summary,
+	}
+
+	if err = mapExportInvoiceOptionalFields(req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// validateExportInvoiceRequest valida que la solicitud de Factura de Exportación sea correcta.
+func validateExportInvoiceRequest(req *structs.CreateExportInvoiceRequest) error {
+	if req == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request")
+	}
+
+	if len(req.Items) == 0 {
+		return dte_errors.NewValidationError("RequiredField", "Request->Items")
+	}
+
+	if req.Receiver == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Receiver")
+	}
+
+	if req.ExportData == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->ExportData")
+	}
+
+	if req.Summary == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Summary")
+	}
+
+	return nil
+}
+
+func mapExportInvoiceOptionalFields(req *structs.CreateExportInvoiceRequest, result *export_invoice_models.ExportInvoiceInput) error {
+	if req.ThirdPartySale != nil {
+		thirdPartySale, err := common.MapCommonRequestThirdPartySale(req.ThirdPartySale)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestThirdPartySale", "MapToExportInvoiceData", err, "ErrorMapping", "ExportInvoice->ThirdPartySale")
+		}
+		result.ThirdPartySale = thirdPartySale
+	}
+
+	if req.Appendixes != nil {
+		appendixes, err := common.MapCommonRequestAppendix(req.Appendixes)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapAppendixes", "MapToExportInvoiceData", err, "ErrorMapping", "ExportInvoice->Appendixes")
+		}
+		result.Appendixes = appendixes
+	}
+
+	return nil
+}
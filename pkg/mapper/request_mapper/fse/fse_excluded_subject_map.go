@@ -0,0 +1,89 @@
+package fse
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/base"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/identification"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapFSERequestExcludedSubject mapea el sujeto excluido de una Factura Sujeto Excluido -> Origen: Request
+func MapFSERequestExcludedSubject(subject *structs.ExcludedSubjectRequest) (*fse_models.ExcludedSubject, error) {
+	var err error
+	if subject == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "ExcludedSubject")
+	}
+
+	if err = validateExcludedSubjectFields(subject); err != nil {
+		return nil, err
+	}
+
+	docType, err := document.NewDTETypeForReceiver(*subject.DocumentType)
+	if err != nil {
+		return nil, err
+	}
+
+	docNumber, err := identification.NewDocumentNumber(*subject.DocumentNumber, *subject.DocumentType)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := common.MapCommonRequestAddress(*subject.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &fse_models.ExcludedSubject{
+		DocumentType:        docType,
+		DocumentNumber:      docNumber,
+		Name:                *subject.Name,
+		Address:             address,
+		ActivityDescription: subject.ActivityDesc,
+	}
+
+	if subject.ActivityCode != nil {
+		result.ActivityCode, err = identification.NewActivityCode(*subject.ActivityCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if subject.Phone != nil {
+		result.Phone, err = base.NewPhone(*subject.Phone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if subject.Email != nil {
+		result.Email, err = base.NewEmail(*subject.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func validateExcludedSubjectFields(subject *structs.ExcludedSubjectRequest) error {
+	if subject.Name == nil {
+		return dte_errors.NewValidationError("RequiredField", "ExcludedSubject->Name")
+	}
+
+	if subject.DocumentType == nil {
+		return dte_errors.NewValidationError("RequiredField", "ExcludedSubject->DocumentType")
+	}
+
+	if subject.DocumentNumber == nil {
+		return dte_errors.NewValidationError("RequiredField", "ExcludedSubject->DocumentNumber")
+	}
+
+	if subject.Address == nil {
+		return dte_errors.NewValidationError("RequiredField", "ExcludedSubject->Address")
+	}
+
+	return nil
+}
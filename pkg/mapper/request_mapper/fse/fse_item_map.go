@@ -0,0 +1,49 @@
+package fse
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+func MapFSEItems(items []structs.FSEItemRequest) ([]fse_models.FSEItem, error) {
+	result := make([]fse_models.FSEItem, len(items))
+
+	for i, fseItem := range items {
+		itemMapped, err := MapFSERequestItem(fseItem, i)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *itemMapped
+	}
+
+	return result, nil
+}
+
+// MapFSERequestItem mapea un item de Factura Sujeto Excluido -> Origen: Request
+func MapFSERequestItem(item structs.FSEItemRequest, index int) (*fse_models.FSEItem, error) {
+	baseItem, err := common.MapCommonRequestItem(structs.ItemRequest{
+		Type:        item.Type,
+		Quantity:    item.Quantity,
+		UnitMeasure: item.UnitMeasure,
+		UnitPrice:   item.UnitPrice,
+		Discount:    item.Discount,
+		Code:        item.Code,
+		Description: item.Description,
+	}, index)
+
+	if err != nil {
+		return nil, err
+	}
+
+	purchase, err := financial.NewAmount(item.Purchase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fse_models.FSEItem{
+		Item:     baseItem,
+		Purchase: *purchase,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package fse
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// MapFSERequestSummary mapea un resumen de Factura Sujeto Excluido a un modelo de resumen -> Origen: Request
+func MapFSERequestSummary(summary *structs.FSESummaryRequest) (*fse_models.FSESummary, error) {
+	if summary == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "FSESummary")
+	}
+
+	if summary.TotalInWords == nil {
+		inLetters := utils.InLetters(summary.TotalToPay)
+		summary.TotalInWords = &inLetters
+	}
+
+	totalPurchase, err := financial.NewAmountForTotal(summary.TotalPurchase)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := financial.NewAmountForTotal(summary.Discount)
+	if err != nil {
+		return nil, err
+	}
+
+	totalDiscount, err := financial.NewAmountForTotal(summary.TotalDiscount)
+	if err != nil {
+		return nil, err
+	}
+
+	subTotal, err := financial.NewAmountForTotal(summary.SubTotal)
+	if err != nil {
+		return nil, err
+	}
+
+	ivaRetention, err := financial.NewAmountForTotal(summary.IVARetention)
+	if err != nil {
+		return nil, err
+	}
+
+	incomeRetention, err := financial.NewAmountForTotal(summary.IncomeRetention)
+	if err != nil {
+		return nil, err
+	}
+
+	totalToPay, err := financial.NewAmountForTotal(summary.TotalToPay)
+	if err != nil {
+		return nil, err
+	}
+
+	operationCondition, err := financial.NewPaymentCondition(summary.OperationCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	var paymentTypes []interfaces.PaymentType
+	if len(summary.PaymentTypes) > 0 {
+		paymentTypes, err = common.MapCommonRequestPaymentsType(summary.PaymentTypes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &fse_models.FSESummary{
+		TotalPurchase:      *totalPurchase,
+		Discount:           *discount,
+		TotalDiscount:      *totalDiscount,
+		SubTotal:           *subTotal,
+		IVARetention:       *ivaRetention,
+		IncomeRetention:    *incomeRetention,
+		TotalToPay:         *totalToPay,
+		TotalInWords:       *summary.TotalInWords,
+		OperationCondition: *operationCondition,
+		PaymentTypes:       paymentTypes,
+		Observations:       summary.Observations,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package request_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/fse"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type FSEMapper struct{}
+
+func NewFSEMapper() *FSEMapper {
+	return &FSEMapper{}
+}
+
+// MapToFSEData convierte una solicitud de Factura Sujeto Excluido a datos de modelo de dominio.
+func (m *FSEMapper) MapToFSEData(req *structs.CreateFSERequest, client *dte.IssuerDTE) (*fse_models.InputFSEData, error) {
+	if err := validateFSERequest(req); err != nil {
+		return nil, err
+	}
+
+	issuer, err := common.MapCommonIssuer(client)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("FSEMapper", "MapToFSEData", err, "ErrorMapping", "FSE->Issuer")
+	}
+
+	identification, err := common.MapCommonRequestIdentification(constants.ModeloFacturacionPrevio, 1, constants.FacturaSujetoExcluidoElectronica)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("FSEMapper", "MapToFSEData", err, "ErrorMapping", "FSE->Identification")
+	}
+
+	excludedSubject, err := fse.MapFSERequestExcludedSubject(req.ExcludedSubject)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("FSEMapper", "MapToFSEData", err, "ErrorMapping", "FSE->ExcludedSubject")
+	}
+
+	items, err := fse.MapFSEItems(req.Items)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("FSEMapper", "MapToFSEData", err, "ErrorMapping", "FSE->Items")
+	}
+
+	summary, err := fse.MapFSERequestSummary(req.Summary)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("FSEMapper", "MapToFSEData", err, "ErrorMapping", "FSE->Summary")
+	}
+
+	result := &fse_models.InputFSEData{
+		InputDataCommon: &models.InputDataCommon{
+			Issuer:         issuer,
+			Identification: identification,
+		},
+		ExcludedSubject: excludedSubject,
+		FSEItems:        items,
+		FSESummary:      summary,
+	}
+
+	if req.Appendixes != nil {
+		appendixes, err := common.MapCommonRequestAppendix(req.Appendixes)
+		if err != nil {
+			return nil, shared_error.NewFormattedGeneralServiceWithError("MapAppendixes", "MapToFSEData", err, "ErrorMapping", "FSE->Appendixes")
+		}
+		result.Appendixes = appendixes
+	}
+
+	return result, nil
+}
+
+// validateFSERequest valida que la solicitud de Factura Sujeto Excluido sea correcta.
+func validateFSERequest(req *structs.CreateFSERequest) error {
+	if req == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request")
+	}
+
+	if len(req.Items) == 0 {
+		return dte_errors.NewValidationError("RequiredField", "Request->Items")
+	}
+
+	if req.ExcludedSubject == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->ExcludedSubject")
+	}
+
+	if req.Summary == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Summary")
+	}
+
+	return nil
+}
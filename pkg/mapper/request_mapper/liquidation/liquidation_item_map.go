@@ -0,0 +1,106 @@
+package liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/item"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/temporal"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapLiquidationItems mapea los documentos liquidados de un Comprobante de Liquidación -> Origen: Request
+func MapLiquidationItems(req []structs.LiquidationItemRequest) ([]liquidation_models.LiquidationItem, error) {
+	if req == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Items")
+	}
+
+	result := make([]liquidation_models.LiquidationItem, len(req))
+	for i, reqItem := range req {
+		liquidationItem, err := mapLiquidationItem(&reqItem, i+1)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *liquidationItem
+	}
+
+	return result, nil
+}
+
+func mapLiquidationItem(req *structs.LiquidationItemRequest, i int) (*liquidation_models.LiquidationItem, error) {
+	dteType, err := document.NewDTEType(req.DTEType)
+	if err != nil {
+		return nil, err
+	}
+
+	generationType, err := document.NewOperationType(req.GenerationType)
+	if err != nil {
+		return nil, err
+	}
+
+	documentNumber, err := document.NewDocumentNumber(req.DocumentNumber, req.GenerationType)
+	if err != nil {
+		return nil, err
+	}
+
+	// La fecha de generación de los documentos electrónicos se obtiene del DTE almacenado
+	generationDate := &temporal.EmissionDate{}
+	if req.GenerationType == constants.PhysicalDocument {
+		if req.GenerationDate == nil {
+			return nil, dte_errors.NewValidationError("InvalidEmissionDateForPhysicalDocument", "")
+		}
+
+		generationDate, err = temporal.NewEmissionDateFromString(*req.GenerationDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nonSubjectSale, err := financial.NewAmount(req.NonSubjectSale)
+	if err != nil {
+		return nil, err
+	}
+
+	exemptSale, err := financial.NewAmount(req.ExemptSale)
+	if err != nil {
+		return nil, err
+	}
+
+	taxedSale, err := financial.NewAmount(req.TaxedSale)
+	if err != nil {
+		return nil, err
+	}
+
+	exportSale, err := financial.NewAmount(req.ExportSale)
+	if err != nil {
+		return nil, err
+	}
+
+	ivaItem, err := financial.NewAmount(req.IVAItem)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tax := range req.Taxes {
+		if _, err = financial.NewTaxType(tax); err != nil {
+			return nil, err
+		}
+	}
+
+	return &liquidation_models.LiquidationItem{
+		Number:         *item.NewValidatedItemNumber(i),
+		DTEType:        *dteType,
+		GenerationType: *generationType,
+		DocumentNumber: documentNumber,
+		GenerationDate: *generationDate,
+		NonSubjectSale: *nonSubjectSale,
+		ExemptSale:     *exemptSale,
+		TaxedSale:      *taxedSale,
+		ExportSale:     *exportSale,
+		Taxes:          req.Taxes,
+		IVAItem:        *ivaItem,
+		Observation:    req.Observation,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/base"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/identification"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapLiquidationRequestReceiver mapea el receptor de un Comprobante de Liquidación, identificado por NIT y NRC -> Origen: Request
+func MapLiquidationRequestReceiver(receiver *structs.ReceiverRequest) (*models.Receiver, error) {
+	if receiver == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Receiver")
+	}
+
+	if err := validateRequiredFields(receiver); err != nil {
+		return nil, err
+	}
+
+	nit, err := identification.NewNIT(*receiver.NIT)
+	if err != nil {
+		return nil, err
+	}
+
+	nrc, err := identification.NewNRC(*receiver.NRC)
+	if err != nil {
+		return nil, err
+	}
+
+	activityCode, err := identification.NewActivityCode(*receiver.ActivityCode)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := common.MapCommonRequestAddress(*receiver.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	phone := base.NewValidatedPhone("")
+	if receiver.Phone != nil {
+		phone, err = base.NewPhone(*receiver.Phone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	email := base.NewValidatedEmail("")
+	if receiver.Email != nil {
+		email, err = base.NewEmail(*receiver.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.Receiver{
+		Name:                receiver.Name,
+		NIT:                 nit,
+		NRC:                 nrc,
+		Email:               email,
+		Address:             address,
+		Phone:               phone,
+		ActivityCode:        activityCode,
+		ActivityDescription: receiver.ActivityDesc,
+		CommercialName:      receiver.CommercialName,
+	}, nil
+}
+
+func validateRequiredFields(receiver *structs.ReceiverRequest) error {
+	if receiver.Name == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->Name")
+	}
+
+	if receiver.NIT == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->NIT")
+	}
+
+	if receiver.NRC == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->NRC")
+	}
+
+	if receiver.Address == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->Address")
+	}
+
+	if receiver.ActivityCode == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->ActivityCode")
+	}
+
+	if receiver.ActivityDesc == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->ActivityDesc")
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapLiquidationRequestSummary mapea el resumen de un Comprobante de Liquidación -> Origen: Request
+func MapLiquidationRequestSummary(req *structs.LiquidationSummaryRequest) (*liquidation_models.LiquidationSummary, error) {
+	if req == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Summary")
+	}
+
+	// La liquidación de documentos en consignación se considera de contado si no se indica lo contrario
+	if req.OperationCondition == 0 {
+		req.OperationCondition = constants.Cash
+	}
+
+	operationCondition, err := financial.NewPaymentCondition(req.OperationCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	totalNonSubject, err := financial.NewAmountForTotal(req.TotalNonSubject)
+	if err != nil {
+		return nil, err
+	}
+
+	totalExempt, err := financial.NewAmountForTotal(req.TotalExempt)
+	if err != nil {
+		return nil, err
+	}
+
+	totalTaxed, err := financial.NewAmountForTotal(req.TotalTaxed)
+	if err != nil {
+		return nil, err
+	}
+
+	totalExport, err := financial.NewAmountForTotal(req.TotalExport)
+	if err != nil {
+		return nil, err
+	}
+
+	subTotalSales, err := financial.NewAmountForTotal(req.SubTotalSales)
+	if err != nil {
+		return nil, err
+	}
+
+	totalOperation, err := financial.NewAmountForTotal(req.TotalOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	ivaPerception, err := financial.NewAmountForTotal(req.IVAPerception)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := financial.NewAmountForTotal(req.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	taxes, err := common.MapCommonRequestSummaryTaxes(req.Taxes)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &liquidation_models.LiquidationSummary{
+		TotalNonSubject:    *totalNonSubject,
+		TotalExempt:        *totalExempt,
+		TotalTaxed:         *totalTaxed,
+		TotalExport:        *totalExport,
+		SubTotalSales:      *subTotalSales,
+		Taxes:              taxes,
+		TotalOperation:     *totalOperation,
+		IVAPerception:      *ivaPerception,
+		Total:              *total,
+		OperationCondition: *operationCondition,
+	}
+
+	if req.TotalInWords != nil {
+		summary.TotalInWords = *req.TotalInWords
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,118 @@
+package request_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/liquidation"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type LiquidationMapper struct{}
+
+func NewLiquidationMapper() *LiquidationMapper {
+	return &LiquidationMapper{}
+}
+
+// MapToLiquidationData convierte una solicitud de Comprobante de Liquidación a datos de modelo de dominio.
+func (m *LiquidationMapper) MapToLiquidationData(req *structs.CreateLiquidationRequest, client *dte.IssuerDTE) (*liquidation_models.InputLiquidationData, error) {
+	if err := validateLiquidationRequest(req); err != nil {
+		return nil, err
+	}
+
+	items, err := liquidation.MapLiquidationItems(req.Items)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("LiquidationMapper", "MapToLiquidationData", err, "ErrorMapping", "Liquidation->Items")
+	}
+
+	receiver, err := liquidation.MapLiquidationRequestReceiver(req.Receiver)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("LiquidationMapper", "MapToLiquidationData", err, "ErrorMapping", "Liquidation->Receiver")
+	}
+
+	identification, err := common.MapCommonRequestIdentification(constants.ModeloFacturacionPrevio, 1, constants.ComprobanteLiquidacionElectronico)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("LiquidationMapper", "MapToLiquidationData", err, "ErrorMapping", "Liquidation->Identification")
+	}
+
+	summary, err := liquidation.MapLiquidationRequestSummary(req.Summary)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("LiquidationMapper", "MapToLiquidationData", err, "ErrorMapping", "Liquidation->Summary")
+	}
+
+	issuer, err := common.MapCommonIssuer(client)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("LiquidationMapper", "MapToLiquidationData", err, "ErrorMapping", "Liquidation->Issuer")
+	}
+
+	result := &liquidation_models.InputLiquidationData{
+		InputDataCommon: &models.InputDataCommon{
+			Issuer:         issuer,
+			Identification: identification,
+			Receiver:       receiver,
+		},
+		LiquidationItems:   items,
+		LiquidationSummary: summary,
+	}
+
+	if err = mapLiquidationOptionalFields(req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// validateLiquidationRequest valida que la solicitud de Comprobante de Liquidación sea correcta.
+func validateLiquidationRequest(req *structs.CreateLiquidationRequest) error {
+	if req == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request")
+	}
+	if req.Items == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Items")
+	}
+	if req.Summary == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Summary")
+	}
+	if req.Receiver == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Receiver")
+	}
+
+	for _, item := range req.Items {
+		if item.DTEType == "" {
+			return dte_errors.NewValidationError("RequiredField", "Request->Items->DTEType")
+		}
+		if item.DocumentNumber == "" {
+			return dte_errors.NewValidationError("RequiredField", "Request->Items->DocumentNumber")
+		}
+		if item.GenerationType == 0 {
+			return dte_errors.NewValidationError("RequiredField", "Request->Items->GenerationType")
+		}
+	}
+
+	return nil
+}
+
+// mapLiquidationOptionalFields mapea los campos opcionales de la solicitud de Comprobante de Liquidación.
+func mapLiquidationOptionalFields(req *structs.CreateLiquidationRequest, result *liquidation_models.InputLiquidationData) error {
+	if req.Extension != nil {
+		extension, err := common.MapCommonRequestExtension(req.Extension)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestExtension", "MapToLiquidationData", err, "ErrorMapping", "Liquidation->Extension")
+		}
+		result.Extension = extension
+	}
+
+	if req.Appendixes != nil {
+		appendixes, err := common.MapCommonRequestAppendix(req.Appendixes)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapAppendixes", "MapToLiquidationData", err, "ErrorMapping", "Liquidation->Appendixes")
+		}
+		result.Appendixes = appendixes
+	}
+
+	return nil
+}
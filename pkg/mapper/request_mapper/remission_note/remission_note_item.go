@@ -0,0 +1,64 @@
+package remission_note
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+func MapRemissionNoteItems(item []structs.RemissionNoteItemRequest) ([]remission_note_models.RemissionNoteItem, error) {
+	result := make([]remission_note_models.RemissionNoteItem, len(item))
+
+	for i, noteItem := range item {
+		itemMapped, err := MapRemissionNoteRequestItem(noteItem, i)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *itemMapped
+	}
+
+	return result, nil
+}
+
+// MapRemissionNoteRequestItem mapea un item de Nota de Remisión -> Origen: Request
+func MapRemissionNoteRequestItem(item structs.RemissionNoteItemRequest, index int) (*remission_note_models.RemissionNoteItem, error) {
+	baseItem, err := common.MapCommonRequestItem(structs.ItemRequest{
+		Type:        item.Type,
+		Quantity:    item.Quantity,
+		UnitMeasure: item.UnitMeasure,
+		UnitPrice:   item.UnitPrice,
+		Discount:    item.Discount,
+		Code:        item.Code,
+		Taxes:       item.Taxes,
+		TaxCode:     item.TaxCode,
+		Description: item.Description,
+		RelatedDoc:  item.RelatedDoc,
+	}, index)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nonSubjectSale, err := financial.NewAmount(item.NonSubjectSale)
+	if err != nil {
+		return nil, err
+	}
+
+	exemptSale, err := financial.NewAmount(item.ExemptSale)
+	if err != nil {
+		return nil, err
+	}
+
+	taxedSale, err := financial.NewAmount(item.TaxedSale)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remission_note_models.RemissionNoteItem{
+		Item:           baseItem,
+		NonSubjectSale: *nonSubjectSale,
+		ExemptSale:     *exemptSale,
+		TaxedSale:      *taxedSale,
+	}, nil
+}
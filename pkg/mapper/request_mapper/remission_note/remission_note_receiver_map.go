@@ -0,0 +1,55 @@
+package remission_note
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+)
+
+// MapRemissionNoteRequestReceiver mapea el receptor de una Nota de Remisión -> Origen: Request
+func MapRemissionNoteRequestReceiver(receiver *structs.ReceiverRequest) (*models.Receiver, error) {
+	if receiver == nil {
+		return nil, dte_errors.NewValidationError("RequiredField", "Receiver")
+	}
+
+	if err := validateRequiredFields(receiver); err != nil {
+		return nil, err
+	}
+
+	result, err := common.MapCommonRequestReceiver(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	result.CommercialName = receiver.CommercialName
+	return result, nil
+}
+
+func validateRequiredFields(receiver *structs.ReceiverRequest) error {
+	if receiver.Name == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->Name")
+	}
+
+	if receiver.DocumentType == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->DocumentType")
+	}
+
+	if receiver.DocumentNumber == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->DocumentNumber")
+	}
+
+	if receiver.Address == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->Address")
+	}
+
+	if receiver.ActivityCode == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->ActivityCode")
+	}
+
+	if receiver.ActivityDesc == nil {
+		return dte_errors.NewValidationError("RequiredField", "Receiver->ActivityDesc")
+	}
+
+	return nil
+}
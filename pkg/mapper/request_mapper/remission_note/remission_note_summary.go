@@ -0,0 +1,57 @@
+package remission_note
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/financial"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// MapRemissionNoteRequestSummary mapea un resumen de Nota de Remisión a un modelo de resumen de Nota de Remisión -> Origen: Request
+func MapRemissionNoteRequestSummary(summary *structs.RemissionNoteSummaryRequest) (*remission_note_models.RemissionNoteSummary, error) {
+	if summary.TotalInWords == nil {
+		inLetters := utils.InLetters(summary.TotalOperation)
+		summary.TotalInWords = &inLetters
+	}
+
+	// El traslado de bienes no tiene condición de operación propia, por defecto se considera de contado
+	if summary.OperationCondition == 0 {
+		summary.OperationCondition = constants.Cash
+	}
+
+	// La Nota de Remisión no contiene sección de pagos, el total a pagar corresponde al monto de la operación
+	baseSummary, err := common.MapCommonRequestSummary(structs.SummaryRequest{
+		TotalNonSubject:    summary.TotalNonSubject,
+		TotalExempt:        summary.TotalExempt,
+		TotalTaxed:         summary.TotalTaxed,
+		SubTotal:           summary.SubTotal,
+		NonSubjectDiscount: summary.NonSubjectDiscount,
+		ExemptDiscount:     summary.ExemptDiscount,
+		DiscountPercentage: summary.DiscountPercentage,
+		TotalDiscount:      summary.TotalDiscount,
+		TotalOperation:     summary.TotalOperation,
+		TotalNonTaxed:      summary.TotalNonTaxed,
+		SubTotalSales:      summary.SubTotalSales,
+		TotalToPay:         summary.TotalOperation,
+		OperationCondition: summary.OperationCondition,
+		Taxes:              summary.Taxes,
+		PaymentTypes:       []structs.PaymentRequest{},
+		TotalInWords:       summary.TotalInWords,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	taxedDiscount, err := financial.NewAmountForTotal(summary.TaxedDiscount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remission_note_models.RemissionNoteSummary{
+		Summary:       baseSummary,
+		TaxedDiscount: *taxedDiscount,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package request_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/remission_note"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+)
+
+type RemissionNoteMapper struct{}
+
+func NewRemissionNoteMapper() *RemissionNoteMapper {
+	return &RemissionNoteMapper{}
+}
+
+// MapToRemissionNoteData convierte una solicitud de Nota de Remisión a datos de modelo de dominio.
+func (m *RemissionNoteMapper) MapToRemissionNoteData(req *structs.CreateRemissionNoteRequest, client *dte.IssuerDTE) (*remission_note_models.RemissionNoteInput, error) {
+	if err := validateRemissionNoteRequest(req); err != nil {
+		return nil, err
+	}
+
+	items, err := remission_note.MapRemissionNoteItems(req.Items)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RemissionNoteMapper", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->Items")
+	}
+
+	receiver, err := remission_note.MapRemissionNoteRequestReceiver(req.Receiver)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RemissionNoteMapper", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->Receiver")
+	}
+
+	goodsTitle, err := document.NewGoodsTitle(*req.GoodsTitle)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RemissionNoteMapper", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->GoodsTitle")
+	}
+
+	identification, err := common.MapCommonRequestIdentification(constants.ModeloFacturacionPrevio, 3, constants.NotaRemisionElectronica)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RemissionNoteMapper", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->Identification")
+	}
+
+	summary, err := remission_note.MapRemissionNoteRequestSummary(req.Summary)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RemissionNoteMapper", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->Summary")
+	}
+
+	issuer, err := common.MapCommonIssuer(client)
+	if err != nil {
+		return nil, shared_error.NewFormattedGeneralServiceWithError("RemissionNoteMapper", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->Issuer")
+	}
+
+	result := &remission_note_models.RemissionNoteInput{
+		InputDataCommon: &models.InputDataCommon{
+			Issuer:         issuer,
+			Identification: identification,
+			Receiver:       receiver,
+		},
+		Items:            items,
+		RemissionSummary: summary,
+		GoodsTitle:       goodsTitle,
+	}
+
+	if err = mapRemissionNoteOptionalFields(req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// validateRemissionNoteRequest valida que la solicitud de Nota de Remisión sea correcta.
+func validateRemissionNoteRequest(req *structs.CreateRemissionNoteRequest) error {
+	if req == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request")
+	}
+	if req.Items == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Items")
+	}
+	if req.Summary == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Summary")
+	}
+	if req.Receiver == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->Receiver")
+	}
+	if req.GoodsTitle == nil {
+		return dte_errors.NewValidationError("RequiredField", "Request->GoodsTitle")
+	}
+
+	// Los documentos relacionados son opcionales, pero si se envían deben estar completos
+	for _, doc := range req.RelatedDocs {
+		if doc.DocumentType == "" {
+			return dte_errors.NewValidationError("RequiredField", "Request->RelatedDocs->DocumentType")
+		}
+		if doc.DocumentNumber == "" {
+			return dte_errors.NewValidationError("RequiredField", "Request->RelatedDocs->DocumentNumber")
+		}
+
+		if doc.GenerationType == 0 {
+			return dte_errors.NewValidationError("RequiredField", "Request->RelatedDocs->GenerationType")
+		}
+
+		if doc.GenerationType == constants.PhysicalDocument && doc.EmissionDate == "" {
+			return dte_errors.NewValidationError("InvalidEmissionDateForPhysicalDocument", doc.EmissionDate)
+		}
+	}
+
+	return nil
+}
+
+// mapRemissionNoteOptionalFields mapea los campos opcionales de la solicitud de Nota de Remisión.
+func mapRemissionNoteOptionalFields(req *structs.CreateRemissionNoteRequest, result *remission_note_models.RemissionNoteInput) error {
+	if req.RelatedDocs != nil {
+		relatedDocs, err := common.MapCommonRequestRelatedDocuments(req.RelatedDocs)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestRelatedDocuments", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->RelatedDocs")
+		}
+		result.RelatedDocs = relatedDocs
+	}
+
+	if req.ThirdPartySale != nil {
+		thirdPartySale, err := common.MapCommonRequestThirdPartySale(req.ThirdPartySale)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestThirdPartySale", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->ThirdPartySales")
+		}
+		result.ThirdPartySale = thirdPartySale
+	}
+
+	if req.Extension != nil {
+		extension, err := common.MapCommonRequestExtension(req.Extension)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapCommonRequestExtension", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->Extension")
+		}
+		result.Extension = extension
+	}
+
+	if req.Appendixes != nil {
+		appendixes, err := common.MapCommonRequestAppendix(req.Appendixes)
+		if err != nil {
+			return shared_error.NewFormattedGeneralServiceWithError("MapAppendixes", "MapToRemissionNoteData", err, "ErrorMapping", "RemissionNote->Appendixes")
+		}
+		result.Appendixes = appendixes
+	}
+
+	return nil
+}
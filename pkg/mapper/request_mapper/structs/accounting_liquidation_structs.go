@@ -0,0 +1,36 @@
+package structs
+
+type CreateAccountingLiquidationRequest struct {
+	Receiver   *ReceiverRequest                       `json:"receiver"`
+	Body       *AccountingLiquidationBodyRequest      `json:"body"`
+	Extension  *AccountingLiquidationExtensionRequest `json:"extension,omitempty"`
+	Appendixes []AppendixRequest                      `json:"appendixes,omitempty"`
+}
+
+// AccountingLiquidationBodyRequest estructura para mapear el cuerpo de un Documento Contable de Liquidación
+type AccountingLiquidationBodyRequest struct {
+	PeriodStart                  string  `json:"period_start"`
+	PeriodEnd                    string  `json:"period_end"`
+	LiquidationCode              string  `json:"liquidation_code"`
+	DocumentCount                int     `json:"document_count"`
+	OperationsValue              float64 `json:"operations_value"`
+	AmountWithoutPerception      float64 `json:"amount_without_perception"`
+	WithoutPerceptionDescription *string `json:"without_perception_description,omitempty"`
+	SubTotal                     float64 `json:"sub_total"`
+	IVA                          float64 `json:"iva"`
+	AmountSubjectToPerception    float64 `json:"amount_subject_to_perception"`
+	IVAPerceived                 float64 `json:"iva_perceived"`
+	Commission                   float64 `json:"commission"`
+	CommissionPercentage         float64 `json:"commission_percentage"`
+	CommissionIVA                float64 `json:"commission_iva"`
+	NetAmountToPay               float64 `json:"net_amount_to_pay"`
+	TotalInWords                 *string `json:"total_in_words,omitempty"`
+	Observations                 *string `json:"observations,omitempty"`
+}
+
+// AccountingLiquidationExtensionRequest estructura para mapear la extensión de un Documento Contable de Liquidación
+type AccountingLiquidationExtensionRequest struct {
+	DeliveryName     string  `json:"delivery_name"`
+	DeliveryDocument string  `json:"delivery_document"`
+	EmployeeCode     *string `json:"employee_code,omitempty"`
+}
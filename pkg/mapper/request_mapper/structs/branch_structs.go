@@ -0,0 +1,32 @@
+package structs
+
+// BranchOfficeRequest representa la solicitud para registrar o modificar una sucursal. EstablishmentType es obligatorio
+// al registrarla; al modificarla, los campos omitidos conservan su valor actual. Role y Scopes definen los permisos de
+// las credenciales de la sucursal: su rol y los scopes adicionales al rol.
+type BranchOfficeRequest struct {
+	EstablishmentType   *string               `json:"establishment_type,omitempty"`
+	EstablishmentCode   *string               `json:"establishment_code,omitempty"`
+	EstablishmentCodeMH *string               `json:"establishment_code_mh,omitempty"`
+	POSCode             *string               `json:"pos_code,omitempty"`
+	POSCodeMH           *string               `json:"pos_code_mh,omitempty"`
+	Email               *string               `json:"email,omitempty"`
+	Phone               *string               `json:"phone,omitempty"`
+	AsyncEmission       *bool                 `json:"async_emission,omitempty"`
+	Role                *string               `json:"role,omitempty"`
+	Scopes              []string              `json:"scopes,omitempty"`
+	Address             *BranchAddressRequest `json:"address,omitempty"`
+}
+
+// BranchAddressRequest representa la dirección de una sucursal con los códigos de departamento y municipio de Hacienda
+type BranchAddressRequest struct {
+	Department   string `json:"department"`
+	Municipality string `json:"municipality"`
+	Complement   string `json:"complement"`
+}
+
+// CredentialsRotationRequest representa la solicitud opcional de rotación de las credenciales de una sucursal.
+// GracePeriodMinutes indica durante cuántos minutos siguen siendo válidas las credenciales anteriores; si se omite se
+// usa el periodo configurado, que también es el máximo, y 0 las invalida de inmediato.
+type CredentialsRotationRequest struct {
+	GracePeriodMinutes *int `json:"grace_period_minutes,omitempty"`
+}
@@ -0,0 +1,10 @@
+package structs
+
+// UploadCertificateRequest representa la solicitud para registrar o renovar un certificado de firma.
+// El certificado es el archivo <NIT>.crt entregado por el Ministerio de Hacienda codificado en base64.
+// ExpiresAt (YYYY-MM-DD) solo es necesario si el certificado no incluye su fecha de vencimiento.
+type UploadCertificateRequest struct {
+	Certificate string  `json:"certificate"`
+	PasswordPri string  `json:"password_pri"`
+	ExpiresAt   *string `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,22 @@
+package structs
+
+// ContingencyListRequest representa los filtros de la consulta de documentos en contingencia. Si no se indica el
+// estado solo se consultan los documentos pendientes de transmisión.
+type ContingencyListRequest struct {
+	BranchID string
+	Status   string
+	Page     string
+	PageSize string
+}
+
+// ContingencyReclassifyRequest representa la solicitud para cambiar el tipo y el motivo de contingencia de un
+// documento pendiente. El motivo es obligatorio solo para el tipo 5 (Otro motivo).
+type ContingencyReclassifyRequest struct {
+	ContingencyType int8   `json:"contingency_type"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+// ContingencyCancelRequest representa la solicitud para retirar un documento de la cola de contingencia
+type ContingencyCancelRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
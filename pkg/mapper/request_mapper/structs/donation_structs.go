@@ -0,0 +1,48 @@
+package structs
+
+/*
+	El emisor del Comprobante de Donación actúa como donatario, por lo que se toma del cliente autenticado.
+	El donante reemplaza al receptor y debe acompañarse de al menos un documento asociado.
+*/
+
+type CreateDonationRequest struct {
+	Items      []DonationItemRequest   `json:"items"`
+	Donor      *DonorRequest           `json:"donor"`
+	OtherDocs  []OtherDocRequest       `json:"other_docs"`
+	Summary    *DonationSummaryRequest `json:"summary"`
+	Appendixes []AppendixRequest       `json:"appendixes,omitempty"`
+}
+
+// DonorRequest estructura para mapear el donante de un Comprobante de Donación
+type DonorRequest struct {
+	DocumentType   *string         `json:"document_type,omitempty"`
+	DocumentNumber *string         `json:"document_number,omitempty"`
+	NRC            *string         `json:"nrc,omitempty"`
+	Name           *string         `json:"name,omitempty"`
+	ActivityCode   *string         `json:"activity_code,omitempty"`
+	ActivityDesc   *string         `json:"activity_description,omitempty"`
+	Address        *AddressRequest `json:"address,omitempty"`
+	Phone          *string         `json:"phone,omitempty"`
+	Email          *string         `json:"email,omitempty"`
+	DomiciledCode  *int            `json:"domiciled_code,omitempty"`
+	CountryCode    *string         `json:"country_code,omitempty"`
+}
+
+// DonationItemRequest estructura para mapear un item de Comprobante de Donación
+type DonationItemRequest struct {
+	Type         int     `json:"type"`
+	Description  string  `json:"description"`
+	Quantity     float64 `json:"quantity"`
+	UnitMeasure  int     `json:"unit_measure"`
+	Code         *string `json:"code,omitempty"`
+	Depreciation float64 `json:"depreciation"`
+	UnitValue    float64 `json:"unit_value"`
+	Value        float64 `json:"value"`
+}
+
+// DonationSummaryRequest estructura para mapear el resumen de un Comprobante de Donación
+type DonationSummaryRequest struct {
+	TotalValue   float64          `json:"total_value"`
+	PaymentTypes []PaymentRequest `json:"payment_types,omitempty"`
+	TotalInWords *string          `json:"total_in_words,omitempty"`
+}
@@ -0,0 +1,12 @@
+package structs
+
+// EventListRequest representa los filtros de la consulta de eventos de dominio. Las fechas se reciben en formato
+// RFC3339 y todos los filtros son opcionales.
+type EventListRequest struct {
+	BranchID  string
+	EventType string
+	StartDate string
+	EndDate   string
+	Page      string
+	PageSize  string
+}
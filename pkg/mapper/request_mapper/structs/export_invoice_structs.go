@@ -0,0 +1,65 @@
+package structs
+
+type CreateExportInvoiceRequest struct {
+	Items          []ExportItemRequest     `json:"items"`
+	Receiver       *ForeignReceiverRequest `json:"receiver"`
+	ExportData     *ExportDataRequest      `json:"export_data"`
+	Summary        *ExportSummaryRequest   `json:"summary"`
+	ThirdPartySale *ThirdPartySaleRequest  `json:"third_party_sale,omitempty"`
+	Appendixes     []AppendixRequest       `json:"appendixes,omitempty"`
+}
+
+// ForeignReceiverRequest estructura para mapear el receptor extranjero de una Factura de Exportación
+type ForeignReceiverRequest struct {
+	Name           *string `json:"name,omitempty"`
+	DocumentType   *string `json:"document_type,omitempty"`
+	DocumentNumber *string `json:"document_number,omitempty"`
+	CommercialName *string `json:"commercial_name,omitempty"`
+	CountryCode    *string `json:"country_code,omitempty"`
+	CountryName    *string `json:"country_name,omitempty"`
+	Complement     *string `json:"complement,omitempty"`
+	PersonType     *int    `json:"person_type,omitempty"`
+	ActivityDesc   *string `json:"activity_description,omitempty"`
+	Phone          *string `json:"phone,omitempty"`
+	Email          *string `json:"email,omitempty"`
+}
+
+// ExportDataRequest estructura para mapear los datos de exportación del emisor
+type ExportDataRequest struct {
+	ItemExportType int     `json:"item_export_type"`
+	FiscalPrecinct *string `json:"fiscal_precinct,omitempty"`
+	Regime         *string `json:"regime,omitempty"`
+}
+
+// ExportItemRequest estructura para mapear un item de Factura de Exportación
+type ExportItemRequest struct {
+	Type        int      `json:"type"`
+	Description string   `json:"description"`
+	Quantity    float64  `json:"quantity"`
+	UnitMeasure int      `json:"unit_measure"`
+	UnitPrice   float64  `json:"unit_price"`
+	Discount    float64  `json:"discount"`
+	Code        *string  `json:"code,omitempty"`
+	Taxes       []string `json:"taxes,omitempty"`
+	TaxedSale   float64  `json:"taxed_sale"`
+	NonTaxed    float64  `json:"non_taxed"`
+}
+
+// ExportSummaryRequest estructura para mapear el resumen de una Factura de Exportación
+type ExportSummaryRequest struct {
+	TotalTaxed              float64          `json:"total_taxed"`
+	Discount                float64          `json:"discount"`
+	DiscountPercentage      float64          `json:"discount_percentage"`
+	TotalDiscount           float64          `json:"total_discount"`
+	Insurance               float64          `json:"insurance"`
+	Freight                 float64          `json:"freight"`
+	TotalOperation          float64          `json:"total_operation"`
+	TotalNonTaxed           float64          `json:"total_non_taxed"`
+	TotalToPay              float64          `json:"total_to_pay"`
+	OperationCondition      int              `json:"operation_condition"`
+	PaymentTypes            []PaymentRequest `json:"payment_types,omitempty"`
+	TotalInWords            *string          `json:"total_in_words,omitempty"`
+	IncotermCode            *string          `json:"incoterm_code,omitempty"`
+	ElectronicPaymentNumber *string          `json:"electronic_payment_number,omitempty"`
+	Observations            *string          `json:"observations,omitempty"`
+}
@@ -0,0 +1,47 @@
+package structs
+
+type CreateFSERequest struct {
+	Items           []FSEItemRequest        `json:"items"`
+	ExcludedSubject *ExcludedSubjectRequest `json:"excluded_subject"`
+	Summary         *FSESummaryRequest      `json:"summary"`
+	Appendixes      []AppendixRequest       `json:"appendixes,omitempty"`
+}
+
+// ExcludedSubjectRequest estructura para mapear el sujeto excluido de una Factura Sujeto Excluido
+type ExcludedSubjectRequest struct {
+	DocumentType   *string         `json:"document_type,omitempty"`
+	DocumentNumber *string         `json:"document_number,omitempty"`
+	Name           *string         `json:"name,omitempty"`
+	ActivityCode   *string         `json:"activity_code,omitempty"`
+	ActivityDesc   *string         `json:"activity_description,omitempty"`
+	Address        *AddressRequest `json:"address,omitempty"`
+	Phone          *string         `json:"phone,omitempty"`
+	Email          *string         `json:"email,omitempty"`
+}
+
+// FSEItemRequest estructura para mapear un item de Factura Sujeto Excluido
+type FSEItemRequest struct {
+	Type        int     `json:"type"`
+	Description string  `json:"description"`
+	Quantity    float64 `json:"quantity"`
+	UnitMeasure int     `json:"unit_measure"`
+	UnitPrice   float64 `json:"unit_price"`
+	Discount    float64 `json:"discount"`
+	Code        *string `json:"code,omitempty"`
+	Purchase    float64 `json:"purchase"`
+}
+
+// FSESummaryRequest estructura para mapear el resumen de una Factura Sujeto Excluido
+type FSESummaryRequest struct {
+	TotalPurchase      float64          `json:"total_purchase"`
+	Discount           float64          `json:"discount"`
+	TotalDiscount      float64          `json:"total_discount"`
+	SubTotal           float64          `json:"sub_total"`
+	IVARetention       float64          `json:"iva_retention"`
+	IncomeRetention    float64          `json:"income_retention"`
+	TotalToPay         float64          `json:"total_to_pay"`
+	OperationCondition int              `json:"operation_condition"`
+	PaymentTypes       []PaymentRequest `json:"payment_types,omitempty"`
+	TotalInWords       *string          `json:"total_in_words,omitempty"`
+	Observations       *string          `json:"observations,omitempty"`
+}
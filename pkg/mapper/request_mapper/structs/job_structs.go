@@ -0,0 +1,10 @@
+package structs
+
+// RetransmissionScheduleRequest representa la solicitud para cambiar la programación de la retransmisión de los
+// documentos en contingencia de un usuario. Los campos omitidos conservan su valor vigente.
+type RetransmissionScheduleRequest struct {
+	Cron                *string `json:"cron,omitempty"`
+	Windows             *string `json:"windows,omitempty"`
+	BatchSize           *int    `json:"batch_size,omitempty"`
+	MaxExecutionMinutes *int    `json:"max_execution_minutes,omitempty"`
+}
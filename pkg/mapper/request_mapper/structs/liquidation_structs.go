@@ -0,0 +1,48 @@
+package structs
+
+/*
+	Para documentos liquidados de tipo generación 1 (Fisico) solicito:
+		- Tipo de DTE, número de documento (correlativo tradicional) y fecha de generación
+
+	Para documentos liquidados de tipo generación 2 (Electronico) solicito:
+		- Tipo de DTE y número de documento (Código de generación UUID)
+		- La fecha de generación se toma automáticamente del DTE previamente emitido
+*/
+
+type CreateLiquidationRequest struct {
+	Items      []LiquidationItemRequest   `json:"items"`
+	Receiver   *ReceiverRequest           `json:"receiver"`
+	Summary    *LiquidationSummaryRequest `json:"summary"`
+	Extension  *ExtensionRequest          `json:"extension,omitempty"`
+	Appendixes []AppendixRequest          `json:"appendixes,omitempty"`
+}
+
+// LiquidationItemRequest estructura para mapear un documento liquidado de un Comprobante de Liquidación
+type LiquidationItemRequest struct {
+	DTEType        string   `json:"dte_type"`
+	GenerationType int      `json:"generation_type"`
+	DocumentNumber string   `json:"document_number"`
+	GenerationDate *string  `json:"generation_date,omitempty"`
+	NonSubjectSale float64  `json:"non_subject_sale"`
+	ExemptSale     float64  `json:"exempt_sale"`
+	TaxedSale      float64  `json:"taxed_sale"`
+	ExportSale     float64  `json:"export_sale"`
+	Taxes          []string `json:"taxes,omitempty"`
+	IVAItem        float64  `json:"iva_item"`
+	Observation    *string  `json:"observation,omitempty"`
+}
+
+// LiquidationSummaryRequest estructura para mapear el resumen de un Comprobante de Liquidación
+type LiquidationSummaryRequest struct {
+	TotalNonSubject    float64      `json:"total_non_subject"`
+	TotalExempt        float64      `json:"total_exempt"`
+	TotalTaxed         float64      `json:"total_taxed"`
+	TotalExport        float64      `json:"total_export"`
+	SubTotalSales      float64      `json:"sub_total_sales"`
+	Taxes              []TaxRequest `json:"taxes,omitempty"`
+	TotalOperation     float64      `json:"total_operation"`
+	IVAPerception      float64      `json:"iva_perception"`
+	Total              float64      `json:"total"`
+	OperationCondition int          `json:"operation_condition"`
+	TotalInWords       *string      `json:"total_in_words,omitempty"`
+}
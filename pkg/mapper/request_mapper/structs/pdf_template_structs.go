@@ -0,0 +1,10 @@
+package structs
+
+// SavePDFTemplateRequest representa la solicitud para configurar la versión legible de los DTE.
+// El logo es una imagen PNG o JPEG codificada en base64; si se omite se elimina el logo actual.
+// El pie de página es una plantilla de texto que puede usar los campos {{.IssuerName}}, {{.IssuerNIT}},
+// {{.DTEType}}, {{.DTETypeName}}, {{.ControlNumber}}, {{.GenerationCode}} y {{.EmissionDate}}.
+type SavePDFTemplateRequest struct {
+	Logo   string `json:"logo,omitempty"`
+	Footer string `json:"footer"`
+}
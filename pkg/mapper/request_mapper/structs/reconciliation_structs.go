@@ -0,0 +1,11 @@
+package structs
+
+// ReconciliationDiscrepancyRequest representa los filtros de la consulta de discrepancias de la conciliación con
+// Hacienda. Todos los filtros son opcionales.
+type ReconciliationDiscrepancyRequest struct {
+	BranchID string
+	Source   string
+	Action   string
+	Page     string
+	PageSize string
+}
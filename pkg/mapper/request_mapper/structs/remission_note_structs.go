@@ -0,0 +1,26 @@
+package structs
+
+type CreateRemissionNoteRequest struct {
+	Items          []RemissionNoteItemRequest   `json:"items"`
+	Receiver       *ReceiverRequest             `json:"receiver"`
+	GoodsTitle     *string                      `json:"goods_title"`
+	Summary        *RemissionNoteSummaryRequest `json:"summary"`
+	ThirdPartySale *ThirdPartySaleRequest       `json:"third_party_sale,omitempty"`
+	Extension      *ExtensionRequest            `json:"extension,omitempty"`
+	RelatedDocs    []RelatedDocRequest          `json:"related_docs,omitempty"`
+	Appendixes     []AppendixRequest            `json:"appendixes,omitempty"`
+}
+
+// RemissionNoteItemRequest estructura para mapear un item de Nota de Remisión
+type RemissionNoteItemRequest struct {
+	ItemRequest
+	NonSubjectSale float64 `json:"non_subject_sale"`
+	ExemptSale     float64 `json:"exempt_sale"`
+	TaxedSale      float64 `json:"taxed_sale"`
+}
+
+// RemissionNoteSummaryRequest estructura para mapear el resumen de una Nota de Remisión, no incluye pagos
+type RemissionNoteSummaryRequest struct {
+	SummaryRequest
+	TaxedDiscount float64 `json:"taxed_discount"`
+}
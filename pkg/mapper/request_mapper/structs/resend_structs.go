@@ -0,0 +1,7 @@
+package structs
+
+// ResendDTERequest representa la solicitud para reenviar un DTE por correo.
+// Si Email se omite, el documento se envía al correo del receptor.
+type ResendDTERequest struct {
+	Email string `json:"email,omitempty"`
+}
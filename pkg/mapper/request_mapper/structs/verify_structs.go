@@ -0,0 +1,6 @@
+package structs
+
+// VerifyDTERequest representa la solicitud para verificar un DTE firmado en formato JWS compacto
+type VerifyDTERequest struct {
+	SignedDocument string `json:"signed_document"`
+}
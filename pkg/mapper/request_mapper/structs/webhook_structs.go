@@ -0,0 +1,11 @@
+package structs
+
+// WebhookSubscriptionRequest representa la solicitud para crear o modificar una suscripción de webhooks.
+// Events acepta los eventos del ciclo de vida de los DTE o "*" para todos. Si Secret se omite al crear la
+// suscripción se genera uno; al modificarla, los campos omitidos conservan su valor actual.
+type WebhookSubscriptionRequest struct {
+	URL      string   `json:"url"`
+	Events   []string `json:"events"`
+	Secret   string   `json:"secret,omitempty"`
+	IsActive *bool    `json:"is_active,omitempty"`
+}
@@ -0,0 +1,34 @@
+package accounting_liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/shopspring/decimal"
+)
+
+// MapAccountingLiquidationResponseBody mapea el cuerpo del Documento Contable de Liquidación -> Origen: Response
+func MapAccountingLiquidationResponseBody(body *accounting_liquidation_models.AccountingLiquidationBody) *structs.AccountingLiquidationDTEBody {
+	if body == nil {
+		return nil
+	}
+
+	return &structs.AccountingLiquidationDTEBody{
+		PeriodoLiquidacionFechaInicio: body.PeriodStart.ToString(),
+		PeriodoLiquidacionFechaFin:    body.PeriodEnd.ToString(),
+		CodLiquidacion:                body.LiquidationCode,
+		CantidadDoc:                   body.DocumentCount,
+		ValorOperaciones:              body.OperationsValue.GetValue(),
+		MontoSinPercepcion:            body.AmountWithoutPerception.GetValue(),
+		DescripSinPercepcion:          body.WithoutPerceptionDescription,
+		SubTotal:                      body.SubTotal.GetValue(),
+		IVA:                           body.IVA.GetValue(),
+		MontoSujetoPercepcion:         body.AmountSubjectToPerception.GetValue(),
+		IVAPercibido:                  body.IVAPerceived.GetValue(),
+		Comision:                      body.Commission.GetValue(),
+		PorcentComision:               decimal.NewFromFloat(body.CommissionPercentage.GetValue()).String(),
+		IVAComision:                   body.CommissionIVA.GetValue(),
+		LiquidoApagar:                 body.NetAmountToPay.GetValue(),
+		TotalLetras:                   body.TotalInWords,
+		Observaciones:                 body.Observations,
+	}
+}
@@ -0,0 +1,18 @@
+package accounting_liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapAccountingLiquidationResponseExtension(extension *accounting_liquidation_models.AccountingLiquidationExtension) *structs.AccountingLiquidationDTEExtension {
+	if extension == nil {
+		return nil
+	}
+
+	return &structs.AccountingLiquidationDTEExtension{
+		NombreEntrega:    extension.DeliveryName.GetValue(),
+		DocumentoEntrega: extension.DeliveryDocument.GetValue(),
+		CodEmpleado:      extension.EmployeeCode,
+	}
+}
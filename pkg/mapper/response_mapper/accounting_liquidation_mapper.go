@@ -0,0 +1,26 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/accounting_liquidation/accounting_liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/accounting_liquidation"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func ToMHAccountingLiquidation(doc interface{}) *structs.AccountingLiquidationDTEResponse {
+
+	cast := doc.(*accounting_liquidation_models.AccountingLiquidationModel)
+	dte := &structs.AccountingLiquidationDTEResponse{
+		Identificacion:  common.MapCommonResponseIdentification(cast.Identification),
+		Emisor:          common.MapCommonResponseIssuer(cast.Issuer),
+		Receptor:        common.MapCommonResponseReceiver(cast.Receiver),
+		CuerpoDocumento: accounting_liquidation.MapAccountingLiquidationResponseBody(cast.Body),
+		Extension:       accounting_liquidation.MapAccountingLiquidationResponseExtension(cast.LiquidationExtension),
+	}
+
+	if cast.GetAppendix() != nil {
+		dte.Apendice = common.MapCommonResponseAppendix(cast.GetAppendix())
+	}
+
+	return dte
+}
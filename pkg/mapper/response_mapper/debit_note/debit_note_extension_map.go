@@ -0,0 +1,20 @@
+package debit_note
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapDebitNoteResponseExtension(extension interfaces.Extension) *structs.DebitNoteDTEExtension {
+	if extension == nil {
+		return nil
+	}
+
+	return &structs.DebitNoteDTEExtension{
+		NombreEntrega:    extension.GetDeliveryName(),
+		DocumentoEntrega: extension.GetDeliveryDocument(),
+		NombreRecibe:     extension.GetReceiverName(),
+		DocumentoRecibe:  extension.GetReceiverDocument(),
+		Observacion:      extension.GetObservation(),
+	}
+}
@@ -0,0 +1,35 @@
+package donation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// MapDonationResponseDonee mapea el emisor del Comprobante de Donación a la sección "donatario", identificado por su NIT
+func MapDonationResponseDonee(issuer interfaces.Issuer) structs.DonationDonee {
+	result := structs.DonationDonee{
+		TipoDocumento:       constants.NIT,
+		NumDocumento:        issuer.GetNIT(),
+		NRC:                 issuer.GetNRC(),
+		Nombre:              issuer.GetName(),
+		CodActividad:        issuer.GetActivityCode(),
+		DescActividad:       issuer.GetActivityDescription(),
+		TipoEstablecimiento: issuer.GetEstablishmentType(),
+		Direccion:           common.MapCommonResponseAddress(issuer.GetAddress()),
+		Telefono:            issuer.GetPhone(),
+		Correo:              issuer.GetEmail(),
+		CodEstable:          issuer.GetEstablishmentCode(),
+		CodEstableMH:        issuer.GetEstablishmentMHCode(),
+		CodPuntoVenta:       issuer.GetPOSCode(),
+		CodPuntoVentaMH:     issuer.GetPOSMHCode(),
+	}
+
+	if commercialName := issuer.GetCommercialName(); commercialName != "" {
+		result.NombreComercial = utils.ToStringPointer(commercialName)
+	}
+
+	return result
+}
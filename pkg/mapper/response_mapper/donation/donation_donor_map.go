@@ -0,0 +1,48 @@
+package donation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+func MapDonationResponseDonor(donor *donation_models.Donor) structs.DonationDonor {
+	if donor == nil {
+		return structs.DonationDonor{}
+	}
+
+	result := structs.DonationDonor{
+		Nombre:         donor.Name,
+		DescActividad:  donor.ActivityDescription,
+		CodDomiciliado: donor.DomiciledCode,
+	}
+
+	if donor.DocumentType != nil {
+		result.TipoDocumento = utils.ToStringPointer(donor.DocumentType.GetValue())
+	}
+	if donor.DocumentNumber != nil {
+		result.NumDocumento = utils.ToStringPointer(donor.DocumentNumber.GetValue())
+	}
+	if donor.NRC != nil {
+		result.NRC = utils.ToStringPointer(donor.NRC.GetValue())
+	}
+	if donor.ActivityCode != nil {
+		result.CodActividad = utils.ToStringPointer(donor.ActivityCode.GetValue())
+	}
+	if donor.Address != nil {
+		address := common.MapCommonResponseAddress(donor.Address)
+		result.Direccion = &address
+	}
+	if donor.Phone != nil {
+		result.Telefono = utils.ToStringPointer(donor.Phone.GetValue())
+	}
+	if donor.Email != nil {
+		result.Correo = utils.ToStringPointer(donor.Email.GetValue())
+	}
+	if donor.CountryCode != nil {
+		result.CodPais = donor.CountryCode.GetValue()
+	}
+
+	return result
+}
@@ -0,0 +1,28 @@
+package donation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+func MapDonationResponseItem(items []donation_models.DonationItem) []structs.DonationItem {
+	result := make([]structs.DonationItem, len(items))
+	for i, item := range items {
+		result[i] = structs.DonationItem{
+			NumItem:      item.Number.GetValue(),
+			TipoDonacion: item.Type.GetValue(),
+			Cantidad:     item.Quantity.GetValue(),
+			UniMedida:    item.UnitMeasure.GetValue(),
+			Descripcion:  item.Description,
+			Depreciacion: item.Depreciation.GetValue(),
+			ValorUni:     item.UnitValue.GetValue(),
+			Valor:        item.Value.GetValue(),
+		}
+
+		if item.Code != nil {
+			result[i].Codigo = utils.ToStringPointer(item.Code.GetValue())
+		}
+	}
+	return result
+}
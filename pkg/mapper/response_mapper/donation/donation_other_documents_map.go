@@ -0,0 +1,24 @@
+package donation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+func MapDonationResponseOtherDocuments(docs []interfaces.OtherDocuments) []structs.DonationOtherDocument {
+	result := make([]structs.DonationOtherDocument, len(docs))
+	for i, doc := range docs {
+		result[i] = structs.DonationOtherDocument{
+			CodDocAsociado: doc.GetAssociatedDocument(),
+		}
+
+		if doc.GetDescription() != "" {
+			result[i].DescDocumento = utils.ToStringPointer(doc.GetDescription())
+		}
+		if doc.GetDetail() != "" {
+			result[i].DetalleDocumento = utils.ToStringPointer(doc.GetDetail())
+		}
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package donation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+func MapDonationResponseSummary(summary *donation_models.DonationSummary) *structs.DonationSummary {
+	if summary == nil {
+		return nil
+	}
+
+	result := &structs.DonationSummary{
+		ValorTotal:  summary.TotalValue.GetValue(),
+		TotalLetras: summary.TotalInWords,
+	}
+
+	if len(summary.PaymentTypes) > 0 {
+		result.Pagos = make([]structs.DonationPayment, len(summary.PaymentTypes))
+		for i, payment := range summary.PaymentTypes {
+			result.Pagos[i] = structs.DonationPayment{
+				Codigo:    payment.GetCode(),
+				MontoPago: payment.GetAmount(),
+			}
+
+			if reference := payment.GetReference(); reference != "" {
+				result.Pagos[i].Referencia = utils.ToStringPointer(reference)
+			}
+		}
+	}
+
+	return result
+}
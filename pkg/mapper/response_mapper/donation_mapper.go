@@ -0,0 +1,27 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/donation/donation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/donation"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func ToMHDonation(doc interface{}) *structs.DonationDTEResponse {
+
+	cast := doc.(*donation_models.DonationModel)
+	dte := &structs.DonationDTEResponse{
+		Identificacion:  common.MapCommonResponseIdentification(cast.Identification),
+		Donatario:       donation.MapDonationResponseDonee(cast.Issuer),
+		Donante:         donation.MapDonationResponseDonor(cast.Donor),
+		OtrosDocumentos: donation.MapDonationResponseOtherDocuments(cast.OtherDocuments),
+		CuerpoDocumento: donation.MapDonationResponseItem(cast.DonationItems),
+		Resumen:         donation.MapDonationResponseSummary(cast.DonationSummary),
+	}
+
+	if cast.Appendix != nil {
+		dte.Apendice = common.MapCommonResponseAppendix(cast.Appendix)
+	}
+
+	return dte
+}
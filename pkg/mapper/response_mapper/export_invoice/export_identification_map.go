@@ -0,0 +1,29 @@
+package export_invoice
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapExportResponseIdentification(identification interfaces.Identification) *structs.ExportIdentification {
+	base := common.MapCommonResponseIdentification(identification)
+	if base == nil {
+		return nil
+	}
+
+	return &structs.ExportIdentification{
+		Version:           base.Version,
+		Ambiente:          base.Ambiente,
+		TipoDte:           base.TipoDte,
+		NumeroControl:     base.NumeroControl,
+		CodigoGeneracion:  base.CodigoGeneracion,
+		TipoModelo:        base.TipoModelo,
+		TipoOperacion:     base.TipoOperacion,
+		TipoContingencia:  base.TipoContingencia,
+		MotivoContigencia: base.MotivoContin,
+		FecEmi:            base.FecEmi,
+		HorEmi:            base.HorEmi,
+		TipoMoneda:        base.TipoMoneda,
+	}
+}
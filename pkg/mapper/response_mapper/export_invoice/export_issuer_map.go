@@ -0,0 +1,32 @@
+package export_invoice
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapExportResponseIssuer(issuer interfaces.Issuer, data *export_invoice_models.ExportData) structs.ExportIssuer {
+	result := structs.ExportIssuer{
+		DTEIssuer: common.MapCommonResponseIssuer(issuer),
+	}
+
+	if data == nil {
+		return result
+	}
+
+	if data.ItemExportType != nil {
+		result.TipoItemExpor = data.ItemExportType.GetValue()
+	}
+	if data.FiscalPrecinct != nil {
+		precinct := data.FiscalPrecinct.GetValue()
+		result.RecintoFiscal = &precinct
+	}
+	if data.Regime != nil {
+		regime := data.Regime.GetValue()
+		result.Regimen = &regime
+	}
+
+	return result
+}
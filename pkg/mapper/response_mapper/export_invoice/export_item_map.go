@@ -0,0 +1,29 @@
+package export_invoice
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+func MapExportResponseItem(items []export_invoice_models.ExportItem) []structs.ExportItem {
+	result := make([]structs.ExportItem, len(items))
+	for i, item := range items {
+		result[i] = structs.ExportItem{
+			NumItem:      item.GetNumber(),
+			Cantidad:     item.GetQuantity(),
+			Codigo:       utils.ToStringPointer(item.GetItemCode()),
+			UniMedida:    item.GetUnitMeasure(),
+			Descripcion:  item.GetDescription(),
+			PrecioUni:    item.GetUnitPrice(),
+			MontoDescu:   item.GetDiscount(),
+			VentaGravada: item.TaxedSale.GetValue(),
+			NoGravado:    item.NonTaxed.GetValue(),
+		}
+
+		if taxes := item.GetTaxes(); len(taxes) > 0 {
+			result[i].Tributos = taxes
+		}
+	}
+	return result
+}
@@ -0,0 +1,42 @@
+package export_invoice
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+func MapExportResponseReceiver(receiver *export_invoice_models.ForeignReceiver) structs.ExportReceiver {
+	if receiver == nil {
+		return structs.ExportReceiver{}
+	}
+
+	result := structs.ExportReceiver{
+		Nombre:          receiver.Name,
+		NombreComercial: receiver.CommercialName,
+		NombrePais:      receiver.CountryName,
+		Complemento:     receiver.Complement,
+		DescActividad:   receiver.ActivityDescription,
+	}
+
+	if receiver.DocumentType != nil {
+		result.TipoDocumento = utils.ToStringPointer(receiver.DocumentType.GetValue())
+	}
+	if receiver.DocumentNumber != nil {
+		result.NumDocumento = utils.ToStringPointer(receiver.DocumentNumber.GetValue())
+	}
+	if receiver.CountryCode != nil {
+		result.CodPais = receiver.CountryCode.GetValue()
+	}
+	if receiver.PersonType != nil {
+		result.TipoPersona = receiver.PersonType.GetValue()
+	}
+	if receiver.Phone != nil {
+		result.Telefono = utils.ToStringPointer(receiver.Phone.GetValue())
+	}
+	if receiver.Email != nil {
+		result.Correo = utils.ToStringPointer(receiver.Email.GetValue())
+	}
+
+	return result
+}
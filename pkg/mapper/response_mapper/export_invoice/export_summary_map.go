@@ -0,0 +1,42 @@
+package export_invoice
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapExportResponseSummary(summary *export_invoice_models.ExportSummary) *structs.ExportSummary {
+	if summary == nil {
+		return nil
+	}
+
+	result := &structs.ExportSummary{
+		TotalGravada:        summary.TotalTaxed.GetValue(),
+		Descuento:           summary.Discount.GetValue(),
+		PorcentajeDescuento: summary.DiscountPercentage.GetValue(),
+		TotalDescu:          summary.TotalDiscount.GetValue(),
+		Seguro:              summary.Insurance.GetValue(),
+		Flete:               summary.Freight.GetValue(),
+		MontoTotalOperacion: summary.TotalOperation.GetValue(),
+		TotalNoGravado:      summary.TotalNonTaxed.GetValue(),
+		TotalPagar:          summary.TotalToPay.GetValue(),
+		TotalLetras:         summary.TotalInWords,
+		CondicionOperacion:  summary.OperationCondition.GetValue(),
+		NumPagoElectronico:  summary.ElectronicPaymentNumber,
+		Observaciones:       summary.Observations,
+	}
+
+	if len(summary.PaymentTypes) > 0 {
+		result.Pagos = common.MapCommonResponsePayments(summary.PaymentTypes)
+	}
+
+	if summary.Incoterm != nil {
+		code := summary.Incoterm.GetValue()
+		description := summary.Incoterm.GetDescription()
+		result.CodIncoterms = &code
+		result.DescIncoterms = &description
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/export_invoice/export_invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/export_invoice"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func ToMHExportInvoice(doc interface{}) *structs.ExportInvoiceDTEResponse {
+
+	cast := doc.(*export_invoice_models.ExportInvoiceModel)
+	dte := &structs.ExportInvoiceDTEResponse{
+		Identificacion:  export_invoice.MapExportResponseIdentification(cast.Identification),
+		Emisor:          export_invoice.MapExportResponseIssuer(cast.Issuer, cast.ExportData),
+		Receptor:        export_invoice.MapExportResponseReceiver(cast.ForeignReceiver),
+		VentaTercero:    common.MapCommonResponseThirdPartySale(cast.ThirdPartySale),
+		CuerpoDocumento: export_invoice.MapExportResponseItem(cast.ExportItems),
+		Resumen:         export_invoice.MapExportResponseSummary(cast.ExportSummary),
+	}
+
+	if cast.Appendix != nil {
+		dte.Apendice = common.MapCommonResponseAppendix(cast.Appendix)
+	}
+
+	return dte
+}
@@ -0,0 +1,41 @@
+package fse
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+func MapFSEResponseExcludedSubject(subject *fse_models.ExcludedSubject) structs.FSEExcludedSubject {
+	if subject == nil {
+		return structs.FSEExcludedSubject{}
+	}
+
+	result := structs.FSEExcludedSubject{
+		Nombre:        subject.Name,
+		DescActividad: subject.ActivityDescription,
+	}
+
+	if subject.DocumentType != nil {
+		result.TipoDocumento = utils.ToStringPointer(subject.DocumentType.GetValue())
+	}
+	if subject.DocumentNumber != nil {
+		result.NumDocumento = utils.ToStringPointer(subject.DocumentNumber.GetValue())
+	}
+	if subject.ActivityCode != nil {
+		result.CodActividad = utils.ToStringPointer(subject.ActivityCode.GetValue())
+	}
+	if subject.Address != nil {
+		address := common.MapCommonResponseAddress(subject.Address)
+		result.Direccion = &address
+	}
+	if subject.Phone != nil {
+		result.Telefono = utils.ToStringPointer(subject.Phone.GetValue())
+	}
+	if subject.Email != nil {
+		result.Correo = utils.ToStringPointer(subject.Email.GetValue())
+	}
+
+	return result
+}
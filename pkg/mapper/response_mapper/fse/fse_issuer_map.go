@@ -0,0 +1,24 @@
+package fse
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapFSEResponseIssuer(issuer interfaces.Issuer) structs.FSEIssuer {
+	return structs.FSEIssuer{
+		NIT:             issuer.GetNIT(),
+		NRC:             issuer.GetNRC(),
+		Nombre:          issuer.GetName(),
+		CodActividad:    issuer.GetActivityCode(),
+		DescActividad:   issuer.GetActivityDescription(),
+		Direccion:       common.MapCommonResponseAddress(issuer.GetAddress()),
+		Telefono:        issuer.GetPhone(),
+		Correo:          issuer.GetEmail(),
+		CodEstable:      issuer.GetEstablishmentCode(),
+		CodEstableMH:    issuer.GetEstablishmentMHCode(),
+		CodPuntoVenta:   issuer.GetPOSCode(),
+		CodPuntoVentaMH: issuer.GetPOSMHCode(),
+	}
+}
@@ -0,0 +1,25 @@
+package fse
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+func MapFSEResponseItem(items []fse_models.FSEItem) []structs.FSEItem {
+	result := make([]structs.FSEItem, len(items))
+	for i, item := range items {
+		result[i] = structs.FSEItem{
+			NumItem:     item.GetNumber(),
+			TipoItem:    item.GetType(),
+			Cantidad:    item.GetQuantity(),
+			Codigo:      utils.ToStringPointer(item.GetItemCode()),
+			UniMedida:   item.GetUnitMeasure(),
+			Descripcion: item.GetDescription(),
+			PrecioUni:   item.GetUnitPrice(),
+			MontoDescu:  item.GetDiscount(),
+			Compra:      item.Purchase.GetValue(),
+		}
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+package fse
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapFSEResponseSummary(summary *fse_models.FSESummary) *structs.FSESummary {
+	if summary == nil {
+		return nil
+	}
+
+	result := &structs.FSESummary{
+		TotalCompra:        summary.TotalPurchase.GetValue(),
+		Descu:              summary.Discount.GetValue(),
+		TotalDescu:         summary.TotalDiscount.GetValue(),
+		SubTotal:           summary.SubTotal.GetValue(),
+		IvaRete1:           summary.IVARetention.GetValue(),
+		ReteRenta:          summary.IncomeRetention.GetValue(),
+		TotalPagar:         summary.TotalToPay.GetValue(),
+		TotalLetras:        summary.TotalInWords,
+		CondicionOperacion: summary.OperationCondition.GetValue(),
+		Observaciones:      summary.Observations,
+	}
+
+	if len(summary.PaymentTypes) > 0 {
+		result.Pagos = common.MapCommonResponsePayments(summary.PaymentTypes)
+	}
+
+	return result
+}
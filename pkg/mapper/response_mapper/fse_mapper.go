@@ -0,0 +1,26 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/fse/fse_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/fse"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func ToMHFSE(doc interface{}) *structs.FSEDTEResponse {
+
+	cast := doc.(*fse_models.FSEModel)
+	dte := &structs.FSEDTEResponse{
+		Identificacion:  common.MapCommonResponseIdentification(cast.Identification),
+		Emisor:          fse.MapFSEResponseIssuer(cast.Issuer),
+		SujetoExcluido:  fse.MapFSEResponseExcludedSubject(cast.ExcludedSubject),
+		CuerpoDocumento: fse.MapFSEResponseItem(cast.FSEItems),
+		Resumen:         fse.MapFSEResponseSummary(cast.FSESummary),
+	}
+
+	if cast.Appendix != nil {
+		dte.Apendice = common.MapCommonResponseAppendix(cast.Appendix)
+	}
+
+	return dte
+}
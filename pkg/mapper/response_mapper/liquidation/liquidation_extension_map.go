@@ -0,0 +1,20 @@
+package liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapLiquidationResponseExtension(extension interfaces.Extension) *structs.LiquidationDTEExtension {
+	if extension == nil {
+		return nil
+	}
+
+	return &structs.LiquidationDTEExtension{
+		NombreEntrega:    extension.GetDeliveryName(),
+		DocumentoEntrega: extension.GetDeliveryDocument(),
+		NombreRecibe:     extension.GetReceiverName(),
+		DocumentoRecibe:  extension.GetReceiverDocument(),
+		Observacion:      extension.GetObservation(),
+	}
+}
@@ -0,0 +1,35 @@
+package liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+// MapLiquidationResponseItems mapea los documentos liquidados del Comprobante de Liquidación -> Origen: Response
+func MapLiquidationResponseItems(items []liquidation_models.LiquidationItem) []structs.LiquidationDTEItem {
+	result := make([]structs.LiquidationDTEItem, len(items))
+
+	for i, item := range items {
+		result[i] = structs.LiquidationDTEItem{
+			NumItem:         item.Number.GetValue(),
+			TipoDte:         item.DTEType.GetValue(),
+			TipoGeneracion:  item.GenerationType.GetValue(),
+			NumeroDocumento: item.DocumentNumber.GetValue(),
+			FechaGeneracion: item.GenerationDate.ToString(),
+			VentaNoSuj:      item.NonSubjectSale.GetValue(),
+			VentaExenta:     item.ExemptSale.GetValue(),
+			VentaGravada:    item.TaxedSale.GetValue(),
+			Exportaciones:   item.ExportSale.GetValue(),
+			Tributos:        item.Taxes,
+			IvaItem:         item.IVAItem.GetValue(),
+			ObsItem:         item.Observation,
+		}
+
+		// Los documentos sin venta gravada no declaran tributos
+		if len(item.Taxes) == 0 {
+			result[i].Tributos = nil
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,24 @@
+package liquidation
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+// MapLiquidationResponseSummary mapea el resumen del Comprobante de Liquidación -> Origen: Response
+func MapLiquidationResponseSummary(summary *liquidation_models.LiquidationSummary) *structs.LiquidationDTESummary {
+	return &structs.LiquidationDTESummary{
+		TotalNoSuj:          summary.TotalNonSubject.GetValue(),
+		TotalExenta:         summary.TotalExempt.GetValue(),
+		TotalGravada:        summary.TotalTaxed.GetValue(),
+		TotalExportacion:    summary.TotalExport.GetValue(),
+		SubTotalVentas:      summary.SubTotalSales.GetValue(),
+		Tributos:            common.MapTaxes(summary.Taxes),
+		MontoTotalOperacion: summary.TotalOperation.GetValue(),
+		IvaPerci:            summary.IVAPerception.GetValue(),
+		Total:               summary.Total.GetValue(),
+		TotalLetras:         summary.TotalInWords,
+		CondicionOperacion:  summary.OperationCondition.GetValue(),
+	}
+}
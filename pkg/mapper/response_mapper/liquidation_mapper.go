@@ -0,0 +1,27 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/liquidation/liquidation_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/liquidation"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func ToMHLiquidation(doc interface{}) *structs.LiquidationDTEResponse {
+
+	cast := doc.(*liquidation_models.LiquidationModel)
+	dte := &structs.LiquidationDTEResponse{
+		Identificacion:  common.MapCommonResponseIdentification(cast.Identification),
+		Emisor:          common.MapCommonResponseIssuer(cast.Issuer),
+		Receptor:        common.MapCommonResponseReceiver(cast.Receiver),
+		CuerpoDocumento: liquidation.MapLiquidationResponseItems(cast.LiquidationItems),
+		Resumen:         liquidation.MapLiquidationResponseSummary(cast.LiquidationSummary),
+		Extension:       liquidation.MapLiquidationResponseExtension(cast.Extension),
+	}
+
+	if cast.GetAppendix() != nil {
+		dte.Apendice = common.MapCommonResponseAppendix(cast.GetAppendix())
+	}
+
+	return dte
+}
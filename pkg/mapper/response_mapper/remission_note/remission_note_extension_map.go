@@ -0,0 +1,20 @@
+package remission_note
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapRemissionNoteResponseExtension(extension interfaces.Extension) *structs.RemissionNoteDTEExtension {
+	if extension == nil {
+		return nil
+	}
+
+	return &structs.RemissionNoteDTEExtension{
+		NombreEntrega:    extension.GetDeliveryName(),
+		DocumentoEntrega: extension.GetDeliveryDocument(),
+		NombreRecibe:     extension.GetReceiverName(),
+		DocumentoRecibe:  extension.GetReceiverDocument(),
+		Observacion:      extension.GetObservation(),
+	}
+}
@@ -0,0 +1,30 @@
+package remission_note
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+func MapRemissionNoteResponseItem(items []remission_note_models.RemissionNoteItem) []structs.RemissionNoteDTEItem {
+	result := make([]structs.RemissionNoteDTEItem, len(items))
+	for i, item := range items {
+		result[i] = structs.RemissionNoteDTEItem{
+			NumItem:         item.GetNumber(),
+			TipoItem:        item.GetType(),
+			NumeroDocumento: item.GetRelatedDoc(),
+			CodTributo:      utils.ToStringPointer(item.TaxCode.GetValue()),
+			Codigo:          utils.ToStringPointer(item.GetItemCode()),
+			Descripcion:     item.GetDescription(),
+			Cantidad:        item.GetQuantity(),
+			UniMedida:       item.GetUnitMeasure(),
+			PrecioUni:       item.GetUnitPrice(),
+			MontoDescu:      item.GetDiscount(),
+			VentaNoSuj:      item.NonSubjectSale.GetValue(),
+			VentaExenta:     item.ExemptSale.GetValue(),
+			VentaGravada:    item.TaxedSale.GetValue(),
+			Tributos:        item.GetTaxes(),
+		}
+	}
+	return result
+}
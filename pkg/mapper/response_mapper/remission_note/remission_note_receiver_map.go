@@ -0,0 +1,21 @@
+package remission_note
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/interfaces"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/value_objects/document"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+// MapRemissionNoteResponseReceiver mapea el receptor de una Nota de Remisión junto al título de los bienes -> Origen: Response
+func MapRemissionNoteResponseReceiver(receiver interfaces.Receiver, goodsTitle *document.GoodsTitle) structs.RemissionNoteDTEReceiver {
+	result := structs.RemissionNoteDTEReceiver{
+		DTEReceiver: common.MapCommonResponseReceiver(receiver),
+	}
+
+	if goodsTitle != nil {
+		result.BienTitulo = goodsTitle.GetValue()
+	}
+
+	return result
+}
@@ -0,0 +1,25 @@
+package remission_note
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func MapRemissionNoteResponseSummary(summary remission_note_models.RemissionNoteSummary) *structs.RemissionNoteDTESummary {
+	return &structs.RemissionNoteDTESummary{
+		TotalNoSuj:          summary.GetTotalNonSubject(),
+		TotalExenta:         summary.GetTotalExempt(),
+		TotalGravada:        summary.GetTotalTaxed(),
+		SubTotalVentas:      summary.GetSubtotalSales(),
+		DescuNoSuj:          summary.GetNonSubjectDiscount(),
+		DescuExenta:         summary.GetExemptDiscount(),
+		DescuGravada:        summary.TaxedDiscount.GetValue(),
+		PorcentajeDescuento: summary.GetDiscountPercentage(),
+		TotalDescu:          summary.GetTotalDiscount(),
+		Tributos:            common.MapTaxes(summary.GetTotalTaxes()),
+		SubTotal:            summary.GetSubTotal(),
+		MontoTotalOperacion: summary.GetTotalOperation(),
+		TotalLetras:         summary.GetTotalInWords(),
+	}
+}
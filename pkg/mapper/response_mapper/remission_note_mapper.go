@@ -0,0 +1,36 @@
+package response_mapper
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/remission_note/remission_note_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/common"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/remission_note"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/response_mapper/structs"
+)
+
+func ToMHRemissionNote(doc interface{}) *structs.RemissionNoteDTEResponse {
+
+	cast := doc.(*remission_note_models.RemissionNoteModel)
+	dte := &structs.RemissionNoteDTEResponse{
+		Identificacion:  common.MapCommonResponseIdentification(cast.Identification),
+		Emisor:          common.MapCommonResponseIssuer(cast.Issuer),
+		Receptor:        remission_note.MapRemissionNoteResponseReceiver(cast.Receiver, cast.GoodsTitle),
+		CuerpoDocumento: remission_note.MapRemissionNoteResponseItem(cast.RemissionItems),
+		Resumen:         remission_note.MapRemissionNoteResponseSummary(cast.RemissionSummary),
+		Extension:       remission_note.MapRemissionNoteResponseExtension(cast.Extension),
+	}
+
+	// En Nota de Remisión, los documentos relacionados son opcionales
+	if cast.GetRelatedDocuments() != nil {
+		dte.DocumentoRelacionado = common.MapCommonResponseRelatedDocuments(cast.GetRelatedDocuments())
+	}
+
+	if cast.GetThirdPartySale() != nil {
+		dte.VentaTercero = common.MapCommonResponseThirdPartySale(cast.GetThirdPartySale())
+	}
+
+	if cast.GetAppendix() != nil {
+		dte.Apendice = common.MapCommonResponseAppendix(cast.GetAppendix())
+	}
+
+	return dte
+}
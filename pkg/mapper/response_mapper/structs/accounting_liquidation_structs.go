@@ -0,0 +1,37 @@
+package structs
+
+type AccountingLiquidationDTEResponse struct {
+	Identificacion  *DTEIdentification                 `json:"identificacion"`
+	Emisor          DTEIssuer                          `json:"emisor"`
+	Receptor        DTEReceiver                        `json:"receptor"`
+	CuerpoDocumento *AccountingLiquidationDTEBody      `json:"cuerpoDocumento"`
+	Extension       *AccountingLiquidationDTEExtension `json:"extension"`
+	Apendice        []DTEApendice                      `json:"apendice"`
+}
+
+// AccountingLiquidationDTEBody cuerpo del Documento Contable de Liquidación, contiene un único resumen del periodo liquidado
+type AccountingLiquidationDTEBody struct {
+	PeriodoLiquidacionFechaInicio string  `json:"periodoLiquidacionFechaInicio"`
+	PeriodoLiquidacionFechaFin    string  `json:"periodoLiquidacionFechaFin"`
+	CodLiquidacion                string  `json:"codLiquidacion"`
+	CantidadDoc                   int     `json:"cantidadDoc"`
+	ValorOperaciones              float64 `json:"valorOperaciones"`
+	MontoSinPercepcion            float64 `json:"montoSinPercepcion"`
+	DescripSinPercepcion          *string `json:"descripSinPercepcion"`
+	SubTotal                      float64 `json:"subTotal"`
+	IVA                           float64 `json:"iva"`
+	MontoSujetoPercepcion         float64 `json:"montoSujetoPercepcion"`
+	IVAPercibido                  float64 `json:"ivaPercibido"`
+	Comision                      float64 `json:"comision"`
+	PorcentComision               string  `json:"porcentComision"`
+	IVAComision                   float64 `json:"ivaComision"`
+	LiquidoApagar                 float64 `json:"liquidoApagar"`
+	TotalLetras                   string  `json:"totalLetras"`
+	Observaciones                 *string `json:"observaciones"`
+}
+
+type AccountingLiquidationDTEExtension struct {
+	NombreEntrega    string  `json:"nombEntrega"`
+	DocumentoEntrega string  `json:"docuEntrega"`
+	CodEmpleado      *string `json:"codEmpleado"`
+}
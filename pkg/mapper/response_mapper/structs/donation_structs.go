@@ -0,0 +1,79 @@
+package structs
+
+type DonationDTEResponse struct {
+	Identificacion  *DTEIdentification      `json:"identificacion"`
+	Donatario       DonationDonee           `json:"donatario"`
+	Donante         DonationDonor           `json:"donante"`
+	OtrosDocumentos []DonationOtherDocument `json:"otrosDocumentos"`
+	CuerpoDocumento []DonationItem          `json:"cuerpoDocumento"`
+	Resumen         *DonationSummary        `json:"resumen"`
+	Apendice        []DTEApendice           `json:"apendice"`
+}
+
+// DonationDonee mapea la sección "donatario" del JSON Schema de Comprobante de Donación, corresponde al emisor
+type DonationDonee struct {
+	TipoDocumento       string     `json:"tipoDocumento"`
+	NumDocumento        string     `json:"numDocumento"`
+	NRC                 string     `json:"nrc"`
+	Nombre              string     `json:"nombre"`
+	CodActividad        string     `json:"codActividad"`
+	DescActividad       string     `json:"descActividad"`
+	NombreComercial     *string    `json:"nombreComercial"`
+	TipoEstablecimiento string     `json:"tipoEstablecimiento"`
+	Direccion           DTEAddress `json:"direccion"`
+	Telefono            string     `json:"telefono"`
+	Correo              string     `json:"correo"`
+	CodEstableMH        *string    `json:"codEstableMH"`
+	CodEstable          *string    `json:"codEstable"`
+	CodPuntoVentaMH     *string    `json:"codPuntoVentaMH"`
+	CodPuntoVenta       *string    `json:"codPuntoVenta"`
+}
+
+// DonationDonor mapea la sección "donante" del JSON Schema de Comprobante de Donación
+type DonationDonor struct {
+	TipoDocumento  *string     `json:"tipoDocumento"`
+	NumDocumento   *string     `json:"numDocumento"`
+	NRC            *string     `json:"nrc"`
+	Nombre         string      `json:"nombre"`
+	CodActividad   *string     `json:"codActividad"`
+	DescActividad  *string     `json:"descActividad"`
+	Direccion      *DTEAddress `json:"direccion"`
+	Telefono       *string     `json:"telefono"`
+	Correo         *string     `json:"correo"`
+	CodDomiciliado int         `json:"codDomiciliado"`
+	CodPais        string      `json:"codPais"`
+}
+
+// DonationOtherDocument mapea un documento asociado del Comprobante de Donación
+type DonationOtherDocument struct {
+	CodDocAsociado   int     `json:"codDocAsociado"`
+	DescDocumento    *string `json:"descDocumento"`
+	DetalleDocumento *string `json:"detalleDocumento"`
+}
+
+// DonationItem mapea un ítem del cuerpo del documento de Comprobante de Donación
+type DonationItem struct {
+	NumItem      int     `json:"numItem"`
+	TipoDonacion int     `json:"tipoDonacion"`
+	Cantidad     float64 `json:"cantidad"`
+	Codigo       *string `json:"codigo"`
+	UniMedida    int     `json:"uniMedida"`
+	Descripcion  string  `json:"descripcion"`
+	Depreciacion float64 `json:"depreciacion"`
+	ValorUni     float64 `json:"valorUni"`
+	Valor        float64 `json:"valor"`
+}
+
+// DonationSummary mapea el resumen de Comprobante de Donación, sin tributos
+type DonationSummary struct {
+	ValorTotal  float64           `json:"valorTotal"`
+	TotalLetras string            `json:"totalLetras"`
+	Pagos       []DonationPayment `json:"pagos"`
+}
+
+// DonationPayment mapea una forma de pago del Comprobante de Donación
+type DonationPayment struct {
+	Codigo     string  `json:"codigo"`
+	MontoPago  float64 `json:"montoPago"`
+	Referencia *string `json:"referencia"`
+}
@@ -0,0 +1,86 @@
+package structs
+
+type ExportInvoiceDTEResponse struct {
+	Identificacion  *ExportIdentification `json:"identificacion"`
+	Emisor          ExportIssuer          `json:"emisor"`
+	Receptor        ExportReceiver        `json:"receptor"`
+	OtrosDocumentos []DTEOtherDocument    `json:"otrosDocumentos"`
+	VentaTercero    *DTEThirdPartySale    `json:"ventaTercero"`
+	CuerpoDocumento []ExportItem          `json:"cuerpoDocumento"`
+	Resumen         *ExportSummary        `json:"resumen"`
+	Apendice        []DTEApendice         `json:"apendice"`
+}
+
+// ExportIdentification mapea la sección "identificacion" del JSON Schema de Factura de Exportación,
+// el esquema de Hacienda nombra el motivo de contingencia como "motivoContigencia"
+type ExportIdentification struct {
+	Version           int     `json:"version"`
+	Ambiente          string  `json:"ambiente"`
+	TipoDte           string  `json:"tipoDte"`
+	NumeroControl     string  `json:"numeroControl"`
+	CodigoGeneracion  string  `json:"codigoGeneracion"`
+	TipoModelo        int     `json:"tipoModelo"`
+	TipoOperacion     int     `json:"tipoOperacion"`
+	TipoContingencia  *int    `json:"tipoContingencia"`
+	MotivoContigencia *string `json:"motivoContigencia"`
+	FecEmi            string  `json:"fecEmi"`
+	HorEmi            string  `json:"horEmi"`
+	TipoMoneda        string  `json:"tipoMoneda"`
+}
+
+// ExportIssuer mapea la sección "emisor" del JSON Schema de Factura de Exportación
+type ExportIssuer struct {
+	DTEIssuer
+	TipoItemExpor int     `json:"tipoItemExpor"`
+	RecintoFiscal *string `json:"recintoFiscal"`
+	Regimen       *string `json:"regimen"`
+}
+
+// ExportReceiver mapea la sección "receptor" del JSON Schema de Factura de Exportación
+type ExportReceiver struct {
+	Nombre          string  `json:"nombre"`
+	TipoDocumento   *string `json:"tipoDocumento"`
+	NumDocumento    *string `json:"numDocumento"`
+	NombreComercial *string `json:"nombreComercial"`
+	CodPais         string  `json:"codPais"`
+	NombrePais      string  `json:"nombrePais"`
+	Complemento     string  `json:"complemento"`
+	TipoPersona     int     `json:"tipoPersona"`
+	DescActividad   *string `json:"descActividad"`
+	Telefono        *string `json:"telefono"`
+	Correo          *string `json:"correo"`
+}
+
+// ExportItem mapea un ítem del cuerpo del documento de Factura de Exportación
+type ExportItem struct {
+	NumItem      int      `json:"numItem"`
+	Cantidad     float64  `json:"cantidad"`
+	Codigo       *string  `json:"codigo"`
+	UniMedida    int      `json:"uniMedida"`
+	Descripcion  string   `json:"descripcion"`
+	PrecioUni    float64  `json:"precioUni"`
+	MontoDescu   float64  `json:"montoDescu"`
+	VentaGravada float64  `json:"ventaGravada"`
+	Tributos     []string `json:"tributos"`
+	NoGravado    float64  `json:"noGravado"`
+}
+
+// ExportSummary mapea el resumen de Factura de Exportación
+type ExportSummary struct {
+	TotalGravada        float64      `json:"totalGravada"`
+	Descuento           float64      `json:"descuento"`
+	PorcentajeDescuento float64      `json:"porcentajeDescuento"`
+	TotalDescu          float64      `json:"totalDescu"`
+	Seguro              float64      `json:"seguro"`
+	Flete               float64      `json:"flete"`
+	MontoTotalOperacion float64      `json:"montoTotalOperacion"`
+	TotalNoGravado      float64      `json:"totalNoGravado"`
+	TotalPagar          float64      `json:"totalPagar"`
+	TotalLetras         string       `json:"totalLetras"`
+	CondicionOperacion  int          `json:"condicionOperacion"`
+	Pagos               []DTEPayment `json:"pagos"`
+	CodIncoterms        *string      `json:"codIncoterms"`
+	DescIncoterms       *string      `json:"descIncoterms"`
+	NumPagoElectronico  *string      `json:"numPagoElectronico"`
+	Observaciones       *string      `json:"observaciones"`
+}
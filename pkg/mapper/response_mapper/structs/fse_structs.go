@@ -0,0 +1,66 @@
+package structs
+
+type FSEDTEResponse struct {
+	Identificacion  *DTEIdentification `json:"identificacion"`
+	Emisor          FSEIssuer          `json:"emisor"`
+	SujetoExcluido  FSEExcludedSubject `json:"sujetoExcluido"`
+	CuerpoDocumento []FSEItem          `json:"cuerpoDocumento"`
+	Resumen         *FSESummary        `json:"resumen"`
+	Apendice        []DTEApendice      `json:"apendice"`
+}
+
+// FSEIssuer mapea la sección "emisor" del JSON Schema de Factura Sujeto Excluido
+type FSEIssuer struct {
+	NIT             string     `json:"nit"`
+	NRC             string     `json:"nrc"`
+	Nombre          string     `json:"nombre"`
+	CodActividad    string     `json:"codActividad"`
+	DescActividad   string     `json:"descActividad"`
+	Direccion       DTEAddress `json:"direccion"`
+	Telefono        string     `json:"telefono"`
+	CodEstableMH    *string    `json:"codEstableMH"`
+	CodEstable      *string    `json:"codEstable"`
+	CodPuntoVentaMH *string    `json:"codPuntoVentaMH"`
+	CodPuntoVenta   *string    `json:"codPuntoVenta"`
+	Correo          string     `json:"correo"`
+}
+
+// FSEExcludedSubject mapea la sección "sujetoExcluido" del JSON Schema
+type FSEExcludedSubject struct {
+	TipoDocumento *string     `json:"tipoDocumento"`
+	NumDocumento  *string     `json:"numDocumento"`
+	Nombre        string      `json:"nombre"`
+	CodActividad  *string     `json:"codActividad"`
+	DescActividad *string     `json:"descActividad"`
+	Direccion     *DTEAddress `json:"direccion"`
+	Telefono      *string     `json:"telefono"`
+	Correo        *string     `json:"correo"`
+}
+
+// FSEItem mapea un ítem del cuerpo del documento de Factura Sujeto Excluido
+type FSEItem struct {
+	NumItem     int     `json:"numItem"`
+	TipoItem    int     `json:"tipoItem"`
+	Cantidad    float64 `json:"cantidad"`
+	Codigo      *string `json:"codigo"`
+	UniMedida   int     `json:"uniMedida"`
+	Descripcion string  `json:"descripcion"`
+	PrecioUni   float64 `json:"precioUni"`
+	MontoDescu  float64 `json:"montoDescu"`
+	Compra      float64 `json:"compra"`
+}
+
+// FSESummary mapea el resumen de Factura Sujeto Excluido
+type FSESummary struct {
+	TotalCompra        float64      `json:"totalCompra"`
+	Descu              float64      `json:"descu"`
+	TotalDescu         float64      `json:"totalDescu"`
+	SubTotal           float64      `json:"subTotal"`
+	IvaRete1           float64      `json:"ivaRete1"`
+	ReteRenta          float64      `json:"reteRenta"`
+	TotalPagar         float64      `json:"totalPagar"`
+	TotalLetras        string       `json:"totalLetras"`
+	CondicionOperacion int          `json:"condicionOperacion"`
+	Pagos              []DTEPayment `json:"pagos"`
+	Observaciones      *string      `json:"observaciones"`
+}
@@ -0,0 +1,49 @@
+package structs
+
+type LiquidationDTEResponse struct {
+	Identificacion  *DTEIdentification       `json:"identificacion"`
+	Emisor          DTEIssuer                `json:"emisor"`
+	Receptor        DTEReceiver              `json:"receptor"`
+	CuerpoDocumento []LiquidationDTEItem     `json:"cuerpoDocumento"`
+	Resumen         *LiquidationDTESummary   `json:"resumen"`
+	Extension       *LiquidationDTEExtension `json:"extension"`
+	Apendice        []DTEApendice            `json:"apendice"`
+}
+
+// LiquidationDTEItem documento liquidado dentro del cuerpo del Comprobante de Liquidación
+type LiquidationDTEItem struct {
+	NumItem         int      `json:"numItem"`
+	TipoDte         string   `json:"tipoDte"`
+	TipoGeneracion  int      `json:"tipoGeneracion"`
+	NumeroDocumento string   `json:"numeroDocumento"`
+	FechaGeneracion string   `json:"fechaGeneracion"`
+	VentaNoSuj      float64  `json:"ventaNoSuj"`
+	VentaExenta     float64  `json:"ventaExenta"`
+	VentaGravada    float64  `json:"ventaGravada"`
+	Exportaciones   float64  `json:"exportaciones"`
+	Tributos        []string `json:"tributos"`
+	IvaItem         float64  `json:"ivaItem"`
+	ObsItem         *string  `json:"obsItem"`
+}
+
+type LiquidationDTESummary struct {
+	TotalNoSuj          float64  `json:"totalNoSuj"`
+	TotalExenta         float64  `json:"totalExenta"`
+	TotalGravada        float64  `json:"totalGravada"`
+	TotalExportacion    float64  `json:"totalExportacion"`
+	SubTotalVentas      float64  `json:"subTotalVentas"`
+	Tributos            []DTETax `json:"tributos"`
+	MontoTotalOperacion float64  `json:"montoTotalOperacion"`
+	IvaPerci            float64  `json:"ivaPerci"`
+	Total               float64  `json:"total"`
+	TotalLetras         string   `json:"totalLetras"`
+	CondicionOperacion  int      `json:"condicionOperacion"`
+}
+
+type LiquidationDTEExtension struct {
+	NombreEntrega    string  `json:"nombEntrega"`
+	DocumentoEntrega string  `json:"docuEntrega"`
+	NombreRecibe     string  `json:"nombRecibe"`
+	DocumentoRecibe  string  `json:"docuRecibe"`
+	Observacion      *string `json:"observaciones"`
+}
@@ -0,0 +1,60 @@
+package structs
+
+type RemissionNoteDTEResponse struct {
+	Identificacion       *DTEIdentification         `json:"identificacion"`
+	DocumentoRelacionado []DTERelatedDocument       `json:"documentoRelacionado"`
+	Emisor               DTEIssuer                  `json:"emisor"`
+	Receptor             RemissionNoteDTEReceiver   `json:"receptor"`
+	VentaTercero         *DTEThirdPartySale         `json:"ventaTercero"`
+	CuerpoDocumento      []RemissionNoteDTEItem     `json:"cuerpoDocumento"`
+	Resumen              *RemissionNoteDTESummary   `json:"resumen"`
+	Extension            *RemissionNoteDTEExtension `json:"extension"`
+	Apendice             []DTEApendice              `json:"apendice"`
+}
+
+// RemissionNoteDTEReceiver receptor de la Nota de Remisión, incluye el título a que se remiten los bienes
+type RemissionNoteDTEReceiver struct {
+	DTEReceiver
+	BienTitulo string `json:"bienTitulo"`
+}
+
+type RemissionNoteDTEItem struct {
+	NumItem         int      `json:"numItem"`
+	TipoItem        int      `json:"tipoItem"`
+	NumeroDocumento *string  `json:"numeroDocumento"`
+	Codigo          *string  `json:"codigo"`
+	CodTributo      *string  `json:"codTributo"`
+	Descripcion     string   `json:"descripcion"`
+	Cantidad        float64  `json:"cantidad"`
+	UniMedida       int      `json:"uniMedida"`
+	PrecioUni       float64  `json:"precioUni"`
+	MontoDescu      float64  `json:"montoDescu"`
+	VentaNoSuj      float64  `json:"ventaNoSuj"`
+	VentaExenta     float64  `json:"ventaExenta"`
+	VentaGravada    float64  `json:"ventaGravada"`
+	Tributos        []string `json:"tributos"`
+}
+
+type RemissionNoteDTESummary struct {
+	TotalNoSuj          float64  `json:"totalNoSuj"`
+	TotalExenta         float64  `json:"totalExenta"`
+	TotalGravada        float64  `json:"totalGravada"`
+	SubTotalVentas      float64  `json:"subTotalVentas"`
+	DescuNoSuj          float64  `json:"descuNoSuj"`
+	DescuExenta         float64  `json:"descuExenta"`
+	DescuGravada        float64  `json:"descuGravada"`
+	PorcentajeDescuento float64  `json:"porcentajeDescuento"`
+	TotalDescu          float64  `json:"totalDescu"`
+	Tributos            []DTETax `json:"tributos"`
+	SubTotal            float64  `json:"subTotal"`
+	MontoTotalOperacion float64  `json:"montoTotalOperacion"`
+	TotalLetras         string   `json:"totalLetras"`
+}
+
+type RemissionNoteDTEExtension struct {
+	NombreEntrega    string  `json:"nombEntrega"`
+	DocumentoEntrega string  `json:"docuEntrega"`
+	NombreRecibe     string  `json:"nombRecibe"`
+	DocumentoRecibe  string  `json:"docuRecibe"`
+	Observacion      *string `json:"observaciones"`
+}
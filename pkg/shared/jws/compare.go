@@ -0,0 +1,124 @@
+package jws
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// receptionStampLabel es la etiqueta del apéndice que se agrega al DTE almacenado después de que
+// Hacienda lo recibe, por lo que no forma parte del contenido firmado
+const receptionStampLabel = "Sello de recepción"
+
+// ComparePayload compara el contenido firmado con el DTE almacenado y devuelve las rutas de los campos
+// que difieren. La comparación es semántica: no depende del orden de las llaves ni del formato numérico,
+// e ignora el sello de recepción agregado al apéndice después de la transmisión.
+func ComparePayload(signed, stored []byte) ([]string, error) {
+	var signedDoc, storedDoc interface{}
+	if err := json.Unmarshal(signed, &signedDoc); err != nil {
+		return nil, fmt.Errorf("error decoding signed payload: %w", err)
+	}
+	if err := json.Unmarshal(stored, &storedDoc); err != nil {
+		return nil, fmt.Errorf("error decoding stored document: %w", err)
+	}
+
+	var diffs []string
+	diff("", removeReceptionStamp(signedDoc), removeReceptionStamp(storedDoc), &diffs)
+	return diffs, nil
+}
+
+// removeReceptionStamp elimina del apéndice las entradas del sello de recepción; si el apéndice
+// queda vacío se considera nulo, igual que antes de agregar el sello
+func removeReceptionStamp(doc interface{}) interface{} {
+	root, ok := doc.(map[string]interface{})
+	if !ok {
+		return doc
+	}
+
+	appendixes, ok := root["apendice"].([]interface{})
+	if !ok {
+		return doc
+	}
+
+	var kept []interface{}
+	for _, appendix := range appendixes {
+		if entry, ok := appendix.(map[string]interface{}); ok && isReceptionStamp(entry) {
+			continue
+		}
+		kept = append(kept, appendix)
+	}
+
+	if len(kept) == 0 {
+		root["apendice"] = nil
+	} else {
+		root["apendice"] = kept
+	}
+
+	return root
+}
+
+func isReceptionStamp(entry map[string]interface{}) bool {
+	for key, value := range entry {
+		if strings.EqualFold(key, "etiqueta") && value == receptionStampLabel {
+			return true
+		}
+	}
+	return false
+}
+
+// diff recorre ambos valores y acumula las rutas donde no coinciden
+func diff(path string, a, b interface{}, diffs *[]string) {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			*diffs = append(*diffs, pathOrRoot(path))
+			return
+		}
+
+		keys := make(map[string]struct{}, len(av)+len(bv))
+		for k := range av {
+			keys[k] = struct{}{}
+		}
+		for k := range bv {
+			keys[k] = struct{}{}
+		}
+
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		for _, k := range sorted {
+			child := k
+			if path != "" {
+				child = path + "." + k
+			}
+			diff(child, av[k], bv[k], diffs)
+		}
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			*diffs = append(*diffs, pathOrRoot(path))
+			return
+		}
+
+		for i := range av {
+			diff(fmt.Sprintf("%s[%d]", path, i), av[i], bv[i], diffs)
+		}
+	default:
+		if !reflect.DeepEqual(a, b) {
+			*diffs = append(*diffs, pathOrRoot(path))
+		}
+	}
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "$"
+	}
+	return path
+}
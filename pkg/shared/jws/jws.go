@@ -0,0 +1,107 @@
+// Package jws permite verificar los DTE firmados en formato JWS compacto (RS512), tal como los
+// genera el firmador del Ministerio de Hacienda. Puede utilizarse de forma independiente por
+// auditores y receptores para confirmar que un documento no fue alterado después de su firma.
+package jws
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// AlgorithmRS512 es el único algoritmo aceptado por el Ministerio de Hacienda para firmar DTE
+const AlgorithmRS512 = "RS512"
+
+var (
+	ErrMalformedToken       = errors.New("malformed compact JWS")
+	ErrUnsupportedAlgorithm = errors.New("unsupported JWS algorithm")
+	ErrInvalidSignature     = errors.New("invalid JWS signature")
+)
+
+// Header representa el encabezado protegido del JWS
+type Header struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ,omitempty"`
+}
+
+// SignedDocument representa un DTE firmado separado en sus partes
+type SignedDocument struct {
+	Header       Header
+	Payload      json.RawMessage
+	Signature    []byte
+	signingInput string
+}
+
+// Parse separa un JWS compacto en encabezado, contenido y firma sin verificarlo
+func Parse(token string) (*SignedDocument, error) {
+	// 1. Separar las tres partes del JWS compacto
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	// 2. Decodificar cada una de las partes
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrMalformedToken, err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: payload: %v", ErrMalformedToken, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrMalformedToken, err)
+	}
+
+	// 3. Interpretar el encabezado y validar que el contenido sea JSON
+	var header Header
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrMalformedToken, err)
+	}
+
+	if !json.Valid(payload) {
+		return nil, fmt.Errorf("%w: payload is not valid JSON", ErrMalformedToken)
+	}
+
+	return &SignedDocument{
+		Header:       header,
+		Payload:      payload,
+		Signature:    signature,
+		signingInput: parts[0] + "." + parts[1],
+	}, nil
+}
+
+// Verify valida la firma RS512 del documento con la llave pública del emisor
+func (d *SignedDocument) Verify(key *rsa.PublicKey) error {
+	if d.Header.Algorithm != AlgorithmRS512 {
+		return fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, d.Header.Algorithm)
+	}
+
+	if err := jwt.SigningMethodRS512.Verify(d.signingInput, d.Signature, key); err != nil {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Verify separa y verifica un JWS compacto con la llave pública del emisor
+func Verify(token string, key *rsa.PublicKey) (*SignedDocument, error) {
+	doc, err := Parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := doc.Verify(key); err != nil {
+		return doc, err
+	}
+
+	return doc, nil
+}
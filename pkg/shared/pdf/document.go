@@ -0,0 +1,291 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Tamaños de página en puntos (1/72 de pulgada)
+const (
+	LetterWidth  = 612.0
+	LetterHeight = 792.0
+)
+
+// Color representa un color RGB
+type Color struct {
+	R, G, B uint8
+}
+
+var (
+	Black = Color{0, 0, 0}
+	White = Color{255, 255, 255}
+)
+
+// Document es un generador mínimo de documentos PDF 1.4 con texto, líneas, rectángulos e imágenes.
+// Las coordenadas se expresan en puntos con origen en la esquina superior izquierda de la página.
+type Document struct {
+	width  float64
+	height float64
+	title  string
+	pages  []*Page
+	images []*Image
+}
+
+// Page representa una página del documento y su flujo de contenido
+type Page struct {
+	doc     *Document
+	content bytes.Buffer
+	images  map[*Image]bool
+}
+
+// New crea un documento vacío con el tamaño de página indicado
+func New(width, height float64) *Document {
+	return &Document{
+		width:  width,
+		height: height,
+	}
+}
+
+// SetTitle establece el título del documento en sus metadatos
+func (d *Document) SetTitle(title string) {
+	d.title = title
+}
+
+// Width obtiene el ancho de las páginas del documento
+func (d *Document) Width() float64 {
+	return d.width
+}
+
+// Height obtiene el alto de las páginas del documento
+func (d *Document) Height() float64 {
+	return d.height
+}
+
+// AddPage agrega una nueva página al final del documento
+func (d *Document) AddPage() *Page {
+	page := &Page{doc: d, images: make(map[*Image]bool)}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Pages obtiene las páginas del documento en orden
+func (d *Document) Pages() []*Page {
+	return d.pages
+}
+
+// Text escribe un texto cuya línea base se ubica en la posición indicada
+func (p *Page) Text(x, y float64, font Font, size float64, color Color, text string) {
+	if text == "" {
+		return
+	}
+
+	fmt.Fprintf(&p.content, "BT %s rg /F%d %s Tf %s %s Td (", rgb(color), font+1, num(size), num(x), num(p.doc.height-y))
+	p.content.Write(encodeWinAnsi(text))
+	p.content.WriteString(") Tj ET\n")
+}
+
+// TextRight escribe un texto alineado a la derecha de la posición indicada
+func (p *Page) TextRight(x, y float64, font Font, size float64, color Color, text string) {
+	p.Text(x-TextWidth(font, size, text), y, font, size, color, text)
+}
+
+// TextCenter escribe un texto centrado en la posición indicada
+func (p *Page) TextCenter(x, y float64, font Font, size float64, color Color, text string) {
+	p.Text(x-TextWidth(font, size, text)/2, y, font, size, color, text)
+}
+
+// Line dibuja una línea entre dos puntos
+func (p *Page) Line(x1, y1, x2, y2, width float64, color Color) {
+	fmt.Fprintf(&p.content, "%s RG %s w %s %s m %s %s l S\n",
+		rgb(color), num(width), num(x1), num(p.doc.height-y1), num(x2), num(p.doc.height-y2))
+}
+
+// FillRect dibuja un rectángulo relleno cuya esquina superior izquierda se ubica en la posición indicada
+func (p *Page) FillRect(x, y, w, h float64, color Color) {
+	fmt.Fprintf(&p.content, "%s rg %s %s %s %s re f\n", rgb(color), num(x), num(p.doc.height-y-h), num(w), num(h))
+}
+
+// StrokeRect dibuja el borde de un rectángulo cuya esquina superior izquierda se ubica en la posición indicada
+func (p *Page) StrokeRect(x, y, w, h, width float64, color Color) {
+	fmt.Fprintf(&p.content, "%s RG %s w %s %s %s %s re S\n", rgb(color), num(width), num(x), num(p.doc.height-y-h), num(w), num(h))
+}
+
+// Image dibuja una imagen escalada al rectángulo indicado
+func (p *Page) Image(img *Image, x, y, w, h float64) {
+	p.images[img] = true
+	fmt.Fprintf(&p.content, "q %s 0 0 %s %s %s cm /Im%d Do Q\n", num(w), num(h), num(x), num(p.doc.height-y-h), img.index)
+}
+
+// Bytes genera el contenido binario del documento
+func (d *Document) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := d.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteTo escribe el documento completo, incluyendo la tabla de referencias cruzadas
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	out := &objectWriter{}
+	out.raw("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+
+	// 1. Reservar los números de objeto: catálogo, árbol de páginas, fuentes, imágenes y páginas
+	const catalogID, pagesID, fontID = 1, 2, 3
+	nextID := fontID + len(fontNames)
+
+	imageIDs := make([]int, len(d.images))
+	maskIDs := make([]int, len(d.images))
+	for i, img := range d.images {
+		imageIDs[i] = nextID
+		nextID++
+		if img.mask != nil {
+			maskIDs[i] = nextID
+			nextID++
+		}
+	}
+
+	pageIDs := make([]int, len(d.pages))
+	for i := range d.pages {
+		pageIDs[i] = nextID
+		nextID += 2
+	}
+	infoID := nextID
+
+	// 2. Catálogo y árbol de páginas
+	out.object(catalogID, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesID))
+
+	kids := bytes.Buffer{}
+	for _, id := range pageIDs {
+		fmt.Fprintf(&kids, "%d 0 R ", id)
+	}
+	out.object(pagesID, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", bytes.TrimSpace(kids.Bytes()), len(pageIDs)))
+
+	// 3. Fuentes estándar
+	fontResources := bytes.Buffer{}
+	for font := Helvetica; int(font) < len(fontNames); font++ {
+		out.object(fontID+int(font), fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", fontNames[font]))
+		fmt.Fprintf(&fontResources, "/F%d %d 0 R ", font+1, fontID+int(font))
+	}
+
+	// 4. Imágenes con su máscara de transparencia
+	for i, img := range d.images {
+		dict := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8",
+			img.width, img.height)
+		if maskIDs[i] != 0 {
+			dict += fmt.Sprintf(" /SMask %d 0 R", maskIDs[i])
+		}
+		if err := out.stream(imageIDs[i], dict, img.data); err != nil {
+			return 0, err
+		}
+
+		if maskIDs[i] != 0 {
+			maskDict := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8",
+				img.width, img.height)
+			if err := out.stream(maskIDs[i], maskDict, img.mask); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	// 5. Páginas con sus recursos y contenido
+	for i, page := range d.pages {
+		xObjects := bytes.Buffer{}
+		for j, img := range d.images {
+			if page.images[img] {
+				fmt.Fprintf(&xObjects, "/Im%d %d 0 R ", img.index, imageIDs[j])
+			}
+		}
+
+		resources := fmt.Sprintf("/Font << %s>>", fontResources.String())
+		if xObjects.Len() > 0 {
+			resources += fmt.Sprintf(" /XObject << %s>>", xObjects.String())
+		}
+
+		out.object(pageIDs[i], fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources << %s >> /Contents %d 0 R >>",
+			pagesID, num(d.width), num(d.height), resources, pageIDs[i]+1))
+		if err := out.stream(pageIDs[i]+1, "", page.content.Bytes()); err != nil {
+			return 0, err
+		}
+	}
+
+	// 6. Metadatos
+	out.object(infoID, fmt.Sprintf("<< /Title (%s) /Producer (api-facturacion-sv) >>", encodeWinAnsi(d.title)))
+
+	// 7. Tabla de referencias cruzadas y trailer
+	xref := out.buf.Len()
+	out.raw(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", infoID+1))
+	for id := 1; id <= infoID; id++ {
+		out.raw(fmt.Sprintf("%010d 00000 n \n", out.offsets[id]))
+	}
+	out.raw(fmt.Sprintf("trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", infoID+1, catalogID, infoID, xref))
+
+	n, err := w.Write(out.buf.Bytes())
+	return int64(n), err
+}
+
+// objectWriter acumula los objetos del documento registrando su posición para la tabla de referencias
+type objectWriter struct {
+	buf     bytes.Buffer
+	offsets map[int]int
+}
+
+func (o *objectWriter) raw(s string) {
+	o.buf.WriteString(s)
+}
+
+func (o *objectWriter) object(id int, body string) {
+	if o.offsets == nil {
+		o.offsets = make(map[int]int)
+	}
+	o.offsets[id] = o.buf.Len()
+	fmt.Fprintf(&o.buf, "%d 0 obj\n%s\nendobj\n", id, body)
+}
+
+// stream escribe un objeto de flujo comprimido con Flate
+func (o *objectWriter) stream(id int, dict string, data []byte) error {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write(data); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	if dict != "" {
+		dict += " "
+	}
+
+	if o.offsets == nil {
+		o.offsets = make(map[int]int)
+	}
+	o.offsets[id] = o.buf.Len()
+	fmt.Fprintf(&o.buf, "%d 0 obj\n<< %s/Filter /FlateDecode /Length %d >>\nstream\n", id, dict, compressed.Len())
+	o.buf.Write(compressed.Bytes())
+	o.buf.WriteString("\nendstream\nendobj\n")
+
+	return nil
+}
+
+// num formatea un número con un máximo de tres decimales, omitiendo los ceros a la derecha
+func num(v float64) string {
+	s := strings.TrimRight(strconv.FormatFloat(v, 'f', 3, 64), "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+func rgb(c Color) string {
+	return fmt.Sprintf("%s %s %s", num(float64(c.R)/255), num(float64(c.G)/255), num(float64(c.B)/255))
+}
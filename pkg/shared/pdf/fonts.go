@@ -0,0 +1,159 @@
+package pdf
+
+import "strings"
+
+// Font identifica una de las fuentes estándar Type1 disponibles en todo lector PDF, por lo que no
+// es necesario incrustarlas en el documento
+type Font int
+
+const (
+	Helvetica Font = iota
+	HelveticaBold
+)
+
+var fontNames = map[Font]string{
+	Helvetica:     "Helvetica",
+	HelveticaBold: "Helvetica-Bold",
+}
+
+// Anchos de los caracteres ASCII 32-126 en milésimas de punto, según las métricas AFM de Adobe
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
+
+// Caracteres de WinAnsiEncoding fuera del rango Latin-1
+var winAnsiSpecial = map[rune]byte{
+	'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+}
+
+// Letras acentuadas de Latin-1 y su letra base, utilizada para obtener el ancho
+var accentBase = map[rune]rune{
+	'á': 'a', 'à': 'a', 'â': 'a', 'ä': 'a', 'ã': 'a', 'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e',
+	'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i', 'ó': 'o', 'ò': 'o', 'ô': 'o', 'ö': 'o', 'õ': 'o',
+	'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u', 'ñ': 'n', 'ç': 'c', 'ý': 'y',
+	'Á': 'A', 'À': 'A', 'Â': 'A', 'Ä': 'A', 'Ã': 'A', 'É': 'E', 'È': 'E', 'Ê': 'E', 'Ë': 'E',
+	'Í': 'I', 'Ì': 'I', 'Î': 'I', 'Ï': 'I', 'Ó': 'O', 'Ò': 'O', 'Ô': 'O', 'Ö': 'O', 'Õ': 'O',
+	'Ú': 'U', 'Ù': 'U', 'Û': 'U', 'Ü': 'U', 'Ñ': 'N', 'Ç': 'C', 'Ý': 'Y',
+	'¿': '?', '¡': '!', '°': 'o', 'º': 'o', 'ª': 'a',
+}
+
+// runeWidth obtiene el ancho de un caracter en milésimas de punto
+func runeWidth(font Font, r rune) int {
+	if base, ok := accentBase[r]; ok {
+		r = base
+	}
+
+	if r < 32 || r > 126 {
+		return 556
+	}
+
+	if font == HelveticaBold {
+		return helveticaBoldWidths[r-32]
+	}
+	return helveticaWidths[r-32]
+}
+
+// TextWidth calcula el ancho en puntos de un texto con la fuente y tamaño indicados
+func TextWidth(font Font, size float64, text string) float64 {
+	total := 0
+	for _, r := range text {
+		total += runeWidth(font, r)
+	}
+	return float64(total) * size / 1000
+}
+
+// WrapText divide un texto en líneas que no excedan el ancho indicado, respetando los saltos de línea
+func WrapText(font Font, size float64, text string, width float64) []string {
+	var lines []string
+
+	for _, paragraph := range strings.Split(text, "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		current := ""
+		for _, word := range words {
+			// 1. Las palabras más anchas que la línea se dividen por caracteres
+			for TextWidth(font, size, word) > width {
+				if current != "" {
+					lines = append(lines, current)
+					current = ""
+				}
+				cut := fitRunes(font, size, word, width)
+				lines = append(lines, word[:cut])
+				word = word[cut:]
+			}
+
+			candidate := word
+			if current != "" {
+				candidate = current + " " + word
+			}
+
+			if TextWidth(font, size, candidate) > width {
+				lines = append(lines, current)
+				current = word
+				continue
+			}
+			current = candidate
+		}
+		lines = append(lines, current)
+	}
+
+	return lines
+}
+
+// fitRunes obtiene la cantidad de bytes del texto que caben en el ancho indicado, al menos un caracter
+func fitRunes(font Font, size float64, text string, width float64) int {
+	total := 0.0
+	for i, r := range text {
+		total += float64(runeWidth(font, r)) * size / 1000
+		if total > width && i > 0 {
+			return i
+		}
+	}
+	return len(text)
+}
+
+// encodeWinAnsi convierte un texto UTF-8 a WinAnsiEncoding y escapa los caracteres reservados de PDF
+func encodeWinAnsi(text string) []byte {
+	result := make([]byte, 0, len(text))
+
+	for _, r := range text {
+		var b byte
+		switch {
+		case r == '\t':
+			b = ' '
+		case r >= 32 && r < 127, r >= 0xA0 && r <= 0xFF:
+			b = byte(r)
+		default:
+			special, ok := winAnsiSpecial[r]
+			if !ok {
+				special = '?'
+			}
+			b = special
+		}
+
+		if b == '(' || b == ')' || b == '\\' {
+			result = append(result, '\\')
+		}
+		result = append(result, b)
+	}
+
+	return result
+}
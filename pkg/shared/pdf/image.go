@@ -0,0 +1,68 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// Image representa una imagen registrada en el documento, almacenada como RGB de 8 bits
+// con una máscara opcional para su canal alfa
+type Image struct {
+	index  int
+	width  int
+	height int
+	data   []byte
+	mask   []byte
+}
+
+// Width obtiene el ancho de la imagen en pixeles
+func (i *Image) Width() int {
+	return i.width
+}
+
+// Height obtiene el alto de la imagen en pixeles
+func (i *Image) Height() int {
+	return i.height
+}
+
+// AddImage decodifica una imagen PNG o JPEG y la registra en el documento para poder dibujarla en sus páginas
+func (d *Document) AddImage(data []byte) (*Image, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding image: %w", err)
+	}
+
+	bounds := src.Bounds()
+	img := &Image{
+		index:  len(d.images) + 1,
+		width:  bounds.Dx(),
+		height: bounds.Dy(),
+		data:   make([]byte, 0, bounds.Dx()*bounds.Dy()*3),
+	}
+
+	// 1. Separar los canales de color del canal alfa
+	alpha := make([]byte, 0, bounds.Dx()*bounds.Dy())
+	opaque := true
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(src.At(x, y)).(color.NRGBA)
+			img.data = append(img.data, c.R, c.G, c.B)
+			alpha = append(alpha, c.A)
+			if c.A != 0xFF {
+				opaque = false
+			}
+		}
+	}
+
+	// 2. La máscara solo se incluye si la imagen posee transparencia
+	if !opaque {
+		img.mask = alpha
+	}
+
+	d.images = append(d.images, img)
+	return img, nil
+}
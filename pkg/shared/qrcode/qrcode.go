@@ -0,0 +1,505 @@
+package qrcode
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrDataTooLong se retorna cuando el contenido no cabe en las versiones soportadas
+	ErrDataTooLong = errors.New("qrcode: data too long")
+	// ErrInvalidMask se retorna cuando la máscara indicada no está entre 0 y 7
+	ErrInvalidMask = errors.New("qrcode: invalid mask")
+)
+
+// versionSpec describe la estructura de bloques de una versión con nivel de corrección M
+type versionSpec struct {
+	ecPerBlock   int
+	group1Blocks int
+	group1Data   int
+	group2Blocks int
+	group2Data   int
+	alignment    []int
+	remainder    int
+}
+
+// versions contiene las versiones 1 a 10 con nivel de corrección M (ISO/IEC 18004, tablas 9 y E.1)
+var versions = []versionSpec{
+	{},
+	{ecPerBlock: 10, group1Blocks: 1, group1Data: 16},
+	{ecPerBlock: 16, group1Blocks: 1, group1Data: 28, alignment: []int{6, 18}, remainder: 7},
+	{ecPerBlock: 26, group1Blocks: 1, group1Data: 44, alignment: []int{6, 22}, remainder: 7},
+	{ecPerBlock: 18, group1Blocks: 2, group1Data: 32, alignment: []int{6, 26}, remainder: 7},
+	{ecPerBlock: 24, group1Blocks: 2, group1Data: 43, alignment: []int{6, 30}, remainder: 7},
+	{ecPerBlock: 16, group1Blocks: 4, group1Data: 27, alignment: []int{6, 34}, remainder: 7},
+	{ecPerBlock: 18, group1Blocks: 4, group1Data: 31, alignment: []int{6, 22, 38}},
+	{ecPerBlock: 22, group1Blocks: 2, group1Data: 38, group2Blocks: 2, group2Data: 39, alignment: []int{6, 24, 42}},
+	{ecPerBlock: 22, group1Blocks: 3, group1Data: 36, group2Blocks: 2, group2Data: 37, alignment: []int{6, 26, 46}},
+	{ecPerBlock: 26, group1Blocks: 4, group1Data: 43, group2Blocks: 1, group2Data: 44, alignment: []int{6, 28, 50}},
+}
+
+const (
+	maxVersion = 10
+	// formatECLevelM son los bits del nivel de corrección M en la información de formato
+	formatECLevelM = 0
+)
+
+func (v versionSpec) dataCodewords() int {
+	return v.group1Blocks*v.group1Data + v.group2Blocks*v.group2Data
+}
+
+// Code representa un código QR generado, donde cada módulo es oscuro o claro
+type Code struct {
+	Version    int
+	Size       int
+	Mask       int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+// Dark indica si el módulo en la columna x y fila y es oscuro
+func (c *Code) Dark(x, y int) bool {
+	if x < 0 || y < 0 || x >= c.Size || y >= c.Size {
+		return false
+	}
+	return c.modules[y][x]
+}
+
+// Encode genera un código QR en modo byte con nivel de corrección M para el contenido indicado,
+// seleccionando la menor versión en la que cabe y la máscara con menor penalización
+func Encode(text string) (*Code, error) {
+	return encode(text, -1)
+}
+
+// EncodeWithMask genera el código QR del contenido indicado con la máscara indicada, de 0 a 7
+func EncodeWithMask(text string, mask int) (*Code, error) {
+	if mask < 0 || mask > 7 {
+		return nil, ErrInvalidMask
+	}
+	return encode(text, mask)
+}
+
+// encode genera el código QR con la máscara indicada o, si es negativa, con la de menor penalización
+func encode(text string, mask int) (*Code, error) {
+	data := []byte(text)
+
+	// 1. Seleccionar la versión más pequeña con capacidad suficiente
+	version := 0
+	for v := 1; v <= maxVersion; v++ {
+		if 4+countBits(v)+len(data)*8 <= versions[v].dataCodewords()*8 {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, ErrDataTooLong
+	}
+
+	// 2. Construir los codewords de datos y de corrección de errores
+	codewords := addErrorCorrection(encodeData(data, version), version)
+
+	// 3. Dibujar los patrones de función y los datos
+	size := version*4 + 17
+	c := &Code{Version: version, Size: size}
+	c.modules = newGrid(size)
+	c.isFunction = newGrid(size)
+
+	c.drawFunctionPatterns()
+	c.drawCodewords(codewords)
+
+	// 4. Seleccionar la máscara con menor penalización, si no se indicó una
+	if mask < 0 {
+		bestPenalty := math.MaxInt
+		for candidate := 0; candidate < 8; candidate++ {
+			c.applyMask(candidate)
+			c.drawFormatBits(candidate)
+			if penalty := c.penalty(); penalty < bestPenalty {
+				mask, bestPenalty = candidate, penalty
+			}
+			c.applyMask(candidate)
+		}
+	}
+
+	c.Mask = mask
+	c.applyMask(mask)
+	c.drawFormatBits(mask)
+
+	return c, nil
+}
+
+func countBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+	return 16
+}
+
+func newGrid(size int) [][]bool {
+	grid := make([][]bool, size)
+	for i := range grid {
+		grid[i] = make([]bool, size)
+	}
+	return grid
+}
+
+// encodeData codifica el contenido en modo byte y completa la capacidad de la versión
+func encodeData(data []byte, version int) []byte {
+	capacity := versions[version].dataCodewords() * 8
+	bits := make([]bool, 0, capacity)
+
+	appendBits := func(value, length int) {
+		for i := length - 1; i >= 0; i-- {
+			bits = append(bits, (value>>i)&1 == 1)
+		}
+	}
+
+	// 1. Indicador de modo byte, longitud y contenido
+	appendBits(0x4, 4)
+	appendBits(len(data), countBits(version))
+	for _, b := range data {
+		appendBits(int(b), 8)
+	}
+
+	// 2. Terminador y alineación a bytes
+	appendBits(0, min(4, capacity-len(bits)))
+	appendBits(0, (8-len(bits)%8)%8)
+
+	// 3. Bytes de relleno alternados
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		appendBits(pad, 8)
+	}
+
+	result := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		if bit {
+			result[i>>3] |= 1 << (7 - i&7)
+		}
+	}
+
+	return result
+}
+
+// addErrorCorrection divide los datos en bloques, calcula su corrección Reed-Solomon e intercala el resultado
+func addErrorCorrection(data []byte, version int) []byte {
+	spec := versions[version]
+	divisor := reedSolomonDivisor(spec.ecPerBlock)
+
+	var dataBlocks, ecBlocks [][]byte
+	offset := 0
+	for i := 0; i < spec.group1Blocks+spec.group2Blocks; i++ {
+		length := spec.group1Data
+		if i >= spec.group1Blocks {
+			length = spec.group2Data
+		}
+
+		block := data[offset : offset+length]
+		offset += length
+
+		dataBlocks = append(dataBlocks, block)
+		ecBlocks = append(ecBlocks, reedSolomonRemainder(block, divisor))
+	}
+
+	result := make([]byte, 0, len(data)+len(ecBlocks)*spec.ecPerBlock)
+	for i := 0; i < max(spec.group1Data, spec.group2Data); i++ {
+		for _, block := range dataBlocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+
+	for i := 0; i < spec.ecPerBlock; i++ {
+		for _, block := range ecBlocks {
+			result = append(result, block[i])
+		}
+	}
+
+	return result
+}
+
+func (c *Code) setFunction(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.isFunction[y][x] = true
+}
+
+func (c *Code) drawFunctionPatterns() {
+	// 1. Patrones de temporización
+	for i := 0; i < c.Size; i++ {
+		c.setFunction(6, i, i%2 == 0)
+		c.setFunction(i, 6, i%2 == 0)
+	}
+
+	// 2. Patrones de localización con su separador
+	c.drawFinderPattern(3, 3)
+	c.drawFinderPattern(c.Size-4, 3)
+	c.drawFinderPattern(3, c.Size-4)
+
+	// 3. Patrones de alineación, excepto los que se solapan con los de localización
+	positions := versions[c.Version].alignment
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			c.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// 4. Reservar la información de formato y dibujar la de versión
+	c.drawFormatBits(0)
+	c.drawVersion()
+}
+
+func (c *Code) drawFinderPattern(cx, cy int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			x, y := cx+dx, cy+dy
+			if x < 0 || y < 0 || x >= c.Size || y >= c.Size {
+				continue
+			}
+			dist := max(abs(dx), abs(dy))
+			c.setFunction(x, y, dist != 2 && dist != 4)
+		}
+	}
+}
+
+func (c *Code) drawAlignmentPattern(cx, cy int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.setFunction(cx+dx, cy+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+func (c *Code) drawFormatBits(mask int) {
+	// 1. Calcular los 15 bits con su código BCH
+	data := formatECLevelM<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+
+	// 2. Primera copia, alrededor del patrón superior izquierdo
+	for i := 0; i <= 5; i++ {
+		c.setFunction(8, i, bit(bits, i))
+	}
+	c.setFunction(8, 7, bit(bits, 6))
+	c.setFunction(8, 8, bit(bits, 7))
+	c.setFunction(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		c.setFunction(14-i, 8, bit(bits, i))
+	}
+
+	// 3. Segunda copia, dividida entre los patrones superior derecho e inferior izquierdo
+	for i := 0; i < 8; i++ {
+		c.setFunction(c.Size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunction(8, c.Size-15+i, bit(bits, i))
+	}
+	c.setFunction(8, c.Size-8, true)
+}
+
+func (c *Code) drawVersion() {
+	if c.Version < 7 {
+		return
+	}
+
+	rem := c.Version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	bits := c.Version<<12 | rem
+
+	for i := 0; i < 18; i++ {
+		a, b := c.Size-11+i%3, i/3
+		c.setFunction(a, b, bit(bits, i))
+		c.setFunction(b, a, bit(bits, i))
+	}
+}
+
+// drawCodewords coloca los codewords en zigzag, de derecha a izquierda en columnas de dos módulos
+func (c *Code) drawCodewords(data []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < c.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vert
+				}
+				if !c.isFunction[y][x] && i < len(data)*8 {
+					c.modules[y][x] = bit(int(data[i>>3]), 7-i&7)
+					i++
+				}
+			}
+		}
+	}
+}
+
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.isFunction[y][x] {
+				continue
+			}
+
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+
+			if invert {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty calcula la penalización de la máscara aplicada según las cuatro reglas del estándar
+func (c *Code) penalty() int {
+	result := 0
+	dark := 0
+
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.modules[y][x] {
+				dark++
+			}
+
+			// Regla 2: bloques de 2x2 del mismo color
+			if x < c.Size-1 && y < c.Size-1 {
+				color := c.modules[y][x]
+				if color == c.modules[y][x+1] && color == c.modules[y+1][x] && color == c.modules[y+1][x+1] {
+					result += 3
+				}
+			}
+		}
+	}
+
+	// Reglas 1 y 3 en filas y columnas
+	for i := 0; i < c.Size; i++ {
+		result += c.linePenalty(func(j int) bool { return c.modules[i][j] })
+		result += c.linePenalty(func(j int) bool { return c.modules[j][i] })
+	}
+
+	// Regla 4: proporción de módulos oscuros
+	total := c.Size * c.Size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	result += k * 10
+
+	return result
+}
+
+var finderLike = [][]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+func (c *Code) linePenalty(module func(int) bool) int {
+	result := 0
+
+	// Regla 1: secuencias de cinco o más módulos del mismo color
+	run := 1
+	for j := 1; j <= c.Size; j++ {
+		if j < c.Size && module(j) == module(j-1) {
+			run++
+			continue
+		}
+		if run >= 5 {
+			result += 3 + run - 5
+		}
+		run = 1
+	}
+
+	// Regla 3: patrones similares a los de localización
+	for j := 0; j+11 <= c.Size; j++ {
+		for _, pattern := range finderLike {
+			matches := true
+			for k, dark := range pattern {
+				if module(j+k) != dark {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				result += 40
+			}
+		}
+	}
+
+	return result
+}
+
+// reedSolomonDivisor calcula el polinomio generador de grado indicado sobre GF(2^8/0x11D)
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+
+	return result
+}
+
+func reedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coef := range divisor {
+			result[i] ^= gfMultiply(coef, factor)
+		}
+	}
+	return result
+}
+
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+func bit(value, i int) bool {
+	return (value>>i)&1 != 0
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
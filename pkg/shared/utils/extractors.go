@@ -13,6 +13,18 @@ type AuxiliarIdentificationExtractor struct {
 	Issuer struct {
 		NIT string `json:"nit"`
 	} `json:"emisor"`
+	// Donee es el emisor del Comprobante de Donación, que utiliza la sección "donatario" en lugar de "emisor"
+	Donee struct {
+		NIT string `json:"numDocumento"`
+	} `json:"donatario"`
+}
+
+// GetIssuerNIT retorna el NIT del emisor del documento, considerando al donatario en comprobantes de donación
+func (a AuxiliarIdentificationExtractor) GetIssuerNIT() string {
+	if a.Issuer.NIT != "" {
+		return a.Issuer.NIT
+	}
+	return a.Donee.NIT
 }
 
 type AuxiliarReceiverExtractor struct {
@@ -112,6 +124,23 @@ func ExtractSummaryTotalAmountsFromStringJSON(document interface{}) (AuxiliarTot
 	return summary, nil
 }
 
+func ExtractRelatedDocAndItems(document interface{}) (AuxiliarRelatedDocAndItemsExtractor, error) {
+	var relatedDocAndItems AuxiliarRelatedDocAndItemsExtractor
+
+	// 1. Convertir a formato JSON el documento
+	jsonData, err := json.Marshal(document)
+	if err != nil {
+		return relatedDocAndItems, err
+	}
+
+	// 2. Extraer los documentos relacionados y los ítems
+	if err = json.Unmarshal(jsonData, &relatedDocAndItems); err != nil {
+		return relatedDocAndItems, err
+	}
+
+	return relatedDocAndItems, nil
+}
+
 func ExtractRelatedDocAndItemsFromStringJSON(document interface{}) AuxiliarRelatedDocAndItemsExtractor {
 	var relatedDocAndItems AuxiliarRelatedDocAndItemsExtractor
 
@@ -20,3 +20,8 @@ func TimeInit() error {
 func TimeNow() time.Time {
 	return time.Now().In(timezone)
 }
+
+// TimeLocation retorna la zona horaria configurada
+func TimeLocation() *time.Location {
+	return timezone
+}
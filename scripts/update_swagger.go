@@ -90,6 +90,41 @@ func main() {
 			"jsonExamples/creditnote_response.json",
 			"Este endpoint permite crear y emitir una Nota de Crédito electrónica.",
 		},
+		"DEBITNOTE_DESCRIPTION": {
+			"jsonExamples/debitnote_request.json",
+			"jsonExamples/debitnote_response.json",
+			"Este endpoint permite crear y emitir una Nota de Débito electrónica.",
+		},
+		"FSE_DESCRIPTION": {
+			"jsonExamples/fse_request.json",
+			"jsonExamples/fse_response.json",
+			"Este endpoint permite crear y emitir una Factura de Sujeto Excluido electrónica.",
+		},
+		"EXPORT_DESCRIPTION": {
+			"jsonExamples/export_request.json",
+			"jsonExamples/export_response.json",
+			"Este endpoint permite crear y emitir una Factura de Exportación electrónica.",
+		},
+		"REMISSION_DESCRIPTION": {
+			"jsonExamples/remission_request.json",
+			"jsonExamples/remission_response.json",
+			"Este endpoint permite crear y emitir una Nota de Remisión electrónica.",
+		},
+		"LIQUIDATION_DESCRIPTION": {
+			"jsonExamples/liquidation_request.json",
+			"jsonExamples/liquidation_response.json",
+			"Este endpoint permite crear y emitir un Comprobante de Liquidación electrónico.",
+		},
+		"ACCOUNTING_LIQUIDATION_DESCRIPTION": {
+			"jsonExamples/accounting_liquidation_request.json",
+			"jsonExamples/accounting_liquidation_response.json",
+			"Este endpoint permite crear y emitir un Documento Contable de Liquidación electrónico.",
+		},
+		"DONATION_DESCRIPTION": {
+			"jsonExamples/donation_request.json",
+			"jsonExamples/donation_response.json",
+			"Este endpoint permite crear y emitir un Comprobante de Donación electrónico.",
+		},
 		"RETENTION_DESCRIPTION": {
 			"jsonExamples/retention_request.json",
 			"jsonExamples/retention_response.json",
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/service/strategies"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/crypt"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+// secretTokenManager firma los tokens con una clave secreta fija
+type secretTokenManager struct {
+	ports.TokenManager
+	secret string
+}
+
+func (m *secretTokenManager) GetSecretKey() string {
+	return m.secret
+}
+
+// memoryCredentials implementa el almacenamiento de credenciales de Hacienda de CacheManager en memoria
+type memoryCredentials struct {
+	ports.CacheManager
+	credentials map[string]*models.HaciendaCredentials
+}
+
+func newMemoryCredentials() *memoryCredentials {
+	return &memoryCredentials{credentials: make(map[string]*models.HaciendaCredentials)}
+}
+
+func (c *memoryCredentials) SetCredentials(token string, creds *models.HaciendaCredentials, _ time.Duration) error {
+	c.credentials[token] = creds
+	return nil
+}
+
+func (c *memoryCredentials) GetCredentials(token string) (*models.HaciendaCredentials, error) {
+	creds, ok := c.credentials[token]
+	if !ok {
+		return nil, shared_error.NewFormattedGeneralServiceError("RedisTokenCache", "GetCredentials", "TokenNotExist")
+	}
+	return creds, nil
+}
+
+func (c *memoryCredentials) DeleteCredentials(token string) error {
+	delete(c.credentials, token)
+	return nil
+}
+
+// newAuthService crea el servicio de autenticación con las credenciales de Hacienda de las sucursales en memoria
+func newAuthService(cache *memoryCredentials) auth.AuthManager {
+	return strategies.NewAuthService(&secretTokenManager{secret: "server-secret"}, nil, cache, crypt.NewCryptService())
+}
+
+// storeBranchCredentials almacena las credenciales de Hacienda de la sucursal como lo hace su inicio de sesión
+func storeBranchCredentials(t *testing.T, authService auth.AuthManager, cache *memoryCredentials, id uint) {
+	t.Helper()
+	require.NoError(t, cache.SetCredentials(authService.GetBranchToken(id), &models.HaciendaCredentials{Username: "06141234567890", Password: "secret"}, time.Hour))
+}
+
+func TestBranchTokenIsStablePerBranchAndDerivedFromServerSecret(t *testing.T) {
+	test.TestMain(t)
+
+	service := strategies.NewAuthService(&secretTokenManager{secret: "server-secret"}, nil, nil, crypt.NewCryptService())
+	other := strategies.NewAuthService(&secretTokenManager{secret: "other-secret"}, nil, nil, crypt.NewCryptService())
+
+	token := service.GetBranchToken(branchID)
+	assert.Equal(t, token, service.GetBranchToken(branchID))
+	assert.NotEqual(t, token, service.GetBranchToken(matrixID))
+	assert.NotEqual(t, token, other.GetBranchToken(branchID))
+	assert.NotContains(t, token, "server-secret")
+}
+
+func TestEvictBranchCredentialsOnlyRemovesTheBranchCredentials(t *testing.T) {
+	test.TestMain(t)
+
+	cache := newMemoryCredentials()
+	authService := newAuthService(cache)
+	storeBranchCredentials(t, authService, cache, matrixID)
+	storeBranchCredentials(t, authService, cache, branchID)
+
+	require.NoError(t, authService.EvictBranchCredentials(branchID))
+
+	exists, err := authService.HasBranchCredentials(branchID)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	exists, err = authService.HasBranchCredentials(matrixID)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
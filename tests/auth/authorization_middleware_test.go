@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/middleware"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+func TestAuthorizationMiddlewareRequiresScopeOfTheRole(t *testing.T) {
+	test.TestMain(t)
+
+	authz := middleware.NewAuthorizationMiddleware()
+	handler := authz.Require(user.ScopeDTEInvalidate, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	serve := func(claims *models.AuthClaims) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/dte/invalidation", nil)
+		if claims != nil {
+			req = req.WithContext(context.WithValue(req.Context(), "claims", claims))
+		}
+
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec.Code
+	}
+
+	admin := &user.BranchOffice{Role: user.RoleAdmin}
+	operator := &user.BranchOffice{Role: user.RoleOperator}
+	auditor := &user.BranchOffice{Role: user.RoleAuditor, Scopes: []string{user.ScopeDTEInvalidate}}
+
+	assert.Equal(t, http.StatusUnauthorized, serve(nil))
+	assert.Equal(t, http.StatusOK, serve(&models.AuthClaims{Role: admin.Role, Scopes: admin.EffectiveScopes()}))
+	assert.Equal(t, http.StatusForbidden, serve(&models.AuthClaims{Role: operator.Role, Scopes: operator.EffectiveScopes()}))
+	assert.Equal(t, http.StatusOK, serve(&models.AuthClaims{Role: auditor.Role, Scopes: auditor.EffectiveScopes()}))
+	assert.Equal(t, http.StatusForbidden, serve(&models.AuthClaims{}))
+}
@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth/service"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/crypt"
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const (
+	userID   = uint(7)
+	matrixID = uint(1)
+	branchID = uint(2)
+)
+
+// memoryRepository implementa las operaciones de sucursales de AuthRepositoryPort en memoria
+type memoryRepository struct {
+	auth.AuthRepositoryPort
+	branches map[uint]user.BranchOffice
+	nextID   uint
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{
+		branches: map[uint]user.BranchOffice{
+			matrixID: {ID: matrixID, UserID: userID, EstablishmentType: constants.CasaMatriz, APIKey: "matrix-key", APISecret: "matrix-secret", IsActive: true, Role: user.RoleAdmin},
+			branchID: {ID: branchID, UserID: userID, EstablishmentType: constants.Sucursal, APIKey: "branch-key", APISecret: "branch-secret", IsActive: true, Role: user.RoleOperator},
+		},
+		nextID: branchID,
+	}
+}
+
+func (r *memoryRepository) GetMatrixBranch(_ context.Context, owner uint) (*user.BranchOffice, error) {
+	for _, branch := range r.branches {
+		if branch.UserID == owner && branch.EstablishmentType == constants.CasaMatriz {
+			return &branch, nil
+		}
+	}
+	return nil, errPackage.ErrBranchOfficeNotFound
+}
+
+func (r *memoryRepository) GetBranchByBranchID(_ context.Context, id uint) (*user.BranchOffice, error) {
+	if branch, ok := r.branches[id]; ok {
+		return &branch, nil
+	}
+	return nil, errPackage.ErrBranchOfficeNotFound
+}
+
+func (r *memoryRepository) ListBranchOffices(_ context.Context, owner uint) ([]user.BranchOffice, error) {
+	var branches []user.BranchOffice
+	for id := uint(1); id <= r.nextID; id++ {
+		if branch, ok := r.branches[id]; ok && branch.UserID == owner {
+			branches = append(branches, branch)
+		}
+	}
+	return branches, nil
+}
+
+func (r *memoryRepository) CreateBranchOffice(_ context.Context, owner uint, branch *user.BranchOffice) error {
+	r.nextID++
+	branch.ID, branch.UserID = r.nextID, owner
+	r.branches[branch.ID] = *branch
+	return nil
+}
+
+func (r *memoryRepository) UpdateBranchOffices(_ context.Context, _ uint, branches []user.BranchOffice) error {
+	for _, branch := range branches {
+		r.branches[branch.ID] = branch
+	}
+	return nil
+}
+
+func (r *memoryRepository) SetBranchOfficeStatus(_ context.Context, _ uint, id uint, active bool) error {
+	branch := r.branches[id]
+	branch.IsActive = active
+	r.branches[id] = branch
+	return nil
+}
+
+func (r *memoryRepository) RotateBranchCredentials(_ context.Context, owner, id uint, apiKey, apiSecret string, previousExpiresAt time.Time) error {
+	branch, ok := r.branches[id]
+	if !ok || branch.UserID != owner {
+		return errPackage.ErrBranchDoesNotBelong
+	}
+
+	previousKey, previousSecret := branch.APIKey, branch.APISecret
+	branch.PreviousAPIKey, branch.PreviousAPISecret, branch.PreviousExpiresAt = &previousKey, &previousSecret, &previousExpiresAt
+	branch.APIKey, branch.APISecret = apiKey, apiSecret
+	r.branches[id] = branch
+	return nil
+}
+
+// fakeTokenManager registra las sucursales cuyos tokens emitidos se revocan
+type fakeTokenManager struct {
+	ports.TokenManager
+	revoked []uint
+}
+
+func (m *fakeTokenManager) RevokeIssuedTokens(id uint) (int, error) {
+	m.revoked = append(m.revoked, id)
+	return 2, nil
+}
+
+func TestCreateBranchGeneratesCredentialsAndRejectsSecondMatrix(t *testing.T) {
+	test.TestMain(t)
+
+	repo := newMemoryRepository()
+	branchService := service.NewBranchService(repo, crypt.NewCryptService(), &fakeTokenManager{}, newAuthService(newMemoryCredentials()))
+	ctx := context.Background()
+
+	matrixType := constants.CasaMatriz
+	_, err := branchService.CreateBranch(ctx, userID, &models.BranchInput{EstablishmentType: &matrixType})
+	assertServiceErrorCode(t, err, "MatrixBranchAlreadyExists")
+
+	branchType, posCodeMH := constants.Sucursal, "P0010"
+	_, err = branchService.CreateBranch(ctx, userID, &models.BranchInput{EstablishmentType: &branchType, POSCodeMH: &posCodeMH})
+	require.Error(t, err)
+
+	posCodeMH = "P001"
+	branch, err := branchService.CreateBranch(ctx, userID, &models.BranchInput{EstablishmentType: &branchType, POSCodeMH: &posCodeMH})
+	require.NoError(t, err)
+	assert.True(t, branch.IsActive)
+	assert.NotEmpty(t, branch.APIKey)
+	assert.NotEmpty(t, branch.APISecret)
+	assert.Equal(t, user.RoleOperator, branch.Role)
+
+	branches, err := branchService.ListBranches(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, branches, 3)
+	for _, listed := range branches {
+		assert.Empty(t, listed.APISecret)
+	}
+}
+
+func TestMatrixBranchCannotChangeTypeRoleOrBeDeactivated(t *testing.T) {
+	test.TestMain(t)
+
+	repo := newMemoryRepository()
+	cache := newMemoryCredentials()
+	authService := newAuthService(cache)
+	branchService := service.NewBranchService(repo, crypt.NewCryptService(), &fakeTokenManager{}, authService)
+	storeBranchCredentials(t, authService, cache, branchID)
+	ctx := context.Background()
+
+	branchType, matrixType := constants.Sucursal, constants.CasaMatriz
+	_, err := branchService.UpdateBranch(ctx, userID, matrixID, &models.BranchInput{EstablishmentType: &branchType})
+	assertServiceErrorCode(t, err, "MatrixBranchTypeChange")
+
+	_, err = branchService.UpdateBranch(ctx, userID, branchID, &models.BranchInput{EstablishmentType: &matrixType})
+	assertServiceErrorCode(t, err, "MatrixBranchTypeChange")
+
+	auditorRole := user.RoleAuditor
+	_, err = branchService.UpdateBranch(ctx, userID, matrixID, &models.BranchInput{Role: &auditorRole})
+	assertServiceErrorCode(t, err, "MatrixBranchRoleChange")
+
+	_, err = branchService.SetBranchStatus(ctx, userID, matrixID, false)
+	assertServiceErrorCode(t, err, "MatrixBranchCannotBeDeactivated")
+
+	_, err = branchService.SetBranchStatus(ctx, userID+1, branchID, false)
+	assertServiceErrorCode(t, err, "BranchNotFound")
+
+	branch, err := branchService.SetBranchStatus(ctx, userID, branchID, false)
+	require.NoError(t, err)
+	assert.False(t, branch.IsActive)
+	assert.False(t, repo.branches[branchID].IsActive)
+
+	// Los workers dejan de transmitir con las credenciales de Hacienda de la sucursal desactivada
+	exists, err := authService.HasBranchCredentials(branchID)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	branch, err = branchService.SetBranchStatus(ctx, userID, branchID, true)
+	require.NoError(t, err)
+	assert.True(t, branch.IsActive)
+	assert.True(t, repo.branches[branchID].IsActive)
+}
+
+func TestUpdateBranchPermissionsValidatesRoleAndScopes(t *testing.T) {
+	test.TestMain(t)
+
+	repo := newMemoryRepository()
+	branchService := service.NewBranchService(repo, crypt.NewCryptService(), &fakeTokenManager{}, newAuthService(newMemoryCredentials()))
+	ctx := context.Background()
+
+	unknownRole := "owner"
+	_, err := branchService.UpdateBranch(ctx, userID, branchID, &models.BranchInput{Role: &unknownRole})
+	require.Error(t, err)
+
+	_, err = branchService.UpdateBranch(ctx, userID, branchID, &models.BranchInput{Scopes: []string{"dte:delete"}})
+	require.Error(t, err)
+
+	auditorRole := user.RoleAuditor
+	branch, err := branchService.UpdateBranch(ctx, userID, branchID, &models.BranchInput{
+		Role:   &auditorRole,
+		Scopes: []string{user.ScopeDTEInvalidate, user.ScopeDTERead},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, user.RoleAuditor, repo.branches[branchID].Role)
+	assert.ElementsMatch(t, []string{user.ScopeDTERead, user.ScopeMetricsRead, user.ScopeDTEInvalidate}, branch.EffectiveScopes())
+}
+
+func TestRotateCredentialsKeepsPreviousCredentialsDuringGracePeriod(t *testing.T) {
+	test.TestMain(t)
+
+	repo := newMemoryRepository()
+	tokenManager := &fakeTokenManager{}
+	cache := newMemoryCredentials()
+	authService := newAuthService(cache)
+	branchService := service.NewBranchService(repo, crypt.NewCryptService(), tokenManager, authService)
+	storeBranchCredentials(t, authService, cache, branchID)
+	ctx := context.Background()
+
+	_, err := branchService.RotateCredentials(ctx, userID+1, branchID, time.Hour)
+	assertServiceErrorCode(t, err, "BranchNotFound")
+
+	rotated, err := branchService.RotateCredentials(ctx, userID, branchID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rotated.RevokedTokens)
+	assert.Equal(t, []uint{branchID}, tokenManager.revoked)
+
+	// Las credenciales de Hacienda de la sucursal se eliminan hasta que inicie sesión con las credenciales nuevas
+	exists, err := authService.HasBranchCredentials(branchID)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// 1. Solo se almacena el hash del API secret nuevo
+	branch := repo.branches[branchID]
+	assert.Equal(t, rotated.APIKey, branch.APIKey)
+	assert.Equal(t, crypt.HashSecret(rotated.APISecret), branch.APISecret)
+
+	// 2. Las credenciales anteriores son válidas hasta que termina el periodo de gracia
+	now := utils.TimeNow()
+	assert.True(t, branch.UsesPreviousCredentials("branch-key", now))
+	secret, ok := branch.CredentialsSecret("branch-key", now)
+	assert.True(t, ok)
+	assert.Equal(t, "branch-secret", secret)
+
+	_, ok = branch.CredentialsSecret("branch-key", rotated.PreviousExpiresAt.Add(time.Second))
+	assert.False(t, ok)
+
+	assert.False(t, branch.UsesPreviousCredentials(rotated.APIKey, now))
+	secret, ok = branch.CredentialsSecret(rotated.APIKey, now)
+	assert.True(t, ok)
+	assert.Equal(t, branch.APISecret, secret)
+}
+
+func assertServiceErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var serviceErr *shared_error.ServiceError
+	require.True(t, errors.As(err, &serviceErr), "expected service error %s, got %v", code, err)
+	assert.Equal(t, code, serviceErr.Code)
+}
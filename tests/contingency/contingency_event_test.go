@@ -0,0 +1,180 @@
+package contingency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const rejectedBranchID = uint(4)
+
+// fakeEventSender registra los eventos enviados, Hacienda rechaza los eventos de la sucursal rejectedBranchID. Si
+// sendErr no es nil, los eventos se envían sin obtener respuesta
+type fakeEventSender struct {
+	sent    [][]string
+	sendErr error
+	repo    *memoryRepository
+	stored  []bool
+}
+
+func (s *fakeEventSender) PrepareContingencyEvent(_ context.Context, docs []dte.ContingencyDocument) (*models.ContingencyEventRecord, error) {
+	ids := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+
+	return &models.ContingencyEventRecord{
+		ID:          "EVT-" + ids[0],
+		BranchID:    docs[0].BranchID,
+		DocumentIDs: ids,
+		Status:      models.ContingencyEventPending,
+	}, nil
+}
+
+func (s *fakeEventSender) SendContingencyEvent(_ context.Context, record *models.ContingencyEventRecord) error {
+	s.sent = append(s.sent, record.DocumentIDs)
+	if s.repo != nil {
+		// Registra si el evento ya estaba almacenado al enviarlo
+		stored := false
+		for _, event := range s.repo.events {
+			stored = stored || (event.ID == record.ID && event.Status == models.ContingencyEventPending)
+		}
+		s.stored = append(s.stored, stored)
+	}
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+
+	record.Status = models.ContingencyEventAccepted
+	if record.BranchID == rejectedBranchID {
+		record.Status = models.ContingencyEventRejected
+		record.Observations = []string{"Validaciones no superadas"}
+	}
+	return nil
+}
+
+// transmittedBranches implementa AuthManager registrando las sucursales cuyos documentos se intentan transmitir por
+// lotes, sin llegar a transmitirlos
+type transmittedBranches struct {
+	auth.AuthManager
+	branches []uint
+}
+
+func (a *transmittedBranches) GetBranchByBranchID(_ context.Context, branchID uint) (*user.BranchOffice, error) {
+	a.branches = append(a.branches, branchID)
+	return nil, errors.New("branch not available in tests")
+}
+
+func newBranchDoc(id string, branch uint) dte.ContingencyDocument {
+	doc := newQueuedDoc(id, constants.DocumentPending, time.Hour)
+	doc.BranchID = branch
+	doc.Branch = &user.BranchOffice{ID: branch, User: &user.User{NIT: "06140101011011"}}
+	return doc
+}
+
+func TestRetransmissionSendsOneEventPerBranchAndOnlyBatchesAcceptedDocuments(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{docs: []dte.ContingencyDocument{
+		newBranchDoc("1", branchID),
+		newBranchDoc("2", rejectedBranchID),
+		newBranchDoc("3", branchID),
+	}}
+	sender := &fakeEventSender{repo: repo}
+	authManager := &transmittedBranches{}
+	service := contingency.NewContingencyManager(authManager, nil, repo, nil, nil, nil, nil, nil, sender, fixedTime{}, nil, &fakePublisher{})
+
+	require.NoError(t, service.RetransmitPendingDocuments(context.Background(), 50, nil))
+
+	// Un evento por sucursal con todos sus documentos, almacenado antes de enviarlo
+	assert.Equal(t, [][]string{{"1", "3"}, {"2"}}, sender.sent)
+	assert.Equal(t, []bool{true, true}, sender.stored)
+
+	// Ambas respuestas se almacenan y solo los documentos del evento aceptado quedan asociados a él
+	require.Len(t, repo.events, 2)
+	assert.True(t, repo.events[0].IsAccepted())
+	assert.False(t, repo.events[1].IsAccepted())
+	require.NotNil(t, repo.docs[0].ContingencyEventID)
+	assert.Equal(t, "EVT-1", *repo.docs[0].ContingencyEventID)
+	assert.Nil(t, repo.docs[1].ContingencyEventID)
+
+	// Solo se transmiten por lotes los documentos de la sucursal cuyo evento fue aceptado
+	assert.Equal(t, []uint{branchID}, authManager.branches)
+
+	// En la siguiente ejecución los documentos ya informados no vuelven a informarse
+	sender.sent = nil
+	require.NoError(t, service.RetransmitPendingDocuments(context.Background(), 50, nil))
+	assert.Equal(t, [][]string{{"2"}}, sender.sent)
+}
+
+func TestEventWhoseResponseCannotBeStoredIsResentBeforeBatchingItsDocuments(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{
+		docs:           []dte.ContingencyDocument{newBranchDoc("1", branchID), newBranchDoc("2", branchID)},
+		updateEventErr: errors.New("database unavailable"),
+	}
+	sender := &fakeEventSender{}
+	authManager := &transmittedBranches{}
+	service := contingency.NewContingencyManager(authManager, nil, repo, nil, nil, nil, nil, nil, sender, fixedTime{}, nil, &fakePublisher{})
+
+	require.NoError(t, service.RetransmitPendingDocuments(context.Background(), 50, nil))
+
+	// El evento queda pendiente con sus documentos asociados, que no se transmiten por lotes sin un evento aceptado
+	require.Len(t, repo.events, 1)
+	assert.Equal(t, models.ContingencyEventPending, repo.events[0].Status)
+	for _, doc := range repo.docs {
+		require.NotNil(t, doc.ContingencyEventID)
+		assert.Equal(t, "EVT-1", *doc.ContingencyEventID)
+	}
+	assert.Empty(t, authManager.branches)
+
+	// Mientras no se registre la respuesta, cada ejecución reenvía el mismo evento sin transmitir sus documentos
+	require.NoError(t, service.RetransmitPendingDocuments(context.Background(), 50, nil))
+	assert.Equal(t, [][]string{{"1", "2"}, {"1", "2"}}, sender.sent)
+	assert.Len(t, repo.events, 1)
+	assert.Empty(t, authManager.branches)
+
+	// Una vez registrada la respuesta se transmiten sin informarse en un nuevo evento
+	repo.updateEventErr = nil
+	require.NoError(t, service.RetransmitPendingDocuments(context.Background(), 50, nil))
+	require.Len(t, repo.events, 1)
+	assert.True(t, repo.events[0].IsAccepted())
+	assert.Len(t, sender.sent, 3)
+	assert.Equal(t, []uint{branchID}, authManager.branches)
+}
+
+func TestEventWithoutResponseIsRejectedAndItsDocumentsReportedAgain(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{docs: []dte.ContingencyDocument{newBranchDoc("1", branchID)}}
+	sender := &fakeEventSender{sendErr: errors.New("connection reset")}
+	authManager := &transmittedBranches{}
+	service := contingency.NewContingencyManager(authManager, nil, repo, nil, nil, nil, nil, nil, sender, fixedTime{}, nil, &fakePublisher{})
+
+	require.NoError(t, service.RetransmitPendingDocuments(context.Background(), 50, nil))
+
+	// El evento se registra como rechazado y el documento queda sin asociar, sin transmitirse por lotes
+	require.Len(t, repo.events, 1)
+	assert.Equal(t, models.ContingencyEventRejected, repo.events[0].Status)
+	assert.Equal(t, "connection reset", repo.events[0].Message)
+	assert.Nil(t, repo.docs[0].ContingencyEventID)
+	assert.Empty(t, authManager.branches)
+
+	// En la siguiente ejecución se informa en un nuevo evento
+	sender.sendErr = nil
+	require.NoError(t, service.RetransmitPendingDocuments(context.Background(), 50, nil))
+	assert.Equal(t, [][]string{{"1"}, {"1"}}, sender.sent)
+}
@@ -0,0 +1,292 @@
+package contingency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const (
+	userID   = uint(7)
+	branchID = uint(3)
+)
+
+var now = time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+// fixedTime implementa TimeProvider con una hora fija
+type fixedTime struct{}
+
+func (fixedTime) Now() time.Time        { return now }
+func (fixedTime) Sleep(_ time.Duration) {}
+
+// memoryRepository implementa ContingencyRepositoryPort en memoria para los documentos de un único usuario. Si
+// updateEventErr no es nil, falla el registro de la respuesta de los eventos
+type memoryRepository struct {
+	docs           []dte.ContingencyDocument
+	events         []models.ContingencyEventRecord
+	updateEventErr error
+}
+
+func (r *memoryRepository) Create(_ context.Context, doc *dte.ContingencyDocument) error {
+	r.docs = append(r.docs, *doc)
+	return nil
+}
+
+func (r *memoryRepository) GetPending(_ context.Context, _ int, _ []string) ([]dte.ContingencyDocument, error) {
+	return r.pending(), nil
+}
+
+func (r *memoryRepository) GetPendingByNIT(_ context.Context, _ string, _ int) ([]dte.ContingencyDocument, error) {
+	return r.pending(), nil
+}
+
+func (r *memoryRepository) List(_ context.Context, owner uint, filters *models.ContingencyFilters) ([]dte.ContingencyDocument, int64, error) {
+	var matching []dte.ContingencyDocument
+	for _, doc := range r.docs {
+		if owner == userID && (filters.Status == "" || doc.Document.Status == filters.Status) {
+			matching = append(matching, doc)
+		}
+	}
+
+	start := min((filters.Page-1)*filters.PageSize, len(matching))
+	end := min(start+filters.PageSize, len(matching))
+	return matching[start:end], int64(len(matching)), nil
+}
+
+func (r *memoryRepository) GetByID(_ context.Context, owner uint, id string) (*dte.ContingencyDocument, error) {
+	if doc := r.find(id); doc != nil && owner == userID {
+		copied := *doc
+		return &copied, nil
+	}
+	return nil, errPackage.ErrContingencyDocumentNotFound
+}
+
+func (r *memoryRepository) UpdateClassification(_ context.Context, id string, contingencyType int8, reason string) error {
+	doc := r.find(id)
+	doc.ContingencyType, doc.Reason = contingencyType, reason
+	return nil
+}
+
+func (r *memoryRepository) Cancel(_ context.Context, id string, observation string) error {
+	doc := r.find(id)
+	if doc.Document.Status != constants.DocumentPending {
+		return errPackage.ErrContingencyDocumentNotPending
+	}
+	doc.Document.Status = constants.DocumentRejected
+	doc.Observations = &observation
+	return nil
+}
+
+func (r *memoryRepository) UpdateBatch(_ context.Context, _ []string, _ []string, _ map[string]string, _ string, _ string, _ string) error {
+	return nil
+}
+
+func (r *memoryRepository) SaveEvent(_ context.Context, record *models.ContingencyEventRecord) error {
+	r.events = append(r.events, *record)
+	for _, id := range record.DocumentIDs {
+		eventID := record.ID
+		r.find(id).ContingencyEventID = &eventID
+	}
+	return nil
+}
+
+func (r *memoryRepository) GetEvent(_ context.Context, id string) (*models.ContingencyEventRecord, error) {
+	for _, event := range r.events {
+		if event.ID == id {
+			copied := event
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
+func (r *memoryRepository) UpdateEvent(_ context.Context, record *models.ContingencyEventRecord) error {
+	if r.updateEventErr != nil {
+		return r.updateEventErr
+	}
+
+	for i := range r.events {
+		if r.events[i].ID == record.ID {
+			r.events[i] = *record
+		}
+	}
+	if !record.IsAccepted() {
+		for i := range r.docs {
+			if eventID := r.docs[i].ContingencyEventID; eventID != nil && *eventID == record.ID {
+				r.docs[i].ContingencyEventID = nil
+			}
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepository) find(id string) *dte.ContingencyDocument {
+	for i := range r.docs {
+		if r.docs[i].ID == id {
+			return &r.docs[i]
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepository) pending() []dte.ContingencyDocument {
+	var pending []dte.ContingencyDocument
+	for _, doc := range r.docs {
+		if doc.Document.Status != constants.DocumentPending {
+			continue
+		}
+		for _, event := range r.events {
+			if doc.ContingencyEventID != nil && *doc.ContingencyEventID == event.ID {
+				doc.ContingencyEventStatus = event.Status
+			}
+		}
+		pending = append(pending, doc)
+	}
+	return pending
+}
+
+// fakePublisher registra los eventos publicados
+type fakePublisher struct {
+	eventTypes []string
+	payloads   []interface{}
+}
+
+func (p *fakePublisher) Publish(_ context.Context, _ uint, eventType string, payload interface{}) {
+	p.eventTypes = append(p.eventTypes, eventType)
+	p.payloads = append(p.payloads, payload)
+}
+
+func newQueuedDoc(id, status string, age time.Duration) dte.ContingencyDocument {
+	return dte.ContingencyDocument{
+		ID:              id,
+		DocumentID:      "GEN-" + id,
+		BranchID:        branchID,
+		ContingencyType: constants.NoDisponibilidadMH,
+		Reason:          constants.GetContingencyReason(constants.NoDisponibilidadMH),
+		CreatedAt:       now.Add(-age),
+		Document: &dte.DTEDetails{
+			ID:            "GEN-" + id,
+			DTEType:       constants.FacturaElectronica,
+			ControlNumber: "DTE-01-00000000-00000000000000" + id,
+			Status:        status,
+		},
+	}
+}
+
+func newService(repo *memoryRepository, publisher *fakePublisher) contingency.ContingencyManager {
+	return contingency.NewContingencyManager(nil, nil, repo, nil, nil, nil, nil, nil, nil, fixedTime{}, nil, publisher)
+}
+
+func TestListDocumentsReportsTimeLeftBeforeDeadline(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{docs: []dte.ContingencyDocument{
+		newQueuedDoc("1", constants.DocumentPending, 80*time.Hour),
+		newQueuedDoc("2", constants.DocumentPending, 70*time.Hour),
+		newQueuedDoc("3", constants.DocumentReceived, 10*time.Hour),
+	}}
+	service := newService(repo, &fakePublisher{})
+
+	page, err := service.ListDocuments(context.Background(), userID, &models.ContingencyFilters{Status: constants.DocumentPending})
+	require.NoError(t, err)
+
+	assert.Equal(t, contingency.DefaultQueuePageSize, page.Pagination.PageSize)
+	assert.Equal(t, int64(2), page.Pagination.TotalItems)
+	require.Len(t, page.Documents, 2)
+
+	overdue, onTime := page.Documents[0], page.Documents[1]
+	assert.True(t, overdue.Overdue)
+	assert.Zero(t, overdue.RemainingSeconds)
+	assert.False(t, onTime.Overdue)
+	assert.Equal(t, int64((2 * time.Hour).Seconds()), onTime.RemainingSeconds)
+	assert.Equal(t, now.Add(2*time.Hour), onTime.Deadline)
+}
+
+func TestReclassifyDocumentValidatesTypeAndUsesDefaultReason(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{docs: []dte.ContingencyDocument{
+		newQueuedDoc("1", constants.DocumentPending, time.Hour),
+		newQueuedDoc("2", constants.DocumentReceived, time.Hour),
+		newQueuedDoc("3", constants.DocumentPending, time.Hour),
+	}}
+	eventID := "EVT-1"
+	repo.docs[2].ContingencyEventID = &eventID
+	service := newService(repo, &fakePublisher{})
+	ctx := context.Background()
+
+	_, err := service.ReclassifyDocument(ctx, userID, "1", 9, "")
+	assertServiceErrorCode(t, err, "InvalidContingencyClassification")
+
+	_, err = service.ReclassifyDocument(ctx, userID, "1", constants.OtroMotivo, "")
+	assertServiceErrorCode(t, err, "InvalidContingencyClassificationReason")
+
+	_, err = service.ReclassifyDocument(ctx, userID, "2", constants.FallaServicioInternet, "")
+	assertServiceErrorCode(t, err, "ContingencyDocumentNotPending")
+
+	_, err = service.ReclassifyDocument(ctx, userID, "3", constants.FallaServicioInternet, "")
+	assertServiceErrorCode(t, err, "ContingencyDocumentAlreadyReported")
+
+	_, err = service.ReclassifyDocument(ctx, userID, "missing", constants.FallaServicioInternet, "")
+	assertServiceErrorCode(t, err, "ContingencyDocumentNotFound")
+
+	doc, err := service.ReclassifyDocument(ctx, userID, "1", constants.FallaServicioInternet, "")
+	require.NoError(t, err)
+	assert.Equal(t, int8(constants.FallaServicioInternet), doc.ContingencyType)
+	assert.Equal(t, constants.GetContingencyReason(constants.FallaServicioInternet), repo.docs[0].Reason)
+}
+
+func TestCancelDocumentRejectsItAndPublishesEvent(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{docs: []dte.ContingencyDocument{newQueuedDoc("1", constants.DocumentPending, time.Hour)}}
+	publisher := &fakePublisher{}
+	service := newService(repo, publisher)
+
+	require.NoError(t, service.CancelDocument(context.Background(), userID, "1", "duplicado"))
+
+	assert.Equal(t, constants.DocumentRejected, repo.docs[0].Document.Status)
+	require.NotNil(t, repo.docs[0].Observations)
+	assert.Equal(t, contingency.CancelledObservation+": duplicado", *repo.docs[0].Observations)
+	assert.Equal(t, []string{event.DTERejected}, publisher.eventTypes)
+
+	err := service.CancelDocument(context.Background(), userID, "1", "")
+	assertServiceErrorCode(t, err, "ContingencyDocumentNotPending")
+}
+
+func TestRetransmitDocumentsByNITWithoutPendingDocuments(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{docs: []dte.ContingencyDocument{newQueuedDoc("1", constants.DocumentReceived, time.Hour)}}
+	service := newService(repo, &fakePublisher{})
+
+	result, err := service.RetransmitDocumentsByNIT(context.Background(), "06140101011011", 50)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Documents)
+
+	// Sin documentos no queda ninguna retransmisión en curso
+	result, err = service.RetransmitDocumentsByNIT(context.Background(), "06140101011011", 50)
+	require.NoError(t, err)
+	assert.Equal(t, "06140101011011", result.NIT)
+}
+
+func assertServiceErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var serviceErr *shared_error.ServiceError
+	require.True(t, errors.As(err, &serviceErr), "expected service error %s, got %v", code, err)
+	assert.Equal(t, code, serviceErr.Code)
+}
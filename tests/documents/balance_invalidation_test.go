@@ -0,0 +1,126 @@
+package documents
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appdte "github.com/MarlonG1/api-facturacion-sv/internal/application/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/invalidation"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const (
+	ccfCode        = "7C1A2B3D-4E5F-4A6B-8C7D-9E0F1A2B3C4D"
+	creditNoteCode = "3F2E1D0C-9B8A-4F7E-8D6C-5B4A3F2E1D0C"
+)
+
+// balanceLedger aplica las transacciones de saldo sobre un control en memoria, omitiendo las que repiten el documento
+// relacionado, el documento de ajuste y el tipo, igual que el índice único de la tabla
+type balanceLedger struct {
+	dte_documents.DTERepositoryPort
+	control      dte.BalanceControl
+	documents    map[string]*dte.DTEDocument
+	transactions map[[3]string]dte.BalanceTransaction
+}
+
+func (r *balanceLedger) Update(context.Context, uint, dte.DTEDetails) error {
+	return nil
+}
+
+func (r *balanceLedger) GetByGenerationCode(_ context.Context, _ uint, id string) (*dte.DTEDocument, error) {
+	return r.documents[id], nil
+}
+
+func (r *balanceLedger) GetDTEBalanceControl(context.Context, uint, string) (*dte.BalanceControl, error) {
+	control := r.control
+	return &control, nil
+}
+
+func (r *balanceLedger) GenerateBalanceTransaction(_ context.Context, _ uint, originalDTE string, transaction *dte.BalanceTransaction) error {
+	key := [3]string{originalDTE, transaction.AdjustmentDocumentID, transaction.TransactionType}
+	if _, exists := r.transactions[key]; exists {
+		return nil
+	}
+	r.transactions[key] = *transaction
+
+	sign := 1.0
+	if transaction.TransactionType == constants.NotaCreditoElectronica {
+		sign = -1.0
+	}
+	r.control.RemainingTaxedAmount += sign * transaction.TaxedAmount
+	r.control.RemainingExemptAmount += sign * transaction.ExemptAmount
+	r.control.RemainingNotSubjectAmount += sign * transaction.NotSubjectAmount
+	return nil
+}
+
+// creditNoteModel arma el modelo de Hacienda de una nota de crédito con dos ítems sobre el mismo CCF
+func creditNoteModel() map[string]interface{} {
+	return map[string]interface{}{
+		"identificacion": map[string]interface{}{
+			"tipoDte":          constants.NotaCreditoElectronica,
+			"codigoGeneracion": creditNoteCode,
+		},
+		"documentoRelacionado": []map[string]interface{}{
+			{"tipoGeneracion": constants.ElectronicDocument, "numeroDocumento": ccfCode},
+		},
+		"cuerpoDocumento": []map[string]interface{}{
+			{"numeroDocumento": ccfCode, "ventaGravada": 30.0, "ventaExenta": 5.0, "ventaNoSuj": 0.0},
+			{"numeroDocumento": ccfCode, "ventaGravada": 10.0, "ventaExenta": 0.0, "ventaNoSuj": 2.0},
+		},
+		"resumen": map[string]interface{}{
+			"totalGravada": 40.0,
+			"totalExenta":  5.0,
+			"totalNoSuj":   2.0,
+		},
+	}
+}
+
+func TestInvalidatingCreditNoteRestoresTheCCFBalance(t *testing.T) {
+	test.TestMain(t)
+
+	model := creditNoteModel()
+	jsonData, err := json.Marshal(model)
+	require.NoError(t, err)
+
+	ledger := &balanceLedger{
+		control: dte.BalanceControl{
+			OriginalDTEID:             ccfCode,
+			RemainingTaxedAmount:      100,
+			RemainingExemptAmount:     20,
+			RemainingNotSubjectAmount: 10,
+		},
+		documents: map[string]*dte.DTEDocument{
+			creditNoteCode: {
+				ID:      creditNoteCode,
+				Details: &dte.DTEDetails{ID: creditNoteCode, DTEType: constants.NotaCreditoElectronica, JSONData: string(jsonData)},
+			},
+		},
+		transactions: map[[3]string]dte.BalanceTransaction{},
+	}
+	dteService := dte_documents.NewDTEService(ledger)
+	ctx := context.Background()
+
+	// 1. La nota de crédito descuenta su total del CCF, aunque sus operaciones se reintenten
+	operations := appdte.NewDTEOperations().GetCreditNoteOperations(dteService, nil)
+	require.NoError(t, operations(ctx, branchID, model))
+	require.NoError(t, operations(ctx, branchID, model))
+
+	assert.InDelta(t, 60, ledger.control.RemainingTaxedAmount, 0.001)
+	assert.InDelta(t, 15, ledger.control.RemainingExemptAmount, 0.001)
+	assert.InDelta(t, 8, ledger.control.RemainingNotSubjectAmount, 0.001)
+
+	// 2. Al invalidarla se recupera el monto de todos sus ítems en una transacción del mismo documento de ajuste
+	require.NoError(t, invalidation.NewInvalidationService(dteService).InvalidateDocument(ctx, branchID, creditNoteCode))
+
+	assert.InDelta(t, 100, ledger.control.RemainingTaxedAmount, 0.001)
+	assert.InDelta(t, 20, ledger.control.RemainingExemptAmount, 0.001)
+	assert.InDelta(t, 10, ledger.control.RemainingNotSubjectAmount, 0.001)
+	assert.Len(t, ledger.transactions, 2)
+}
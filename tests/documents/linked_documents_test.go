@@ -0,0 +1,173 @@
+package documents
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/ccf"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/ccf/ccf_models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/invoice"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/invoice/invoice_models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const (
+	branchID       = uint(1)
+	receivedCode   = "DA1E261A-BAD7-460F-AD15-04F2E281FC6A"
+	rejectedCode   = "5B0F3C8E-1D2A-4E6B-9C7D-8A9B0C1D2E3F"
+	missingCode    = "0E3C4B5A-6F7D-4821-9A3B-C4D5E6F7A8B9"
+	physicalNumber = "NR-000123"
+)
+
+// storedDocuments implementa la consulta de documentos por código de generación sobre un mapa en memoria
+type storedDocuments struct {
+	dte_documents.DTERepositoryPort
+	documents map[string]*dte.DTEDocument
+	lookups   []string
+}
+
+func (r *storedDocuments) GetByGenerationCode(_ context.Context, _ uint, id string) (*dte.DTEDocument, error) {
+	r.lookups = append(r.lookups, id)
+	document, ok := r.documents[id]
+	if !ok {
+		return nil, errors.New("record not found")
+	}
+	return document, nil
+}
+
+func newStoredDocuments(receivedAt time.Time) *storedDocuments {
+	remissionNote := func(code, status string) *dte.DTEDocument {
+		return &dte.DTEDocument{
+			ID:        code,
+			BranchID:  branchID,
+			CreatedAt: receivedAt,
+			Details:   &dte.DTEDetails{ID: code, DTEType: constants.NotaRemisionElectronica, Status: status},
+		}
+	}
+
+	return &storedDocuments{documents: map[string]*dte.DTEDocument{
+		receivedCode: remissionNote(receivedCode, constants.DocumentReceived),
+		rejectedCode: remissionNote(rejectedCode, constants.DocumentRejected),
+	}}
+}
+
+func relatedDocument(t *testing.T, generationType int, number string) models.RelatedDocument {
+	var related models.RelatedDocument
+	require.NoError(t, related.SetDocumentType(constants.NotaRemisionElectronica))
+	require.NoError(t, related.SetGenerationType(generationType))
+	require.NoError(t, related.SetDocumentNumber(number))
+	require.NoError(t, related.SetEmissionDate(time.Now().Add(-72*time.Hour)))
+	return related
+}
+
+func errorCode(t *testing.T, err error) string {
+	var serviceErr *shared_error.ServiceError
+	require.True(t, errors.As(err, &serviceErr), "expected a service error, got %v", err)
+	return serviceErr.GetCode()
+}
+
+// linkedDocumentCase describe los documentos relacionados declarados y el resultado esperado de su verificación. Cada
+// caso termina en un documento que no supera la verificación, por lo que la creación se detiene antes de validar el
+// resto del DTE.
+type linkedDocumentCase struct {
+	name      string
+	related   func(t *testing.T) []models.RelatedDocument
+	errorCode string
+	lookups   []string
+}
+
+var linkedDocumentCases = []linkedDocumentCase{
+	{
+		name: "Missing remission note",
+		related: func(t *testing.T) []models.RelatedDocument {
+			return []models.RelatedDocument{relatedDocument(t, constants.ElectronicDocument, missingCode)}
+		},
+		errorCode: "FailedToGetDTE",
+		lookups:   []string{missingCode},
+	},
+	{
+		name: "Remission note not received",
+		related: func(t *testing.T) []models.RelatedDocument {
+			return []models.RelatedDocument{relatedDocument(t, constants.ElectronicDocument, rejectedCode)}
+		},
+		errorCode: "RelatedDocumentNotReceived",
+		lookups:   []string{rejectedCode},
+	},
+	{
+		name: "Physical remission note is skipped",
+		related: func(t *testing.T) []models.RelatedDocument {
+			return []models.RelatedDocument{
+				relatedDocument(t, constants.PhysicalDocument, physicalNumber),
+				relatedDocument(t, constants.ElectronicDocument, missingCode),
+			}
+		},
+		errorCode: "FailedToGetDTE",
+		lookups:   []string{missingCode},
+	},
+	{
+		name: "Received remission note is accepted",
+		related: func(t *testing.T) []models.RelatedDocument {
+			return []models.RelatedDocument{
+				relatedDocument(t, constants.ElectronicDocument, receivedCode),
+				relatedDocument(t, constants.ElectronicDocument, missingCode),
+			}
+		},
+		errorCode: "FailedToGetDTE",
+		lookups:   []string{receivedCode, missingCode},
+	},
+}
+
+// assertLinkedDocuments verifica el error y las consultas realizadas. Los documentos recibidos toman la fecha de
+// emisión del documento almacenado en lugar de la declarada.
+func assertLinkedDocuments(t *testing.T, tt linkedDocumentCase, repo *storedDocuments, err error, related []models.RelatedDocument, receivedAt time.Time) {
+	assert.Equal(t, tt.errorCode, errorCode(t, err))
+	assert.Equal(t, tt.lookups, repo.lookups)
+
+	for _, doc := range related {
+		if doc.GetDocumentNumber() == receivedCode {
+			assert.Equal(t, receivedAt.Format(time.DateOnly), doc.GetEmissionDate().Format(time.DateOnly))
+		}
+	}
+}
+
+func TestInvoiceValidatesLinkedDocuments(t *testing.T) {
+	test.TestMain(t)
+	receivedAt := time.Now().Add(-24 * time.Hour)
+
+	for _, tt := range linkedDocumentCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStoredDocuments(receivedAt)
+			service := invoice.NewInvoiceService(nil, dte_documents.NewDTEService(repo))
+			data := &invoice_models.InvoiceData{InputDataCommon: &models.InputDataCommon{RelatedDocs: tt.related(t)}}
+
+			_, err := service.Create(context.Background(), data, branchID)
+			assertLinkedDocuments(t, tt, repo, err, data.RelatedDocs, receivedAt)
+		})
+	}
+}
+
+func TestCCFValidatesLinkedDocuments(t *testing.T) {
+	test.TestMain(t)
+	receivedAt := time.Now().Add(-24 * time.Hour)
+
+	for _, tt := range linkedDocumentCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStoredDocuments(receivedAt)
+			service := ccf.NewCCFService(nil, dte_documents.NewDTEService(repo))
+			data := &ccf_models.CCFData{InputDataCommon: &models.InputDataCommon{RelatedDocs: tt.related(t)}}
+
+			_, err := service.Create(context.Background(), data, branchID)
+			assertLinkedDocuments(t, tt, repo, err, data.RelatedDocs, receivedAt)
+		})
+	}
+}
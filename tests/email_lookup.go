@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/badoux/checkmail"
+)
+
+// SkipWithoutMXLookup omite la prueba cuando no es posible verificar el dominio del correo, por ejemplo sin acceso a la
+// red. Se usa en los casos válidos de documentos cuyo receptor requiere un correo, que se verifica contra su registro MX.
+func SkipWithoutMXLookup(t *testing.T, email string) {
+	if err := checkmail.ValidateMX(email); err != nil {
+		t.Skipf("MX lookup for %s is not available: %v", email, err)
+	}
+}
@@ -0,0 +1,70 @@
+package emission
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+// updatedDocuments registra las actualizaciones de los documentos
+type updatedDocuments struct {
+	dte_documents.DTERepositoryPort
+	created []interface{}
+	updates []dte.DTEDetails
+}
+
+func (r *updatedDocuments) Create(_ context.Context, document interface{}, _, _ string, _ *string) error {
+	r.created = append(r.created, document)
+	return nil
+}
+
+func (r *updatedDocuments) Update(_ context.Context, _ uint, document dte.DTEDetails) error {
+	r.updates = append(r.updates, document)
+	return nil
+}
+
+const pendingDocument = `{"identificacion":{"tipoDte":"01","codigoGeneracion":"DOC-1"},"apendice":null}`
+
+func TestPendingDocumentIsStoredWithoutReceptionStamp(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &updatedDocuments{}
+	service := dte_documents.NewDTEService(repo)
+
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(pendingDocument), &document))
+
+	require.NoError(t, service.Create(context.Background(), document, constants.TransmissionNormal, constants.DocumentPending, nil))
+	assert.Len(t, repo.created, 1)
+	assert.Nil(t, document["apendice"])
+}
+
+func TestMarkReceivedAddsReceptionStampToAppendix(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &updatedDocuments{}
+	service := dte_documents.NewDTEService(repo)
+	stamp := "2025ABCDEF"
+
+	document := &dte.DTEDocument{Details: &dte.DTEDetails{ID: "DOC-1", JSONData: pendingDocument}}
+	require.NoError(t, service.MarkReceived(context.Background(), 1, document, &stamp))
+
+	require.Len(t, repo.updates, 1)
+	update := repo.updates[0]
+	assert.Equal(t, constants.DocumentReceived, update.Status)
+	assert.Equal(t, &stamp, update.ReceptionStamp)
+
+	var stored struct {
+		Apendice []map[string]string `json:"apendice"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(update.JSONData), &stored))
+	assert.Equal(t, []map[string]string{{"campo": "Datos del documento", "etiqueta": "Sello de recepción", "valor": stamp}}, stored.Apendice)
+}
@@ -0,0 +1,254 @@
+package emission
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/emission/models"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+// memoryRepository implementa EmissionRepositoryPort en memoria
+type memoryRepository struct {
+	mu     sync.Mutex
+	nextID uint
+	jobs   map[uint]models.EmissionJob
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{jobs: make(map[uint]models.EmissionJob)}
+}
+
+func (r *memoryRepository) Create(_ context.Context, job *models.EmissionJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	job.ID = r.nextID
+	r.jobs[job.ID] = *job
+	return nil
+}
+
+func (r *memoryRepository) GetReady(_ context.Context, now time.Time, limit int) ([]models.EmissionJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ready := make([]models.EmissionJob, 0)
+	for _, job := range r.jobs {
+		if isReady(job, now) && len(ready) < limit {
+			ready = append(ready, job)
+		}
+	}
+	return ready, nil
+}
+
+func (r *memoryRepository) Claim(_ context.Context, id uint, now, until time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok || !isReady(job, now) {
+		return false, nil
+	}
+	job.Status = models.JobProcessing
+	job.LockedUntil = &until
+	job.Attempts++
+	r.jobs[id] = job
+	return true, nil
+}
+
+func (r *memoryRepository) Update(_ context.Context, job *models.EmissionJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := r.jobs[job.ID]
+	stored.Status = job.Status
+	stored.Attempts = job.Attempts
+	stored.LastError = job.LastError
+	stored.NextAttemptAt = job.NextAttemptAt
+	stored.LockedUntil = job.LockedUntil
+	r.jobs[job.ID] = stored
+	return nil
+}
+
+func (r *memoryRepository) GetByDocumentID(_ context.Context, branchID uint, documentID string) (*models.EmissionJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, job := range r.jobs {
+		if job.BranchID == branchID && job.DocumentID == documentID {
+			return &job, nil
+		}
+	}
+	return nil, errPackage.ErrEmissionJobNotFound
+}
+
+// expire adelanta el próximo intento de un job para que esté listo de inmediato
+func (r *memoryRepository) expire(documentID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	past := time.Now().Add(-time.Second)
+	for id, job := range r.jobs {
+		if job.DocumentID == documentID {
+			job.NextAttemptAt = &past
+			r.jobs[id] = job
+		}
+	}
+}
+
+func isReady(job models.EmissionJob, now time.Time) bool {
+	switch job.Status {
+	case models.JobQueued:
+		return job.NextAttemptAt != nil && !job.NextAttemptAt.After(now)
+	case models.JobProcessing:
+		return job.LockedUntil != nil && !job.LockedUntil.After(now)
+	}
+	return false
+}
+
+func newService(repo *memoryRepository, maxAttempts int) emission.EmissionQueueManager {
+	return emission.NewEmissionQueueService(repo, maxAttempts)
+}
+
+func TestEnqueueQueuesJobAndClaimReservesIt(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	service := newService(repo, 3)
+
+	require.NoError(t, service.Enqueue(ctx, 1, "DOC-1", "01"))
+
+	stored, err := repo.GetByDocumentID(ctx, 1, "DOC-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.JobQueued, stored.Status)
+
+	job, err := service.ClaimNext(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, "DOC-1", job.DocumentID)
+	assert.Equal(t, models.JobProcessing, job.Status)
+	assert.Equal(t, 1, job.Attempts)
+
+	// El job reservado no puede ser tomado por otro worker
+	next, err := service.ClaimNext(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, next)
+}
+
+func TestFailReschedulesUntilAttemptsAreExhausted(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	service := newService(repo, 2)
+
+	require.NoError(t, service.Enqueue(ctx, 1, "DOC-1", "01"))
+
+	// 1. Primer intento fallido, el job vuelve a la cola con espera
+	job, err := service.ClaimNext(ctx)
+	require.NoError(t, err)
+	require.NoError(t, service.Fail(ctx, job, assert.AnError, true))
+
+	stored, _ := repo.GetByDocumentID(ctx, 1, "DOC-1")
+	assert.Equal(t, models.JobQueued, stored.Status)
+	assert.Equal(t, assert.AnError.Error(), stored.LastError)
+	require.NotNil(t, stored.NextAttemptAt)
+	assert.True(t, stored.NextAttemptAt.After(time.Now()))
+
+	next, err := service.ClaimNext(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, next, "the job must wait for its next attempt")
+
+	// 2. Segundo intento fallido, se agotan los intentos
+	repo.expire("DOC-1")
+	job, err = service.ClaimNext(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, 2, job.Attempts)
+	require.NoError(t, service.Fail(ctx, job, assert.AnError, true))
+
+	stored, _ = repo.GetByDocumentID(ctx, 1, "DOC-1")
+	assert.Equal(t, models.JobFailed, stored.Status)
+	assert.Nil(t, stored.NextAttemptAt)
+}
+
+func TestFailWithoutRetryAndFinish(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	service := newService(repo, 5)
+
+	require.NoError(t, service.Enqueue(ctx, 1, "DOC-1", "01"))
+	require.NoError(t, service.Enqueue(ctx, 1, "DOC-2", "03"))
+
+	first, err := service.ClaimNext(ctx)
+	require.NoError(t, err)
+	require.NoError(t, service.Fail(ctx, first, assert.AnError, false))
+
+	second, err := service.ClaimNext(ctx)
+	require.NoError(t, err)
+	require.NoError(t, service.Finish(ctx, second, models.JobContingency))
+
+	failed, err := service.GetJob(ctx, 1, first.DocumentID)
+	require.NoError(t, err)
+	assert.Equal(t, models.JobFailed, failed.Status)
+
+	finished, err := service.GetJob(ctx, 1, second.DocumentID)
+	require.NoError(t, err)
+	assert.Equal(t, models.JobContingency, finished.Status)
+	assert.True(t, finished.IsFinished())
+
+	missing, err := service.GetJob(ctx, 1, "DOC-3")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestRescheduleDoesNotConsumeAttempts(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	service := newService(repo, 1)
+
+	require.NoError(t, service.Enqueue(ctx, 1, "DOC-1", "01"))
+
+	// 1. El job se reprograma mientras la sucursal no tenga credenciales, aunque solo admita un intento
+	for i := 0; i < 3; i++ {
+		repo.expire("DOC-1")
+		job, err := service.ClaimNext(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, job)
+		require.NoError(t, service.Reschedule(ctx, job, assert.AnError, emission.CredentialsWaitDelay))
+
+		stored, _ := repo.GetByDocumentID(ctx, 1, "DOC-1")
+		assert.Equal(t, models.JobQueued, stored.Status)
+		assert.Equal(t, 0, stored.Attempts)
+		assert.Equal(t, assert.AnError.Error(), stored.LastError)
+		require.NotNil(t, stored.NextAttemptAt)
+		assert.True(t, stored.NextAttemptAt.After(time.Now().Add(emission.CredentialsWaitDelay-time.Minute)))
+	}
+
+	next, err := service.ClaimNext(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, next, "the job must wait for the branch credentials")
+
+	// 2. Al retomarlo conserva su único intento
+	repo.expire("DOC-1")
+	job, err := service.ClaimNext(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, 1, job.Attempts)
+}
+
+func TestNextRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, emission.NextRetryDelay(1))
+	assert.Equal(t, time.Minute, emission.NextRetryDelay(2))
+	assert.Equal(t, 4*time.Minute, emission.NextRetryDelay(4))
+	assert.Equal(t, emission.RetryMaxDelay, emission.NextRetryDelay(10))
+}
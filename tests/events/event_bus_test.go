@@ -0,0 +1,163 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/events/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
+	webhookModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook/models"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const (
+	userID   = uint(7)
+	branchID = uint(3)
+)
+
+// memoryRepository implementa EventRepositoryPort en memoria, branchOwners relaciona cada sucursal con su dueño
+type memoryRepository struct {
+	mu           sync.Mutex
+	events       []event.DomainEvent
+	branchOwners map[uint]uint
+	err          error
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{branchOwners: map[uint]uint{branchID: userID}}
+}
+
+func (r *memoryRepository) Create(_ context.Context, domainEvent *event.DomainEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return r.err
+	}
+	if domainEvent.UserID == 0 {
+		domainEvent.UserID = r.branchOwners[domainEvent.BranchID]
+	}
+
+	domainEvent.ID = uint(len(r.events) + 1)
+	r.events = append(r.events, *domainEvent)
+	return nil
+}
+
+func (r *memoryRepository) List(_ context.Context, userID uint, filters *models.EventFilters) ([]event.DomainEvent, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matching []event.DomainEvent
+	for i := len(r.events) - 1; i >= 0; i-- {
+		if r.events[i].UserID == userID && (filters.EventType == "" || r.events[i].EventType == filters.EventType) {
+			matching = append(matching, r.events[i])
+		}
+	}
+
+	start := min((filters.Page-1)*filters.PageSize, len(matching))
+	end := min(start+filters.PageSize, len(matching))
+	return matching[start:end], int64(len(matching)), nil
+}
+
+// fakePublisher registra los eventos publicados hacia los webhooks
+type fakePublisher struct {
+	published []publishedEvent
+}
+
+type publishedEvent struct {
+	branchID  uint
+	eventType string
+	data      interface{}
+}
+
+func (p *fakePublisher) Publish(_ context.Context, branchID uint, eventType string, data interface{}) {
+	p.published = append(p.published, publishedEvent{branchID: branchID, eventType: eventType, data: data})
+}
+
+func TestPublishStoresEventAndDispatchesToSubscribers(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	bus := events.NewEventBus(repo)
+
+	var rejected, all []event.DomainEvent
+	bus.Subscribe(event.DTERejected, func(_ context.Context, domainEvent event.DomainEvent) {
+		rejected = append(rejected, domainEvent)
+	})
+	bus.Subscribe(event.AllEvents, func(_ context.Context, domainEvent event.DomainEvent) {
+		all = append(all, domainEvent)
+	})
+
+	bus.Publish(ctx, branchID, event.DTEReceived, event.DTEPayload{GenerationCode: "DOC-1"})
+	bus.Publish(ctx, branchID, event.DTERejected, event.DTEPayload{GenerationCode: "DOC-2", Reason: "invalid"})
+
+	require.Len(t, repo.events, 2)
+	assert.Equal(t, userID, repo.events[0].UserID, "the event must belong to the branch owner")
+	assert.NotEmpty(t, repo.events[0].OccurredAt)
+
+	require.Len(t, rejected, 1)
+	assert.Equal(t, uint(2), rejected[0].ID, "subscribers must receive the stored event")
+	assert.JSONEq(t, `{"generation_code":"DOC-2","reason":"invalid"}`, rejected[0].Payload)
+	assert.Len(t, all, 2)
+}
+
+func TestPublishDoesNotDispatchEventsThatCouldNotBeStored(t *testing.T) {
+	test.TestMain(t)
+	repo := newMemoryRepository()
+	repo.err = errors.New("database unavailable")
+	bus := events.NewEventBus(repo)
+
+	dispatched := 0
+	bus.Subscribe(event.AllEvents, func(context.Context, event.DomainEvent) { dispatched++ })
+
+	bus.Publish(context.Background(), branchID, event.DTEInvalidated, event.DTEPayload{GenerationCode: "DOC-1"})
+	assert.Zero(t, dispatched)
+}
+
+func TestListPaginatesUserEvents(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	bus := events.NewEventBus(repo)
+
+	for i := 0; i < 5; i++ {
+		bus.Publish(ctx, branchID, event.DTEReceived, event.DTEPayload{GenerationCode: "DOC"})
+	}
+	bus.Publish(ctx, 99, event.DTEReceived, event.DTEPayload{GenerationCode: "OTHER"})
+
+	page, err := bus.List(ctx, userID, &models.EventFilters{Page: 2, PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Events, 2)
+	assert.Equal(t, uint(3), page.Events[0].ID, "events must be returned from the most recent")
+	assert.Equal(t, int64(5), page.Pagination.TotalItems)
+	assert.Equal(t, 3, page.Pagination.TotalPages)
+
+	page, err = bus.List(ctx, userID, &models.EventFilters{PageSize: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, 1, page.Pagination.Page)
+	assert.Equal(t, events.MaxPageSize, page.Pagination.PageSize)
+}
+
+func TestWebhookHandlerForwardsMappedEvents(t *testing.T) {
+	publisher := &fakePublisher{}
+	handler := webhook.EventHandler(publisher)
+
+	handler(context.Background(), event.DomainEvent{BranchID: branchID, EventType: event.DTEReceived, Payload: `{"generation_code":"DOC-1"}`})
+	handler(context.Background(), event.DomainEvent{BranchID: branchID, EventType: event.UserRegistered, Payload: `{}`})
+
+	require.Len(t, publisher.published, 1, "events without a webhook equivalent must be ignored")
+	assert.Equal(t, branchID, publisher.published[0].branchID)
+	assert.Equal(t, webhookModels.EventDTEReceived, publisher.published[0].eventType)
+
+	data, err := json.Marshal(publisher.published[0].data)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"generation_code":"DOC-1"}`, string(data))
+}
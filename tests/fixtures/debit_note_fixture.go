@@ -0,0 +1,95 @@
+package fixtures
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// CreateDefaultDebitNoteItem crea un ítem de nota de débito predeterminado válido
+func CreateDefaultDebitNoteItem(index int) structs.DebitNoteItemRequest {
+	code := "DN" + string(rune(65+index))
+
+	return structs.DebitNoteItemRequest{
+		ItemRequest: structs.ItemRequest{
+			Number:      index + 1,
+			Type:        2, // Servicio
+			Description: "Intereses por mora " + string(rune(65+index)),
+			Quantity:    5,
+			UnitMeasure: 59, // Unidades
+			UnitPrice:   5.0,
+			Discount:    0,
+			Code:        &code,
+			Taxes:       []string{"20"}, // Código IVA
+		},
+		NonSubjectSale: 0,
+		ExemptSale:     0,
+		TaxedSale:      25.0, // Cantidad * Precio unitario
+	}
+}
+
+// CreateDefaultDebitNoteSummary crea un resumen de nota de débito predeterminado válido
+func CreateDefaultDebitNoteSummary() *structs.DebitNoteSummaryRequest {
+	return &structs.DebitNoteSummaryRequest{
+		SummaryRequest: structs.SummaryRequest{
+			TotalNonSubject:    0,
+			TotalExempt:        0,
+			TotalTaxed:         50.0,
+			SubTotal:           50.0,
+			NonSubjectDiscount: 0,
+			ExemptDiscount:     0,
+			DiscountPercentage: 0,
+			TotalDiscount:      0,
+			SubTotalSales:      50.0,
+			TotalOperation:     56.5,
+			TotalNonTaxed:      0,
+			TotalToPay:         1, // Por convención en Notas de Débito
+			OperationCondition: 1, // Contado
+			Taxes: []structs.TaxRequest{
+				{
+					Code:        "20", // Código IVA
+					Description: "IVA",
+					Value:       6.5, // 13% del monto gravado
+				},
+			},
+			PaymentTypes: []structs.PaymentRequest{},
+		},
+		TaxedDiscount:   0,
+		IVAPerception:   0,
+		IVARetention:    0,
+		IncomeRetention: 0,
+	}
+}
+
+// CreateDefaultDebitNoteRequest crea una solicitud de nota de débito predeterminada válida
+func CreateDefaultDebitNoteRequest() *structs.CreateDebitNoteRequest {
+	items := []structs.DebitNoteItemRequest{
+		CreateDefaultDebitNoteItem(1),
+		CreateDefaultDebitNoteItem(2),
+	}
+
+	receiver := CreateDefaultReceiver()
+	receiver.NIT = utils.ToStringPointer("06141804941035")
+	receiver.DocumentType = nil
+	receiver.DocumentNumber = nil
+
+	return &structs.CreateDebitNoteRequest{
+		Items:     items,
+		Receiver:  receiver,
+		ModelType: constants.ModeloFacturacionPrevio, // Modelo normal
+		Summary:   CreateDefaultDebitNoteSummary(),
+		RelatedDocs: []structs.RelatedDocRequest{
+			CreateDefaultRelatedDocument(),
+		},
+	}
+}
+
+// CreateDebitNoteRequestWithAllOptionalFields crea una solicitud de nota de débito con todos los campos opcionales
+func CreateDebitNoteRequestWithAllOptionalFields() *structs.CreateDebitNoteRequest {
+	req := CreateDefaultDebitNoteRequest()
+	req.Extension = CreateDefaultCreditNoteExtension()
+	req.ThirdPartySale = CreateDefaultThirdPartySale()
+	req.OtherDocs = []structs.OtherDocRequest{CreateDefaultOtherDocument()}
+	req.Appendixes = []structs.AppendixRequest{CreateDefaultAppendix()}
+	return req
+}
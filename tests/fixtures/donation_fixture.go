@@ -0,0 +1,84 @@
+package fixtures
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// CreateDefaultDonationItems crea una donación en efectivo y una donación de bienes predeterminadas válidas
+func CreateDefaultDonationItems() []structs.DonationItemRequest {
+	return []structs.DonationItemRequest{
+		{
+			Type:         constants.DonacionEfectivo,
+			Description:  "Donación en efectivo",
+			Quantity:     1,
+			UnitMeasure:  99,
+			Depreciation: 0,
+			UnitValue:    500.0,
+			Value:        500.0,
+		},
+		{
+			Type:         constants.DonacionBien,
+			Description:  "Computadoras portátiles",
+			Quantity:     5,
+			UnitMeasure:  59,
+			Code:         utils.ToStringPointer("EQ-LAPTOP"),
+			Depreciation: 250.0,
+			UnitValue:    300.0,
+			Value:        1250.0, // 5 * 300 - 250
+		},
+	}
+}
+
+// CreateDefaultDonor crea un donante domiciliado predeterminado válido
+func CreateDefaultDonor() *structs.DonorRequest {
+	return &structs.DonorRequest{
+		DocumentType:   utils.ToStringPointer(constants.NIT),
+		DocumentNumber: utils.ToStringPointer("06141809931020"),
+		Name:           utils.ToStringPointer("DISTRIBUIDORA EL SOL SA DE CV"),
+		Address: &structs.AddressRequest{
+			Department:   "06",
+			Municipality: "20",
+			Complement:   "Colonia Escalón, San Salvador",
+		},
+		DomiciledCode: utils.ToIntPointer(constants.DonanteDomiciliado),
+		CountryCode:   utils.ToStringPointer(constants.CodigoPaisElSalvador),
+	}
+}
+
+// CreateDefaultDonationRequest crea una solicitud de comprobante de donación predeterminada válida
+func CreateDefaultDonationRequest() *structs.CreateDonationRequest {
+	return &structs.CreateDonationRequest{
+		Items: CreateDefaultDonationItems(),
+		Donor: CreateDefaultDonor(),
+		OtherDocs: []structs.OtherDocRequest{
+			{
+				DocumentCode: constants.DocumentoEmisor,
+				Description:  utils.ToStringPointer("Acuerdo de calificación como entidad de utilidad pública"),
+				Detail:       utils.ToStringPointer("Acuerdo ejecutivo No. 123"),
+			},
+		},
+		Summary: &structs.DonationSummaryRequest{
+			TotalValue: 1750.0,
+			PaymentTypes: []structs.PaymentRequest{
+				{
+					Code:   constants.BilletesMonedas,
+					Amount: 500.0,
+				},
+			},
+		},
+	}
+}
+
+// CreateDonationRequestWithAllOptionalFields crea una solicitud de comprobante de donación con todos los campos opcionales
+func CreateDonationRequestWithAllOptionalFields() *structs.CreateDonationRequest {
+	req := CreateDefaultDonationRequest()
+	req.Donor.NRC = utils.ToStringPointer("1234567")
+	req.Donor.ActivityCode = utils.ToStringPointer("46900")
+	req.Donor.ActivityDesc = utils.ToStringPointer("Venta al por mayor de otros productos")
+	req.Donor.Phone = utils.ToStringPointer("22223333")
+	req.Summary.TotalInWords = utils.ToStringPointer("UN MIL SETECIENTOS CINCUENTA 00/100")
+	req.Appendixes = []structs.AppendixRequest{CreateDefaultAppendix()}
+	return req
+}
@@ -0,0 +1,106 @@
+package fixtures
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// CreateDefaultExportItem crea un ítem de factura de exportación predeterminado válido
+func CreateDefaultExportItem(index int) structs.ExportItemRequest {
+	code := "EXP" + string(rune(65+index))
+
+	return structs.ExportItemRequest{
+		Type:        1, // Bien
+		Description: "Café oro lavado " + string(rune(65+index)),
+		Quantity:    10,
+		UnitMeasure: 59, // Unidades
+		UnitPrice:   25.0,
+		Discount:    0,
+		Code:        &code,
+		Taxes:       []string{constants.TaxIVAExport},
+		TaxedSale:   250.0, // Cantidad * Precio unitario
+		NonTaxed:    0,
+	}
+}
+
+// CreateDefaultForeignReceiver crea un receptor extranjero predeterminado válido
+func CreateDefaultForeignReceiver() *structs.ForeignReceiverRequest {
+	personType := constants.PersonaJuridica
+
+	return &structs.ForeignReceiverRequest{
+		Name:           utils.ToStringPointer("GLOBAL IMPORTS LLC"),
+		DocumentType:   utils.ToStringPointer(constants.OtroDocumento),
+		DocumentNumber: utils.ToStringPointer("EIN-12-3456789"),
+		CountryCode:    utils.ToStringPointer("9450"),
+		CountryName:    utils.ToStringPointer("ESTADOS UNIDOS"),
+		Complement:     utils.ToStringPointer("1200 Brickell Ave, Miami, FL"),
+		PersonType:     &personType,
+	}
+}
+
+// CreateDefaultExportData crea los datos de exportación predeterminados válidos para bienes
+func CreateDefaultExportData() *structs.ExportDataRequest {
+	return &structs.ExportDataRequest{
+		ItemExportType: constants.ExportBienes,
+		FiscalPrecinct: utils.ToStringPointer("02"),
+		Regime:         utils.ToStringPointer("EX-1.1000.000"),
+	}
+}
+
+// CreateDefaultExportSummary crea un resumen de factura de exportación predeterminado válido
+func CreateDefaultExportSummary() *structs.ExportSummaryRequest {
+	return &structs.ExportSummaryRequest{
+		TotalTaxed:         500.0,
+		Discount:           0,
+		DiscountPercentage: 0,
+		TotalDiscount:      0,
+		Insurance:          20.0,
+		Freight:            30.0,
+		TotalOperation:     550.0, // Total gravado + seguro + flete
+		TotalNonTaxed:      0,
+		TotalToPay:         550.0,
+		OperationCondition: 1, // Contado
+		PaymentTypes: []structs.PaymentRequest{
+			{
+				Code:   "05", // Transferencia
+				Amount: 550.0,
+			},
+		},
+	}
+}
+
+// CreateDefaultExportInvoiceRequest crea una solicitud de factura de exportación predeterminada válida
+func CreateDefaultExportInvoiceRequest() *structs.CreateExportInvoiceRequest {
+	return &structs.CreateExportInvoiceRequest{
+		Items: []structs.ExportItemRequest{
+			CreateDefaultExportItem(0),
+			CreateDefaultExportItem(1),
+		},
+		Receiver:   CreateDefaultForeignReceiver(),
+		ExportData: CreateDefaultExportData(),
+		Summary:    CreateDefaultExportSummary(),
+	}
+}
+
+// CreateExportInvoiceRequestWithAllOptionalFields crea una solicitud de factura de exportación con todos los campos opcionales
+func CreateExportInvoiceRequestWithAllOptionalFields() *structs.CreateExportInvoiceRequest {
+	req := CreateDefaultExportInvoiceRequest()
+
+	req.Receiver.CommercialName = utils.ToStringPointer("GLOBAL IMPORTS")
+	req.Receiver.ActivityDesc = utils.ToStringPointer("Importación de alimentos")
+	req.Receiver.Phone = utils.ToStringPointer("13055550100")
+	// El correo se omite para evitar la verificación del dominio
+	req.Summary.IncotermCode = utils.ToStringPointer("11")
+	req.Summary.Observations = utils.ToStringPointer("Embarque por Puerto de Acajutla")
+
+	req.Appendixes = []structs.AppendixRequest{
+		{
+			Field: "vendedor",
+			Label: "Vendedor",
+			Value: "Juan Pérez",
+		},
+	}
+
+	return req
+}
@@ -0,0 +1,90 @@
+package fixtures
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// CreateDefaultFSEItem crea un ítem de factura sujeto excluido predeterminado válido
+func CreateDefaultFSEItem(index int) structs.FSEItemRequest {
+	code := "FSE" + string(rune(65+index))
+
+	return structs.FSEItemRequest{
+		Type:        1, // Bien
+		Description: "Compra de granos básicos " + string(rune(65+index)),
+		Quantity:    10,
+		UnitMeasure: 59, // Unidades
+		UnitPrice:   5.0,
+		Discount:    0,
+		Code:        &code,
+		Purchase:    50.0, // Cantidad * Precio unitario
+	}
+}
+
+// CreateDefaultExcludedSubject crea un sujeto excluido predeterminado válido
+func CreateDefaultExcludedSubject() *structs.ExcludedSubjectRequest {
+	return &structs.ExcludedSubjectRequest{
+		DocumentType:   utils.ToStringPointer(constants.DUI),
+		DocumentNumber: utils.ToStringPointer("00000000-0"),
+		Name:           utils.ToStringPointer("JUAN PEREZ"),
+		Address: &structs.AddressRequest{
+			Department:   "06",
+			Municipality: "20",
+			Complement:   "Cantón El Rosario",
+		},
+	}
+}
+
+// CreateDefaultFSESummary crea un resumen de factura sujeto excluido predeterminado válido
+func CreateDefaultFSESummary() *structs.FSESummaryRequest {
+	return &structs.FSESummaryRequest{
+		TotalPurchase:      100.0,
+		Discount:           0,
+		TotalDiscount:      0,
+		SubTotal:           100.0,
+		IVARetention:       0,
+		IncomeRetention:    10.0, // 10% de retención de renta
+		TotalToPay:         90.0,
+		OperationCondition: 1, // Contado
+		PaymentTypes: []structs.PaymentRequest{
+			{
+				Code:   "01", // Efectivo
+				Amount: 90.0,
+			},
+		},
+	}
+}
+
+// CreateDefaultFSERequest crea una solicitud de factura sujeto excluido predeterminada válida
+func CreateDefaultFSERequest() *structs.CreateFSERequest {
+	return &structs.CreateFSERequest{
+		Items: []structs.FSEItemRequest{
+			CreateDefaultFSEItem(0),
+			CreateDefaultFSEItem(1),
+		},
+		ExcludedSubject: CreateDefaultExcludedSubject(),
+		Summary:         CreateDefaultFSESummary(),
+	}
+}
+
+// CreateFSERequestWithAllOptionalFields crea una solicitud de factura sujeto excluido con todos los campos opcionales
+func CreateFSERequestWithAllOptionalFields() *structs.CreateFSERequest {
+	req := CreateDefaultFSERequest()
+
+	req.ExcludedSubject.ActivityCode = utils.ToStringPointer("01111")
+	req.ExcludedSubject.ActivityDesc = utils.ToStringPointer("Cultivo de cereales")
+	req.ExcludedSubject.Phone = utils.ToStringPointer("77777777")
+	// El correo se omite para evitar la verificación del dominio
+	req.Summary.Observations = utils.ToStringPointer("Compra a productor local")
+
+	req.Appendixes = []structs.AppendixRequest{
+		{
+			Field: "vendedor",
+			Label: "Vendedor",
+			Value: "Juan Pérez",
+		},
+	}
+
+	return req
+}
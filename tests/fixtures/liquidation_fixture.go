@@ -0,0 +1,114 @@
+package fixtures
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// CreateDefaultLiquidationItem crea un documento liquidado físico predeterminado válido
+func CreateDefaultLiquidationItem(index int) structs.LiquidationItemRequest {
+	return structs.LiquidationItemRequest{
+		DTEType:        constants.CCFElectronico,
+		GenerationType: constants.PhysicalDocument,
+		DocumentNumber: "000123" + string(rune(65+index)),
+		GenerationDate: utils.ToStringPointer("2025-04-10"),
+		NonSubjectSale: 0,
+		ExemptSale:     0,
+		TaxedSale:      100.0,
+		ExportSale:     0,
+		Taxes:          []string{constants.TaxIVA},
+		IVAItem:        13.0, // 13% de la venta gravada
+	}
+}
+
+// CreateDefaultLiquidationSummary crea un resumen de comprobante de liquidación predeterminado válido para dos documentos
+func CreateDefaultLiquidationSummary() *structs.LiquidationSummaryRequest {
+	return &structs.LiquidationSummaryRequest{
+		TotalNonSubject: 0,
+		TotalExempt:     0,
+		TotalTaxed:      200.0,
+		TotalExport:     0,
+		SubTotalSales:   200.0,
+		Taxes: []structs.TaxRequest{
+			{
+				Code:        constants.TaxIVA,
+				Description: "IVA",
+				Value:       26.0,
+			},
+		},
+		TotalOperation:     226.0,
+		IVAPerception:      2.0, // 1% del total gravado
+		Total:              228.0,
+		OperationCondition: constants.Cash,
+	}
+}
+
+// CreateDefaultLiquidationRequest crea una solicitud de comprobante de liquidación predeterminada válida
+func CreateDefaultLiquidationRequest() *structs.CreateLiquidationRequest {
+	// El receptor por defecto no incluye correo para evitar la verificación del dominio
+	receiver := CreateDefaultReceiverWithoutDocsFields()
+	receiver.Email = nil
+
+	return &structs.CreateLiquidationRequest{
+		Items: []structs.LiquidationItemRequest{
+			CreateDefaultLiquidationItem(0),
+			CreateDefaultLiquidationItem(1),
+		},
+		Receiver: receiver,
+		Summary:  CreateDefaultLiquidationSummary(),
+	}
+}
+
+// CreateLiquidationRequestWithAllOptionalFields crea una solicitud de comprobante de liquidación con todos los campos opcionales
+func CreateLiquidationRequestWithAllOptionalFields() *structs.CreateLiquidationRequest {
+	req := CreateDefaultLiquidationRequest()
+	req.Items[0].Observation = utils.ToStringPointer("Venta en consignación")
+	req.Extension = CreateDefaultCreditNoteExtension()
+	req.Appendixes = []structs.AppendixRequest{CreateDefaultAppendix()}
+	return req
+}
+
+// CreateDefaultAccountingLiquidationBody crea un cuerpo de documento contable de liquidación predeterminado válido
+func CreateDefaultAccountingLiquidationBody() *structs.AccountingLiquidationBodyRequest {
+	return &structs.AccountingLiquidationBodyRequest{
+		PeriodStart:               "2025-04-01",
+		PeriodEnd:                 "2025-04-15",
+		LiquidationCode:           "LIQ-0001",
+		DocumentCount:             5,
+		OperationsValue:           1000.0,
+		AmountWithoutPerception:   0,
+		SubTotal:                  1000.0,
+		IVA:                       130.0,
+		AmountSubjectToPerception: 1000.0,
+		IVAPerceived:              10.0,
+		Commission:                50.0,
+		CommissionPercentage:      5.0,
+		CommissionIVA:             6.5,
+		NetAmountToPay:            1063.5, // 1000 + 130 - 10 - 50 - 6.5
+	}
+}
+
+// CreateDefaultAccountingLiquidationRequest crea una solicitud de documento contable de liquidación predeterminada válida
+func CreateDefaultAccountingLiquidationRequest() *structs.CreateAccountingLiquidationRequest {
+	receiver := CreateDefaultReceiverWithoutDocsFields()
+	receiver.Email = nil
+
+	return &structs.CreateAccountingLiquidationRequest{
+		Receiver: receiver,
+		Body:     CreateDefaultAccountingLiquidationBody(),
+	}
+}
+
+// CreateAccountingLiquidationRequestWithAllOptionalFields crea una solicitud de documento contable de liquidación con todos los campos opcionales
+func CreateAccountingLiquidationRequestWithAllOptionalFields() *structs.CreateAccountingLiquidationRequest {
+	req := CreateDefaultAccountingLiquidationRequest()
+	req.Body.Observations = utils.ToStringPointer("Liquidación quincenal")
+	req.Extension = &structs.AccountingLiquidationExtensionRequest{
+		DeliveryName:     "Juan Pérez",
+		DeliveryDocument: "06141809931020",
+		EmployeeCode:     utils.ToStringPointer("EMP-001"),
+	}
+	req.Appendixes = []structs.AppendixRequest{CreateDefaultAppendix()}
+	return req
+}
@@ -0,0 +1,85 @@
+package fixtures
+
+import (
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+)
+
+// CreateDefaultRemissionNoteItem crea un ítem de nota de remisión predeterminado válido
+func CreateDefaultRemissionNoteItem(index int) structs.RemissionNoteItemRequest {
+	code := "NR" + string(rune(65+index))
+
+	return structs.RemissionNoteItemRequest{
+		ItemRequest: structs.ItemRequest{
+			Number:      index + 1,
+			Type:        1, // Bien
+			Description: "Mercadería en traslado " + string(rune(65+index)),
+			Quantity:    5,
+			UnitMeasure: 59, // Unidades
+			UnitPrice:   5.0,
+			Discount:    0,
+			Code:        &code,
+			Taxes:       []string{"20"}, // Código IVA
+		},
+		NonSubjectSale: 0,
+		ExemptSale:     0,
+		TaxedSale:      25.0, // Cantidad * Precio unitario
+	}
+}
+
+// CreateDefaultRemissionNoteSummary crea un resumen de nota de remisión predeterminado válido
+func CreateDefaultRemissionNoteSummary() *structs.RemissionNoteSummaryRequest {
+	return &structs.RemissionNoteSummaryRequest{
+		SummaryRequest: structs.SummaryRequest{
+			TotalNonSubject:    0,
+			TotalExempt:        0,
+			TotalTaxed:         50.0,
+			SubTotal:           50.0,
+			NonSubjectDiscount: 0,
+			ExemptDiscount:     0,
+			DiscountPercentage: 0,
+			TotalDiscount:      0,
+			SubTotalSales:      50.0,
+			TotalOperation:     56.5,
+			TotalNonTaxed:      0,
+			Taxes: []structs.TaxRequest{
+				{
+					Code:        "20", // Código IVA
+					Description: "IVA",
+					Value:       6.5, // 13% del monto gravado
+				},
+			},
+		},
+		TaxedDiscount: 0,
+	}
+}
+
+// CreateDefaultRemissionNoteRequest crea una solicitud de nota de remisión predeterminada válida
+func CreateDefaultRemissionNoteRequest() *structs.CreateRemissionNoteRequest {
+	items := []structs.RemissionNoteItemRequest{
+		CreateDefaultRemissionNoteItem(1),
+		CreateDefaultRemissionNoteItem(2),
+	}
+
+	// El receptor por defecto no incluye correo para evitar la verificación del dominio
+	receiver := CreateDefaultReceiver()
+	receiver.Email = nil
+
+	return &structs.CreateRemissionNoteRequest{
+		Items:      items,
+		Receiver:   receiver,
+		GoodsTitle: utils.ToStringPointer(constants.BienTituloTraslado),
+		Summary:    CreateDefaultRemissionNoteSummary(),
+	}
+}
+
+// CreateRemissionNoteRequestWithAllOptionalFields crea una solicitud de nota de remisión con todos los campos opcionales
+func CreateRemissionNoteRequestWithAllOptionalFields() *structs.CreateRemissionNoteRequest {
+	req := CreateDefaultRemissionNoteRequest()
+	req.Extension = CreateDefaultCreditNoteExtension()
+	req.ThirdPartySale = CreateDefaultThirdPartySale()
+	req.RelatedDocs = []structs.RelatedDocRequest{CreateDefaultRelatedDocument()}
+	req.Appendixes = []structs.AppendixRequest{CreateDefaultAppendix()}
+	return req
+}
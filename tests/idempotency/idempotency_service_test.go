@@ -0,0 +1,164 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency/models"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+// memoryStore implementa IdempotencyRepositoryPort en memoria
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]models.IdempotencyRecord
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: make(map[string]models.IdempotencyRecord)}
+}
+
+func (s *memoryStore) Reserve(_ context.Context, branchID uint, key string, record *models.IdempotencyRecord, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.records[storeKey(branchID, key)]; ok {
+		return false, nil
+	}
+	s.records[storeKey(branchID, key)] = *record
+	return true, nil
+}
+
+func (s *memoryStore) Get(_ context.Context, branchID uint, key string) (*models.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[storeKey(branchID, key)]
+	if !ok {
+		return nil, errPackage.ErrIdempotencyKeyNotFound
+	}
+	return &record, nil
+}
+
+func (s *memoryStore) Save(_ context.Context, branchID uint, key string, record *models.IdempotencyRecord, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[storeKey(branchID, key)] = *record
+	return nil
+}
+
+func (s *memoryStore) Delete(_ context.Context, branchID uint, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, storeKey(branchID, key))
+	return nil
+}
+
+func storeKey(branchID uint, key string) string {
+	return fmt.Sprintf("%d:%s", branchID, key)
+}
+
+func errorCode(err error) string {
+	var svcErr *shared_error.ServiceError
+	if errors.As(err, &svcErr) {
+		return svcErr.GetCode()
+	}
+	return ""
+}
+
+func TestIdempotencyServiceReplaysCompletedRequest(t *testing.T) {
+	test.TestMain(t)
+
+	ctx := context.Background()
+	service := idempotency.NewIdempotencyService(newMemoryStore())
+	hash := idempotency.HashRequest(http.MethodPost, "/api/v1/dte/invoices", []byte(`{"items":[1]}`))
+
+	// 1. La primera solicitud reserva la llave
+	record, err := service.Begin(ctx, 1, "pos-0001", hash)
+	require.NoError(t, err)
+	assert.Nil(t, record)
+
+	// 2. Un reintento mientras la solicitud original sigue en proceso se rechaza
+	_, err = service.Begin(ctx, 1, "pos-0001", hash)
+	assert.Equal(t, "IdempotencyKeyInProgress", errorCode(err))
+
+	// 3. Al completar, los reintentos reciben la respuesta original
+	require.NoError(t, service.Complete(ctx, 1, "pos-0001", hash, http.StatusCreated, []byte(`{"success":true}`)))
+
+	record, err = service.Begin(ctx, 1, "pos-0001", hash)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, http.StatusCreated, record.StatusCode)
+	assert.Equal(t, []byte(`{"success":true}`), record.Body)
+
+	// 4. La misma llave en otra sucursal es independiente
+	record, err = service.Begin(ctx, 2, "pos-0001", hash)
+	require.NoError(t, err)
+	assert.Nil(t, record)
+}
+
+func TestIdempotencyServiceRejectsDifferentPayload(t *testing.T) {
+	test.TestMain(t)
+
+	ctx := context.Background()
+	service := idempotency.NewIdempotencyService(newMemoryStore())
+	original := idempotency.HashRequest(http.MethodPost, "/api/v1/dte/invoices", []byte(`{"items":[1]}`))
+
+	_, err := service.Begin(ctx, 1, "pos-0002", original)
+	require.NoError(t, err)
+	require.NoError(t, service.Complete(ctx, 1, "pos-0002", original, http.StatusCreated, []byte(`{}`)))
+
+	differentBody := idempotency.HashRequest(http.MethodPost, "/api/v1/dte/invoices", []byte(`{"items":[2]}`))
+	_, err = service.Begin(ctx, 1, "pos-0002", differentBody)
+	assert.Equal(t, "IdempotencyKeyMismatch", errorCode(err))
+
+	differentPath := idempotency.HashRequest(http.MethodPost, "/api/v1/dte/ccf", []byte(`{"items":[1]}`))
+	_, err = service.Begin(ctx, 1, "pos-0002", differentPath)
+	assert.Equal(t, "IdempotencyKeyMismatch", errorCode(err))
+}
+
+func TestIdempotencyServiceReleaseAllowsRetry(t *testing.T) {
+	test.TestMain(t)
+
+	ctx := context.Background()
+	service := idempotency.NewIdempotencyService(newMemoryStore())
+	hash := idempotency.HashRequest(http.MethodPost, "/api/v1/dte/invoices", []byte(`{}`))
+
+	_, err := service.Begin(ctx, 1, "pos-0003", hash)
+	require.NoError(t, err)
+	require.NoError(t, service.Release(ctx, 1, "pos-0003"))
+
+	record, err := service.Begin(ctx, 1, "pos-0003", hash)
+	require.NoError(t, err)
+	assert.Nil(t, record)
+}
+
+func TestIdempotencyServiceValidatesKey(t *testing.T) {
+	test.TestMain(t)
+
+	service := idempotency.NewIdempotencyService(newMemoryStore())
+	for _, key := range []string{"con espacio", "llave\n", string(make([]byte, idempotency.MaxKeyLength+1))} {
+		_, err := service.Begin(context.Background(), 1, key, "hash")
+		assert.Equal(t, "InvalidIdempotencyKey", errorCode(err), "key %q", key)
+	}
+}
+
+func TestHashRequestIgnoresJSONFormatting(t *testing.T) {
+	compact := idempotency.HashRequest(http.MethodPost, "/api/v1/dte/invoices", []byte(`{"items":[{"quantity":1}]}`))
+	indented := idempotency.HashRequest(http.MethodPost, "/api/v1/dte/invoices", []byte("{\n  \"items\": [ { \"quantity\": 1 } ]\n}"))
+
+	assert.Equal(t, compact, indented)
+}
@@ -0,0 +1,146 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	jobModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/jobs"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+// memoryLocks simula los bloqueos compartidos por varias réplicas
+type memoryLocks struct {
+	mu     sync.Mutex
+	owners map[string]string
+}
+
+// memoryLocker implementa JobLocker para una réplica sobre los bloqueos compartidos
+type memoryLocker struct {
+	locks    *memoryLocks
+	instance string
+}
+
+func (l *memoryLocker) Instance() string {
+	return l.instance
+}
+
+func (l *memoryLocker) Acquire(_ context.Context, name string, _ time.Duration) (bool, error) {
+	l.locks.mu.Lock()
+	defer l.locks.mu.Unlock()
+
+	if _, ok := l.locks.owners[name]; ok {
+		return false, nil
+	}
+	l.locks.owners[name] = l.instance
+	return true, nil
+}
+
+func (l *memoryLocker) Renew(_ context.Context, name string, _ time.Duration) (bool, error) {
+	l.locks.mu.Lock()
+	defer l.locks.mu.Unlock()
+
+	return l.locks.owners[name] == l.instance, nil
+}
+
+func (l *memoryLocker) Release(_ context.Context, name string) error {
+	l.locks.mu.Lock()
+	defer l.locks.mu.Unlock()
+
+	if l.locks.owners[name] == l.instance {
+		delete(l.locks.owners, name)
+	}
+	return nil
+}
+
+func (l *memoryLocker) Owner(_ context.Context, name string) (string, time.Duration, error) {
+	l.locks.mu.Lock()
+	defer l.locks.mu.Unlock()
+
+	if owner, ok := l.locks.owners[name]; ok {
+		return owner, time.Minute, nil
+	}
+	return "", 0, nil
+}
+
+// takeOver simula que el bloqueo expiró y otra réplica lo adquirió
+func (l *memoryLocks) takeOver(name, instance string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.owners[name] = instance
+}
+
+func TestOnlyOneReplicaRunsAJobAtATime(t *testing.T) {
+	test.TestMain(t)
+
+	locks := &memoryLocks{owners: make(map[string]string)}
+	replicaA := jobs.NewJobMonitor(&memoryLocker{locks: locks, instance: "replica-a"}, jobs.DefaultJobLeaseTTL)
+	replicaB := jobs.NewJobMonitor(&memoryLocker{locks: locks, instance: "replica-b"}, jobs.DefaultJobLeaseTTL)
+
+	started, finish := make(chan struct{}), make(chan struct{})
+	runs := 0
+	job := func(context.Context) error {
+		runs++
+		close(started)
+		<-finish
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		replicaA.Track("sample", job)()
+		close(done)
+	}()
+	<-started
+
+	// Mientras la réplica A ejecuta el job, la réplica B lo omite y muestra a la réplica A como dueña del bloqueo
+	replicaB.Track("sample", func(context.Context) error { return errors.New("must not run") })()
+	status := replicaB.List(context.Background())
+	assert.Equal(t, "replica-b", status.Instance)
+	require.Len(t, status.Jobs, 1)
+	assert.Equal(t, jobModels.JobOutcomeSkipped, status.Jobs[0].LastOutcome)
+	assert.Contains(t, status.Jobs[0].LastError, "replica-a")
+	assert.Equal(t, "replica-a", status.Jobs[0].LockOwner)
+
+	close(finish)
+	<-done
+	assert.Equal(t, 1, runs)
+
+	// Al terminar se libera el bloqueo y la réplica B puede ejecutar el job
+	replicaB.Track("sample", func(context.Context) error { return nil })()
+	status = replicaB.List(context.Background())
+	assert.Equal(t, jobModels.JobOutcomeSuccess, status.Jobs[0].LastOutcome)
+	assert.Empty(t, status.Jobs[0].LockOwner)
+}
+
+func TestJobIsCancelledWhenTheLeaseIsLost(t *testing.T) {
+	test.TestMain(t)
+
+	locks := &memoryLocks{owners: make(map[string]string)}
+	monitor := jobs.NewJobMonitor(&memoryLocker{locks: locks, instance: "replica-a"}, 30*time.Millisecond)
+
+	monitor.Track("sample", func(ctx context.Context) error {
+		locks.takeOver("sample", "replica-b")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+			return nil
+		}
+	})()
+
+	status := monitor.List(context.Background()).Jobs[0]
+	assert.Equal(t, jobModels.JobOutcomeFailed, status.LastOutcome)
+	assert.Contains(t, status.LastError, jobs.ErrJobLeaseLost.Error())
+
+	// El bloqueo tomado por la otra réplica no se libera
+	assert.Equal(t, "replica-b", status.LockOwner)
+}
@@ -0,0 +1,188 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	jobModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/jobs/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/jobs"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const nit = "06140101011011"
+
+// memoryScheduleRepository implementa RetransmissionScheduleRepositoryPort en memoria
+type memoryScheduleRepository struct {
+	schedules map[uint]models.RetransmissionSchedule
+}
+
+func (r *memoryScheduleRepository) Get(_ context.Context, userID uint) (*models.RetransmissionSchedule, error) {
+	schedule, ok := r.schedules[userID]
+	if !ok {
+		return nil, errPackage.ErrRetransmissionScheduleNotFound
+	}
+	return &schedule, nil
+}
+
+func (r *memoryScheduleRepository) List(_ context.Context) ([]models.RetransmissionSchedule, error) {
+	var schedules []models.RetransmissionSchedule
+	for _, schedule := range r.schedules {
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+func (r *memoryScheduleRepository) Save(_ context.Context, schedule *models.RetransmissionSchedule) error {
+	r.schedules[schedule.UserID] = *schedule
+	return nil
+}
+
+func (r *memoryScheduleRepository) Delete(_ context.Context, userID uint) error {
+	delete(r.schedules, userID)
+	return nil
+}
+
+// fakeScheduler registra las programaciones aplicadas y eliminadas
+type fakeScheduler struct {
+	applied []models.RetransmissionSchedule
+	removed []string
+}
+
+func (s *fakeScheduler) ValidateCron(expression string) error {
+	if expression == "invalid" {
+		return errors.New("invalid cron expression")
+	}
+	return nil
+}
+
+func (s *fakeScheduler) Apply(schedule *models.RetransmissionSchedule) error {
+	s.applied = append(s.applied, *schedule)
+	return nil
+}
+
+func (s *fakeScheduler) Remove(nit string) {
+	s.removed = append(s.removed, nit)
+}
+
+func newScheduleService() (contingency.RetransmissionScheduleManager, *memoryScheduleRepository, *fakeScheduler) {
+	repo := &memoryScheduleRepository{schedules: make(map[uint]models.RetransmissionSchedule)}
+	scheduler := &fakeScheduler{}
+
+	service := contingency.NewRetransmissionScheduleService(repo, models.RetransmissionSchedule{
+		Cron:                "*/30 * * * *",
+		Windows:             "22:00-05:00",
+		BatchSize:           50,
+		MaxExecutionMinutes: 10,
+	}, 100)
+	service.AttachScheduler(scheduler)
+
+	return service, repo, scheduler
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func TestTimeWindowsWrapAroundMidnight(t *testing.T) {
+	windows, err := models.ParseTimeWindows("22:00-05:00, 12:00-13:30")
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+	assert.Equal(t, "22:00-05:00", windows[0].String())
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 3, 10, hour, minute, 0, 0, time.UTC)
+	}
+
+	assert.True(t, models.InWindows(windows, at(23, 15)))
+	assert.True(t, models.InWindows(windows, at(4, 59)))
+	assert.True(t, models.InWindows(windows, at(13, 0)))
+	assert.False(t, models.InWindows(windows, at(5, 0)))
+	assert.False(t, models.InWindows(windows, at(13, 30)))
+	assert.True(t, models.InWindows(nil, at(9, 0)))
+
+	for _, invalid := range []string{"", "22:00", "25:00-05:00", "8:00-17:00", "08:00-17:00,"} {
+		_, err = models.ParseTimeWindows(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSaveScheduleValidatesAndReschedulesTenantJob(t *testing.T) {
+	test.TestMain(t)
+
+	service, repo, scheduler := newScheduleService()
+	ctx := context.Background()
+
+	// Sin programación propia se utiliza la predeterminada
+	schedule, err := service.Get(ctx, 7, nit)
+	require.NoError(t, err)
+	assert.True(t, schedule.IsDefault)
+	assert.Equal(t, nit, schedule.NIT)
+
+	_, err = service.Save(ctx, 7, nit, &models.RetransmissionScheduleInput{Cron: ptr("invalid")})
+	test.AssertErrorCode(t, err, "InvalidRetransmissionCron")
+
+	_, err = service.Save(ctx, 7, nit, &models.RetransmissionScheduleInput{Windows: ptr("22:00")})
+	test.AssertErrorCode(t, err, "InvalidRetransmissionWindows")
+
+	_, err = service.Save(ctx, 7, nit, &models.RetransmissionScheduleInput{BatchSize: ptr(101)})
+	test.AssertErrorCode(t, err, "InvalidRetransmissionBatchSize")
+
+	_, err = service.Save(ctx, 7, nit, &models.RetransmissionScheduleInput{MaxExecutionMinutes: ptr(0)})
+	test.AssertErrorCode(t, err, "InvalidRetransmissionMaxExecution")
+	assert.Empty(t, repo.schedules)
+
+	// Los campos omitidos conservan el valor predeterminado
+	schedule, err = service.Save(ctx, 7, nit, &models.RetransmissionScheduleInput{Cron: ptr("*/5 * * * *"), BatchSize: ptr(20)})
+	require.NoError(t, err)
+	assert.False(t, schedule.IsDefault)
+	assert.Equal(t, "*/5 * * * *", schedule.Cron)
+	assert.Equal(t, "22:00-05:00", schedule.Windows)
+	assert.Equal(t, 20, schedule.BatchSize)
+	require.Len(t, scheduler.applied, 1)
+	assert.Equal(t, nit, scheduler.applied[0].NIT)
+
+	schedule, err = service.Reset(ctx, 7, nit)
+	require.NoError(t, err)
+	assert.True(t, schedule.IsDefault)
+	assert.Empty(t, repo.schedules)
+	assert.Equal(t, []string{nit}, scheduler.removed)
+}
+
+func TestJobMonitorRecordsOutcomes(t *testing.T) {
+	test.TestMain(t)
+
+	monitor := jobs.NewJobMonitor(nil, jobs.DefaultJobLeaseTTL)
+	next := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	monitor.Register("sample", "every 1 minute", func() time.Time { return next })
+
+	monitor.Track("sample", func(context.Context) error { return nil })()
+	status := monitor.List(context.Background()).Jobs[0]
+	assert.Equal(t, jobModels.JobOutcomeSuccess, status.LastOutcome)
+	assert.Equal(t, "every 1 minute", status.Schedule)
+	require.NotNil(t, status.NextRun)
+	assert.Equal(t, next, *status.NextRun)
+
+	monitor.Track("sample", func(context.Context) error { return jobs.ErrOutsideWindow })()
+	status = monitor.List(context.Background()).Jobs[0]
+	assert.Equal(t, jobModels.JobOutcomeSkipped, status.LastOutcome)
+	assert.Zero(t, status.Failures)
+
+	monitor.Track("sample", func(context.Context) error { return errors.New("boom") })()
+	status = monitor.List(context.Background()).Jobs[0]
+	assert.Equal(t, jobModels.JobOutcomeFailed, status.LastOutcome)
+	assert.Equal(t, "boom", status.LastError)
+	assert.Equal(t, 3, status.Runs)
+	assert.Equal(t, 1, status.Failures)
+	assert.False(t, status.Running)
+
+	monitor.Unregister("sample")
+	assert.Empty(t, monitor.List(context.Background()).Jobs)
+}
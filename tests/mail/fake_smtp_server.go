@@ -0,0 +1,171 @@
+package mail
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// ReceivedMessage representa un mensaje aceptado por el servidor SMTP de prueba
+type ReceivedMessage struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+// FakeSMTPServer es un servidor SMTP local mínimo para probar el envío de correos sin un servidor real.
+// Si Username se define, anuncia AUTH PLAIN y exige la autenticación antes de aceptar mensajes.
+// RejectMessages indica cuántos mensajes se rechazarán con un error temporal antes de aceptarlos.
+type FakeSMTPServer struct {
+	Username       string
+	Password       string
+	RejectMessages int
+
+	listener net.Listener
+	mu       sync.Mutex
+	messages []ReceivedMessage
+	wg       sync.WaitGroup
+}
+
+// NewFakeSMTPServer inicia el servidor en un puerto local libre y lo detiene al finalizar la prueba
+func NewFakeSMTPServer(t *testing.T) *FakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start fake SMTP server: %v", err)
+	}
+
+	server := &FakeSMTPServer{listener: listener}
+	server.wg.Add(1)
+	go server.serve()
+
+	t.Cleanup(func() {
+		listener.Close()
+		server.wg.Wait()
+	})
+
+	return server
+}
+
+// Addr obtiene el host y el puerto en el que escucha el servidor
+func (s *FakeSMTPServer) Addr() (string, string) {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return host, port
+}
+
+// Messages obtiene los mensajes aceptados
+func (s *FakeSMTPServer) Messages() []ReceivedMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ReceivedMessage(nil), s.messages...)
+}
+
+func (s *FakeSMTPServer) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(textproto.NewConn(conn))
+		}()
+	}
+}
+
+func (s *FakeSMTPServer) handle(conn *textproto.Conn) {
+	var current ReceivedMessage
+	authenticated := s.Username == ""
+
+	reply := func(format string, args ...interface{}) bool {
+		return conn.PrintfLine(format, args...) == nil
+	}
+
+	if !reply("220 fake.local ESMTP ready") {
+		return
+	}
+
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			if s.Username != "" {
+				reply("250-fake.local")
+				reply("250 AUTH PLAIN")
+			} else {
+				reply("250 fake.local")
+			}
+		case strings.HasPrefix(command, "AUTH PLAIN "):
+			credentials, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(line[len("AUTH PLAIN "):]))
+			if string(credentials) == fmt.Sprintf("\x00%s\x00%s", s.Username, s.Password) {
+				authenticated = true
+				reply("235 Authentication successful")
+			} else {
+				reply("535 Authentication failed")
+			}
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			if !authenticated {
+				reply("530 Authentication required")
+				continue
+			}
+			current = ReceivedMessage{From: trimAddress(line[len("MAIL FROM:"):])}
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			current.To = append(current.To, trimAddress(line[len("RCPT TO:"):]))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			data, err := io.ReadAll(conn.DotReader())
+			if err != nil {
+				return
+			}
+
+			s.mu.Lock()
+			reject := s.RejectMessages > 0
+			if reject {
+				s.RejectMessages--
+			} else {
+				current.Data = data
+				s.messages = append(s.messages, current)
+			}
+			s.mu.Unlock()
+
+			if reject {
+				reply("451 Temporary failure, try again later")
+			} else {
+				reply("250 OK: queued")
+			}
+		case command == "RSET", command == "NOOP":
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func trimAddress(value string) string {
+	value = strings.TrimSpace(value)
+	if end := strings.Index(value, ">"); end >= 0 {
+		value = value[:end]
+	}
+	return strings.TrimPrefix(value, "<")
+}
@@ -0,0 +1,140 @@
+package mail
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/notification"
+	deliveryService "github.com/MarlonG1/api-facturacion-sv/internal/domain/notification"
+	adapterMail "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/mail"
+)
+
+func newSender(server *FakeSMTPServer, username, password string) *adapterMail.SMTPSender {
+	host, port := server.Addr()
+	return adapterMail.NewSMTPSender(adapterMail.SMTPConfig{
+		Host:     host,
+		Port:     port,
+		Username: username,
+		Password: password,
+		From:     "facturacion@example.com",
+		FromName: "Empresa de Prueba",
+	}).(*adapterMail.SMTPSender)
+}
+
+func testEmail() *notification.Email {
+	return &notification.Email{
+		To:      []string{"cliente@example.com"},
+		Subject: "Comprobante de Crédito Fiscal DTE-03-M001P001-000000000000001",
+		Body:    "Estimado cliente:\n\nSe adjunta el documento tributario electrónico.",
+		Attachments: []notification.Attachment{
+			{Filename: "dte.json", ContentType: "application/json", Content: []byte(`{"identificacion":{"tipoDte":"03"},"firmaElectronica":"a.b.c"}`)},
+			{Filename: "dte.pdf", ContentType: "application/pdf", Content: []byte(strings.Repeat("%PDF-1.4 binary \x00\x01\x02", 40))},
+		},
+	}
+}
+
+func TestSMTPSenderDeliversMessageWithAttachments(t *testing.T) {
+	server := NewFakeSMTPServer(t)
+	email := testEmail()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, newSender(server, "", "").Send(ctx, email))
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "facturacion@example.com", messages[0].From)
+	assert.Equal(t, []string{"cliente@example.com"}, messages[0].To)
+
+	// 1. Verificar los encabezados
+	msg, err := mail.ReadMessage(strings.NewReader(string(messages[0].Data)))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, email.Subject, subject)
+	assert.Contains(t, msg.Header.Get("From"), "facturacion@example.com")
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	// 2. Verificar el cuerpo y los adjuntos decodificados
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	body, err := reader.NextPart()
+	require.NoError(t, err)
+	content, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, email.Body, string(content))
+
+	for _, expected := range email.Attachments {
+		part, err := reader.NextPart()
+		require.NoError(t, err)
+		assert.Equal(t, expected.Filename, part.FileName())
+
+		assert.Equal(t, "base64", part.Header.Get("Content-Transfer-Encoding"))
+		content, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, part))
+		require.NoError(t, err)
+		assert.Equal(t, expected.Content, content)
+	}
+
+	_, err = reader.NextPart()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestSMTPSenderAuthentication(t *testing.T) {
+	server := NewFakeSMTPServer(t)
+	server.Username = "mailer"
+	server.Password = "secret"
+
+	ctx := context.Background()
+	assert.Error(t, newSender(server, "mailer", "wrong").Send(ctx, testEmail()))
+	assert.Error(t, newSender(server, "", "").Send(ctx, testEmail()))
+	assert.Empty(t, server.Messages())
+
+	require.NoError(t, newSender(server, "mailer", "secret").Send(ctx, testEmail()))
+	assert.Len(t, server.Messages(), 1)
+}
+
+func TestSMTPSenderReportsRejectedMessage(t *testing.T) {
+	server := NewFakeSMTPServer(t)
+	server.RejectMessages = 1
+	sender := newSender(server, "", "")
+
+	err := sender.Send(context.Background(), testEmail())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "451")
+	assert.Empty(t, server.Messages())
+
+	require.NoError(t, sender.Send(context.Background(), testEmail()))
+	assert.Len(t, server.Messages(), 1)
+}
+
+func TestNextRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 0, expected: time.Minute},
+		{attempts: 1, expected: time.Minute},
+		{attempts: 2, expected: 2 * time.Minute},
+		{attempts: 4, expected: 8 * time.Minute},
+		{attempts: 7, expected: time.Hour},
+		{attempts: 30, expected: time.Hour},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, deliveryService.NextRetryDelay(tt.attempts), "attempts %d", tt.attempts)
+	}
+}
@@ -0,0 +1,143 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/tests"
+	"github.com/MarlonG1/api-facturacion-sv/tests/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapToAccountingLiquidationData(t *testing.T) {
+	test.TestMain(t)
+
+	// Emisor por defecto para todas las pruebas
+	issuer := fixtures.CreateDefaultIssuer()
+
+	// Definir casos de prueba
+	tests := []struct {
+		name      string
+		req       func() *structs.CreateAccountingLiquidationRequest
+		wantErr   bool
+		errorCode string
+	}{
+		// ------ VALIDACIONES BÁSICAS ------
+		{
+			name: "Valid accounting liquidation request",
+			req: func() *structs.CreateAccountingLiquidationRequest {
+				return fixtures.CreateDefaultAccountingLiquidationRequest()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Accounting liquidation with all optional fields",
+			req: func() *structs.CreateAccountingLiquidationRequest {
+				return fixtures.CreateAccountingLiquidationRequestWithAllOptionalFields()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Null accounting liquidation request",
+			req: func() *structs.CreateAccountingLiquidationRequest {
+				return nil
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Accounting liquidation without body",
+			req: func() *structs.CreateAccountingLiquidationRequest {
+				req := fixtures.CreateDefaultAccountingLiquidationRequest()
+				req.Body = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Accounting liquidation without receiver",
+			req: func() *structs.CreateAccountingLiquidationRequest {
+				req := fixtures.CreateDefaultAccountingLiquidationRequest()
+				req.Receiver = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DEL CUERPO ------
+		{
+			name: "Accounting liquidation with invalid period date",
+			req: func() *structs.CreateAccountingLiquidationRequest {
+				req := fixtures.CreateDefaultAccountingLiquidationRequest()
+				req.Body.PeriodStart = "01/04/2025"
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidDateTime",
+		},
+		{
+			name: "Accounting liquidation with more than two decimals",
+			req: func() *structs.CreateAccountingLiquidationRequest {
+				req := fixtures.CreateDefaultAccountingLiquidationRequest()
+				req.Body.SubTotal = 1000.123
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidDecimals",
+		},
+
+		// ------ VALIDACIONES DE EXTENSIÓN ------
+		{
+			name: "Accounting liquidation extension without delivery name",
+			req: func() *structs.CreateAccountingLiquidationRequest {
+				req := fixtures.CreateAccountingLiquidationRequestWithAllOptionalFields()
+				req.Extension.DeliveryName = ""
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+	}
+
+	// Ejecutar casos de prueba
+	mapper := request_mapper.NewAccountingLiquidationMapper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req()
+
+			got, err := mapper.MapToAccountingLiquidationData(req, issuer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errorCode != "" {
+					test.AssertErrorCode(t, err, tt.errorCode)
+				}
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NotNil(t, got)
+			assert.NotNil(t, got.InputDataCommon)
+			assert.NotNil(t, got.InputDataCommon.Issuer)
+			assert.NotNil(t, got.InputDataCommon.Identification)
+			assert.Equal(t, constants.DocContableLiquidacionElectronico, got.Identification.GetDTEType())
+			assert.NotNil(t, got.Receiver)
+			assert.NotNil(t, got.Body)
+			assert.Equal(t, req.Body.NetAmountToPay, got.Body.NetAmountToPay.GetValue())
+
+			if req.Extension != nil {
+				assert.NotNil(t, got.LiquidationExtension)
+			}
+			if req.Appendixes != nil {
+				assert.Len(t, got.Appendixes, len(req.Appendixes))
+			}
+		})
+	}
+}
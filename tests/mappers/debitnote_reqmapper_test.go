@@ -0,0 +1,174 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/tests"
+	"github.com/MarlonG1/api-facturacion-sv/tests/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapToDebitNoteData(t *testing.T) {
+	test.TestMain(t)
+
+	// Emisor por defecto para todas las pruebas
+	issuer := fixtures.CreateDefaultIssuer()
+
+	// Definir casos de prueba
+	tests := []struct {
+		name      string
+		req       func() *structs.CreateDebitNoteRequest
+		wantErr   bool
+		errorCode string
+	}{
+		// ------ VALIDACIONES BÁSICAS ------
+		{
+			name: "Valid DebitNote request",
+			req: func() *structs.CreateDebitNoteRequest {
+				return fixtures.CreateDefaultDebitNoteRequest()
+			},
+			wantErr: false,
+		},
+		{
+			name: "DebitNote with all optional fields",
+			req: func() *structs.CreateDebitNoteRequest {
+				return fixtures.CreateDebitNoteRequestWithAllOptionalFields()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Null DebitNote request",
+			req: func() *structs.CreateDebitNoteRequest {
+				return nil
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "DebitNote without items",
+			req: func() *structs.CreateDebitNoteRequest {
+				req := fixtures.CreateDefaultDebitNoteRequest()
+				req.Items = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "DebitNote without summary",
+			req: func() *structs.CreateDebitNoteRequest {
+				req := fixtures.CreateDefaultDebitNoteRequest()
+				req.Summary = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "DebitNote without receiver",
+			req: func() *structs.CreateDebitNoteRequest {
+				req := fixtures.CreateDefaultDebitNoteRequest()
+				req.Receiver = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "DebitNote without related documents",
+			req: func() *structs.CreateDebitNoteRequest {
+				req := fixtures.CreateDefaultDebitNoteRequest()
+				req.RelatedDocs = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DE RECEPTOR ------
+		{
+			name: "DebitNote without receiver NRC",
+			req: func() *structs.CreateDebitNoteRequest {
+				req := fixtures.CreateDefaultDebitNoteRequest()
+				req.Receiver.NRC = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "DebitNote without receiver activity code",
+			req: func() *structs.CreateDebitNoteRequest {
+				req := fixtures.CreateDefaultDebitNoteRequest()
+				req.Receiver.ActivityCode = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DE DOCUMENTOS RELACIONADOS ------
+		{
+			name: "DebitNote with related document without generation type",
+			req: func() *structs.CreateDebitNoteRequest {
+				req := fixtures.CreateDefaultDebitNoteRequest()
+				req.RelatedDocs[0].GenerationType = 0
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+	}
+
+	// Ejecutar casos de prueba
+	mapper := request_mapper.NewDebitNoteMapper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req()
+			if !tt.wantErr {
+				// El receptor de la nota de débito requiere un correo
+				test.SkipWithoutMXLookup(t, *req.Receiver.Email)
+			}
+
+			got, err := mapper.MapToDebitNoteData(req, issuer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errorCode != "" {
+					test.AssertErrorCode(t, err, tt.errorCode)
+				}
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NotNil(t, got)
+			assert.NotNil(t, got.InputDataCommon)
+			assert.NotNil(t, got.InputDataCommon.Issuer)
+			assert.NotNil(t, got.InputDataCommon.Identification)
+			assert.Equal(t, constants.NotaDebitoElectronica, got.Identification.GetDTEType())
+			assert.NotNil(t, got.InputDataCommon.Receiver)
+			assert.Len(t, got.Items, len(req.Items))
+			assert.NotNil(t, got.DebitSummary)
+			assert.Len(t, got.RelatedDocs, len(req.RelatedDocs))
+
+			// Verificar campos opcionales si están presentes
+			if req.ThirdPartySale != nil {
+				assert.NotNil(t, got.ThirdPartySale)
+			}
+
+			if req.Extension != nil {
+				assert.NotNil(t, got.Extension)
+			}
+
+			if req.Appendixes != nil {
+				assert.Len(t, got.Appendixes, len(req.Appendixes))
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+	"github.com/MarlonG1/api-facturacion-sv/tests"
+	"github.com/MarlonG1/api-facturacion-sv/tests/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapToDonationData(t *testing.T) {
+	test.TestMain(t)
+
+	// Emisor por defecto para todas las pruebas, actúa como donatario
+	issuer := fixtures.CreateDefaultIssuer()
+
+	// Definir casos de prueba
+	tests := []struct {
+		name      string
+		req       func() *structs.CreateDonationRequest
+		wantErr   bool
+		errorCode string
+	}{
+		// ------ VALIDACIONES BÁSICAS ------
+		{
+			name: "Valid donation request",
+			req: func() *structs.CreateDonationRequest {
+				return fixtures.CreateDefaultDonationRequest()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Donation with all optional fields",
+			req: func() *structs.CreateDonationRequest {
+				return fixtures.CreateDonationRequestWithAllOptionalFields()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Null donation request",
+			req: func() *structs.CreateDonationRequest {
+				return nil
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Donation without items",
+			req: func() *structs.CreateDonationRequest {
+				req := fixtures.CreateDefaultDonationRequest()
+				req.Items = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Donation without associated documents",
+			req: func() *structs.CreateDonationRequest {
+				req := fixtures.CreateDefaultDonationRequest()
+				req.OtherDocs = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Donation without summary",
+			req: func() *structs.CreateDonationRequest {
+				req := fixtures.CreateDefaultDonationRequest()
+				req.Summary = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DE DONANTE ------
+		{
+			name: "Donation without donor",
+			req: func() *structs.CreateDonationRequest {
+				req := fixtures.CreateDefaultDonationRequest()
+				req.Donor = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Donor without country code",
+			req: func() *structs.CreateDonationRequest {
+				req := fixtures.CreateDefaultDonationRequest()
+				req.Donor.CountryCode = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Donor with invalid domiciled code",
+			req: func() *structs.CreateDonationRequest {
+				req := fixtures.CreateDefaultDonationRequest()
+				req.Donor.DomiciledCode = utils.ToIntPointer(3)
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidDomiciledCode",
+		},
+		{
+			name: "Foreign donor without address",
+			req: func() *structs.CreateDonationRequest {
+				req := fixtures.CreateDefaultDonationRequest()
+				req.Donor.DocumentType = utils.ToStringPointer(constants.Pasaporte)
+				req.Donor.DocumentNumber = utils.ToStringPointer("A12345678")
+				req.Donor.DomiciledCode = utils.ToIntPointer(constants.DonanteNoDomiciliado)
+				req.Donor.CountryCode = utils.ToStringPointer("9450")
+				req.Donor.Address = nil
+				return req
+			},
+			wantErr: false,
+		},
+
+		// ------ VALIDACIONES DE ITEMS ------
+		{
+			name: "Donation item with invalid donation type",
+			req: func() *structs.CreateDonationRequest {
+				req := fixtures.CreateDefaultDonationRequest()
+				req.Items[0].Type = 4
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidDonationType",
+		},
+		{
+			name: "Donation item with negative value",
+			req: func() *structs.CreateDonationRequest {
+				req := fixtures.CreateDefaultDonationRequest()
+				req.Items[1].Value = -10
+				return req
+			},
+			wantErr: true,
+		},
+	}
+
+	// Ejecutar casos de prueba
+	mapper := request_mapper.NewDonationMapper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req()
+
+			got, err := mapper.MapToDonationData(req, issuer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errorCode != "" {
+					test.AssertErrorCode(t, err, tt.errorCode)
+				}
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NotNil(t, got)
+			assert.NotNil(t, got.InputDataCommon)
+			assert.NotNil(t, got.InputDataCommon.Issuer)
+			assert.NotNil(t, got.InputDataCommon.Identification)
+			assert.Equal(t, constants.ComprobanteDonacionElectronico, got.Identification.GetDTEType())
+			assert.Nil(t, got.Receiver)
+			assert.NotNil(t, got.Donor)
+			assert.Len(t, got.OtherDocs, len(req.OtherDocs))
+			assert.Len(t, got.DonationItems, len(req.Items))
+			assert.NotNil(t, got.DonationSummary)
+			assert.Equal(t, req.Summary.TotalValue, got.DonationSummary.TotalValue.GetValue())
+
+			if req.Appendixes != nil {
+				assert.Len(t, got.Appendixes, len(req.Appendixes))
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+	"github.com/MarlonG1/api-facturacion-sv/tests"
+	"github.com/MarlonG1/api-facturacion-sv/tests/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapToExportInvoiceData(t *testing.T) {
+	test.TestMain(t)
+
+	// Emisor por defecto para todas las pruebas
+	issuer := fixtures.CreateDefaultIssuer()
+
+	// Definir casos de prueba
+	tests := []struct {
+		name      string
+		req       func() *structs.CreateExportInvoiceRequest
+		wantErr   bool
+		errorCode string
+	}{
+		// ------ VALIDACIONES BÁSICAS ------
+		{
+			name: "Valid export invoice request",
+			req: func() *structs.CreateExportInvoiceRequest {
+				return fixtures.CreateDefaultExportInvoiceRequest()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Export invoice with all optional fields",
+			req: func() *structs.CreateExportInvoiceRequest {
+				return fixtures.CreateExportInvoiceRequestWithAllOptionalFields()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Null export invoice request",
+			req: func() *structs.CreateExportInvoiceRequest {
+				return nil
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Export invoice without items",
+			req: func() *structs.CreateExportInvoiceRequest {
+				req := fixtures.CreateDefaultExportInvoiceRequest()
+				req.Items = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Export invoice without receiver",
+			req: func() *structs.CreateExportInvoiceRequest {
+				req := fixtures.CreateDefaultExportInvoiceRequest()
+				req.Receiver = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Export invoice without export data",
+			req: func() *structs.CreateExportInvoiceRequest {
+				req := fixtures.CreateDefaultExportInvoiceRequest()
+				req.ExportData = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DE RECEPTOR EXTRANJERO ------
+		{
+			name: "Export invoice without receiver country code",
+			req: func() *structs.CreateExportInvoiceRequest {
+				req := fixtures.CreateDefaultExportInvoiceRequest()
+				req.Receiver.CountryCode = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Export invoice with invalid country code",
+			req: func() *structs.CreateExportInvoiceRequest {
+				req := fixtures.CreateDefaultExportInvoiceRequest()
+				req.Receiver.CountryCode = utils.ToStringPointer("us-a")
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidCountryCode",
+		},
+		{
+			name: "Export invoice with invalid person type",
+			req: func() *structs.CreateExportInvoiceRequest {
+				req := fixtures.CreateDefaultExportInvoiceRequest()
+				personType := 3
+				req.Receiver.PersonType = &personType
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidPersonType",
+		},
+
+		// ------ VALIDACIONES DE DATOS DE EXPORTACIÓN ------
+		{
+			name: "Export invoice with invalid item export type",
+			req: func() *structs.CreateExportInvoiceRequest {
+				req := fixtures.CreateDefaultExportInvoiceRequest()
+				req.ExportData.ItemExportType = 4
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidItemExportType",
+		},
+
+		// ------ VALIDACIONES DE RESUMEN ------
+		{
+			name: "Export invoice with invalid incoterm",
+			req: func() *structs.CreateExportInvoiceRequest {
+				req := fixtures.CreateDefaultExportInvoiceRequest()
+				req.Summary.IncotermCode = utils.ToStringPointer("99")
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidIncoterm",
+		},
+	}
+
+	// Ejecutar casos de prueba
+	mapper := request_mapper.NewExportInvoiceMapper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req()
+
+			got, err := mapper.MapToExportInvoiceData(req, issuer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errorCode != "" {
+					test.AssertErrorCode(t, err, tt.errorCode)
+				}
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NotNil(t, got)
+			assert.NotNil(t, got.InputDataCommon)
+			assert.NotNil(t, got.InputDataCommon.Issuer)
+			assert.NotNil(t, got.InputDataCommon.Identification)
+			assert.Equal(t, constants.FacturaExportacionElectronica, got.Identification.GetDTEType())
+			assert.NotNil(t, got.ForeignReceiver)
+			assert.NotNil(t, got.ExportData)
+			assert.Len(t, got.ExportItems, len(req.Items))
+			assert.NotNil(t, got.ExportSummary)
+
+			if req.Appendixes != nil {
+				assert.Len(t, got.Appendixes, len(req.Appendixes))
+			}
+		})
+	}
+}
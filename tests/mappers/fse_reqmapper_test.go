@@ -0,0 +1,150 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/tests"
+	"github.com/MarlonG1/api-facturacion-sv/tests/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapToFSEData(t *testing.T) {
+	test.TestMain(t)
+
+	// Emisor por defecto para todas las pruebas
+	issuer := fixtures.CreateDefaultIssuer()
+
+	// Definir casos de prueba
+	tests := []struct {
+		name      string
+		req       func() *structs.CreateFSERequest
+		wantErr   bool
+		errorCode string
+	}{
+		// ------ VALIDACIONES BÁSICAS ------
+		{
+			name: "Valid FSE request",
+			req: func() *structs.CreateFSERequest {
+				return fixtures.CreateDefaultFSERequest()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FSE with all optional fields",
+			req: func() *structs.CreateFSERequest {
+				return fixtures.CreateFSERequestWithAllOptionalFields()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Null FSE request",
+			req: func() *structs.CreateFSERequest {
+				return nil
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "FSE without items",
+			req: func() *structs.CreateFSERequest {
+				req := fixtures.CreateDefaultFSERequest()
+				req.Items = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "FSE without summary",
+			req: func() *structs.CreateFSERequest {
+				req := fixtures.CreateDefaultFSERequest()
+				req.Summary = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "FSE without excluded subject",
+			req: func() *structs.CreateFSERequest {
+				req := fixtures.CreateDefaultFSERequest()
+				req.ExcludedSubject = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DE SUJETO EXCLUIDO ------
+		{
+			name: "FSE without excluded subject name",
+			req: func() *structs.CreateFSERequest {
+				req := fixtures.CreateDefaultFSERequest()
+				req.ExcludedSubject.Name = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "FSE without excluded subject document number",
+			req: func() *structs.CreateFSERequest {
+				req := fixtures.CreateDefaultFSERequest()
+				req.ExcludedSubject.DocumentNumber = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DE RESUMEN ------
+		{
+			name: "FSE with invalid total decimals",
+			req: func() *structs.CreateFSERequest {
+				req := fixtures.CreateDefaultFSERequest()
+				req.Summary.TotalPurchase = 100.001
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidDecimals",
+		},
+	}
+
+	// Ejecutar casos de prueba
+	mapper := request_mapper.NewFSEMapper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req()
+
+			got, err := mapper.MapToFSEData(req, issuer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errorCode != "" {
+					test.AssertErrorCode(t, err, tt.errorCode)
+				}
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NotNil(t, got)
+			assert.NotNil(t, got.InputDataCommon)
+			assert.NotNil(t, got.InputDataCommon.Issuer)
+			assert.NotNil(t, got.InputDataCommon.Identification)
+			assert.Equal(t, constants.FacturaSujetoExcluidoElectronica, got.Identification.GetDTEType())
+			assert.NotNil(t, got.ExcludedSubject)
+			assert.Len(t, got.FSEItems, len(req.Items))
+			assert.NotNil(t, got.FSESummary)
+
+			if req.Appendixes != nil {
+				assert.Len(t, got.Appendixes, len(req.Appendixes))
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/tests"
+	"github.com/MarlonG1/api-facturacion-sv/tests/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapToLiquidationData(t *testing.T) {
+	test.TestMain(t)
+
+	// Emisor por defecto para todas las pruebas
+	issuer := fixtures.CreateDefaultIssuer()
+
+	// Definir casos de prueba
+	tests := []struct {
+		name      string
+		req       func() *structs.CreateLiquidationRequest
+		wantErr   bool
+		errorCode string
+	}{
+		// ------ VALIDACIONES BÁSICAS ------
+		{
+			name: "Valid liquidation request",
+			req: func() *structs.CreateLiquidationRequest {
+				return fixtures.CreateDefaultLiquidationRequest()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Liquidation with all optional fields",
+			req: func() *structs.CreateLiquidationRequest {
+				return fixtures.CreateLiquidationRequestWithAllOptionalFields()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Null liquidation request",
+			req: func() *structs.CreateLiquidationRequest {
+				return nil
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Liquidation without items",
+			req: func() *structs.CreateLiquidationRequest {
+				req := fixtures.CreateDefaultLiquidationRequest()
+				req.Items = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Liquidation without summary",
+			req: func() *structs.CreateLiquidationRequest {
+				req := fixtures.CreateDefaultLiquidationRequest()
+				req.Summary = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DE RECEPTOR ------
+		{
+			name: "Liquidation without receiver NIT",
+			req: func() *structs.CreateLiquidationRequest {
+				req := fixtures.CreateDefaultLiquidationRequest()
+				req.Receiver.NIT = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Liquidation without receiver NRC",
+			req: func() *structs.CreateLiquidationRequest {
+				req := fixtures.CreateDefaultLiquidationRequest()
+				req.Receiver.NRC = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DE DOCUMENTOS LIQUIDADOS ------
+		{
+			name: "Liquidated document without DTE type",
+			req: func() *structs.CreateLiquidationRequest {
+				req := fixtures.CreateDefaultLiquidationRequest()
+				req.Items[0].DTEType = ""
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Physical liquidated document without generation date",
+			req: func() *structs.CreateLiquidationRequest {
+				req := fixtures.CreateDefaultLiquidationRequest()
+				req.Items[0].GenerationDate = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidEmissionDateForPhysicalDocument",
+		},
+		{
+			name: "Electronic liquidated document without generation date",
+			req: func() *structs.CreateLiquidationRequest {
+				req := fixtures.CreateDefaultLiquidationRequest()
+				req.Items[0].GenerationType = constants.ElectronicDocument
+				req.Items[0].DocumentNumber = "1EEAB582-AA75-4D9C-A123-123456789012"
+				req.Items[0].GenerationDate = nil
+				return req
+			},
+			wantErr: false,
+		},
+		{
+			name: "Electronic liquidated document with invalid generation code",
+			req: func() *structs.CreateLiquidationRequest {
+				req := fixtures.CreateDefaultLiquidationRequest()
+				req.Items[0].GenerationType = constants.ElectronicDocument
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidDocumentNumberItem",
+		},
+	}
+
+	// Ejecutar casos de prueba
+	mapper := request_mapper.NewLiquidationMapper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req()
+
+			got, err := mapper.MapToLiquidationData(req, issuer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errorCode != "" {
+					test.AssertErrorCode(t, err, tt.errorCode)
+				}
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NotNil(t, got)
+			assert.NotNil(t, got.InputDataCommon)
+			assert.NotNil(t, got.InputDataCommon.Issuer)
+			assert.NotNil(t, got.InputDataCommon.Identification)
+			assert.Equal(t, constants.ComprobanteLiquidacionElectronico, got.Identification.GetDTEType())
+			assert.NotNil(t, got.Receiver)
+			assert.Len(t, got.LiquidationItems, len(req.Items))
+			assert.NotNil(t, got.LiquidationSummary)
+			assert.Equal(t, req.Summary.Total, got.LiquidationSummary.Total.GetValue())
+
+			if req.Appendixes != nil {
+				assert.Len(t, got.Appendixes, len(req.Appendixes))
+			}
+		})
+	}
+}
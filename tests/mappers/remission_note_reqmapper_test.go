@@ -0,0 +1,181 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/mapper/request_mapper/structs"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/utils"
+	"github.com/MarlonG1/api-facturacion-sv/tests"
+	"github.com/MarlonG1/api-facturacion-sv/tests/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapToRemissionNoteData(t *testing.T) {
+	test.TestMain(t)
+
+	// Emisor por defecto para todas las pruebas
+	issuer := fixtures.CreateDefaultIssuer()
+
+	// Definir casos de prueba
+	tests := []struct {
+		name      string
+		req       func() *structs.CreateRemissionNoteRequest
+		wantErr   bool
+		errorCode string
+	}{
+		// ------ VALIDACIONES BÁSICAS ------
+		{
+			name: "Valid remission note request",
+			req: func() *structs.CreateRemissionNoteRequest {
+				return fixtures.CreateDefaultRemissionNoteRequest()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Remission note with all optional fields",
+			req: func() *structs.CreateRemissionNoteRequest {
+				return fixtures.CreateRemissionNoteRequestWithAllOptionalFields()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Null remission note request",
+			req: func() *structs.CreateRemissionNoteRequest {
+				return nil
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Remission note without items",
+			req: func() *structs.CreateRemissionNoteRequest {
+				req := fixtures.CreateDefaultRemissionNoteRequest()
+				req.Items = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Remission note without summary",
+			req: func() *structs.CreateRemissionNoteRequest {
+				req := fixtures.CreateDefaultRemissionNoteRequest()
+				req.Summary = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Remission note without receiver",
+			req: func() *structs.CreateRemissionNoteRequest {
+				req := fixtures.CreateDefaultRemissionNoteRequest()
+				req.Receiver = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DEL TÍTULO DE LOS BIENES ------
+		{
+			name: "Remission note without goods title",
+			req: func() *structs.CreateRemissionNoteRequest {
+				req := fixtures.CreateDefaultRemissionNoteRequest()
+				req.GoodsTitle = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Remission note with invalid goods title",
+			req: func() *structs.CreateRemissionNoteRequest {
+				req := fixtures.CreateDefaultRemissionNoteRequest()
+				req.GoodsTitle = utils.ToStringPointer("06")
+				return req
+			},
+			wantErr:   true,
+			errorCode: "InvalidGoodsTitle",
+		},
+
+		// ------ VALIDACIONES DE RECEPTOR ------
+		{
+			name: "Remission note without receiver document number",
+			req: func() *structs.CreateRemissionNoteRequest {
+				req := fixtures.CreateDefaultRemissionNoteRequest()
+				req.Receiver.DocumentNumber = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+		{
+			name: "Remission note without receiver activity code",
+			req: func() *structs.CreateRemissionNoteRequest {
+				req := fixtures.CreateDefaultRemissionNoteRequest()
+				req.Receiver.ActivityCode = nil
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+
+		// ------ VALIDACIONES DE DOCUMENTOS RELACIONADOS ------
+		{
+			name: "Remission note with incomplete related document",
+			req: func() *structs.CreateRemissionNoteRequest {
+				req := fixtures.CreateDefaultRemissionNoteRequest()
+				relatedDoc := fixtures.CreateDefaultRelatedDocument()
+				relatedDoc.DocumentNumber = ""
+				req.RelatedDocs = []structs.RelatedDocRequest{relatedDoc}
+				return req
+			},
+			wantErr:   true,
+			errorCode: "RequiredField",
+		},
+	}
+
+	// Ejecutar casos de prueba
+	mapper := request_mapper.NewRemissionNoteMapper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req()
+
+			got, err := mapper.MapToRemissionNoteData(req, issuer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errorCode != "" {
+					test.AssertErrorCode(t, err, tt.errorCode)
+				}
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NotNil(t, got)
+			assert.NotNil(t, got.InputDataCommon)
+			assert.NotNil(t, got.InputDataCommon.Issuer)
+			assert.NotNil(t, got.InputDataCommon.Identification)
+			assert.Equal(t, constants.NotaRemisionElectronica, got.Identification.GetDTEType())
+			assert.NotNil(t, got.Receiver)
+			assert.NotNil(t, got.GoodsTitle)
+			assert.Equal(t, *req.GoodsTitle, got.GoodsTitle.GetValue())
+			assert.Len(t, got.Items, len(req.Items))
+			assert.NotNil(t, got.RemissionSummary)
+			assert.Empty(t, got.RemissionSummary.GetPaymentTypes())
+
+			if req.RelatedDocs != nil {
+				assert.Len(t, got.RelatedDocs, len(req.RelatedDocs))
+			}
+			if req.Appendixes != nil {
+				assert.Len(t, got.Appendixes, len(req.Appendixes))
+			}
+		})
+	}
+}
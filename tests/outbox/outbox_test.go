@@ -0,0 +1,251 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	coreDTE "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/outbox/models"
+	transmitterModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const (
+	branchID       = uint(3)
+	generationCode = "0F6C1B8E-5D5A-4B0E-9E3B-7A2B4C6D8E10"
+	stamp          = "2025ABCDEF0123456789"
+)
+
+// memoryRepository implementa OutboxRepositoryPort en memoria
+type memoryRepository struct {
+	entries []models.OutboxEntry
+}
+
+func (r *memoryRepository) Create(_ context.Context, entry *models.OutboxEntry) error {
+	entry.ID = uint(len(r.entries) + 1)
+	r.entries = append(r.entries, *entry)
+	return nil
+}
+
+func (r *memoryRepository) Update(_ context.Context, entry *models.OutboxEntry) error {
+	r.entries[entry.ID-1] = *entry
+	return nil
+}
+
+func (r *memoryRepository) GetDue(_ context.Context, now time.Time, _ int) ([]models.OutboxEntry, error) {
+	var due []models.OutboxEntry
+	for _, entry := range r.entries {
+		if isDue(entry, now) {
+			due = append(due, entry)
+		}
+	}
+	return due, nil
+}
+
+func (r *memoryRepository) Claim(_ context.Context, id uint, now, until time.Time) (bool, error) {
+	if !isDue(r.entries[id-1], now) {
+		return false, nil
+	}
+	r.entries[id-1].LockedUntil = &until
+	return true, nil
+}
+
+func isDue(entry models.OutboxEntry, now time.Time) bool {
+	return (entry.Status == models.EntryTransmitted || entry.Status == models.EntrySaved) &&
+		entry.NextAttemptAt != nil && !entry.NextAttemptAt.After(now) &&
+		(entry.LockedUntil == nil || !entry.LockedUntil.After(now))
+}
+
+func (r *memoryRepository) GetInterrupted(_ context.Context, _, _ time.Time, _ int) ([]models.OutboxEntry, error) {
+	return nil, nil
+}
+
+// makeDue adelanta el próximo intento de todos los documentos para que el job los procese
+func (r *memoryRepository) makeDue() {
+	past := time.Now().Add(-time.Hour)
+	for i := range r.entries {
+		if r.entries[i].NextAttemptAt != nil {
+			r.entries[i].NextAttemptAt = &past
+		}
+	}
+}
+
+// flakyDTEManager falla al almacenar los documentos mientras failures sea mayor a cero
+type flakyDTEManager struct {
+	dte_documents.DTEManager
+	failures int
+	stored   []string
+}
+
+func (m *flakyDTEManager) Create(_ context.Context, _ interface{}, _ string, status string, _ *string) error {
+	if m.failures > 0 {
+		m.failures--
+		return errors.New("database unavailable")
+	}
+	m.stored = append(m.stored, status)
+	return nil
+}
+
+func (m *flakyDTEManager) GetByGenerationCode(_ context.Context, _ uint, _ string) (*coreDTE.DTEDocument, error) {
+	return nil, errors.New("document not found")
+}
+
+// fakeAuth obtiene la sucursal que emitió los documentos
+type fakeAuth struct {
+	auth.AuthManager
+}
+
+func (fakeAuth) GetBranchByBranchID(_ context.Context, id uint) (*user.BranchOffice, error) {
+	return &user.BranchOffice{ID: id, User: &user.User{ID: 7, NIT: "06140101011011", AuthType: "basic"}}, nil
+}
+
+// fakePublisher registra los eventos publicados
+type fakePublisher struct {
+	eventTypes []string
+}
+
+func (p *fakePublisher) Publish(_ context.Context, _ uint, eventType string, _ interface{}) {
+	p.eventTypes = append(p.eventTypes, eventType)
+}
+
+func newDocument(dteType string) map[string]interface{} {
+	return map[string]interface{}{
+		"identificacion": map[string]interface{}{
+			"tipoDte":          dteType,
+			"numeroControl":    "DTE-05-00000000-000000000000001",
+			"codigoGeneracion": generationCode,
+		},
+	}
+}
+
+func TestOutboxRetriesFailedSaveAndRunsOperations(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{}
+	manager := outbox.NewOutboxService(repo)
+	dteManager := &flakyDTEManager{failures: 1}
+	publisher := &fakePublisher{}
+	useCase := dte.NewDTEOutboxUseCase(fakeAuth{}, dteManager, manager, nil, publisher)
+
+	operations := 0
+	useCase.RegisterOperations(constants.NotaCreditoElectronica, func(_ context.Context, id uint, _ interface{}) error {
+		assert.Equal(t, branchID, id)
+		operations++
+		return nil
+	})
+
+	ctx := context.Background()
+	document := newDocument(constants.NotaCreditoElectronica)
+	receptionStamp := stamp
+
+	// 1. El documento se registra antes de transmitirse y su almacenamiento falla después de que Hacienda lo recibe
+	entry, err := useCase.Register(ctx, branchID, document)
+	require.NoError(t, err)
+	assert.Equal(t, models.EntryPending, repo.entries[0].Status)
+
+	err = useCase.Complete(ctx, entry, &transmitterModels.TransmitResult{Status: "PROCESADO", ReceptionStamp: &receptionStamp}, document)
+	require.Error(t, err)
+
+	stored := repo.entries[0]
+	assert.Equal(t, models.EntryTransmitted, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Equal(t, "database unavailable", stored.LastError)
+	require.NotNil(t, stored.ReceptionStamp)
+	assert.Equal(t, stamp, *stored.ReceptionStamp)
+	assert.Zero(t, operations)
+	assert.Empty(t, publisher.eventTypes)
+
+	// 2. El job retoma el documento desde el outbox, lo almacena y ejecuta sus operaciones adicionales
+	repo.makeDue()
+	completed, err := useCase.ProcessPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, completed)
+
+	stored = repo.entries[0]
+	assert.Equal(t, models.EntryCompleted, stored.Status)
+	assert.Empty(t, stored.LastError)
+	assert.Nil(t, stored.NextAttemptAt)
+	assert.Equal(t, []string{constants.DocumentReceived}, dteManager.stored)
+	assert.Equal(t, 1, operations)
+	assert.Equal(t, []string{event.DTEReceived}, publisher.eventTypes)
+
+	// 3. Un documento completado no vuelve a procesarse
+	completed, err = useCase.ProcessPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, completed)
+}
+
+func TestOutboxRetryFailsEntryAfterMaxAttempts(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{}
+	manager := outbox.NewOutboxService(repo)
+	ctx := context.Background()
+
+	entry, err := manager.Register(ctx, branchID, newDocument(constants.NotaDebitoElectronica))
+	require.NoError(t, err)
+	require.NoError(t, manager.RecordTransmission(ctx, entry, &transmitterModels.TransmitResult{Status: "PROCESADO"}))
+	require.NoError(t, manager.Advance(ctx, entry, models.EntrySaved))
+
+	for i := 1; i < outbox.MaxAttempts; i++ {
+		require.NoError(t, manager.Retry(ctx, entry, errors.New("balance unavailable")))
+		assert.Equal(t, models.EntrySaved, entry.Status)
+		require.NotNil(t, entry.NextAttemptAt)
+	}
+
+	require.NoError(t, manager.Retry(ctx, entry, errors.New("balance unavailable")))
+	assert.Equal(t, models.EntryFailed, repo.entries[0].Status)
+	assert.Equal(t, outbox.MaxAttempts, repo.entries[0].Attempts)
+	assert.Nil(t, repo.entries[0].NextAttemptAt)
+	assert.True(t, repo.entries[0].IsFinished())
+
+	assert.Equal(t, outbox.RetryBaseDelay, outbox.NextRetryDelay(1))
+	assert.Equal(t, outbox.RetryMaxDelay, outbox.NextRetryDelay(outbox.MaxAttempts))
+}
+
+func TestOutboxJobSkipsEntryClaimedByTransmittingRequest(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{}
+	manager := outbox.NewOutboxService(repo)
+	dteManager := &flakyDTEManager{}
+	useCase := dte.NewDTEOutboxUseCase(fakeAuth{}, dteManager, manager, nil, &fakePublisher{})
+	ctx := context.Background()
+
+	// 1. La solicitud registra la transmisión y conserva la reserva mientras completa el documento
+	entry, err := manager.Register(ctx, branchID, newDocument(constants.FacturaElectronica))
+	require.NoError(t, err)
+	require.NoError(t, manager.RecordTransmission(ctx, entry, &transmitterModels.TransmitResult{Status: "PROCESADO"}))
+	require.NotNil(t, entry.NextAttemptAt)
+	assert.WithinDuration(t, time.Now().Add(outbox.RetryBaseDelay), *entry.NextAttemptAt, time.Minute)
+
+	// 2. Aunque su próximo intento se cumpla, el job no toma un documento reservado
+	repo.makeDue()
+	completed, err := useCase.ProcessPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, completed)
+	assert.Empty(t, dteManager.stored)
+
+	// 3. Solo uno de dos procesos concurrentes obtiene la reserva una vez expirada
+	past := time.Now().Add(-time.Minute)
+	repo.entries[0].LockedUntil = &past
+	claimed, err := manager.Claim(ctx, &repo.entries[0])
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = manager.Claim(ctx, &repo.entries[0])
+	require.NoError(t, err)
+	assert.False(t, claimed)
+}
@@ -0,0 +1,175 @@
+package printing
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/printing"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/qrcode"
+	"github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const qrLink = "https://admin.factura.gob.sv/consultaPublica?ambiente=00&codGen=A1B2C3D4-0000-0000-0000-000000000001&fechaEmi=2025-01-01"
+
+func invoiceDocument(items int) map[string]interface{} {
+	body := make([]interface{}, 0, items)
+	for i := 1; i <= items; i++ {
+		body = append(body, map[string]interface{}{
+			"numItem":      float64(i),
+			"cantidad":     float64(2),
+			"descripcion":  fmt.Sprintf("Producto de prueba número %d con una descripción larga para forzar el ajuste de línea", i),
+			"precioUni":    12.5,
+			"montoDescu":   float64(0),
+			"ventaNoSuj":   float64(0),
+			"ventaExenta":  float64(0),
+			"ventaGravada": float64(25),
+		})
+	}
+
+	return map[string]interface{}{
+		"identificacion": map[string]interface{}{
+			"ambiente":         "00",
+			"tipoDte":          constants.FacturaElectronica,
+			"numeroControl":    "DTE-01-M001P001-000000000000001",
+			"codigoGeneracion": "A1B2C3D4-0000-0000-0000-000000000001",
+			"tipoModelo":       float64(1),
+			"tipoOperacion":    float64(1),
+			"fecEmi":           "2025-01-01",
+			"horEmi":           "10:20:30",
+			"tipoMoneda":       "USD",
+		},
+		"emisor": map[string]interface{}{
+			"nit":    "06141234567890",
+			"nrc":    "1234567",
+			"nombre": "Empresa de Prueba, S.A. de C.V.",
+			"direccion": map[string]interface{}{
+				"departamento": "06",
+				"municipio":    "14",
+				"complemento":  "Colonia Escalón (Edificio Torre) \\ Nivel 12",
+			},
+		},
+		"receptor": map[string]interface{}{
+			"nombre": "Cliente Ejemplo",
+		},
+		"cuerpoDocumento": body,
+		"resumen": map[string]interface{}{
+			"totalGravada": float64(items * 25),
+			"totalPagar":   float64(items * 25),
+			"totalLetras":  "DOSCIENTOS CINCUENTA 00/100 USD",
+		},
+	}
+}
+
+// assertValidPDF verifica la estructura del documento: encabezado, fin de archivo y que cada
+// entrada de la tabla de referencias cruzadas apunte al inicio de su objeto
+func assertValidPDF(t *testing.T, data []byte) int {
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.")))
+	require.True(t, bytes.HasSuffix(bytes.TrimSpace(data), []byte("%%EOF")))
+
+	match := regexp.MustCompile(`startxref\n(\d+)`).FindSubmatch(data)
+	require.NotNil(t, match)
+	start, err := strconv.Atoi(string(match[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data[start:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(data[start:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data[offset:], []byte(fmt.Sprintf("%d 0 obj", i+1))), "object %d", i+1)
+	}
+
+	return len(regexp.MustCompile(`/Type /Page\b`).FindAll(data, -1))
+}
+
+func TestRenderInvoice(t *testing.T) {
+	renderer := printing.NewDTEPDFRenderer()
+	stamp := "2025A1B2C3D4E5F6A7B8C9D0E1F2A3B4C5D6E7F8"
+
+	tests := []struct {
+		name  string
+		items int
+		pages int
+	}{
+		{name: "Single page invoice", items: 3, pages: 1},
+		{name: "Invoice spanning several pages", items: 60, pages: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := renderer.Render(&dte.PrintableDTE{
+				DTEType:        constants.FacturaElectronica,
+				Status:         constants.DocumentReceived,
+				Transmission:   constants.TransmissionNormal,
+				ReceptionStamp: &stamp,
+				QRLink:         qrLink,
+				Document:       invoiceDocument(tt.items),
+				Footer:         "Empresa de Prueba - DTE-01-M001P001-000000000000001",
+			})
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, assertValidPDF(t, data), tt.pages)
+		})
+	}
+}
+
+func TestRenderAccountingLiquidation(t *testing.T) {
+	document := invoiceDocument(0)
+	document["identificacion"].(map[string]interface{})["tipoDte"] = constants.DocContableLiquidacionElectronico
+	document["cuerpoDocumento"] = map[string]interface{}{
+		"periodoLiquidacionFechaInicio": "2024-12-01",
+		"periodoLiquidacionFechaFin":    "2024-12-31",
+		"valorOperaciones":              float64(300),
+		"liquidoApagar":                 330.7,
+	}
+
+	data, err := printing.NewDTEPDFRenderer().Render(&dte.PrintableDTE{
+		DTEType:  constants.DocContableLiquidacionElectronico,
+		Status:   constants.DocumentInvalid,
+		QRLink:   qrLink,
+		Document: document,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, assertValidPDF(t, data))
+}
+
+func TestRenderIgnoresInvalidLogo(t *testing.T) {
+	test.TestMain(t)
+
+	data, err := printing.NewDTEPDFRenderer().Render(&dte.PrintableDTE{
+		DTEType:  constants.FacturaElectronica,
+		QRLink:   qrLink,
+		Document: invoiceDocument(1),
+		Logo:     []byte("not an image"),
+	})
+	require.NoError(t, err)
+	assertValidPDF(t, data)
+	assert.NotContains(t, string(data), "/Subtype /Image")
+}
+
+func TestEncodeQRLink(t *testing.T) {
+	code, err := qrcode.Encode(qrLink)
+	require.NoError(t, err)
+	assert.Equal(t, 7, code.Version)
+	assert.Equal(t, 45, code.Size)
+
+	// Los patrones de búsqueda ocupan tres esquinas: borde oscuro, anillo claro y centro oscuro
+	for _, corner := range [][2]int{{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7}} {
+		x, y := corner[0], corner[1]
+		assert.True(t, code.Dark(x, y))
+		assert.True(t, code.Dark(x+6, y+6))
+		assert.False(t, code.Dark(x+1, y+1))
+		assert.True(t, code.Dark(x+3, y+3))
+	}
+
+	_, err = qrcode.Encode(string(make([]byte, 500)))
+	assert.ErrorIs(t, err, qrcode.ErrDataTooLong)
+}
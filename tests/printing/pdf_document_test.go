@@ -0,0 +1,186 @@
+package printing
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/pdf"
+)
+
+// pdfObject es un objeto indirecto del documento con su diccionario y, si es un flujo, su contenido descomprimido
+type pdfObject struct {
+	offset int
+	dict   string
+	stream []byte
+}
+
+var (
+	objectHeader = regexp.MustCompile(`(?m)^(\d+) 0 obj\n`)
+	streamLength = regexp.MustCompile(`/Length (\d+)`)
+	reference    = regexp.MustCompile(`(\d+) 0 R`)
+	trailerDict  = regexp.MustCompile(`trailer\n<< /Size (\d+) /Root (\d+) 0 R /Info (\d+) 0 R >>\nstartxref\n(\d+)\n%%EOF\n$`)
+)
+
+// parsePDF recorre los objetos del documento y su tabla de referencias cruzadas, verificando que la tabla tenga una
+// entrada por objeto con su posición exacta y que la longitud declarada de cada flujo coincida con su contenido
+func parsePDF(t *testing.T, data []byte) (map[int]pdfObject, int) {
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+
+	// 1. Trailer y tabla de referencias cruzadas
+	trailer := trailerDict.FindSubmatch(data)
+	require.NotNil(t, trailer, "trailer")
+	size, _ := strconv.Atoi(string(trailer[1]))
+	root, _ := strconv.Atoi(string(trailer[2]))
+	start, _ := strconv.Atoi(string(trailer[4]))
+
+	xref := data[start:]
+	header := fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", size)
+	require.True(t, bytes.HasPrefix(xref, []byte(header)), "xref header")
+
+	offsets := make([]int, size)
+	for id := 1; id < size; id++ {
+		entry := xref[len(header)+(id-1)*20 : len(header)+id*20]
+		require.Regexp(t, `^\d{10} 00000 n \n$`, string(entry), "xref entry %d", id)
+		offsets[id], _ = strconv.Atoi(string(entry[:10]))
+	}
+
+	// 2. Objetos indirectos: cada uno debe estar registrado en la tabla con su posición
+	objects := make(map[int]pdfObject)
+	for _, match := range objectHeader.FindAllSubmatchIndex(data[:start], -1) {
+		id, _ := strconv.Atoi(string(data[match[2]:match[3]]))
+		require.Less(t, id, size, "object %d outside xref", id)
+		require.Equal(t, offsets[id], match[0], "xref offset of object %d", id)
+
+		body := data[match[1]:start]
+		end := bytes.Index(body, []byte("endobj\n"))
+		require.GreaterOrEqual(t, end, 0, "object %d without endobj", id)
+		body = body[:end]
+
+		object := pdfObject{offset: match[0]}
+		if streamStart := bytes.Index(body, []byte("\nstream\n")); streamStart >= 0 {
+			object.dict = string(body[:streamStart])
+			length := streamLength.FindStringSubmatch(object.dict)
+			require.NotNil(t, length, "object %d stream without length", id)
+			n, _ := strconv.Atoi(length[1])
+
+			raw := body[streamStart+len("\nstream\n"):]
+			require.True(t, bytes.HasPrefix(raw[n:], []byte("\nendstream\n")), "object %d stream length", id)
+
+			zr, err := zlib.NewReader(bytes.NewReader(raw[:n]))
+			require.NoError(t, err, "object %d stream", id)
+			object.stream, err = io.ReadAll(zr)
+			require.NoError(t, err, "object %d stream", id)
+		} else {
+			object.dict = string(body)
+		}
+
+		objects[id] = object
+	}
+	require.Len(t, objects, size-1, "every xref entry must point to an object")
+
+	// 3. Las referencias apuntan a objetos existentes
+	for id, object := range objects {
+		for _, ref := range reference.FindAllStringSubmatch(object.dict, -1) {
+			target, _ := strconv.Atoi(ref[1])
+			assert.Contains(t, objects, target, "object %d references missing object %d", id, target)
+		}
+	}
+
+	return objects, root
+}
+
+func testImage(alpha uint8) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 3))
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 4; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 60), G: uint8(y * 80), B: 200, A: alpha})
+		}
+	}
+
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, img)
+	return buf.Bytes()
+}
+
+func TestPDFDocumentStructure(t *testing.T) {
+	doc := pdf.New(pdf.LetterWidth, pdf.LetterHeight)
+	doc.SetTitle("Factura (prueba)")
+
+	opaque, err := doc.AddImage(testImage(0xFF))
+	require.NoError(t, err)
+	transparent, err := doc.AddImage(testImage(0x80))
+	require.NoError(t, err)
+
+	first := doc.AddPage()
+	first.Text(40, 60, pdf.HelveticaBold, 12, pdf.Black, "Total (USD): 10\\00")
+	first.Line(40, 70, 570, 70, 1, pdf.Black)
+	first.Image(opaque, 40, 80, 40, 30)
+
+	second := doc.AddPage()
+	second.FillRect(40, 40, 100, 20, pdf.Color{R: 230, G: 230, B: 230})
+	second.Image(transparent, 40, 80, 40, 30)
+	second.Text(40, 120, pdf.Helvetica, 10, pdf.Black, "Página 2")
+
+	data, err := doc.Bytes()
+	require.NoError(t, err)
+
+	objects, root := parsePDF(t, data)
+
+	// 1. Catálogo y árbol de páginas
+	require.Contains(t, objects[root].dict, "/Type /Catalog")
+	pagesID, _ := strconv.Atoi(regexp.MustCompile(`/Pages (\d+) 0 R`).FindStringSubmatch(objects[root].dict)[1])
+	pages := objects[pagesID].dict
+	assert.Contains(t, pages, "/Count 2")
+
+	kids := reference.FindAllStringSubmatch(regexp.MustCompile(`/Kids \[([^\]]*)\]`).FindStringSubmatch(pages)[1], -1)
+	require.Len(t, kids, 2)
+
+	// 2. Cada página declara sus recursos y su contenido descomprime a los operadores dibujados
+	contents := make([]string, 0, len(kids))
+	for _, kid := range kids {
+		id, _ := strconv.Atoi(kid[1])
+		page := objects[id].dict
+		assert.Contains(t, page, "/Type /Page ")
+		assert.Contains(t, page, fmt.Sprintf("/Parent %d 0 R", pagesID))
+		assert.Contains(t, page, "/MediaBox [0 0 612 792]")
+
+		contentID, _ := strconv.Atoi(regexp.MustCompile(`/Contents (\d+) 0 R`).FindStringSubmatch(page)[1])
+		contents = append(contents, string(objects[contentID].stream))
+	}
+
+	assert.Contains(t, contents[0], "/F2 12 Tf 40 732 Td (Total \\(USD\\): 10\\\\00) Tj ET")
+	assert.Contains(t, contents[0], "40 722 m 570 722 l S")
+	assert.Contains(t, contents[0], "/Im1 Do")
+	assert.Contains(t, contents[1], "(P\xe1gina 2) Tj")
+	assert.Contains(t, contents[1], "/Im2 Do")
+	assert.NotContains(t, contents[1], "/Im1 Do")
+
+	// 3. Las imágenes conservan sus pixeles y solo la transparente incluye máscara
+	var images, masks int
+	for _, object := range objects {
+		if !bytes.Contains([]byte(object.dict), []byte("/Subtype /Image")) {
+			continue
+		}
+		if bytes.Contains([]byte(object.dict), []byte("/DeviceGray")) {
+			masks++
+			assert.Equal(t, bytes.Repeat([]byte{0x80}, 12), object.stream)
+			continue
+		}
+		images++
+		assert.Contains(t, object.dict, "/Width 4 /Height 3")
+		assert.Len(t, object.stream, 4*3*3)
+	}
+	assert.Equal(t, 2, images)
+	assert.Equal(t, 1, masks)
+}
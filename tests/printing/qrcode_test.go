@@ -0,0 +1,133 @@
+package printing
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/qrcode"
+)
+
+// matrix representa los módulos del código, una fila por línea con 1 para los oscuros y 0 para los claros
+func matrix(code *qrcode.Code) string {
+	var sb strings.Builder
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			if code.Dark(x, y) {
+				sb.WriteByte('1')
+			} else {
+				sb.WriteByte('0')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// matrixDigest obtiene los primeros 8 bytes en hexadecimal del SHA-256 de la matriz del código
+func matrixDigest(code *qrcode.Code) string {
+	sum := sha256.Sum256([]byte(matrix(code)))
+	return hex.EncodeToString(sum[:8])
+}
+
+func TestEncodeMatchesReferenceMatrix(t *testing.T) {
+	// Generado con rsc.io/qr/coding v0.2.0: versión 1, nivel M, máscara 2
+	expected := strings.Join([]string{
+		"111111100101101111111",
+		"100000100101001000001",
+		"101110101010001011101",
+		"101110101000101011101",
+		"101110101001101011101",
+		"100000101100101000001",
+		"111111101010101111111",
+		"000000001111100000000",
+		"101111100110101111100",
+		"010100010100100101110",
+		"011110111011010010110",
+		"101101011100000111100",
+		"001000110101010010110",
+		"000000001011111101100",
+		"111111100010101101110",
+		"100000101001111000101",
+		"101110101110100100010",
+		"101110101000100011000",
+		"101110101001010101100",
+		"100000100110000100100",
+		"111111101011010101010",
+	}, "\n") + "\n"
+
+	code, err := qrcode.EncodeWithMask("DTE-01", 2)
+	require.NoError(t, err)
+	assert.Equal(t, expected, matrix(code))
+}
+
+func TestEncodeMatchesReferenceForEveryVersionAndMask(t *testing.T) {
+	// Resúmenes de las matrices generadas con rsc.io/qr/coding v0.2.0 para el mismo contenido, versión, nivel M y
+	// cada máscara de 0 a 7. Cubren un bloque (1, 3), dos grupos de bloques (8, 10) e información de versión (7 a 10)
+	tests := []struct {
+		name    string
+		text    string
+		version int
+		digests [8]string
+	}{
+		{
+			name:    "Version 1",
+			text:    "DTE-01",
+			version: 1,
+			digests: [8]string{"94b7e52f948d5196", "90a55567a8f88ae2", "eb91ddd3cf42990c", "3a547bacd9c694e9", "9bf6b1ba92c2ebd8", "7392402cc68ef044", "a50d58de42765316", "bec12803b7a45ba0"},
+		},
+		{
+			name:    "Version 3",
+			text:    "https://admin.factura.gob.sv",
+			version: 3,
+			digests: [8]string{"a2c066b0d88cd858", "3d612be575080275", "26d11393314df8ca", "a55578d9cbe5b0bb", "6b754092b1a2ef8d", "f883ad9251ce0f48", "a751cfcfa07d4336", "e743e1736be4ee99"},
+		},
+		{
+			name:    "Version 5",
+			text:    strings.Repeat("DTE-01-M001P001-", 4) + "XXXXXX",
+			version: 5,
+			digests: [8]string{"23fdf66a0b5faffd", "5d5127f0b15cf16f", "471e46bd889db646", "24f3757957ac9014", "87a2019e82a357ed", "0de7f5c8622c8af0", "4e63a3d60dc9ea38", "c43ff25788849a30"},
+		},
+		{
+			name:    "Version 7",
+			text:    qrLink,
+			version: 7,
+			digests: [8]string{"dc0d894d020f5cce", "d57d37333a3448a2", "ca61574df3a8edc2", "b39976ed6083373d", "c5ad64eb38881abb", "8aca7712f8ed2f7a", "6ac12870ae38efcc", "e6aedbe13bddf1e6"},
+		},
+		{
+			name:    "Version 8",
+			text:    strings.Repeat("codigoGeneracion=A1B2C3D4-0000-0000-0000-00000000000", 2) + strings.Repeat("Z", 34),
+			version: 8,
+			digests: [8]string{"d678d285ffe3426b", "061cc81abf8f5b88", "eb7f35812642a9d3", "f3d496772fd448c2", "fe9172c88b3310ea", "533de621e639992a", "9a904ab84add5372", "deb9d521926eaa43"},
+		},
+		{
+			name:    "Version 10",
+			text:    strings.Repeat("0123456789ABCDEF", 12) + "abcdefgh",
+			version: 10,
+			digests: [8]string{"f7fdb43bb130891d", "b650af1891d2c3f0", "80fb14775a7c6e2b", "9b8c314ef65c11f6", "8cb7ac1d4f2f92ec", "41b8d543a9537f88", "6dbb1d18de7432a4", "9124adaf027c74c5"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for mask, digest := range tt.digests {
+				code, err := qrcode.EncodeWithMask(tt.text, mask)
+				require.NoError(t, err)
+				assert.Equal(t, tt.version, code.Version)
+				assert.Equal(t, digest, matrixDigest(code), "mask %d", mask)
+			}
+
+			// La máscara seleccionada por penalización produce la misma matriz que al indicarla
+			code, err := qrcode.Encode(tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.digests[code.Mask], matrixDigest(code))
+		})
+	}
+
+	_, err := qrcode.EncodeWithMask("DTE-01", 8)
+	assert.ErrorIs(t, err, qrcode.ErrInvalidMask)
+}
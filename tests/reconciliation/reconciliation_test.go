@@ -0,0 +1,228 @@
+package reconciliation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/dte_documents"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/reconciliation/models"
+	transmitterModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+	domainPorts "github.com/MarlonG1/api-facturacion-sv/internal/domain/ports"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const (
+	userID   = uint(7)
+	branchID = uint(3)
+	stamp    = "2025ABCDEF0123456789"
+)
+
+// memoryRepository implementa ReconciliationRepositoryPort en memoria
+type memoryRepository struct {
+	pending       []models.PendingDocument
+	sequences     []models.FailedSequence
+	stored        map[string]bool
+	reconciled    []uint
+	discrepancies []models.Discrepancy
+}
+
+func (r *memoryRepository) GetPendingDocuments(_ context.Context, _ time.Time, _ int) ([]models.PendingDocument, error) {
+	return r.pending, nil
+}
+
+func (r *memoryRepository) GetUnreconciledSequences(_ context.Context, _ time.Time, _ int) ([]models.FailedSequence, error) {
+	return r.sequences, nil
+}
+
+func (r *memoryRepository) MarkSequenceReconciled(_ context.Context, id uint) error {
+	r.reconciled = append(r.reconciled, id)
+	return nil
+}
+
+func (r *memoryRepository) DocumentExists(_ context.Context, generationCode string) (bool, error) {
+	return r.stored[generationCode], nil
+}
+
+func (r *memoryRepository) SaveDiscrepancy(_ context.Context, discrepancy *models.Discrepancy) error {
+	r.discrepancies = append(r.discrepancies, *discrepancy)
+	return nil
+}
+
+func (r *memoryRepository) ListDiscrepancies(_ context.Context, _ uint, _ *models.DiscrepancyFilters) ([]models.Discrepancy, int64, error) {
+	return r.discrepancies, int64(len(r.discrepancies)), nil
+}
+
+// fakeTransmitter responde la consulta de cada documento con el estado indicado por su código de generación, los
+// documentos sin estado no existen en Hacienda
+type fakeTransmitter struct {
+	ports.DTETransmitter
+	statuses map[string]string
+}
+
+func (t *fakeTransmitter) CheckDocumentStatus(_ context.Context, document interface{}, _ string) (*transmitterModels.TransmitResult, error) {
+	generationCode := document.(map[string]interface{})["identificacion"].(map[string]interface{})["codigoGeneracion"].(string)
+	status, ok := t.statuses[generationCode]
+	if !ok {
+		return nil, errors.New("document not found")
+	}
+
+	result := &transmitterModels.TransmitResult{Status: status}
+	if status == reconciliation.MHProcessedStatus {
+		receptionStamp := stamp
+		result.ReceptionStamp = &receptionStamp
+	} else {
+		result.MessageDesc = "Documento rechazado"
+	}
+	return result, nil
+}
+
+// memoryDTEManager registra los documentos actualizados y creados
+type memoryDTEManager struct {
+	dte_documents.DTEManager
+	updated []dte.DTEDetails
+	created []string
+}
+
+func (m *memoryDTEManager) UpdateDTE(_ context.Context, _ uint, document dte.DTEDetails) error {
+	m.updated = append(m.updated, document)
+	return nil
+}
+
+func (m *memoryDTEManager) Create(_ context.Context, document interface{}, _ string, status string, _ *string) error {
+	m.created = append(m.created, status)
+	return nil
+}
+
+// fakeAuth obtiene la sucursal del usuario de pruebas
+type fakeAuth struct {
+	auth.AuthManager
+}
+
+func (fakeAuth) GetBranchByBranchID(_ context.Context, id uint) (*user.BranchOffice, error) {
+	return &user.BranchOffice{ID: id, User: &user.User{ID: userID, NIT: "06140101011011", AuthType: "basic"}}, nil
+}
+
+// fakeCache contiene las marcas de tiempo de la última autenticación del usuario
+type fakeCache struct {
+	domainPorts.CacheManager
+}
+
+func (fakeCache) Get(_ string) (string, error) {
+	return `{"IssuedAt":1741600000,"ExpiresAt":1741686400}`, nil
+}
+
+type fakeTokens struct {
+	domainPorts.TokenManager
+}
+
+func (fakeTokens) GetSecretKey() string { return "secret" }
+
+// fakePublisher registra los eventos publicados
+type fakePublisher struct {
+	eventTypes []string
+}
+
+func (p *fakePublisher) Publish(_ context.Context, _ uint, eventType string, _ interface{}) {
+	p.eventTypes = append(p.eventTypes, eventType)
+}
+
+func documentJSON(t *testing.T, dteType, generationCode string) string {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]interface{}{
+		"identificacion": map[string]interface{}{
+			"tipoDte":          dteType,
+			"codigoGeneracion": generationCode,
+			"numeroControl":    "DTE-" + dteType + "-00000000-000000000000001",
+		},
+	})
+	require.NoError(t, err)
+	return string(data)
+}
+
+func pendingDocument(t *testing.T, generationCode string) models.PendingDocument {
+	return models.PendingDocument{
+		GenerationCode: generationCode,
+		BranchID:       branchID,
+		DTEType:        constants.FacturaElectronica,
+		JSONData:       documentJSON(t, constants.FacturaElectronica, generationCode),
+	}
+}
+
+func TestReconcileRepairsPendingDocumentsFromHaciendaStatus(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{pending: []models.PendingDocument{
+		pendingDocument(t, "GEN-RECEIVED"),
+		pendingDocument(t, "GEN-REJECTED"),
+		pendingDocument(t, "GEN-UNKNOWN"),
+	}}
+	transmitter := &fakeTransmitter{statuses: map[string]string{
+		"GEN-RECEIVED": reconciliation.MHProcessedStatus,
+		"GEN-REJECTED": reconciliation.MHRejectedStatus,
+	}}
+	dteManager := &memoryDTEManager{}
+	publisher := &fakePublisher{}
+	service := reconciliation.NewReconciliationService(repo, fakeAuth{}, dteManager, transmitter, fakeCache{}, fakeTokens{}, publisher, nil)
+
+	result, err := service.Reconcile(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Checked)
+	assert.Equal(t, 2, result.Discrepancies)
+
+	// El documento que Hacienda no conoce sigue pendiente
+	require.Len(t, dteManager.updated, 2)
+	assert.Equal(t, constants.DocumentReceived, dteManager.updated[0].Status)
+	require.NotNil(t, dteManager.updated[0].ReceptionStamp)
+	assert.Equal(t, stamp, *dteManager.updated[0].ReceptionStamp)
+	assert.Equal(t, constants.DocumentRejected, dteManager.updated[1].Status)
+
+	require.Len(t, repo.discrepancies, 2)
+	assert.Equal(t, models.ActionMarkedReceived, repo.discrepancies[0].Action)
+	assert.Equal(t, userID, repo.discrepancies[0].UserID)
+	assert.Equal(t, models.ActionMarkedRejected, repo.discrepancies[1].Action)
+	assert.Equal(t, []string{event.DTEReceived, event.DTERejected}, publisher.eventTypes)
+}
+
+func TestReconcileRecoversFailedSequencesReceivedByHacienda(t *testing.T) {
+	test.TestMain(t)
+
+	repo := &memoryRepository{
+		stored: map[string]bool{"GEN-STORED": true},
+		sequences: []models.FailedSequence{
+			{ID: 1, BranchID: branchID, OriginalRequestData: documentJSON(t, constants.FacturaElectronica, "GEN-LOST")},
+			{ID: 2, BranchID: branchID, OriginalRequestData: documentJSON(t, constants.FacturaElectronica, "GEN-STORED")},
+			{ID: 3, BranchID: branchID, OriginalRequestData: documentJSON(t, constants.FacturaElectronica, "GEN-UNKNOWN")},
+			{ID: 4, BranchID: branchID, OriginalRequestData: "not json"},
+		},
+	}
+	transmitter := &fakeTransmitter{statuses: map[string]string{"GEN-LOST": reconciliation.MHProcessedStatus}}
+	dteManager := &memoryDTEManager{}
+	service := reconciliation.NewReconciliationService(repo, fakeAuth{}, dteManager, transmitter, fakeCache{}, fakeTokens{}, &fakePublisher{}, nil)
+
+	result, err := service.Reconcile(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Checked)
+	assert.Equal(t, 1, result.Discrepancies)
+
+	assert.Equal(t, []string{constants.DocumentReceived}, dteManager.created)
+	require.Len(t, repo.discrepancies, 1)
+	assert.Equal(t, models.ActionRecovered, repo.discrepancies[0].Action)
+	assert.Equal(t, reconciliation.LocalStatusMissing, repo.discrepancies[0].LocalStatus)
+
+	// El documento que Hacienda no conoce se revisa de nuevo en la siguiente ejecución
+	assert.ElementsMatch(t, []uint{1, 2, 4}, repo.reconciled)
+}
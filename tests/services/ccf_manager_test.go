@@ -715,7 +715,7 @@ func TestCreditFiscalServiceCreate(t *testing.T) {
 			mockSeqNumberManager := mocks.NewMockSequentialNumberManager(ctrl)
 			tt.setupMock(mockSeqNumberManager)
 
-			service := ccf.NewCCFService(mockSeqNumberManager)
+			service := ccf.NewCCFService(mockSeqNumberManager, mocks.NewMockDTEManager(ctrl))
 
 			result, err := service.Create(context.Background(), ccfData, 1)
 
@@ -823,7 +823,7 @@ func TestCreditFiscalServiceCreateWithDifferentOperationConditions(t *testing.T)
 			mockSeqNumberManager := mocks.NewMockSequentialNumberManager(ctrl)
 			tt.setupMock(mockSeqNumberManager)
 
-			service := ccf.NewCCFService(mockSeqNumberManager)
+			service := ccf.NewCCFService(mockSeqNumberManager, mocks.NewMockDTEManager(ctrl))
 
 			result, err := service.Create(context.Background(), ccfData, 1)
 
@@ -855,7 +855,7 @@ func TestCreditFiscalServiceCreateWithDifferentItems(t *testing.T) {
 		gomock.Any(),
 	).Return("DTE-03-C0010001-000000000012345", nil).AnyTimes()
 
-	service := ccf.NewCCFService(mockSeqNumberManager)
+	service := ccf.NewCCFService(mockSeqNumberManager, mocks.NewMockDTEManager(ctrl))
 
 	ccf, err := fixtures.BuildCCFWithMixedItemsType()
 	if err != nil {
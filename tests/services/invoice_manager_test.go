@@ -505,7 +505,7 @@ func TestInvoiceServiceCreate(t *testing.T) {
 			mockSeqNumberManager := mocks.NewMockSequentialNumberManager(ctrl)
 			tt.setupMock(mockSeqNumberManager)
 
-			service := invoice.NewInvoiceService(mockSeqNumberManager)
+			service := invoice.NewInvoiceService(mockSeqNumberManager, mocks.NewMockDTEManager(ctrl))
 
 			result, err := service.Create(context.Background(), invoiceData, 1)
 
@@ -605,7 +605,7 @@ func TestInvoiceServiceCreateWithDifferentOperationConditions(t *testing.T) {
 			mockSeqNumberManager := mocks.NewMockSequentialNumberManager(ctrl)
 			tt.setupMock(mockSeqNumberManager)
 
-			service := invoice.NewInvoiceService(mockSeqNumberManager)
+			service := invoice.NewInvoiceService(mockSeqNumberManager, mocks.NewMockDTEManager(ctrl))
 
 			result, err := service.Create(context.Background(), invoiceData, 1)
 
@@ -637,7 +637,7 @@ func TestInvoiceServiceCreateWithMixedItemTypes(t *testing.T) {
 		gomock.Any(),
 	).Return("DTE-01-F0010001-000000000012345", nil)
 
-	service := invoice.NewInvoiceService(mockSeqNumberManager)
+	service := invoice.NewInvoiceService(mockSeqNumberManager, mocks.NewMockDTEManager(ctrl))
 
 	// Crear una factura con tipos de ítems mixtos
 	builder := fixtures.NewInvoiceBuilder()
@@ -690,7 +690,7 @@ func TestInvoiceServiceCreateWithElectronicPayment(t *testing.T) {
 		gomock.Any(),
 	).Return("DTE-01-F0010001-000000000012345", nil)
 
-	service := invoice.NewInvoiceService(mockSeqNumberManager)
+	service := invoice.NewInvoiceService(mockSeqNumberManager, mocks.NewMockDTEManager(ctrl))
 
 	// Crear una factura con pago electrónico
 	builder := fixtures.NewInvoiceBuilder()
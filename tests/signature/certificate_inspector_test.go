@@ -0,0 +1,73 @@
+package signature
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/signing/signer"
+)
+
+// buildMHCertificate genera un certificado con el formato XML que entrega el Ministerio de Hacienda
+func buildMHCertificate(t *testing.T, nit, password string, notAfter time.Time) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	private, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	public, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	hash := sha512.Sum512([]byte(password))
+
+	return []byte(fmt.Sprintf(`<CertificadoMH>
+	<nit>%s</nit>
+	<publicKey><keyType>PUBLIC</keyType><algorithm>RSA</algorithm><encodied>%s</encodied><format>X.509</format></publicKey>
+	<privateKey><keyType>PRIVATE</keyType><algorithm>RSA</algorithm><encodied>%s</encodied><format>PKCS#8</format><clave>%s</clave></privateKey>
+	<activated>true</activated>
+	<certificado><basicEstructure><validity><notAfter>%d</notAfter></validity></basicEstructure></certificado>
+</CertificadoMH>`, nit, base64.StdEncoding.EncodeToString(public), base64.StdEncoding.EncodeToString(private),
+		hex.EncodeToString(hash[:]), notAfter.UnixMilli()))
+}
+
+func TestCertificateInspector(t *testing.T) {
+	notAfter := time.Now().Add(365 * 24 * time.Hour).Truncate(time.Millisecond)
+	content := buildMHCertificate(t, "06141234567890", "secret", notAfter)
+	inspector := signer.NewCertificateInspector()
+
+	tests := []struct {
+		name     string
+		content  []byte
+		password string
+		wantErr  bool
+	}{
+		{name: "Valid certificate", content: content, password: "secret"},
+		{name: "Wrong private password", content: content, password: "other", wantErr: true},
+		{name: "Not a Hacienda certificate", content: []byte("<html></html>"), password: "secret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := inspector.Inspect(tt.content, tt.password)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "06141234567890", info.NIT)
+			assert.Len(t, info.Fingerprint, 64)
+			require.NotNil(t, info.ExpiresAt)
+			assert.True(t, notAfter.Equal(*info.ExpiresAt))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package signature
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/certificate/models"
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/crypt"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/signing/signer"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+// activatedCertificates registra los certificados activados, el NIT no posee un certificado activo previo salvo que
+// se indique en active
+type activatedCertificates struct {
+	certificate.CertificateRepositoryPort
+	active    *models.SigningCertificate
+	activated []*models.SigningCertificate
+}
+
+func (r *activatedCertificates) GetActiveByNIT(_ context.Context, _ string) (*models.SigningCertificate, error) {
+	if r.active == nil {
+		return nil, errPackage.ErrCertificateNotFound
+	}
+	return r.active, nil
+}
+
+func (r *activatedCertificates) Activate(_ context.Context, cert *models.SigningCertificate, _ string) error {
+	r.activated = append(r.activated, cert)
+	return nil
+}
+
+func TestCertificateUploadRequiresNativeSigner(t *testing.T) {
+	test.TestMain(t)
+	const nit = "06141234567890"
+	upload := &models.CertificateUpload{
+		Content:     buildMHCertificate(t, nit, "secret", time.Now().Add(365*24*time.Hour)),
+		PasswordPri: "secret",
+	}
+
+	// Con el firmador externo se rechazan el registro y la renovación sin almacenar el certificado
+	repo := &activatedCertificates{}
+	external := certificate.NewCertificateService(repo, nil, signer.NewCertificateInspector(), crypt.NewCryptService(), "certificates-key", false, nil)
+
+	_, err := external.Upload(context.Background(), 1, nit, upload)
+	var serviceErr *shared_error.ServiceError
+	require.True(t, errors.As(err, &serviceErr), "expected a service error, got %v", err)
+	assert.Equal(t, "CertificateUploadRequiresNativeSigner", serviceErr.GetCode())
+
+	repo.active = &models.SigningCertificate{ID: 1, NIT: nit, IsActive: true}
+	_, err = external.Rotate(context.Background(), 1, nit, upload)
+	require.True(t, errors.As(err, &serviceErr), "expected a service error, got %v", err)
+	assert.Equal(t, "CertificateUploadRequiresNativeSigner", serviceErr.GetCode())
+	assert.Empty(t, repo.activated)
+
+	// Con el firmador nativo el certificado se activa
+	repo = &activatedCertificates{}
+	native := certificate.NewCertificateService(repo, nil, signer.NewCertificateInspector(), crypt.NewCryptService(), "certificates-key", true, nil)
+
+	cert, err := native.Upload(context.Background(), 1, nit, upload)
+	require.NoError(t, err)
+	assert.Equal(t, nit, cert.NIT)
+	assert.Len(t, repo.activated, 1)
+}
@@ -0,0 +1,34 @@
+package signature
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/signing/signer"
+)
+
+func TestFileCertificateSourceRequiresConfiguredDirectory(t *testing.T) {
+	dir := t.TempDir()
+	data := buildMHCertificate(t, "06141234567890", "secret", time.Now().Add(time.Hour))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "06141234567890.crt"), data, 0o600))
+
+	// El certificado se lee del directorio configurado
+	loaded, err := signer.NewFileCertificateSource(dir).Load(context.Background(), "06141234567890")
+	require.NoError(t, err)
+	assert.Equal(t, data, loaded)
+
+	// Sin directorio no se lee del directorio de trabajo, aunque contenga el certificado del NIT
+	workingDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(workingDir) })
+
+	_, err = signer.NewFileCertificateSource("").Load(context.Background(), "06141234567890")
+	assert.Error(t, err)
+}
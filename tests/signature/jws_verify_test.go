@@ -0,0 +1,96 @@
+package signature
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/jws"
+)
+
+const signedPayload = `{"identificacion":{"tipoDte":"01","codigoGeneracion":"A1B2C3D4-0000-0000-0000-000000000001"},"emisor":{"nit":"06141234567890"},"resumen":{"totalPagar":11.3},"apendice":null}`
+
+// signPayload firma el contenido con RS512 de la misma forma que el firmador de Hacienda
+func signPayload(t *testing.T, key *rsa.PrivateKey, alg, payload string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"` + alg + `"}`))
+	input := header + "." + base64.RawURLEncoding.EncodeToString([]byte(payload))
+
+	signature, err := jwt.SigningMethodRS512.Sign(input, key)
+	require.NoError(t, err)
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestVerifySignedDTE(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	token := signPayload(t, key, jws.AlgorithmRS512, signedPayload)
+	parts := strings.Split(token, ".")
+	tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(strings.Replace(signedPayload, "11.3", "1.3", 1))) + "." + parts[2]
+
+	tests := []struct {
+		name    string
+		token   string
+		key     *rsa.PublicKey
+		wantErr error
+	}{
+		{name: "Valid signature", token: token, key: &key.PublicKey},
+		{name: "Signed with another certificate", token: token, key: &otherKey.PublicKey, wantErr: jws.ErrInvalidSignature},
+		{name: "Tampered payload", token: tampered, key: &key.PublicKey, wantErr: jws.ErrInvalidSignature},
+		{name: "Unsupported algorithm", token: signPayload(t, key, "RS256", signedPayload), key: &key.PublicKey, wantErr: jws.ErrUnsupportedAlgorithm},
+		{name: "Malformed token", token: "not-a-jws", key: &key.PublicKey, wantErr: jws.ErrMalformedToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := jws.Verify(tt.token, tt.key)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, signedPayload, string(doc.Payload))
+		})
+	}
+}
+
+func TestComparePayload(t *testing.T) {
+	tests := []struct {
+		name      string
+		stored    string
+		wantDiffs []string
+	}{
+		{
+			name:   "Stored document with reception stamp and different key order",
+			stored: `{"resumen":{"totalPagar":11.30},"emisor":{"nit":"06141234567890"},"identificacion":{"codigoGeneracion":"A1B2C3D4-0000-0000-0000-000000000001","tipoDte":"01"},"apendice":[{"campo":"Datos del documento","etiqueta":"Sello de recepción","valor":"2025ABC"}]}`,
+		},
+		{
+			name:      "Stored document with modified total",
+			stored:    `{"identificacion":{"tipoDte":"01","codigoGeneracion":"A1B2C3D4-0000-0000-0000-000000000001"},"emisor":{"nit":"06141234567890"},"resumen":{"totalPagar":12},"apendice":null}`,
+			wantDiffs: []string{"resumen.totalPagar"},
+		},
+		{
+			name:      "Stored document with an extra appendix",
+			stored:    `{"identificacion":{"tipoDte":"01","codigoGeneracion":"A1B2C3D4-0000-0000-0000-000000000001"},"emisor":{"nit":"06141234567890"},"resumen":{"totalPagar":11.3},"apendice":[{"campo":"Vendedor","etiqueta":"Nombre","valor":"Juan"}]}`,
+			wantDiffs: []string{"apendice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs, err := jws.ComparePayload([]byte(signedPayload), []byte(tt.stored))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantDiffs, diffs)
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package signature
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/config"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/auth"
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/user"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/signing/signer"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/jws"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const signerNIT = "06141234567890"
+
+// unformattedDTE es el documento a firmar con espacios y saltos de línea, el firmador lo compacta antes de firmarlo
+const unformattedDTE = `{
+	"identificacion": {"tipoDte": "01", "codigoGeneracion": "A1B2C3D4-0000-0000-0000-000000000001"},
+	"emisor": {"nit": "06141234567890"},
+	"resumen": {"totalPagar": 11.3},
+	"apendice": null
+}`
+
+// certificateFiles implementa CertificateSource en memoria y cuenta las cargas de cada certificado
+type certificateFiles struct {
+	data  map[string][]byte
+	loads int
+}
+
+func (s *certificateFiles) Load(_ context.Context, nit string) ([]byte, error) {
+	s.loads++
+	data, ok := s.data[nit]
+	if !ok {
+		return nil, errPackage.ErrCertificateNotFound
+	}
+	return data, nil
+}
+
+// signingClients implementa la consulta de clientes por NIT con la contraseña privada indicada
+type signingClients struct {
+	auth.AuthRepositoryPort
+	password string
+}
+
+func (r *signingClients) GetByNIT(_ context.Context, nit string) (*user.User, error) {
+	return &user.User{NIT: nit, PasswordPri: r.password}, nil
+}
+
+// steppedClock implementa TimeProvider con una hora que solo avanza al indicarlo
+type steppedClock struct {
+	now time.Time
+}
+
+func (c *steppedClock) Now() time.Time        { return c.now }
+func (c *steppedClock) Sleep(d time.Duration) { c.now = c.now.Add(d) }
+
+func newNativeSigner(password string, data []byte) (*signer.NativeDTESigner, *certificateFiles, *signingClients, *steppedClock) {
+	source := &certificateFiles{data: map[string][]byte{signerNIT: data}}
+	clients := &signingClients{password: password}
+	clock := &steppedClock{now: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)}
+	return signer.NewNativeDTESigner(clients, source, clock), source, clients, clock
+}
+
+// verifySignedDTE verifica el JWS con la llave pública del certificado y compara el documento firmado
+func verifySignedDTE(t *testing.T, token string, certificate []byte) {
+	t.Helper()
+
+	cert, err := signer.ParseMHCertificate(certificate)
+	require.NoError(t, err)
+	key, err := cert.RSAPublicKey()
+	require.NoError(t, err)
+
+	doc, err := jws.Verify(token, key)
+	require.NoError(t, err)
+	assert.JSONEq(t, unformattedDTE, string(doc.Payload))
+}
+
+func assertServiceErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var serviceErr *shared_error.ServiceError
+	require.True(t, errors.As(err, &serviceErr), "expected service error %s, got %v", code, err)
+	assert.Equal(t, code, serviceErr.Code)
+}
+
+func TestParseMHCertificate(t *testing.T) {
+	content := buildMHCertificate(t, signerNIT, "secret", time.Now().Add(time.Hour))
+	withoutPrivateKey := strings.Replace(string(content), "<privateKey>", "<removed>", 1)
+	withoutPrivateKey = strings.Replace(withoutPrivateKey, "</privateKey>", "</removed>", 1)
+
+	tests := []struct {
+		name    string
+		content []byte
+		wantErr bool
+	}{
+		{name: "Valid certificate", content: content},
+		{name: "Certificate without private key", content: []byte(withoutPrivateKey), wantErr: true},
+		{name: "Malformed XML", content: []byte("<CertificadoMH><nit>"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cert, err := signer.ParseMHCertificate(tt.content)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, signerNIT, cert.NIT)
+			_, err = cert.RSAPrivateKey()
+			assert.NoError(t, err)
+			_, err = cert.RSAPublicKey()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMHCertificateCheckPassword(t *testing.T) {
+	cert, err := signer.ParseMHCertificate(buildMHCertificate(t, signerNIT, "secret", time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+
+	assert.True(t, cert.CheckPassword("secret"))
+	assert.False(t, cert.CheckPassword("Secret"))
+	assert.False(t, cert.CheckPassword(""))
+
+	// El hash almacenado se compara sin distinguir mayúsculas ni espacios alrededor
+	cert.PrivateKey.Password = "  " + strings.ToUpper(cert.PrivateKey.Password) + "\n"
+	assert.True(t, cert.CheckPassword("secret"))
+}
+
+func TestNativeSignerProducesVerifiableRS512Signature(t *testing.T) {
+	test.TestMain(t)
+
+	certificate := buildMHCertificate(t, signerNIT, "secret", time.Now().Add(time.Hour))
+	nativeSigner, _, _, _ := newNativeSigner("secret", certificate)
+
+	token, err := nativeSigner.SignDTE(context.Background(), []byte(unformattedDTE), signerNIT)
+	require.NoError(t, err)
+
+	// El encabezado y el contenido compactado son los que genera el firmador de Hacienda
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"alg":"RS512"}`, string(header))
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	assert.NotContains(t, string(payload), "\n")
+
+	verifySignedDTE(t, token, certificate)
+}
+
+func TestNativeSignerReloadsCertificateWhenPasswordDoesNotMatch(t *testing.T) {
+	test.TestMain(t)
+
+	previous := buildMHCertificate(t, signerNIT, "previous", time.Now().Add(time.Hour))
+	nativeSigner, source, clients, _ := newNativeSigner("previous", previous)
+
+	_, err := nativeSigner.SignDTE(context.Background(), []byte(unformattedDTE), signerNIT)
+	require.NoError(t, err)
+	require.Equal(t, 1, source.loads)
+
+	// Tras renovar el certificado, la nueva contraseña no coincide con el certificado en memoria y se recarga
+	renewed := buildMHCertificate(t, signerNIT, "renewed", time.Now().Add(time.Hour))
+	source.data[signerNIT] = renewed
+	clients.password = "renewed"
+
+	token, err := nativeSigner.SignDTE(context.Background(), []byte(unformattedDTE), signerNIT)
+	require.NoError(t, err)
+	assert.Equal(t, 2, source.loads)
+	verifySignedDTE(t, token, renewed)
+
+	// Una contraseña que tampoco coincide con el certificado recargado se rechaza
+	clients.password = "wrong"
+	_, err = nativeSigner.SignDTE(context.Background(), []byte(unformattedDTE), signerNIT)
+	assertServiceErrorCode(t, err, "InvalidCertificatePassword")
+	assert.Equal(t, 3, source.loads)
+}
+
+func TestNativeSignerReloadsCertificateAfterCacheExpires(t *testing.T) {
+	test.TestMain(t)
+
+	nativeSigner, source, _, clock := newNativeSigner("secret", buildMHCertificate(t, signerNIT, "secret", time.Now().Add(time.Hour)))
+
+	for i := 0; i < 2; i++ {
+		_, err := nativeSigner.SignDTE(context.Background(), []byte(unformattedDTE), signerNIT)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, source.loads)
+
+	// Antes de vencer sigue en memoria
+	clock.Sleep(5*time.Minute - time.Second)
+	_, err := nativeSigner.SignDTE(context.Background(), []byte(unformattedDTE), signerNIT)
+	require.NoError(t, err)
+	assert.Equal(t, 1, source.loads)
+
+	// Al vencer se vuelve a cargar, de modo que una desactivación se aplica sin reiniciar el servicio
+	clock.Sleep(time.Second)
+	delete(source.data, signerNIT)
+	_, err = nativeSigner.SignDTE(context.Background(), []byte(unformattedDTE), signerNIT)
+	assertServiceErrorCode(t, err, "CertificateNotFound")
+	assert.Equal(t, 2, source.loads)
+}
+
+func TestSignerModeValidation(t *testing.T) {
+	test.TestMain(t)
+
+	const signerURL = "http://localhost:8113/firmardocumento/"
+
+	tests := []struct {
+		name             string
+		mode             string
+		path             string
+		certificatesPath string
+		certificatesKey  string
+		wantMode         string
+		wantErr          string
+	}{
+		{name: "External mode by default", path: signerURL, wantMode: config.SignerModeExternal},
+		{name: "External mode requires the signer URL", mode: config.SignerModeExternal, wantErr: "SIGNER_PATH is required"},
+		{name: "Native mode with certificates path", mode: config.SignerModeNative, certificatesPath: "/certificates", wantMode: config.SignerModeNative},
+		{name: "Native mode requires the certificates path", mode: config.SignerModeNative, wantErr: "SIGNER_CERTIFICATES_PATH is required"},
+		{name: "Unknown mode", mode: "hsm", path: signerURL, wantErr: "SIGNER_MODE must be a valid mode"},
+		{name: "Short certificates key", mode: config.SignerModeNative, certificatesPath: "/certificates", certificatesKey: "short", wantErr: "SIGNER_CERTIFICATES_KEY must have at least 32 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.EnvConfig.Signer.Mode = tt.mode
+			config.EnvConfig.Signer.Path = tt.path
+			config.EnvConfig.Signer.Health = tt.path
+			config.EnvConfig.Signer.CertificatesPath = tt.certificatesPath
+			config.EnvConfig.Signer.CertificatesKey = tt.certificatesKey
+
+			err := config.ValidateSignerFields()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMode, config.EnvConfig.Signer.Mode)
+		})
+	}
+}
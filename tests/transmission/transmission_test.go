@@ -0,0 +1,175 @@
+package transmission
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/dte"
+	"github.com/MarlonG1/api-facturacion-sv/internal/application/ports"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/constants"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/common/dte_errors"
+	contingencyModels "github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/contingency/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/dte/transmitter/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/idempotency"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/circuit"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/api/helpers"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+// fakeSigner firma cualquier documento
+type fakeSigner struct{}
+
+func (fakeSigner) SignDTE(_ context.Context, _ json.RawMessage, _ string) (string, error) {
+	return "signed", nil
+}
+
+// scriptedTransmitter responde cada transmisión con el siguiente error de la lista, al agotarla recibe el documento
+type scriptedTransmitter struct {
+	ports.DTETransmitter
+	errs      []error
+	transmits int
+	checks    int
+}
+
+func (t *scriptedTransmitter) Transmit(_ context.Context, _ interface{}, _ string, _ string) (*models.TransmitResult, error) {
+	t.transmits++
+	if len(t.errs) > 0 {
+		err := t.errs[0]
+		t.errs = t.errs[1:]
+		return nil, err
+	}
+	return &models.TransmitResult{Status: dte.ReceivedStatus}, nil
+}
+
+func (t *scriptedTransmitter) CheckDocumentStatus(_ context.Context, _ interface{}, _ string) (*models.TransmitResult, error) {
+	t.checks++
+	return nil, errors.New("document not found")
+}
+
+func newConfig(maxAttempts int) *models.TransmissionConfig {
+	return &models.TransmissionConfig{
+		MaxAttempts:   maxAttempts,
+		RetryInterval: time.Millisecond,
+		MaxInterval:   5 * time.Millisecond,
+		BackoffFactor: 2,
+		Jitter:        0.2,
+	}
+}
+
+func unavailable() error {
+	return dte_errors.NewServiceUnavailableError(constants.CircuitHaciendaReception, &net.OpError{Op: "dial", Err: errors.New("connection refused")})
+}
+
+func TestRetryPolicyBackoffGrowsUpToMaxIntervalWithJitter(t *testing.T) {
+	test.TestMain(t)
+
+	policy := contingencyModels.RetryPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     10 * time.Second,
+		BackoffFactor:   2,
+		Jitter:          0.2,
+	}
+
+	for retry, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 8: 10 * time.Second} {
+		backoff := policy.Backoff(retry)
+		assert.GreaterOrEqual(t, backoff, time.Duration(float64(expected)*0.8), "retry %d", retry)
+		assert.LessOrEqual(t, backoff, time.Duration(float64(expected)*1.2), "retry %d", retry)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, policy.Wait(ctx, 1), context.Canceled)
+}
+
+func TestRetryTransmissionRetriesWhileHaciendaIsUnavailable(t *testing.T) {
+	test.TestMain(t)
+
+	transmitter := &scriptedTransmitter{errs: []error{unavailable(), unavailable()}}
+	base := dte.NewBaseTransmitter(transmitter, fakeSigner{}, newConfig(3))
+
+	result, err := base.RetryTransmission(context.Background(), map[string]interface{}{}, "token", "06140101011011")
+	require.NoError(t, err)
+	assert.Equal(t, dte.ReceivedStatus, result.Status)
+	assert.Equal(t, 3, transmitter.transmits)
+	assert.Equal(t, 2, transmitter.checks)
+}
+
+func TestRetryTransmissionReturnsWaitErrorWhenRequestIsCancelled(t *testing.T) {
+	test.TestMain(t)
+
+	transmitter := &scriptedTransmitter{errs: []error{unavailable()}}
+	base := dte.NewBaseTransmitter(transmitter, fakeSigner{}, newConfig(3))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := base.RetryTransmission(ctx, map[string]interface{}{}, "token", "06140101011011")
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, transmitter.transmits)
+	assert.Equal(t, 0, transmitter.checks)
+}
+
+func TestRetryTransmissionStopsWhenCircuitIsOpen(t *testing.T) {
+	test.TestMain(t)
+
+	transmitter := &scriptedTransmitter{errs: []error{unavailable(), dte_errors.NewCircuitOpenError(constants.CircuitHaciendaReception)}}
+	base := dte.NewBaseTransmitter(transmitter, fakeSigner{}, newConfig(5))
+
+	_, err := base.RetryTransmission(context.Background(), map[string]interface{}{}, "token", "06140101011011")
+
+	var unavailableErr *dte_errors.ServiceUnavailableError
+	require.ErrorAs(t, err, &unavailableErr)
+	assert.True(t, unavailableErr.CircuitOpen)
+	assert.Equal(t, 2, transmitter.transmits)
+}
+
+func TestRetryTransmissionTracksWhetherTheDocumentWasSent(t *testing.T) {
+	test.TestMain(t)
+
+	open := &scriptedTransmitter{errs: []error{dte_errors.NewCircuitOpenError(constants.CircuitHaciendaReception)}}
+	ctx, tracker := idempotency.WithTransmissionTracker(context.Background())
+	_, err := dte.NewBaseTransmitter(open, fakeSigner{}, newConfig(3)).RetryTransmission(ctx, map[string]interface{}{}, "token", "06140101011011")
+	require.Error(t, err)
+	assert.False(t, tracker.Transmitted())
+
+	failing := &scriptedTransmitter{errs: []error{unavailable()}}
+	ctx, tracker = idempotency.WithTransmissionTracker(context.Background())
+	_, err = dte.NewBaseTransmitter(failing, fakeSigner{}, newConfig(1)).RetryTransmission(ctx, map[string]interface{}{}, "token", "06140101011011")
+	require.Error(t, err)
+	assert.True(t, tracker.Transmitted())
+}
+
+func TestContingencyHandlerShortCircuitsWhileHaciendaIsDown(t *testing.T) {
+	test.TestMain(t)
+
+	breaker := circuit.NewCircuitBreaker(2, time.Minute)
+	handler := helpers.NewContingencyHandler(nil).WithCircuit(breaker)
+
+	// Un firmador no disponible es una falla del sistema del emisor
+	contingencyType, _ := handler.Classify(dte_errors.NewCircuitOpenError(constants.CircuitSigner))
+	require.NotNil(t, contingencyType)
+	assert.Equal(t, int8(constants.FallaConexionSistema), *contingencyType)
+
+	// Con el circuito de Hacienda abierto los errores de transmisión son no disponibilidad del MH
+	breaker.RecordFailure()
+	breaker.RecordFailure()
+	assert.Equal(t, constants.StateOpen, breaker.GetState())
+	assert.False(t, breaker.AllowRequest())
+
+	contingencyType, reason := handler.Classify(unavailable())
+	require.NotNil(t, contingencyType)
+	assert.Equal(t, int8(constants.NoDisponibilidadMH), *contingencyType)
+	assert.Equal(t, constants.GetContingencyReason(constants.NoDisponibilidadMH), *reason)
+
+	// Los rechazos de validación nunca pasan a contingencia
+	contingencyType, _ = handler.Classify(&dte_errors.ValidationError{})
+	assert.Nil(t, contingencyType)
+}
@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/crypt"
+	adapterWebhook "github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/webhook"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+func TestCreateSubscriptionRejectsNonPublicDestinations(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	service := newService(newMemoryRepository(), &fakeSender{status: 200}, 3)
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "Plain http", url: "http://example.com/hook"},
+		{name: "Localhost", url: "https://localhost:8080/hook"},
+		{name: "Localhost subdomain", url: "https://api.localhost/hook"},
+		{name: "Loopback", url: "https://127.0.0.1/hook"},
+		{name: "Cloud metadata", url: "https://169.254.169.254/latest/meta-data"},
+		{name: "Private network", url: "https://10.0.0.5/hook"},
+		{name: "Unspecified", url: "https://0.0.0.0/hook"},
+		{name: "IPv6 loopback", url: "https://[::1]/hook"},
+		{name: "IPv6 unique local", url: "https://[fd00::1]/hook"},
+		{name: "IPv4-mapped loopback", url: "https://[::ffff:127.0.0.1]/hook"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: tt.url, Events: []string{models.AllEvents}})
+			assert.Equal(t, "WebhookURLNotPublic", errorCode(t, err))
+		})
+	}
+
+	_, err := service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: "https://93.184.215.14/hook", Events: []string{models.AllEvents}})
+	assert.NoError(t, err)
+}
+
+func TestUpdateSubscriptionRejectsNonPublicDestinations(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	service := newService(newMemoryRepository(), &fakeSender{status: 200}, 3)
+
+	subscription, err := service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: "https://example.com/hook", Events: []string{models.AllEvents}})
+	require.NoError(t, err)
+
+	_, err = service.UpdateSubscription(ctx, userID, subscription.ID, &models.SubscriptionInput{URL: "https://169.254.169.254/hook"})
+	assert.Equal(t, "WebhookURLNotPublic", errorCode(t, err))
+}
+
+func TestPrivateNetworksAreAllowedWhenEnabled(t *testing.T) {
+	test.TestMain(t)
+	service := webhook.NewWebhookService(newMemoryRepository(), &fakeSender{status: 200}, crypt.NewCryptService(), encryptionKey, 3, true)
+
+	for _, url := range []string{"http://localhost:8080/hook", "https://10.0.0.5/hook", "http://127.0.0.1/hook"} {
+		_, err := service.CreateSubscription(context.Background(), userID, &models.SubscriptionInput{URL: url, Events: []string{models.AllEvents}})
+		assert.NoError(t, err, url)
+	}
+}
+
+func TestHTTPSenderOnlyConnectsToPublicAddresses(t *testing.T) {
+	test.TestMain(t)
+
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		received++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// El servidor de prueba escucha en loopback, igual que un servicio interno alcanzado por nombre de dominio
+	_, err := adapterWebhook.NewHTTPSender(false).Send(context.Background(), server.URL, nil, []byte(`{}`))
+	assert.ErrorContains(t, err, "is not a public address")
+	assert.Zero(t, received)
+
+	status, err := adapterWebhook.NewHTTPSender(true).Send(context.Background(), server.URL, nil, []byte(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.Equal(t, 1, received)
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for _, ip := range []string{"8.8.8.8", "93.184.215.14", "2606:4700:4700::1111"} {
+		assert.True(t, webhook.IsPublicIP(net.ParseIP(ip)), ip)
+	}
+	for _, ip := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "198.18.0.1", "0.0.0.0", "224.0.0.1", "::1", "fe80::1", "fd12::1"} {
+		assert.False(t, webhook.IsPublicIP(net.ParseIP(ip)), ip)
+	}
+}
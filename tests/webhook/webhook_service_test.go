@@ -0,0 +1,402 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errPackage "github.com/MarlonG1/api-facturacion-sv/internal/domain/core/error"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/core/event"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook"
+	"github.com/MarlonG1/api-facturacion-sv/internal/domain/webhook/models"
+	"github.com/MarlonG1/api-facturacion-sv/internal/infrastructure/adapters/crypt"
+	"github.com/MarlonG1/api-facturacion-sv/pkg/shared/shared_error"
+	test "github.com/MarlonG1/api-facturacion-sv/tests"
+)
+
+const (
+	encryptionKey = "webhook-test-key"
+	userID        = uint(7)
+	branchID      = uint(3)
+)
+
+// memoryRepository implementa WebhookRepositoryPort en memoria para un único usuario dueño de branchID
+type memoryRepository struct {
+	mu            sync.Mutex
+	nextSubID     uint
+	nextDelivery  uint
+	subscriptions map[uint]models.WebhookSubscription
+	deliveries    map[uint]models.WebhookDelivery
+	lockedUntil   map[uint]time.Time
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{
+		subscriptions: make(map[uint]models.WebhookSubscription),
+		deliveries:    make(map[uint]models.WebhookDelivery),
+		lockedUntil:   make(map[uint]time.Time),
+	}
+}
+
+func (r *memoryRepository) CreateSubscription(_ context.Context, subscription *models.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextSubID++
+	subscription.ID = r.nextSubID
+	r.subscriptions[subscription.ID] = *subscription
+	return nil
+}
+
+func (r *memoryRepository) ListSubscriptions(_ context.Context, owner uint) ([]models.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []models.WebhookSubscription
+	for _, subscription := range r.subscriptions {
+		if subscription.UserID == owner {
+			result = append(result, subscription)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryRepository) GetSubscription(_ context.Context, owner, id uint) (*models.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subscription, ok := r.subscriptions[id]
+	if !ok || subscription.UserID != owner {
+		return nil, errPackage.ErrWebhookNotFound
+	}
+	return &subscription, nil
+}
+
+func (r *memoryRepository) UpdateSubscription(_ context.Context, subscription *models.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscriptions[subscription.ID] = *subscription
+	return nil
+}
+
+func (r *memoryRepository) DeleteSubscription(_ context.Context, _ uint, id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.subscriptions, id)
+	return nil
+}
+
+func (r *memoryRepository) GetActiveSubscriptionsByBranch(_ context.Context, branch uint) ([]models.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []models.WebhookSubscription
+	for _, subscription := range r.subscriptions {
+		if branch == branchID && subscription.IsActive {
+			result = append(result, subscription)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryRepository) CreateDeliveries(_ context.Context, deliveries []*models.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, delivery := range deliveries {
+		r.nextDelivery++
+		delivery.ID = r.nextDelivery
+		stored := *delivery
+		stored.Subscription = nil
+		r.deliveries[delivery.ID] = stored
+	}
+	return nil
+}
+
+func (r *memoryRepository) GetPendingDeliveries(_ context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []models.WebhookDelivery
+	for id, delivery := range r.deliveries {
+		if r.isPending(id, now) && len(result) < limit {
+			subscription := r.subscriptions[delivery.SubscriptionID]
+			delivery.Subscription = &subscription
+			result = append(result, delivery)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryRepository) ClaimDelivery(_ context.Context, id uint, now, until time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.isPending(id, now) {
+		return false, nil
+	}
+	r.lockedUntil[id] = until
+	return true, nil
+}
+
+func (r *memoryRepository) UpdateDelivery(_ context.Context, delivery *models.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *delivery
+	stored.Subscription = nil
+	r.deliveries[delivery.ID] = stored
+	delete(r.lockedUntil, delivery.ID)
+	return nil
+}
+
+func (r *memoryRepository) ListDeliveries(_ context.Context, _ uint, _ *models.DeliveryFilters) ([]models.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []models.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		result = append(result, delivery)
+	}
+	return result, nil
+}
+
+func (r *memoryRepository) GetDelivery(_ context.Context, owner, id uint) (*models.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivery, ok := r.deliveries[id]
+	if !ok {
+		return nil, errPackage.ErrWebhookDeliveryNotFound
+	}
+	if subscription, exists := r.subscriptions[delivery.SubscriptionID]; exists && subscription.UserID == owner {
+		delivery.Subscription = &subscription
+	}
+	return &delivery, nil
+}
+
+func (r *memoryRepository) isPending(id uint, now time.Time) bool {
+	delivery, ok := r.deliveries[id]
+	if !ok || delivery.Status != models.DeliveryPending || delivery.NextAttemptAt == nil || delivery.NextAttemptAt.After(now) {
+		return false
+	}
+	lockedUntil, locked := r.lockedUntil[id]
+	return !locked || !lockedUntil.After(now)
+}
+
+func (r *memoryRepository) delivery(id uint) models.WebhookDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.deliveries[id]
+}
+
+// expire adelanta el próximo intento de las entregas pendientes para que estén listas de inmediato
+func (r *memoryRepository) expire() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	past := time.Now().Add(-time.Second)
+	for id, delivery := range r.deliveries {
+		if delivery.Status == models.DeliveryPending {
+			delivery.NextAttemptAt = &past
+			r.deliveries[id] = delivery
+		}
+	}
+}
+
+// sentRequest es una solicitud recibida por el fakeSender
+type sentRequest struct {
+	url     string
+	headers map[string]string
+	body    []byte
+}
+
+// fakeSender registra los envíos y responde con el estado configurado
+type fakeSender struct {
+	mu       sync.Mutex
+	status   int
+	requests []sentRequest
+}
+
+func (s *fakeSender) Send(_ context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, sentRequest{url: url, headers: headers, body: body})
+	if s.status < 200 || s.status >= 300 {
+		return s.status, errors.New("endpoint unavailable")
+	}
+	return s.status, nil
+}
+
+func (s *fakeSender) setStatus(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+func (s *fakeSender) sent() []sentRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]sentRequest(nil), s.requests...)
+}
+
+func newService(repo *memoryRepository, sender *fakeSender, maxAttempts int) webhook.WebhookManager {
+	return webhook.NewWebhookService(repo, sender, crypt.NewCryptService(), encryptionKey, maxAttempts, false)
+}
+
+func errorCode(t *testing.T, err error) string {
+	var serviceErr *shared_error.ServiceError
+	require.True(t, errors.As(err, &serviceErr), "expected a service error, got %v", err)
+	return serviceErr.GetCode()
+}
+
+func TestCreateSubscriptionValidatesAndEncryptsSecret(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	service := newService(repo, &fakeSender{status: 200}, 3)
+
+	_, err := service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: "ftp://example.com", Events: []string{models.AllEvents}})
+	assert.Equal(t, "InvalidWebhookURL", errorCode(t, err))
+
+	_, err = service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: "https://example.com/hook", Events: []string{"dte.unknown"}})
+	assert.Equal(t, "InvalidWebhookEvent", errorCode(t, err))
+
+	_, err = service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: "https://example.com/hook", Events: []string{models.AllEvents}, Secret: "short"})
+	assert.Equal(t, "InvalidWebhookSecret", errorCode(t, err))
+
+	subscription, err := service.CreateSubscription(ctx, userID, &models.SubscriptionInput{
+		URL:    "https://example.com/hook",
+		Events: []string{models.EventDTEReceived, models.EventDTEReceived, models.EventDTERejected},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, subscription.Secret, "a secret must be generated and returned on creation")
+	assert.Equal(t, []string{models.EventDTEReceived, models.EventDTERejected}, subscription.Events)
+	assert.True(t, subscription.IsActive)
+
+	stored, err := repo.GetSubscription(ctx, userID, subscription.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, subscription.Secret, stored.Secret, "the secret must be stored encrypted")
+
+	listed, err := service.ListSubscriptions(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Empty(t, listed[0].Secret)
+}
+
+func TestPublishSignsAndDeliversMatchingSubscriptions(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	sender := &fakeSender{status: 200}
+	service := newService(repo, sender, 3)
+
+	secret := "0123456789abcdef-secret"
+	_, err := service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: "https://example.com/received", Events: []string{models.EventDTEReceived}, Secret: secret})
+	require.NoError(t, err)
+	_, err = service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: "https://example.com/invalidated", Events: []string{models.EventDTEInvalidated}})
+	require.NoError(t, err)
+
+	service.Publish(ctx, branchID, models.EventDTEReceived, event.DTEPayload{GenerationCode: "DOC-1"})
+
+	require.Eventually(t, func() bool { return len(sender.sent()) == 1 }, time.Second, 10*time.Millisecond)
+	request := sender.sent()[0]
+	assert.Equal(t, "https://example.com/received", request.url)
+	assert.Equal(t, models.EventDTEReceived, request.headers[webhook.HeaderEvent])
+	assert.Contains(t, string(request.body), `"generation_code":"DOC-1"`)
+
+	expected := webhook.SignPayload(secret, request.headers[webhook.HeaderTimestamp], request.body)
+	assert.Equal(t, expected, request.headers[webhook.HeaderSignature])
+
+	require.Eventually(t, func() bool { return repo.delivery(1).Status == models.DeliveryDelivered }, time.Second, 10*time.Millisecond)
+	delivery := repo.delivery(1)
+	assert.Equal(t, 1, delivery.Attempts)
+	require.NotNil(t, delivery.ResponseStatus)
+	assert.Equal(t, 200, *delivery.ResponseStatus)
+}
+
+func TestFailedDeliveriesAreRetriedUntilAttemptsAreExhausted(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	sender := &fakeSender{status: 500}
+	service := newService(repo, sender, 2)
+
+	_, err := service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: "https://example.com/hook", Events: []string{models.AllEvents}})
+	require.NoError(t, err)
+
+	// 1. El primer envío falla y la entrega queda pendiente con espera
+	service.Publish(ctx, branchID, models.EventDTERejected, event.DTEPayload{GenerationCode: "DOC-1"})
+	require.Eventually(t, func() bool { return repo.delivery(1).Attempts == 1 }, time.Second, 10*time.Millisecond)
+
+	delivery := repo.delivery(1)
+	assert.Equal(t, models.DeliveryPending, delivery.Status)
+	assert.Equal(t, "endpoint unavailable", delivery.LastError)
+	require.NotNil(t, delivery.NextAttemptAt)
+	assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+
+	delivered, err := service.DeliverPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, delivered)
+	assert.Len(t, sender.sent(), 1, "the delivery must wait for its next attempt")
+
+	// 2. El segundo intento agota los intentos
+	repo.expire()
+	delivered, err = service.DeliverPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, delivered)
+
+	delivery = repo.delivery(1)
+	assert.Equal(t, models.DeliveryFailed, delivery.Status)
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.Nil(t, delivery.NextAttemptAt)
+}
+
+func TestReplayCreatesANewDeliveryOfTheSameEvent(t *testing.T) {
+	test.TestMain(t)
+	ctx := context.Background()
+	repo := newMemoryRepository()
+	sender := &fakeSender{status: 500}
+	service := newService(repo, sender, 1)
+
+	subscription, err := service.CreateSubscription(ctx, userID, &models.SubscriptionInput{URL: "https://example.com/hook", Events: []string{models.AllEvents}})
+	require.NoError(t, err)
+
+	service.Publish(ctx, branchID, models.EventDTEContingency, event.DTEPayload{GenerationCode: "DOC-1"})
+	require.Eventually(t, func() bool { return repo.delivery(1).Status == models.DeliveryFailed }, time.Second, 10*time.Millisecond)
+
+	sender.setStatus(204)
+	replay, err := service.Replay(ctx, userID, 1)
+	require.NoError(t, err)
+	assert.NotEqual(t, uint(1), replay.ID)
+	require.NotNil(t, replay.ReplayOf)
+	assert.Equal(t, uint(1), *replay.ReplayOf)
+	assert.Equal(t, repo.delivery(1).EventID, replay.EventID)
+	assert.Equal(t, models.DeliveryDelivered, repo.delivery(replay.ID).Status)
+
+	requests := sender.sent()
+	require.Len(t, requests, 2)
+	assert.Equal(t, requests[0].headers[webhook.HeaderWebhookID], requests[1].headers[webhook.HeaderWebhookID])
+	assert.Equal(t, requests[0].body, requests[1].body)
+
+	_, err = service.Replay(ctx, userID, 99)
+	assert.Equal(t, "WebhookDeliveryNotFound", errorCode(t, err))
+
+	require.NoError(t, service.DeleteSubscription(ctx, userID, subscription.ID))
+	_, err = service.Replay(ctx, userID, 1)
+	assert.Equal(t, "WebhookInactive", errorCode(t, err))
+}
+
+func TestNextRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Minute, webhook.NextRetryDelay(1))
+	assert.Equal(t, 4*time.Minute, webhook.NextRetryDelay(3))
+	assert.Equal(t, webhook.RetryMaxDelay, webhook.NextRetryDelay(12))
+}